@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attribute // import "go.opentelemetry.io/otel/attribute"
+
+import "slices"
+
+// Builder builds Sets. It holds the KeyValues added to it until Set is
+// called, and can be Reset to build another Set reusing the memory it
+// already allocated.
+//
+// When the KeyValues of a built Set are the same as the ones of the
+// previously built Set, that Set is returned without any allocation. This
+// makes a Builder suitable for hot paths repeatedly recording the same
+// attributes.
+//
+// The zero value is ready to use. A Builder must not be used concurrently.
+type Builder struct {
+	kvs []KeyValue
+
+	// last is the de-duplicated KeyValues of lastSet.
+	last    []KeyValue
+	lastSet Set
+}
+
+// Add adds kvs to the Set being built. If a key is added more than once, the
+// last value added is used.
+func (b *Builder) Add(kvs ...KeyValue) {
+	b.kvs = append(b.kvs, kvs...)
+}
+
+// Len returns the number of KeyValues added since the last Reset, including
+// the ones with duplicate keys.
+func (b *Builder) Len() int {
+	return len(b.kvs)
+}
+
+// Reset removes all the KeyValues added to b. The memory held by b is kept to
+// be reused.
+func (b *Builder) Reset() {
+	clear(b.kvs)
+	b.kvs = b.kvs[:0]
+}
+
+// Set returns the Set holding the KeyValues added to b. Duplicate keys are
+// eliminated by taking the last value added, the same way NewSet does.
+//
+// The KeyValues added are kept, more can be added before Set is called
+// again.
+func (b *Builder) Set() Set {
+	if len(b.kvs) == 0 {
+		return empty()
+	}
+
+	kvs := sortAndDedup(b.kvs)
+	if b.lastSet.equivalent.Valid() && slices.Equal(kvs, b.last) {
+		return b.lastSet
+	}
+
+	b.last = append(b.last[:0], kvs...)
+	b.lastSet = Set{equivalent: computeDistinct(kvs)}
+	return b.lastSet
+}
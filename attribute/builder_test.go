@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attribute_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestBuilder(t *testing.T) {
+	var b attribute.Builder
+	empty := b.Set()
+	assert.True(t, empty.Equals(attribute.EmptySet()), "zero value Builder")
+
+	b.Add(attribute.String("B", "1"), attribute.Int("A", 1))
+	b.Add(attribute.String("B", "2"))
+	assert.Equal(t, 3, b.Len())
+
+	want := attribute.NewSet(
+		attribute.Int("A", 1),
+		attribute.String("B", "2"),
+	)
+	got := b.Set()
+	assert.Truef(t, want.Equals(&got), "want %v, got %v", want.ToSlice(), got.ToSlice())
+
+	got = b.Set()
+	assert.True(t, want.Equals(&got), "repeated Set call")
+
+	b.Add(attribute.Int("A", 2))
+	want = attribute.NewSet(
+		attribute.Int("A", 2),
+		attribute.String("B", "2"),
+	)
+	got = b.Set()
+	assert.Truef(t, want.Equals(&got), "Add after Set: want %v, got %v", want.ToSlice(), got.ToSlice())
+
+	b.Reset()
+	assert.Equal(t, 0, b.Len())
+	got = b.Set()
+	assert.True(t, got.Equals(attribute.EmptySet()), "Set after Reset")
+
+	b.Add(attribute.Bool("C", true))
+	want = attribute.NewSet(attribute.Bool("C", true))
+	got = b.Set()
+	assert.True(t, want.Equals(&got), "reused Builder")
+}
+
+func TestBuilderAllocs(t *testing.T) {
+	attrs := []attribute.KeyValue{
+		attribute.String("B", "2"),
+		attribute.Int("A", 1),
+		attribute.Bool("C", true),
+	}
+
+	var b attribute.Builder
+	b.Add(attrs...)
+	_ = b.Set()
+	allocs := testing.AllocsPerRun(100, func() {
+		b.Reset()
+		b.Add(attrs...)
+		_ = b.Set()
+	})
+	assert.Zero(t, allocs, "building the same Set allocated")
+}
+
+func TestNewSetFromSortedSlice(t *testing.T) {
+	sorted := []attribute.KeyValue{
+		attribute.Int("A", 1),
+		attribute.String("B", "2"),
+		attribute.Bool("C", true),
+	}
+	s := attribute.NewSetFromSortedSlice(sorted)
+	want := attribute.NewSet(sorted...)
+	assert.True(t, want.Equals(&s))
+
+	sorted[0] = attribute.Int("A", 2)
+	v, _ := s.Value("A")
+	assert.Equal(t, int64(1), v.AsInt64(), "Set retained input slice")
+
+	unsorted := []attribute.KeyValue{
+		attribute.String("B", "2"),
+		attribute.Int("A", 1),
+		attribute.String("B", "3"),
+	}
+	s = attribute.NewSetFromSortedSlice(unsorted)
+	want = attribute.NewSet(
+		attribute.Int("A", 1),
+		attribute.String("B", "3"),
+	)
+	assert.Truef(t, want.Equals(&s), "unsorted input: want %v, got %v", want.ToSlice(), s.ToSlice())
+
+	s = attribute.NewSetFromSortedSlice(nil)
+	assert.True(t, s.Equals(attribute.EmptySet()), "empty input")
+}
+
+func BenchmarkBuilder(b *testing.B) {
+	attrs := []attribute.KeyValue{
+		attribute.String("B1", "2"),
+		attribute.String("C2", "5"),
+		attribute.String("B3", "2"),
+		attribute.String("C4", "1"),
+		attribute.String("A5", "4"),
+		attribute.String("C6", "3"),
+		attribute.String("A7", "1"),
+	}
+	var builder attribute.Builder
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		builder.Reset()
+		builder.Add(attrs...)
+		sinkSet = builder.Set()
+	}
+}
+
+func BenchmarkNewSetFromSortedSlice(b *testing.B) {
+	attrs := []attribute.KeyValue{
+		attribute.String("A5", "4"),
+		attribute.String("A7", "1"),
+		attribute.String("B1", "2"),
+		attribute.String("B3", "2"),
+		attribute.String("C2", "5"),
+		attribute.String("C4", "1"),
+		attribute.String("C6", "3"),
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sinkSet = attribute.NewSetFromSortedSlice(attrs)
+	}
+}
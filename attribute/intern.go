@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attribute // import "go.opentelemetry.io/otel/attribute"
+
+import "unique"
+
+// Intern returns kv with its key, and its value if it is a STRING or a
+// STRINGSLICE, replaced by canonical copies. All the strings interned with
+// the same content share the same memory.
+//
+// Interning is opt-in. It is useful for attributes with a small set of
+// values that are built repeatedly (e.g. HTTP routes, methods, or status
+// strings derived from each request) and retained by the SDKs, such as the
+// attributes of buffered spans or of metric streams. The memory of the
+// retained attributes is then shared instead of being allocated for every
+// occurrence. Interned strings are released once they are no longer
+// referenced, so interning does not grow the heap indefinitely.
+//
+// Interning has a cost: it hashes and looks up every string. Do not intern
+// attributes with high cardinality values (e.g. identifiers).
+func Intern(kv KeyValue) KeyValue {
+	kv.Key = Key(intern(string(kv.Key)))
+	switch kv.Value.vtype {
+	case STRING:
+		kv.Value.stringly = intern(kv.Value.stringly)
+	case STRINGSLICE:
+		s := kv.Value.asStringSlice()
+		for i := range s {
+			s[i] = intern(s[i])
+		}
+		kv.Value = StringSliceValue(s)
+	}
+	return kv
+}
+
+// InternSet returns a Set with the attributes of s interned with Intern.
+func InternSet(s *Set) Set {
+	if s.Len() == 0 {
+		return empty()
+	}
+	kvs := s.ToSlice()
+	for i := range kvs {
+		kvs[i] = Intern(kvs[i])
+	}
+	// The keys are unchanged, kvs is still sorted and de-duplicated.
+	return NewSetFromSortedSlice(kvs)
+}
+
+func intern(s string) string {
+	return unique.Make(s).Value()
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attribute_test
+
+import (
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func sameString(a, b string) bool {
+	return len(a) == len(b) && unsafe.StringData(a) == unsafe.StringData(b)
+}
+
+func TestIntern(t *testing.T) {
+	a := attribute.Intern(attribute.String(strings.Clone("http.route"), strings.Clone("/users/{id}")))
+	b := attribute.Intern(attribute.String(strings.Clone("http.route"), strings.Clone("/users/{id}")))
+	assert.Equal(t, a, b)
+	assert.True(t, sameString(string(a.Key), string(b.Key)), "keys not interned")
+	assert.True(t, sameString(a.Value.AsString(), b.Value.AsString()), "values not interned")
+
+	a = attribute.Intern(attribute.StringSlice("k", []string{strings.Clone("GET"), strings.Clone("POST")}))
+	b = attribute.Intern(attribute.StringSlice("k", []string{strings.Clone("GET"), strings.Clone("POST")}))
+	assert.Equal(t, []string{"GET", "POST"}, a.Value.AsStringSlice())
+	as, bs := a.Value.AsStringSlice(), b.Value.AsStringSlice()
+	for i := range as {
+		assert.Truef(t, sameString(as[i], bs[i]), "value %d not interned", i)
+	}
+
+	for _, kv := range []attribute.KeyValue{
+		attribute.Bool("k", true),
+		attribute.Int("k", 1),
+		attribute.Float64("k", 1),
+		attribute.IntSlice("k", []int{1, 2}),
+		attribute.Bytes("k", []byte("v")),
+	} {
+		assert.Equal(t, kv, attribute.Intern(kv), kv.Value.Type().String())
+	}
+}
+
+func TestInternSet(t *testing.T) {
+	empty := attribute.NewSet()
+	assert.Equal(t, empty, attribute.InternSet(&empty))
+
+	s0 := attribute.NewSet(
+		attribute.String(strings.Clone("method"), strings.Clone("GET")),
+		attribute.Int("status", 200),
+	)
+	s1 := attribute.NewSet(
+		attribute.String(strings.Clone("method"), strings.Clone("GET")),
+		attribute.Int("status", 200),
+	)
+	i0, i1 := attribute.InternSet(&s0), attribute.InternSet(&s1)
+	assert.True(t, i0.Equals(&s0), "interned set not equal")
+
+	v0, _ := i0.Value("method")
+	v1, _ := i1.Value("method")
+	assert.True(t, sameString(v0.AsString(), v1.AsString()), "values not interned")
+}
+
+func BenchmarkIntern(b *testing.B) {
+	kv := attribute.String("http.route", "/users/{id}")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = attribute.Intern(kv)
+	}
+}
@@ -117,6 +117,39 @@ func (k Key) StringSlice(v []string) KeyValue {
 	}
 }
 
+// Bytes creates a KeyValue instance with a BYTES Value.
+//
+// If creating both a key and value at the same time, use the provided
+// convenience function instead -- Bytes(name, value).
+func (k Key) Bytes(v []byte) KeyValue {
+	return KeyValue{
+		Key:   k,
+		Value: BytesValue(v),
+	}
+}
+
+// Slice creates a KeyValue instance with a SLICE Value.
+//
+// If creating both a key and value at the same time, use the provided
+// convenience function instead -- Slice(name, values...).
+func (k Key) Slice(v ...Value) KeyValue {
+	return KeyValue{
+		Key:   k,
+		Value: SliceValue(v...),
+	}
+}
+
+// Map creates a KeyValue instance with a MAP Value.
+//
+// If creating both a key and value at the same time, use the provided
+// convenience function instead -- Map(name, kvs...).
+func (k Key) Map(v ...KeyValue) KeyValue {
+	return KeyValue{
+		Key:   k,
+		Value: MapValue(v...),
+	}
+}
+
 // Defined returns true for non-empty keys.
 func (k Key) Defined() bool {
 	return len(k) != 0
@@ -93,6 +93,21 @@ func TestEmit(t *testing.T) {
 			v:    attribute.StringSliceValue([]string{"foo", "bar"}),
 			want: `["foo","bar"]`,
 		},
+		{
+			name: `test Key.Emit() can emit a string representing self.BYTES`,
+			v:    attribute.BytesValue([]byte("foo")),
+			want: "Zm9v",
+		},
+		{
+			name: `test Key.Emit() can emit a string representing self.SLICE`,
+			v:    attribute.SliceValue(attribute.StringValue("foo"), attribute.Int64Value(42)),
+			want: `["foo",42]`,
+		},
+		{
+			name: `test Key.Emit() can emit a string representing self.MAP`,
+			v:    attribute.MapValue(attribute.String("foo", "bar"), attribute.Bool("baz", true)),
+			want: `{"baz":true,"foo":"bar"}`,
+		},
 	} {
 		t.Run(testcase.name, func(t *testing.T) {
 			// proto: func (v attribute.Value) Emit() string {
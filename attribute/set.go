@@ -207,6 +207,43 @@ func NewSetWithFiltered(kvs []KeyValue, filter Filter) (Set, []KeyValue) {
 		return empty(), nil
 	}
 
+	kvs = sortAndDedup(kvs)
+
+	if filter != nil {
+		if div := filteredToFront(kvs, filter); div != 0 {
+			return Set{equivalent: computeDistinct(kvs[div:])}, kvs[:div]
+		}
+	}
+	return Set{equivalent: computeDistinct(kvs)}, nil
+}
+
+// NewSetFromSortedSlice returns a new Set holding kvs. It avoids the sorting
+// and de-duplication performed by NewSet for callers that already hold kvs
+// sorted by key with no duplicate keys.
+//
+// If kvs is not sorted or holds duplicate keys, it is sorted and
+// de-duplicated the same way NewSet does, re-ordering kvs.
+//
+// The returned Set does not retain kvs.
+func NewSetFromSortedSlice(kvs []KeyValue) Set {
+	if len(kvs) == 0 {
+		return empty()
+	}
+	for i := 1; i < len(kvs); i++ {
+		if kvs[i-1].Key >= kvs[i].Key {
+			return NewSet(kvs...)
+		}
+	}
+	return Set{equivalent: computeDistinct(kvs)}
+}
+
+// sortAndDedup sorts kvs by key and de-duplicates them with last-value-wins
+// semantics. It returns the tail of kvs holding the unique values.
+func sortAndDedup(kvs []KeyValue) []KeyValue {
+	if len(kvs) == 0 {
+		return kvs
+	}
+
 	// Stable sort so the following de-duplication can implement
 	// last-value-wins semantics.
 	slices.SortStableFunc(kvs, func(a, b KeyValue) int {
@@ -216,9 +253,8 @@ func NewSetWithFiltered(kvs []KeyValue, filter Filter) (Set, []KeyValue) {
 	position := len(kvs) - 1
 	offset := position - 1
 
-	// The requirements stated above require that the stable
-	// result be placed in the end of the input slice, while
-	// overwritten values are swapped to the beginning.
+	// The stable result is placed in the end of the input slice,
+	// while overwritten values are swapped to the beginning.
 	//
 	// De-duplicate with last-value-wins semantics.  Preserve
 	// duplicate values at the beginning of the input slice.
@@ -229,14 +265,7 @@ func NewSetWithFiltered(kvs []KeyValue, filter Filter) (Set, []KeyValue) {
 		position--
 		kvs[offset], kvs[position] = kvs[position], kvs[offset]
 	}
-	kvs = kvs[position:]
-
-	if filter != nil {
-		if div := filteredToFront(kvs, filter); div != 0 {
-			return Set{equivalent: computeDistinct(kvs[div:])}, kvs[:div]
-		}
-	}
-	return Set{equivalent: computeDistinct(kvs)}, nil
+	return kvs[position:]
 }
 
 // NewSetWithSortableFiltered returns a new Set.
@@ -329,6 +358,79 @@ func (l *Set) Filter(re Filter) (Set, []KeyValue) {
 	return Set{equivalent: computeDistinct(slice[div:])}, slice[:div]
 }
 
+// Merge returns a new Set holding the attributes of this Set and o. When both
+// Sets hold the same key, the value from o is used.
+func (l *Set) Merge(o *Set) Set {
+	switch {
+	case o.Len() == 0:
+		return l.copy()
+	case l.Len() == 0:
+		return o.copy()
+	}
+
+	// Both Sets are sorted, merge them without sorting again.
+	merged := make([]KeyValue, 0, l.Len()+o.Len())
+	iter := NewMergeIterator(o, l)
+	for iter.Next() {
+		merged = append(merged, iter.Attribute())
+	}
+	return Set{equivalent: computeDistinct(merged)}
+}
+
+// Without returns a new Set holding the attributes of this Set except the
+// ones with the passed keys.
+func (l *Set) Without(keys ...Key) Set {
+	var found bool
+	for _, k := range keys {
+		if l.HasValue(k) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return l.copy()
+	}
+
+	kept := make([]KeyValue, 0, l.Len())
+	for iter := l.Iter(); iter.Next(); {
+		if kv := iter.Attribute(); !slices.Contains(keys, kv.Key) {
+			kept = append(kept, kv)
+		}
+	}
+	if len(kept) == 0 {
+		return empty()
+	}
+	return Set{equivalent: computeDistinct(kept)}
+}
+
+// Project returns a new Set holding only the attributes of this Set with the
+// passed keys.
+func (l *Set) Project(keys ...Key) Set {
+	kept := make([]KeyValue, 0, min(len(keys), l.Len()))
+	for _, k := range keys {
+		if v, ok := l.Value(k); ok {
+			kept = append(kept, KeyValue{Key: k, Value: v})
+		}
+	}
+	if len(kept) == 0 {
+		return empty()
+	}
+	// The keys are not guaranteed to be ordered or unique.
+	kept = sortAndDedup(kept)
+	if len(kept) == l.Len() {
+		return l.copy()
+	}
+	return Set{equivalent: computeDistinct(kept)}
+}
+
+// copy returns a copy of l, or an empty Set if l is nil.
+func (l *Set) copy() Set {
+	if l == nil || !l.equivalent.Valid() {
+		return empty()
+	}
+	return *l
+}
+
 // computeDistinct returns a Distinct using either the fixed- or
 // reflect-oriented code path, depending on the size of the input. The input
 // slice is assumed to already be sorted and de-duplicated.
@@ -408,3 +408,158 @@ func BenchmarkNewSet(b *testing.B) {
 		sinkSet = attribute.NewSet(attrs...)
 	}
 }
+
+func TestSetMerge(t *testing.T) {
+	a := attribute.NewSet(
+		attribute.String("A", "a"),
+		attribute.String("B", "a"),
+		attribute.String("D", "a"),
+	)
+	b := attribute.NewSet(
+		attribute.String("B", "b"),
+		attribute.String("C", "b"),
+	)
+	empty := attribute.NewSet()
+
+	for _, tc := range []struct {
+		name   string
+		l, o   *attribute.Set
+		expect []attribute.KeyValue
+	}{
+		{
+			name: "Overlapping",
+			l:    &a,
+			o:    &b,
+			expect: []attribute.KeyValue{
+				attribute.String("A", "a"),
+				attribute.String("B", "b"),
+				attribute.String("C", "b"),
+				attribute.String("D", "a"),
+			},
+		},
+		{
+			name: "Reversed",
+			l:    &b,
+			o:    &a,
+			expect: []attribute.KeyValue{
+				attribute.String("A", "a"),
+				attribute.String("B", "a"),
+				attribute.String("C", "b"),
+				attribute.String("D", "a"),
+			},
+		},
+		{
+			name:   "EmptyOther",
+			l:      &a,
+			o:      &empty,
+			expect: a.ToSlice(),
+		},
+		{
+			name:   "EmptyReceiver",
+			l:      &empty,
+			o:      &b,
+			expect: b.ToSlice(),
+		},
+		{
+			name:   "NilOther",
+			l:      &a,
+			o:      nil,
+			expect: a.ToSlice(),
+		},
+		{
+			name:   "NilReceiver",
+			l:      nil,
+			o:      &b,
+			expect: b.ToSlice(),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.l.Merge(tc.o)
+			want := attribute.NewSet(tc.expect...)
+			assert.Equal(t, want.Equivalent(), got.Equivalent())
+		})
+	}
+}
+
+func TestSetWithout(t *testing.T) {
+	s := attribute.NewSet(
+		attribute.String("A", "a"),
+		attribute.String("B", "b"),
+		attribute.String("C", "c"),
+	)
+
+	got := s.Without("B", "Z")
+	want := attribute.NewSet(attribute.String("A", "a"), attribute.String("C", "c"))
+	assert.Equal(t, want.Equivalent(), got.Equivalent())
+
+	got = s.Without("Z")
+	assert.Equal(t, s.Equivalent(), got.Equivalent(), "no key removed")
+
+	got = s.Without()
+	assert.Equal(t, s.Equivalent(), got.Equivalent(), "no key passed")
+
+	got = s.Without("C", "A", "B")
+	assert.Equal(t, attribute.EmptySet().Equivalent(), got.Equivalent(), "all keys removed")
+
+	var nilSet *attribute.Set
+	got = nilSet.Without("A")
+	assert.Equal(t, attribute.EmptySet().Equivalent(), got.Equivalent(), "nil Set")
+}
+
+func TestSetProject(t *testing.T) {
+	s := attribute.NewSet(
+		attribute.String("A", "a"),
+		attribute.String("B", "b"),
+		attribute.String("C", "c"),
+	)
+
+	got := s.Project("C", "A", "Z", "C")
+	want := attribute.NewSet(attribute.String("A", "a"), attribute.String("C", "c"))
+	assert.Equal(t, want.Equivalent(), got.Equivalent())
+
+	got = s.Project("B", "C", "A")
+	assert.Equal(t, s.Equivalent(), got.Equivalent(), "all keys kept")
+
+	got = s.Project("Z")
+	assert.Equal(t, attribute.EmptySet().Equivalent(), got.Equivalent(), "no key kept")
+
+	got = s.Project()
+	assert.Equal(t, attribute.EmptySet().Equivalent(), got.Equivalent(), "no key passed")
+
+	var nilSet *attribute.Set
+	got = nilSet.Project("A")
+	assert.Equal(t, attribute.EmptySet().Equivalent(), got.Equivalent(), "nil Set")
+}
+
+func BenchmarkSetOperations(b *testing.B) {
+	s := attribute.NewSet(
+		attribute.String("A1", "1"),
+		attribute.String("B2", "2"),
+		attribute.String("C3", "3"),
+		attribute.String("D4", "4"),
+		attribute.String("E5", "5"),
+	)
+	o := attribute.NewSet(
+		attribute.String("B2", "b"),
+		attribute.String("F6", "6"),
+	)
+
+	b.Run("Merge", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkSet = s.Merge(&o)
+		}
+	})
+	b.Run("Without", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkSet = s.Without("B2", "D4")
+		}
+	})
+	b.Run("Project", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkSet = s.Project("B2", "D4")
+		}
+	})
+}
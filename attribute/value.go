@@ -4,9 +4,11 @@
 package attribute // import "go.opentelemetry.io/otel/attribute"
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 
 	attribute "go.opentelemetry.io/otel/attribute/internal"
@@ -17,6 +19,9 @@ import (
 // Type describes the type of the data Value holds.
 type Type int // nolint: revive  // redefines builtin Type.
 
+// valueType is used in SliceValue.
+var valueType = reflect.TypeOf(Value{})
+
 // Value represents the value part in key-value pairs.
 type Value struct {
 	vtype    Type
@@ -44,6 +49,12 @@ const (
 	FLOAT64SLICE
 	// STRINGSLICE is a slice of strings Type Value.
 	STRINGSLICE
+	// BYTES is a byte slice Type Value.
+	BYTES
+	// SLICE is a heterogeneous slice of Values Type Value.
+	SLICE
+	// MAP is a map of keys to Values Type Value.
+	MAP
 )
 
 // BoolValue creates a BOOL Value.
@@ -116,6 +127,30 @@ func StringSliceValue(v []string) Value {
 	return Value{vtype: STRINGSLICE, slice: attribute.StringSliceValue(v)}
 }
 
+// BytesValue creates a BYTES Value.
+func BytesValue(v []byte) Value {
+	return Value{
+		vtype:    BYTES,
+		stringly: string(v),
+	}
+}
+
+// SliceValue creates a SLICE Value holding the passed Values. The Values may
+// be of different types.
+func SliceValue(v ...Value) Value {
+	cp := reflect.New(reflect.ArrayOf(len(v), valueType)).Elem()
+	reflect.Copy(cp, reflect.ValueOf(v))
+	return Value{vtype: SLICE, slice: cp.Interface()}
+}
+
+// MapValue creates a MAP Value holding the passed KeyValues.
+//
+// The KeyValues are sorted by key and duplicate keys are eliminated by taking
+// the last value, the same way a Set is created.
+func MapValue(kvs ...KeyValue) Value {
+	return Value{vtype: MAP, slice: NewSet(slices.Clone(kvs)...)}
+}
+
 // Type returns a type of the Value.
 func (v Value) Type() Type {
 	return v.vtype
@@ -197,6 +232,47 @@ func (v Value) asStringSlice() []string {
 	return attribute.AsStringSlice(v.slice)
 }
 
+// AsBytes returns the []byte value. Make sure that the Value's type is
+// BYTES.
+func (v Value) AsBytes() []byte {
+	if v.vtype != BYTES {
+		return nil
+	}
+	return []byte(v.stringly)
+}
+
+// AsSlice returns the []Value value. Make sure that the Value's type is
+// SLICE.
+func (v Value) AsSlice() []Value {
+	if v.vtype != SLICE {
+		return nil
+	}
+	return v.asSlice()
+}
+
+func (v Value) asSlice() []Value {
+	rv := reflect.ValueOf(v.slice)
+	cpy := make([]Value, rv.Len())
+	if len(cpy) > 0 {
+		_ = reflect.Copy(reflect.ValueOf(cpy), rv)
+	}
+	return cpy
+}
+
+// AsMap returns the []KeyValue value, sorted by key. Make sure that the
+// Value's type is MAP.
+func (v Value) AsMap() []KeyValue {
+	if v.vtype != MAP {
+		return nil
+	}
+	return v.asMap()
+}
+
+func (v Value) asMap() []KeyValue {
+	s := v.slice.(Set)
+	return s.ToSlice()
+}
+
 type unknownValueType struct{}
 
 // AsInterface returns Value's data as interface{}.
@@ -218,6 +294,22 @@ func (v Value) AsInterface() interface{} {
 		return v.stringly
 	case STRINGSLICE:
 		return v.asStringSlice()
+	case BYTES:
+		return []byte(v.stringly)
+	case SLICE:
+		vals := v.asSlice()
+		out := make([]interface{}, len(vals))
+		for i, val := range vals {
+			out[i] = val.AsInterface()
+		}
+		return out
+	case MAP:
+		kvs := v.asMap()
+		out := make(map[string]interface{}, len(kvs))
+		for _, kv := range kvs {
+			out[string(kv.Key)] = kv.Value.AsInterface()
+		}
+		return out
 	}
 	return unknownValueType{}
 }
@@ -253,6 +345,14 @@ func (v Value) Emit() string {
 		return string(j)
 	case STRING:
 		return v.stringly
+	case BYTES:
+		return base64.StdEncoding.EncodeToString([]byte(v.stringly))
+	case SLICE, MAP:
+		j, err := json.Marshal(v.AsInterface())
+		if err != nil {
+			return fmt.Sprintf("invalid: %v", v.AsInterface())
+		}
+		return string(j)
 	default:
 		return "unknown"
 	}
@@ -86,6 +86,30 @@ func TestValue(t *testing.T) {
 			wantType:  attribute.STRINGSLICE,
 			wantValue: []string{"forty-two", "negative three", "twelve"},
 		},
+		{
+			name:      "Key.Bytes() correctly returns keys's internal []byte value",
+			value:     k.Bytes([]byte{0, 1, 2}).Value,
+			wantType:  attribute.BYTES,
+			wantValue: []byte{0, 1, 2},
+		},
+		{
+			name:      "Key.Slice() correctly returns keys's internal []interface{} value",
+			value:     k.Slice(attribute.StringValue("one"), attribute.Int64Value(2)).Value,
+			wantType:  attribute.SLICE,
+			wantValue: []interface{}{"one", int64(2)},
+		},
+		{
+			name: "Key.Map() correctly returns keys's internal map[string]interface{} value",
+			value: k.Map(
+				attribute.String("b", "two"),
+				attribute.Map("a", attribute.Bool("c", true)),
+			).Value,
+			wantType: attribute.MAP,
+			wantValue: map[string]interface{}{
+				"a": map[string]interface{}{"c": true},
+				"b": "two",
+			},
+		},
 	} {
 		t.Logf("Running test case %s", testcase.name)
 		if testcase.value.Type() != testcase.wantType {
@@ -143,6 +167,18 @@ func TestEquivalence(t *testing.T) {
 			attribute.StringSlice("StringSlice", []string{"one", "two", "three"}),
 			attribute.StringSlice("StringSlice", []string{"one", "two", "three"}),
 		},
+		{
+			attribute.Bytes("Bytes", []byte("bytes value")),
+			attribute.Bytes("Bytes", []byte("bytes value")),
+		},
+		{
+			attribute.Slice("Slice", attribute.StringValue("one"), attribute.SliceValue(attribute.Int64Value(2))),
+			attribute.Slice("Slice", attribute.StringValue("one"), attribute.SliceValue(attribute.Int64Value(2))),
+		},
+		{
+			attribute.Map("Map", attribute.String("a", "one"), attribute.Int("b", 2)),
+			attribute.Map("Map", attribute.Int("b", 2), attribute.String("a", "one")),
+		},
 	}
 
 	t.Run("Distinct", func(t *testing.T) {
@@ -206,3 +242,50 @@ func TestAsSlice(t *testing.T) {
 	ss2 := kv.Value.AsStringSlice()
 	assert.Equal(t, ss1, ss2)
 }
+
+func TestAsBytes(t *testing.T) {
+	b := []byte("bytes value")
+	kv := attribute.Bytes("Bytes", b)
+	assert.Equal(t, b, kv.Value.AsBytes())
+
+	b[0] = 'B'
+	assert.Equal(t, []byte("bytes value"), kv.Value.AsBytes(), "value mutated by input")
+	kv.Value.AsBytes()[0] = 'B'
+	assert.Equal(t, []byte("bytes value"), kv.Value.AsBytes(), "value mutated by output")
+
+	assert.Nil(t, attribute.StringValue("bytes value").AsBytes())
+}
+
+func TestAsSliceValue(t *testing.T) {
+	vals := []attribute.Value{
+		attribute.StringValue("one"),
+		attribute.Int64Value(2),
+		attribute.MapValue(attribute.Bool("three", true)),
+	}
+	kv := attribute.Slice("Slice", vals...)
+	assert.Equal(t, vals, kv.Value.AsSlice())
+
+	vals[0] = attribute.StringValue("mutated")
+	assert.Equal(t, attribute.StringValue("one"), kv.Value.AsSlice()[0], "value mutated by input")
+
+	assert.Empty(t, attribute.SliceValue().AsSlice())
+	assert.Nil(t, attribute.StringValue("one").AsSlice())
+}
+
+func TestAsMap(t *testing.T) {
+	kvs := []attribute.KeyValue{
+		attribute.String("b", "two"),
+		attribute.Int("a", 1),
+		attribute.String("b", "last"),
+	}
+	kv := attribute.Map("Map", kvs...)
+	want := []attribute.KeyValue{
+		attribute.Int("a", 1),
+		attribute.String("b", "last"),
+	}
+	assert.Equal(t, want, kv.Value.AsMap(), "not sorted and de-duplicated")
+	assert.Equal(t, attribute.String("b", "two"), kvs[0], "input reordered")
+
+	assert.Empty(t, attribute.MapValue().AsMap())
+	assert.Nil(t, attribute.StringValue("one").AsMap())
+}
@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package autoinit sets up the OpenTelemetry SDK for all the signals from the
+standard [environment variables] in a single call.
+
+[Setup] builds a TracerProvider, a MeterProvider, and a LoggerProvider with
+the exporters selected by the environment, registers them and the configured
+propagator globally, and returns a function shutting them all down:
+
+	func main() {
+		shutdown, err := autoinit.Setup(context.Background())
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer func() { _ = shutdown(context.Background()) }()
+
+		// ...
+	}
+
+The following environment variables select the components:
+
+  - OTEL_SDK_DISABLED: if true, nothing is set up.
+  - OTEL_TRACES_EXPORTER, OTEL_METRICS_EXPORTER, and OTEL_LOGS_EXPORTER: a
+    comma-separated list of the exporters of each signal. Supported values
+    are "otlp" (default), "console", and "none".
+  - OTEL_EXPORTER_OTLP_PROTOCOL, and its signal specific variants
+    OTEL_EXPORTER_OTLP_TRACES_PROTOCOL, OTEL_EXPORTER_OTLP_METRICS_PROTOCOL,
+    and OTEL_EXPORTER_OTLP_LOGS_PROTOCOL: the OTLP protocol. Supported
+    values are "http/protobuf" (default) and "grpc".
+  - OTEL_PROPAGATORS: a comma-separated list of propagators. Supported
+    values are "tracecontext", "baggage", and "none". It defaults to
+    "tracecontext,baggage".
+
+All the other environment variables, such as the OTLP endpoint, the resource
+attributes, the sampler, or the batch processor settings, are read by the
+exporters and the SDKs themselves.
+
+[environment variables]: https://opentelemetry.io/docs/specs/otel/configuration/sdk-environment-variables/
+*/
+package autoinit // import "go.opentelemetry.io/otel/autoinit"
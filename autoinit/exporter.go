@@ -0,0 +1,216 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package autoinit // import "go.opentelemetry.io/otel/autoinit"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	envTracesExporter  = "OTEL_TRACES_EXPORTER"
+	envMetricsExporter = "OTEL_METRICS_EXPORTER"
+	envLogsExporter    = "OTEL_LOGS_EXPORTER"
+
+	envProtocol        = "OTEL_EXPORTER_OTLP_PROTOCOL"
+	envTracesProtocol  = "OTEL_EXPORTER_OTLP_TRACES_PROTOCOL"
+	envMetricsProtocol = "OTEL_EXPORTER_OTLP_METRICS_PROTOCOL"
+	envLogsProtocol    = "OTEL_EXPORTER_OTLP_LOGS_PROTOCOL"
+
+	exporterOTLP    = "otlp"
+	exporterConsole = "console"
+	exporterNone    = "none"
+
+	protocolHTTP = "http/protobuf"
+	protocolGRPC = "grpc"
+)
+
+var (
+	errUnsupportedExporter = errors.New("autoinit: unsupported exporter")
+	errUnsupportedProtocol = errors.New("autoinit: unsupported OTLP protocol")
+)
+
+// exporterNames returns the exporter names of the comma-separated list of the
+// key environment variable. It defaults to OTLP if the variable is unset. No
+// name is returned if "none" is in the list.
+func exporterNames(key string) []string {
+	v := strings.TrimSpace(os.Getenv(key))
+	if v == "" {
+		return []string{exporterOTLP}
+	}
+
+	var names []string
+	for _, n := range strings.Split(v, ",") {
+		n = strings.ToLower(strings.TrimSpace(n))
+		switch n {
+		case "":
+			continue
+		case exporterNone:
+			return nil
+		}
+		names = append(names, n)
+	}
+	return names
+}
+
+// protocol returns the OTLP protocol configured by the signal specific key
+// environment variable, or otherwise the general one.
+func protocol(key string) (string, error) {
+	p := strings.TrimSpace(os.Getenv(key))
+	if p == "" {
+		p = strings.TrimSpace(os.Getenv(envProtocol))
+	}
+	switch p {
+	case "", protocolHTTP:
+		return protocolHTTP, nil
+	case protocolGRPC:
+		return protocolGRPC, nil
+	default:
+		return "", fmt.Errorf("%w: %q", errUnsupportedProtocol, p)
+	}
+}
+
+func newSpanProcessors(ctx context.Context) ([]sdktrace.SpanProcessor, error) {
+	var sps []sdktrace.SpanProcessor
+	for _, name := range exporterNames(envTracesExporter) {
+		sp, err := newSpanProcessor(ctx, name)
+		if err != nil {
+			for _, created := range sps {
+				_ = created.Shutdown(ctx)
+			}
+			return nil, err
+		}
+		sps = append(sps, sp)
+	}
+	return sps, nil
+}
+
+func newSpanProcessor(ctx context.Context, name string) (sdktrace.SpanProcessor, error) {
+	switch name {
+	case exporterOTLP:
+		p, err := protocol(envTracesProtocol)
+		if err != nil {
+			return nil, err
+		}
+		var exp sdktrace.SpanExporter
+		if p == protocolGRPC {
+			exp, err = otlptracegrpc.New(ctx)
+		} else {
+			exp, err = otlptracehttp.New(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return sdktrace.NewBatchSpanProcessor(exp), nil
+	case exporterConsole:
+		exp, err := stdouttrace.New()
+		if err != nil {
+			return nil, err
+		}
+		return sdktrace.NewSimpleSpanProcessor(exp), nil
+	default:
+		return nil, fmt.Errorf("%w: %s=%q", errUnsupportedExporter, envTracesExporter, name)
+	}
+}
+
+func newReaders(ctx context.Context) ([]sdkmetric.Reader, error) {
+	var readers []sdkmetric.Reader
+	for _, name := range exporterNames(envMetricsExporter) {
+		r, err := newReader(ctx, name)
+		if err != nil {
+			for _, created := range readers {
+				_ = created.Shutdown(ctx)
+			}
+			return nil, err
+		}
+		readers = append(readers, r)
+	}
+	return readers, nil
+}
+
+func newReader(ctx context.Context, name string) (sdkmetric.Reader, error) {
+	var (
+		exp sdkmetric.Exporter
+		err error
+	)
+	switch name {
+	case exporterOTLP:
+		var p string
+		if p, err = protocol(envMetricsProtocol); err != nil {
+			return nil, err
+		}
+		if p == protocolGRPC {
+			exp, err = otlpmetricgrpc.New(ctx)
+		} else {
+			exp, err = otlpmetrichttp.New(ctx)
+		}
+	case exporterConsole:
+		exp, err = stdoutmetric.New()
+	default:
+		return nil, fmt.Errorf("%w: %s=%q", errUnsupportedExporter, envMetricsExporter, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sdkmetric.NewPeriodicReader(exp), nil
+}
+
+func newLogProcessors(ctx context.Context) ([]sdklog.Processor, error) {
+	var ps []sdklog.Processor
+	for _, name := range exporterNames(envLogsExporter) {
+		p, err := newLogProcessor(ctx, name)
+		if err != nil {
+			for _, created := range ps {
+				_ = created.Shutdown(ctx)
+			}
+			return nil, err
+		}
+		ps = append(ps, p)
+	}
+	return ps, nil
+}
+
+func newLogProcessor(ctx context.Context, name string) (sdklog.Processor, error) {
+	switch name {
+	case exporterOTLP:
+		p, err := protocol(envLogsProtocol)
+		if err != nil {
+			return nil, err
+		}
+		var exp sdklog.Exporter
+		if p == protocolGRPC {
+			exp, err = otlploggrpc.New(ctx)
+		} else {
+			exp, err = otlploghttp.New(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return sdklog.NewBatchProcessor(exp), nil
+	case exporterConsole:
+		exp, err := stdoutlog.New()
+		if err != nil {
+			return nil, err
+		}
+		return sdklog.NewSimpleProcessor(exp), nil
+	default:
+		return nil, fmt.Errorf("%w: %s=%q", errUnsupportedExporter, envLogsExporter, name)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package autoinit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExporterNames(t *testing.T) {
+	for _, tc := range []struct {
+		value string
+		want  []string
+	}{
+		{value: "", want: []string{exporterOTLP}},
+		{value: "otlp", want: []string{exporterOTLP}},
+		{value: " OTLP , console,", want: []string{exporterOTLP, exporterConsole}},
+		{value: "console,none", want: nil},
+		{value: "none", want: nil},
+	} {
+		t.Run(tc.value, func(t *testing.T) {
+			t.Setenv(envTracesExporter, tc.value)
+			assert.Equal(t, tc.want, exporterNames(envTracesExporter))
+		})
+	}
+}
+
+func TestProtocol(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		general string
+		signal  string
+		want    string
+		wantErr error
+	}{
+		{name: "Default", want: protocolHTTP},
+		{name: "General", general: protocolGRPC, want: protocolGRPC},
+		{name: "Signal", signal: protocolGRPC, want: protocolGRPC},
+		{name: "SignalPrecedence", general: protocolGRPC, signal: protocolHTTP, want: protocolHTTP},
+		{name: "Unsupported", general: "http/json", wantErr: errUnsupportedProtocol},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(envProtocol, tc.general)
+			t.Setenv(envTracesProtocol, tc.signal)
+			got, err := protocol(envTracesProtocol)
+			require.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestNewProcessorsUnsupported(t *testing.T) {
+	ctx := context.Background()
+
+	t.Setenv(envTracesExporter, "console,zipkin")
+	_, err := newSpanProcessors(ctx)
+	assert.ErrorIs(t, err, errUnsupportedExporter)
+
+	t.Setenv(envMetricsExporter, "prometheus")
+	_, err = newReaders(ctx)
+	assert.ErrorIs(t, err, errUnsupportedExporter)
+
+	t.Setenv(envLogsExporter, "otlp")
+	t.Setenv(envLogsProtocol, "http/json")
+	_, err = newLogProcessors(ctx)
+	assert.ErrorIs(t, err, errUnsupportedProtocol)
+}
+
+func TestNewProcessorsGRPC(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv(envProtocol, protocolGRPC)
+
+	sps, err := newSpanProcessors(ctx)
+	require.NoError(t, err)
+	assert.Len(t, sps, 1)
+
+	readers, err := newReaders(ctx)
+	require.NoError(t, err)
+	assert.Len(t, readers, 1)
+
+	lps, err := newLogProcessors(ctx)
+	require.NoError(t, err)
+	assert.Len(t, lps, 1)
+
+	// Nothing was recorded, nothing is exported.
+	for _, sp := range sps {
+		assert.NoError(t, sp.Shutdown(ctx))
+	}
+	for _, r := range readers {
+		assert.NoError(t, r.Shutdown(ctx))
+	}
+	for _, lp := range lps {
+		assert.NoError(t, lp.Shutdown(ctx))
+	}
+}
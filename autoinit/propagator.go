@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package autoinit // import "go.opentelemetry.io/otel/autoinit"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const envPropagators = "OTEL_PROPAGATORS"
+
+var errUnsupportedPropagator = errors.New("autoinit: unsupported propagator")
+
+// newPropagator returns the composite propagator of the comma-separated list
+// of the OTEL_PROPAGATORS environment variable. It defaults to the W3C trace
+// context and baggage propagators.
+func newPropagator() (propagation.TextMapPropagator, error) {
+	v := strings.TrimSpace(os.Getenv(envPropagators))
+	if v == "" {
+		v = "tracecontext,baggage"
+	}
+
+	var props []propagation.TextMapPropagator
+	for _, name := range strings.Split(v, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case "":
+		case "tracecontext":
+			props = append(props, propagation.TraceContext{})
+		case "baggage":
+			props = append(props, propagation.Baggage{})
+		case "none":
+			return propagation.NewCompositeTextMapPropagator(), nil
+		default:
+			return nil, fmt.Errorf("%w: %s=%q", errUnsupportedPropagator, envPropagators, name)
+		}
+	}
+	return propagation.NewCompositeTextMapPropagator(props...), nil
+}
@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package autoinit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPropagator(t *testing.T) {
+	for _, tc := range []struct {
+		value   string
+		want    []string
+		wantErr error
+	}{
+		{value: "", want: []string{"traceparent", "tracestate", "baggage"}},
+		{value: "baggage", want: []string{"baggage"}},
+		{value: " TraceContext ,", want: []string{"traceparent", "tracestate"}},
+		{value: "tracecontext,none", want: []string{}},
+		{value: "b3", wantErr: errUnsupportedPropagator},
+	} {
+		t.Run(tc.value, func(t *testing.T) {
+			t.Setenv(envPropagators, tc.value)
+			p, err := newPropagator()
+			require.ErrorIs(t, err, tc.wantErr)
+			if tc.wantErr != nil {
+				return
+			}
+			assert.ElementsMatch(t, tc.want, p.Fields())
+		})
+	}
+}
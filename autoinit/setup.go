@@ -0,0 +1,151 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package autoinit // import "go.opentelemetry.io/otel/autoinit"
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/log/global"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// envSDKDisabled disables Setup if it is true. It is checked the same way as
+// by sdk/internal/env.SDKDisabled, which cannot be imported from this module.
+const envSDKDisabled = "OTEL_SDK_DISABLED"
+
+type config struct {
+	tracerProviderOpts []sdktrace.TracerProviderOption
+	meterProviderOpts  []sdkmetric.Option
+	loggerProviderOpts []sdklog.LoggerProviderOption
+}
+
+// Option configures Setup.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(c config) config {
+	return fn(c)
+}
+
+// WithTracerProviderOptions adds opts to the options used to create the
+// TracerProvider. The span processors of the exporters selected by the
+// environment are registered in addition to the ones of opts.
+func WithTracerProviderOptions(opts ...sdktrace.TracerProviderOption) Option {
+	return optionFunc(func(c config) config {
+		c.tracerProviderOpts = append(c.tracerProviderOpts, opts...)
+		return c
+	})
+}
+
+// WithMeterProviderOptions adds opts to the options used to create the
+// MeterProvider. The readers of the exporters selected by the environment are
+// registered in addition to the ones of opts.
+func WithMeterProviderOptions(opts ...sdkmetric.Option) Option {
+	return optionFunc(func(c config) config {
+		c.meterProviderOpts = append(c.meterProviderOpts, opts...)
+		return c
+	})
+}
+
+// WithLoggerProviderOptions adds opts to the options used to create the
+// LoggerProvider. The processors of the exporters selected by the environment
+// are registered in addition to the ones of opts.
+func WithLoggerProviderOptions(opts ...sdklog.LoggerProviderOption) Option {
+	return optionFunc(func(c config) config {
+		c.loggerProviderOpts = append(c.loggerProviderOpts, opts...)
+		return c
+	})
+}
+
+// Setup creates a TracerProvider, a MeterProvider, and a LoggerProvider
+// exporting to the exporters selected by the environment variables, and
+// registers them and the propagator selected by the OTEL_PROPAGATORS
+// environment variable globally. See the package documentation for the
+// supported environment variables.
+//
+// The returned shutdown function shuts down all the created providers,
+// flushing the telemetry they hold. It is never nil and should be called
+// before the application exits. The global providers are not reset by it.
+//
+// If an error is returned, nothing is registered globally and all the
+// created components are shut down. If the OTEL_SDK_DISABLED environment
+// variable is true, nothing is created nor registered.
+func Setup(ctx context.Context, opts ...Option) (shutdown func(context.Context) error, err error) {
+	noopShutdown := func(context.Context) error { return nil }
+	if strings.EqualFold(strings.TrimSpace(os.Getenv(envSDKDisabled)), "true") {
+		return noopShutdown, nil
+	}
+
+	var c config
+	for _, o := range opts {
+		c = o.apply(c)
+	}
+
+	prop, err := newPropagator()
+	if err != nil {
+		return noopShutdown, err
+	}
+
+	var shutdownFuncs []func(context.Context) error
+	shutdown = func(ctx context.Context) error {
+		var err error
+		for _, f := range shutdownFuncs {
+			err = errors.Join(err, f(ctx))
+		}
+		shutdownFuncs = nil
+		return err
+	}
+	fail := func(err error) (func(context.Context) error, error) {
+		return noopShutdown, errors.Join(err, shutdown(ctx))
+	}
+
+	spanProcessors, err := newSpanProcessors(ctx)
+	if err != nil {
+		return fail(err)
+	}
+	tpOpts := c.tracerProviderOpts
+	for _, sp := range spanProcessors {
+		tpOpts = append(tpOpts, sdktrace.WithSpanProcessor(sp))
+	}
+	tp := sdktrace.NewTracerProvider(tpOpts...)
+	shutdownFuncs = append(shutdownFuncs, tp.Shutdown)
+
+	readers, err := newReaders(ctx)
+	if err != nil {
+		return fail(err)
+	}
+	mpOpts := c.meterProviderOpts
+	for _, r := range readers {
+		mpOpts = append(mpOpts, sdkmetric.WithReader(r))
+	}
+	mp := sdkmetric.NewMeterProvider(mpOpts...)
+	shutdownFuncs = append(shutdownFuncs, mp.Shutdown)
+
+	logProcessors, err := newLogProcessors(ctx)
+	if err != nil {
+		return fail(err)
+	}
+	lpOpts := c.loggerProviderOpts
+	for _, p := range logProcessors {
+		lpOpts = append(lpOpts, sdklog.WithProcessor(p))
+	}
+	lp := sdklog.NewLoggerProvider(lpOpts...)
+	shutdownFuncs = append(shutdownFuncs, lp.Shutdown)
+
+	otel.SetTextMapPropagator(prop)
+	otel.SetTracerProvider(tp)
+	otel.SetMeterProvider(mp)
+	global.SetLoggerProvider(lp)
+
+	return shutdown, nil
+}
@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package autoinit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func setNoopGlobal(t *testing.T) {
+	t.Helper()
+	otel.SetTracerProvider(noop.NewTracerProvider())
+}
+
+func TestSetupDisabled(t *testing.T) {
+	setNoopGlobal(t)
+	t.Setenv(envSDKDisabled, "true")
+
+	shutdown, err := Setup(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, shutdown)
+	assert.NoError(t, shutdown(context.Background()))
+	assert.IsType(t, noop.TracerProvider{}, otel.GetTracerProvider())
+}
+
+func TestSetupConsole(t *testing.T) {
+	t.Setenv(envTracesExporter, "console")
+	t.Setenv(envMetricsExporter, "console")
+	t.Setenv(envLogsExporter, "console")
+
+	shutdown, err := Setup(context.Background())
+	require.NoError(t, err)
+	assert.IsType(t, &sdktrace.TracerProvider{}, otel.GetTracerProvider())
+	assert.IsType(t, &sdkmetric.MeterProvider{}, otel.GetMeterProvider())
+	assert.IsType(t, &sdklog.LoggerProvider{}, global.GetLoggerProvider())
+	assert.ElementsMatch(t, []string{"traceparent", "tracestate", "baggage"}, otel.GetTextMapPropagator().Fields())
+	assert.NoError(t, shutdown(context.Background()))
+}
+
+func TestSetupOptions(t *testing.T) {
+	t.Setenv(envTracesExporter, "none")
+	t.Setenv(envMetricsExporter, "none")
+	t.Setenv(envLogsExporter, "none")
+
+	var ended bool
+	sp := sdktrace.NewSimpleSpanProcessor(spanExporterFunc(func() { ended = true }))
+	shutdown, err := Setup(context.Background(), WithTracerProviderOptions(sdktrace.WithSpanProcessor(sp)))
+	require.NoError(t, err)
+
+	_, span := otel.Tracer("TestSetupOptions").Start(context.Background(), "span")
+	span.End()
+	assert.True(t, ended, "span not exported by the option span processor")
+	assert.NoError(t, shutdown(context.Background()))
+}
+
+func TestSetupError(t *testing.T) {
+	setNoopGlobal(t)
+	t.Setenv(envTracesExporter, "console")
+	t.Setenv(envMetricsExporter, "prometheus")
+
+	shutdown, err := Setup(context.Background())
+	assert.ErrorIs(t, err, errUnsupportedExporter)
+	require.NotNil(t, shutdown)
+	assert.NoError(t, shutdown(context.Background()))
+	assert.IsType(t, noop.TracerProvider{}, otel.GetTracerProvider(), "global registered on error")
+}
+
+func TestSetupOTLP(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		paths = make(map[string]int)
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths[r.URL.Path]++
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", srv.URL)
+
+	ctx := context.Background()
+	shutdown, err := Setup(ctx)
+	require.NoError(t, err)
+
+	_, span := otel.Tracer("TestSetupOTLP").Start(ctx, "span")
+	span.End()
+	c, err := otel.Meter("TestSetupOTLP").Int64Counter("counter")
+	require.NoError(t, err)
+	c.Add(ctx, 1)
+	var rec log.Record
+	rec.SetBody(log.StringValue("message"))
+	global.Logger("TestSetupOTLP").Emit(ctx, rec)
+
+	require.NoError(t, shutdown(ctx))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 1, paths["/v1/traces"], "traces exported")
+	assert.Equal(t, 1, paths["/v1/metrics"], "metrics exported")
+	assert.Equal(t, 1, paths["/v1/logs"], "logs exported")
+}
+
+type spanExporterFunc func()
+
+func (f spanExporterFunc) ExportSpans(context.Context, []sdktrace.ReadOnlySpan) error {
+	f()
+	return nil
+}
+
+func (spanExporterFunc) Shutdown(context.Context) error { return nil }
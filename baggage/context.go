@@ -26,3 +26,54 @@ func FromContext(ctx context.Context) Baggage {
 	// Delegate so any hooks for the OpenTracing bridge are handled.
 	return Baggage{list: baggage.ListFromContext(ctx)}
 }
+
+// SetMemberInContext returns a copy of parent with the baggage list-member
+// defined by key and value added to the baggage it contains. If the baggage
+// already contains a list-member with key, that list-member is replaced.
+// The baggage contained in parent is not modified.
+//
+// The passed key and value are validated the same way as NewMemberRaw. If
+// they are invalid, an error is returned with parent.
+func SetMemberInContext(parent context.Context, key, value string) (context.Context, error) {
+	m, err := NewMemberRaw(key, value)
+	if err != nil {
+		return parent, err
+	}
+	b, err := FromContext(parent).SetMember(m)
+	if err != nil {
+		return parent, err
+	}
+	return ContextWithBaggage(parent, b), nil
+}
+
+// RemoveMemberFromContext returns a copy of parent with the list-member
+// identified by key removed from the baggage it contains. The baggage
+// contained in parent is not modified. If the baggage does not contain a
+// list-member with key, parent is returned.
+func RemoveMemberFromContext(parent context.Context, key string) context.Context {
+	b := FromContext(parent)
+	if _, ok := b.list[key]; !ok {
+		return parent
+	}
+	return ContextWithBaggage(parent, b.DeleteMember(key))
+}
+
+// MergeIntoContext returns a copy of parent with the list-members of other
+// added to the baggage it contains. List-members of other replace any
+// list-members with the same key. The baggage contained in parent is not
+// modified. If other is empty, parent is returned.
+func MergeIntoContext(parent context.Context, other Baggage) context.Context {
+	if len(other.list) == 0 {
+		return parent
+	}
+
+	b := FromContext(parent)
+	list := make(baggage.List, len(b.list)+len(other.list))
+	for k, v := range b.list {
+		list[k] = v
+	}
+	for k, v := range other.list {
+		list[k] = v
+	}
+	return ContextWithBaggage(parent, Baggage{list: list})
+}
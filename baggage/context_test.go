@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/internal/baggage"
 )
@@ -23,3 +24,63 @@ func TestContext(t *testing.T) {
 	ctx = ContextWithoutBaggage(ctx)
 	assert.Equal(t, Baggage{}, FromContext(ctx))
 }
+
+func TestSetMemberInContext(t *testing.T) {
+	orig := Baggage{list: baggage.List{"key": baggage.Item{Value: "val"}}}
+	parent := ContextWithBaggage(context.Background(), orig)
+
+	ctx, err := SetMemberInContext(parent, "key2", "val 2")
+	require.NoError(t, err)
+	assert.Equal(t, Baggage{list: baggage.List{
+		"key":  baggage.Item{Value: "val"},
+		"key2": baggage.Item{Value: "val 2"},
+	}}, FromContext(ctx))
+
+	ctx, err = SetMemberInContext(ctx, "key", "new")
+	require.NoError(t, err)
+	assert.Equal(t, "new", FromContext(ctx).Member("key").Value())
+
+	assert.Equal(t, orig, FromContext(parent), "parent baggage modified")
+
+	ctx, err = SetMemberInContext(parent, "", "val")
+	assert.ErrorIs(t, err, errInvalidKey)
+	assert.Equal(t, parent, ctx)
+}
+
+func TestRemoveMemberFromContext(t *testing.T) {
+	orig := Baggage{list: baggage.List{
+		"key":  baggage.Item{Value: "val"},
+		"key2": baggage.Item{Value: "val2"},
+	}}
+	parent := ContextWithBaggage(context.Background(), orig)
+
+	ctx := RemoveMemberFromContext(parent, "key")
+	assert.Equal(t, Baggage{list: baggage.List{
+		"key2": baggage.Item{Value: "val2"},
+	}}, FromContext(ctx))
+	assert.Equal(t, orig, FromContext(parent), "parent baggage modified")
+
+	assert.Equal(t, parent, RemoveMemberFromContext(parent, "missing"))
+}
+
+func TestMergeIntoContext(t *testing.T) {
+	orig := Baggage{list: baggage.List{
+		"key":  baggage.Item{Value: "val"},
+		"key2": baggage.Item{Value: "val2"},
+	}}
+	parent := ContextWithBaggage(context.Background(), orig)
+
+	other := Baggage{list: baggage.List{
+		"key2": baggage.Item{Value: "other"},
+		"key3": baggage.Item{Value: "val3"},
+	}}
+	ctx := MergeIntoContext(parent, other)
+	assert.Equal(t, Baggage{list: baggage.List{
+		"key":  baggage.Item{Value: "val"},
+		"key2": baggage.Item{Value: "other"},
+		"key3": baggage.Item{Value: "val3"},
+	}}, FromContext(ctx))
+	assert.Equal(t, orig, FromContext(parent), "parent baggage modified")
+
+	assert.Equal(t, parent, MergeIntoContext(parent, Baggage{}))
+}
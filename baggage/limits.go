@@ -0,0 +1,193 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package baggage // import "go.opentelemetry.io/otel/baggage"
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel/internal/baggage"
+)
+
+// LimitPolicy determines how a limit violation is handled when encoding or
+// decoding a baggage-string with Limits.
+type LimitPolicy int
+
+const (
+	// LimitPolicyError returns an error when any limit is exceeded. This is
+	// the default.
+	LimitPolicyError LimitPolicy = iota
+	// LimitPolicyTruncate keeps list-members up to, but not including, the
+	// first list-member that would exceed a limit. All following
+	// list-members are dropped. This mirrors how a baggage-string truncated
+	// at a list-member boundary is interpreted.
+	LimitPolicyTruncate
+	// LimitPolicyDropNewest drops any list-member that would exceed a limit
+	// and continues with the following list-members, keeping those that
+	// still fit.
+	LimitPolicyDropNewest
+)
+
+// Limits are the size limits applied to a baggage-string.
+//
+// The W3C Baggage specification defines the maximum limits a baggage-string
+// is allowed to have. Limits can only be used to lower these limits. Any
+// zero, negative, or larger than specified value is replaced with the
+// specification limit.
+type Limits struct {
+	// MaxMembers is the maximum number of list-members.
+	MaxMembers int
+	// MaxBytesPerMember is the maximum size, in bytes, of an encoded
+	// list-member.
+	MaxBytesPerMember int
+	// MaxBytes is the maximum size, in bytes, of the encoded baggage-string.
+	MaxBytes int
+
+	// Policy determines how a limit violation is handled.
+	Policy LimitPolicy
+}
+
+func (l Limits) normalize() Limits {
+	if l.MaxMembers <= 0 || l.MaxMembers > maxMembers {
+		l.MaxMembers = maxMembers
+	}
+	if l.MaxBytesPerMember <= 0 || l.MaxBytesPerMember > maxBytesPerMembers {
+		l.MaxBytesPerMember = maxBytesPerMembers
+	}
+	if l.MaxBytes <= 0 || l.MaxBytes > maxBytesPerBaggageString {
+		l.MaxBytes = maxBytesPerBaggageString
+	}
+	return l
+}
+
+// limiter tracks the state of a baggage-string being checked against Limits.
+type limiter struct {
+	limits Limits
+	keys   map[string]struct{}
+	bytes  int
+}
+
+func newLimiter(l Limits) *limiter {
+	return &limiter{limits: l.normalize(), keys: make(map[string]struct{})}
+}
+
+// check returns an error if adding the list-member with key and encoded
+// length n would exceed a limit. Otherwise, the list-member is accounted
+// for and nil is returned.
+func (l *limiter) check(key string, n int) error {
+	if n > l.limits.MaxBytesPerMember {
+		return fmt.Errorf("%w: %d", errMemberBytes, n)
+	}
+	if _, dup := l.keys[key]; !dup && len(l.keys) >= l.limits.MaxMembers {
+		return errMemberNumber
+	}
+	size := l.bytes + n
+	if l.bytes > 0 {
+		size += len(listDelimiter)
+	}
+	if size > l.limits.MaxBytes {
+		return fmt.Errorf("%w: %d", errBaggageBytes, size)
+	}
+	l.keys[key] = struct{}{}
+	l.bytes = size
+	return nil
+}
+
+// handle returns whether processing should stop and the error to return
+// given a limit violation err.
+func (l *limiter) handle(err error) (stop bool, _ error) {
+	switch l.limits.Policy {
+	case LimitPolicyTruncate:
+		return true, nil
+	case LimitPolicyDropNewest:
+		return false, nil
+	default:
+		return true, err
+	}
+}
+
+// ParseWithLimits attempts to decode a baggage-string from the passed string
+// the same way as Parse, but applies limits to the decoded list-members.
+// List-members are evaluated left-to-right, and limit violations are handled
+// according to the limits Policy.
+//
+// An error is still returned for any list-member that is invalid according
+// to the W3C Baggage specification, regardless of the Policy.
+func ParseWithLimits(bStr string, limits Limits) (Baggage, error) {
+	if bStr == "" {
+		return Baggage{}, nil
+	}
+
+	l := newLimiter(limits)
+	b := make(baggage.List)
+	for _, memberStr := range strings.Split(bStr, listDelimiter) {
+		m, err := parseMember(memberStr)
+		if err == nil {
+			err = l.check(m.key, len(memberStr))
+			if err == nil {
+				// OpenTelemetry resolves duplicates by last-one-wins.
+				b[m.key] = baggage.Item{
+					Value:      m.value,
+					Properties: m.properties.asInternal(),
+				}
+				continue
+			}
+		} else if !errors.Is(err, errMemberBytes) {
+			return Baggage{}, err
+		}
+
+		stop, err := l.handle(err)
+		if err != nil {
+			return Baggage{}, err
+		}
+		if stop {
+			break
+		}
+	}
+	return Baggage{b}, nil
+}
+
+// StringWithLimits encodes Baggage into a header string compliant with the
+// W3C Baggage specification the same way as String, but applies limits to
+// the encoded list-members. List-members are evaluated in ascending key
+// order, and limit violations are handled according to the limits Policy.
+func (b Baggage) StringWithLimits(limits Limits) (string, error) {
+	keys := make([]string, 0, len(b.list))
+	for k := range b.list {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	l := newLimiter(limits)
+	members := make([]string, 0, len(keys))
+	for _, k := range keys {
+		v := b.list[k]
+		s := Member{
+			key:        k,
+			value:      v.Value,
+			properties: fromInternalProperties(v.Properties),
+		}.String()
+		// Ignored empty members.
+		if s == "" {
+			continue
+		}
+
+		err := l.check(k, len(s))
+		if err == nil {
+			members = append(members, s)
+			continue
+		}
+
+		stop, err := l.handle(err)
+		if err != nil {
+			return "", err
+		}
+		if stop {
+			break
+		}
+	}
+	return strings.Join(members, listDelimiter), nil
+}
@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package baggage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/internal/baggage"
+)
+
+func TestLimitsNormalize(t *testing.T) {
+	want := Limits{
+		MaxMembers:        maxMembers,
+		MaxBytesPerMember: maxBytesPerMembers,
+		MaxBytes:          maxBytesPerBaggageString,
+	}
+	assert.Equal(t, want, Limits{}.normalize())
+	assert.Equal(t, want, Limits{
+		MaxMembers:        -1,
+		MaxBytesPerMember: maxBytesPerMembers + 1,
+		MaxBytes:          maxBytesPerBaggageString + 1,
+	}.normalize())
+
+	l := Limits{MaxMembers: 1, MaxBytesPerMember: 2, MaxBytes: 3, Policy: LimitPolicyTruncate}
+	assert.Equal(t, l, l.normalize())
+}
+
+func TestParseWithLimits(t *testing.T) {
+	const in = "k1=v1,key2=value2,k3=v3"
+
+	testcases := []struct {
+		name   string
+		in     string
+		limits Limits
+		want   baggage.List
+		err    error
+	}{
+		{
+			name: "within limits",
+			in:   in,
+			want: baggage.List{
+				"k1":   {Value: "v1"},
+				"key2": {Value: "value2"},
+				"k3":   {Value: "v3"},
+			},
+		},
+		{
+			name:   "too many members error",
+			in:     in,
+			limits: Limits{MaxMembers: 2},
+			err:    errMemberNumber,
+		},
+		{
+			name:   "too many members truncate",
+			in:     in,
+			limits: Limits{MaxMembers: 2, Policy: LimitPolicyTruncate},
+			want: baggage.List{
+				"k1":   {Value: "v1"},
+				"key2": {Value: "value2"},
+			},
+		},
+		{
+			name:   "duplicate does not count towards members",
+			in:     "k1=v1,k1=v2",
+			limits: Limits{MaxMembers: 1},
+			want:   baggage.List{"k1": {Value: "v2"}},
+		},
+		{
+			name:   "member too large error",
+			in:     in,
+			limits: Limits{MaxBytesPerMember: 5},
+			err:    errMemberBytes,
+		},
+		{
+			name:   "member too large truncate",
+			in:     in,
+			limits: Limits{MaxBytesPerMember: 5, Policy: LimitPolicyTruncate},
+			want:   baggage.List{"k1": {Value: "v1"}},
+		},
+		{
+			name:   "member too large drop newest",
+			in:     in,
+			limits: Limits{MaxBytesPerMember: 5, Policy: LimitPolicyDropNewest},
+			want: baggage.List{
+				"k1": {Value: "v1"},
+				"k3": {Value: "v3"},
+			},
+		},
+		{
+			name:   "baggage too large error",
+			in:     in,
+			limits: Limits{MaxBytes: 17},
+			err:    errBaggageBytes,
+		},
+		{
+			name:   "baggage too large truncate",
+			in:     in,
+			limits: Limits{MaxBytes: 17, Policy: LimitPolicyTruncate},
+			want: baggage.List{
+				"k1":   {Value: "v1"},
+				"key2": {Value: "value2"},
+			},
+		},
+		{
+			name:   "baggage too large drop newest",
+			in:     in,
+			limits: Limits{MaxBytes: 11, Policy: LimitPolicyDropNewest},
+			want: baggage.List{
+				"k1": {Value: "v1"},
+				"k3": {Value: "v3"},
+			},
+		},
+		{
+			name:   "invalid member",
+			in:     "k1=v1,invalid",
+			limits: Limits{Policy: LimitPolicyDropNewest},
+			err:    errInvalidMember,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := ParseWithLimits(tc.in, tc.limits)
+			assert.ErrorIs(t, err, tc.err)
+			if tc.err == nil {
+				assert.Equal(t, Baggage{list: tc.want}, actual)
+			}
+		})
+	}
+}
+
+func TestParseWithLimitsEmpty(t *testing.T) {
+	b, err := ParseWithLimits("", Limits{})
+	require.NoError(t, err)
+	assert.Equal(t, Baggage{}, b)
+}
+
+func TestBaggageStringWithLimits(t *testing.T) {
+	b := Baggage{list: baggage.List{
+		"a":   {Value: "1"},
+		"bbb": {Value: "22222"},
+		"c":   {Value: "3"},
+	}}
+
+	s, err := b.StringWithLimits(Limits{})
+	require.NoError(t, err)
+	assert.Equal(t, "a=1,bbb=22222,c=3", s)
+
+	_, err = b.StringWithLimits(Limits{MaxMembers: 2})
+	assert.ErrorIs(t, err, errMemberNumber)
+
+	s, err = b.StringWithLimits(Limits{MaxBytesPerMember: 3, Policy: LimitPolicyTruncate})
+	require.NoError(t, err)
+	assert.Equal(t, "a=1", s)
+
+	s, err = b.StringWithLimits(Limits{MaxBytesPerMember: 3, Policy: LimitPolicyDropNewest})
+	require.NoError(t, err)
+	assert.Equal(t, "a=1,c=3", s)
+
+	_, err = b.StringWithLimits(Limits{MaxBytes: 8})
+	assert.ErrorIs(t, err, errBaggageBytes)
+}
@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package baggage // import "go.opentelemetry.io/otel/baggage"
+
+import (
+	"strings"
+)
+
+// Metadata is the structured set of properties associated with a baggage
+// list-member.
+//
+// The W3C Baggage specification defines the metadata of a list-member as a
+// list of properties, each either a key=value pair or a bare key. Metadata
+// provides access to both forms: key=value pairs are accessed with Get and
+// Set, and bare keys are treated as boolean flags accessed with HasFlag and
+// SetFlag.
+//
+// Metadata is immutable. All methods that modify Metadata return a modified
+// copy.
+type Metadata struct {
+	props properties
+}
+
+// NewMetadata returns Metadata containing props. If props contains multiple
+// properties with the same key, the last value is kept at the position of
+// the first.
+func NewMetadata(props ...Property) (Metadata, error) {
+	var md Metadata
+	for _, p := range props {
+		if err := p.validate(); err != nil {
+			return Metadata{}, err
+		}
+		md = md.set(p)
+	}
+	return md, nil
+}
+
+// ParseMetadata decodes Metadata from the passed string of properties
+// delimited by ";" (e.g. "ttl=30;sensitive"). It returns an error if the
+// input is invalid according to the W3C Baggage specification.
+func ParseMetadata(s string) (Metadata, error) {
+	var md Metadata
+	for _, pStr := range strings.Split(s, propertyDelimiter) {
+		p, err := parseProperty(pStr)
+		if err != nil {
+			return Metadata{}, err
+		}
+		// Ignore empty properties.
+		if p.key == "" {
+			continue
+		}
+		md = md.set(p)
+	}
+	return md, nil
+}
+
+// set returns a copy of md with p added. An existing property with the same
+// key is replaced in place, otherwise p is appended.
+func (md Metadata) set(p Property) Metadata {
+	props := make(properties, len(md.props), len(md.props)+1)
+	copy(props, md.props)
+	for i := range props {
+		if props[i].key == p.key {
+			props[i] = p
+			return Metadata{props: props}
+		}
+	}
+	return Metadata{props: append(props, p)}
+}
+
+// Get returns the value of the key=value property identified by key. The
+// returned bool is false if no property with key exists, or if the property
+// is a flag without a value.
+func (md Metadata) Get(key string) (string, bool) {
+	for _, p := range md.props {
+		if p.key == key {
+			return p.value, p.hasValue
+		}
+	}
+	return "", false
+}
+
+// HasFlag returns whether md contains a property identified by key that does
+// not have a value.
+func (md Metadata) HasFlag(key string) bool {
+	for _, p := range md.props {
+		if p.key == key {
+			return !p.hasValue
+		}
+	}
+	return false
+}
+
+// Set returns a copy of md with the key=value property added, replacing any
+// existing property with the same key. The value does not need to be
+// percent-encoded.
+//
+// If key or value are invalid, an error is returned with the original
+// Metadata.
+func (md Metadata) Set(key, value string) (Metadata, error) {
+	p, err := NewKeyValuePropertyRaw(key, value)
+	if err != nil {
+		return md, err
+	}
+	return md.set(p), nil
+}
+
+// SetFlag returns a copy of md with a property for key that has no value
+// added, replacing any existing property with the same key.
+//
+// If key is invalid, an error is returned with the original Metadata.
+func (md Metadata) SetFlag(key string) (Metadata, error) {
+	p, err := NewKeyProperty(key)
+	if err != nil {
+		return md, err
+	}
+	return md.set(p), nil
+}
+
+// Delete returns a copy of md with the property identified by key removed.
+func (md Metadata) Delete(key string) Metadata {
+	props := make(properties, 0, len(md.props))
+	for _, p := range md.props {
+		if p.key != key {
+			props = append(props, p)
+		}
+	}
+	return Metadata{props: props}
+}
+
+// Properties returns a copy of the properties in md.
+func (md Metadata) Properties() []Property {
+	return md.props.Copy()
+}
+
+// Len returns the number of properties in md.
+func (md Metadata) Len() int {
+	return len(md.props)
+}
+
+// String encodes md into a string compliant with the W3C Baggage
+// specification.
+func (md Metadata) String() string {
+	return md.props.String()
+}
+
+// Metadata returns the structured Metadata of the Member properties.
+func (m Member) Metadata() Metadata {
+	// Properties were validated when m was created.
+	var md Metadata
+	for _, p := range m.properties {
+		// Ignore empty properties.
+		if p.key == "" {
+			continue
+		}
+		md = md.set(p)
+	}
+	return md
+}
+
+// WithMetadata returns a copy of m with its properties replaced by those of
+// md.
+func (m Member) WithMetadata(md Metadata) Member {
+	m.properties = md.props.Copy()
+	return m
+}
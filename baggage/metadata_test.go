@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package baggage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMetadata(t *testing.T) {
+	md, err := ParseMetadata("ttl=30;sensitive; owner = team%20a ;;")
+	require.NoError(t, err)
+	assert.Equal(t, 3, md.Len())
+
+	v, ok := md.Get("ttl")
+	assert.True(t, ok)
+	assert.Equal(t, "30", v)
+
+	v, ok = md.Get("owner")
+	assert.True(t, ok)
+	assert.Equal(t, "team a", v)
+
+	_, ok = md.Get("sensitive")
+	assert.False(t, ok, "flag has no value")
+	assert.True(t, md.HasFlag("sensitive"))
+	assert.False(t, md.HasFlag("ttl"), "key=value is not a flag")
+	assert.False(t, md.HasFlag("missing"))
+
+	_, err = ParseMetadata("invalid key=1")
+	assert.ErrorIs(t, err, errInvalidProperty)
+
+	md, err = ParseMetadata("")
+	require.NoError(t, err)
+	assert.Equal(t, 0, md.Len())
+}
+
+func TestParseMetadataDuplicate(t *testing.T) {
+	md, err := ParseMetadata("a=1;b;a=2")
+	require.NoError(t, err)
+	assert.Equal(t, "a=2;b", md.String())
+}
+
+func TestNewMetadata(t *testing.T) {
+	p1, err := NewKeyValuePropertyRaw("a", "1")
+	require.NoError(t, err)
+	p2, err := NewKeyProperty("b")
+	require.NoError(t, err)
+
+	md, err := NewMetadata(p1, p2)
+	require.NoError(t, err)
+	assert.Equal(t, []Property{p1, p2}, md.Properties())
+
+	_, err = NewMetadata(Property{})
+	assert.ErrorIs(t, err, errInvalidKey)
+}
+
+func TestMetadataModify(t *testing.T) {
+	var md Metadata
+
+	md1, err := md.Set("ttl", "30 s")
+	require.NoError(t, err)
+	md2, err := md1.SetFlag("sensitive")
+	require.NoError(t, err)
+	assert.Equal(t, "ttl=30%20s;sensitive", md2.String())
+
+	// Replacing a flag with a value keeps its position.
+	md3, err := md2.Set("sensitive", "true")
+	require.NoError(t, err)
+	assert.Equal(t, "ttl=30%20s;sensitive=true", md3.String())
+
+	md4 := md3.Delete("ttl")
+	assert.Equal(t, "sensitive=true", md4.String())
+
+	// Originals are not modified.
+	assert.Equal(t, 0, md.Len())
+	assert.Equal(t, "ttl=30%20s", md1.String())
+	assert.Equal(t, "ttl=30%20s;sensitive", md2.String())
+
+	_, err = md.Set("", "1")
+	assert.ErrorIs(t, err, errInvalidKey)
+	_, err = md.SetFlag("")
+	assert.ErrorIs(t, err, errInvalidKey)
+}
+
+func TestMemberMetadata(t *testing.T) {
+	b, err := Parse("key=value;ttl=30;sensitive")
+	require.NoError(t, err)
+	m := b.Member("key")
+
+	md := m.Metadata()
+	v, ok := md.Get("ttl")
+	assert.True(t, ok)
+	assert.Equal(t, "30", v)
+	assert.True(t, md.HasFlag("sensitive"))
+
+	md = md.Delete("sensitive")
+	m2 := m.WithMetadata(md)
+	assert.Equal(t, "key=value;ttl=30", m2.String())
+	assert.Equal(t, "key=value;ttl=30;sensitive", m.String(), "original modified")
+}
@@ -5,6 +5,7 @@ package opencensus // import "go.opentelemetry.io/otel/bridge/opencensus"
 
 import (
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -20,7 +21,8 @@ func newTraceConfig(options []TraceOption) traceConfig {
 }
 
 type traceConfig struct {
-	tp trace.TracerProvider
+	tp                trace.TracerProvider
+	samplerAttributes bool
 }
 
 // TraceOption applies a configuration option value to an OpenCensus bridge
@@ -45,10 +47,58 @@ func WithTracerProvider(tp trace.TracerProvider) TraceOption {
 	})
 }
 
-type metricConfig struct{}
+// WithSamplerAttributes adds the SamplerKey and SamplerProbabilityKey
+// attributes to the spans started with one of the AlwaysSample, NeverSample,
+// or ProbabilitySampler Samplers of this package. Use NewSampler to configure
+// the OpenTelemetry SDK to honor them.
+//
+// By default, and for the other Samplers, starting a span with a Sampler is
+// reported as an error to the OpenTelemetry ErrorHandler.
+func WithSamplerAttributes() TraceOption {
+	return traceOptionFunc(func(conf traceConfig) traceConfig {
+		conf.samplerAttributes = true
+		return conf
+	})
+}
+
+// newMetricConfig returns a config configured with options.
+func newMetricConfig(options []MetricOption) metricConfig {
+	var conf metricConfig
+	for _, o := range options {
+		conf = o.apply(conf)
+	}
+	return conf
+}
+
+type metricConfig struct {
+	gaugeDistribution func(metricdata.Histogram[float64]) metricdata.Aggregation
+}
 
 // MetricOption applies a configuration option value to an OpenCensus bridge
 // MetricProducer.
 type MetricOption interface {
 	apply(metricConfig) metricConfig
 }
+
+// metricOptionFunc applies a set of options to a config.
+type metricOptionFunc func(metricConfig) metricConfig
+
+// apply returns a config with option(s) applied.
+func (o metricOptionFunc) apply(conf metricConfig) metricConfig {
+	return o(conf)
+}
+
+// WithGaugeDistributionConversion sets the function converting OpenCensus
+// GaugeDistribution metrics. OpenTelemetry has no gauge histogram, these
+// metrics are dropped by default.
+//
+// The function is passed the histogram of the distributions reported by
+// OpenCensus, without temporality. It returns the aggregation exported for
+// the metric, or nil to drop it. For example, it can set the histogram
+// temporality if the exporter is known to handle it.
+func WithGaugeDistributionConversion(f func(metricdata.Histogram[float64]) metricdata.Aggregation) MetricOption {
+	return metricOptionFunc(func(conf metricConfig) metricConfig {
+		conf.gaugeDistribution = f
+		return conf
+	})
+}
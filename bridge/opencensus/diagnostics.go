@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opencensus // import "go.opentelemetry.io/otel/bridge/opencensus"
+
+import (
+	"go.opentelemetry.io/otel/bridge/opencensus/internal/diag"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// EnableDiagnostics enables the diagnostic mode of the trace and metric
+// bridges. In this mode, every piece of OpenCensus telemetry the bridges
+// cannot faithfully translate is counted by the
+// opencensus.bridge.untranslated counter created with mp. The reason
+// attribute of the counter describes the translation issue:
+//
+//   - attribute_type: an attribute value of an unsupported type was
+//     converted to its string representation.
+//   - sampler: a span start Sampler could not be translated.
+//   - span_context: a span could not be added to a context.
+//   - metric_type: a metric of an unsupported type was dropped.
+//   - data_point: an invalid metric data point was dropped.
+//   - sum_of_squared_deviation: the sum of squared deviation of a
+//     distribution data point was dropped.
+//
+// This is meant to quantify the fidelity loss of a migration. The issues
+// resulting in errors are still sent to the OpenTelemetry ErrorHandler or
+// returned by the MetricProducer.
+//
+// Passing a nil mp disables the diagnostic mode.
+func EnableDiagnostics(mp metric.MeterProvider) error {
+	if mp == nil {
+		diag.Disable()
+		return nil
+	}
+	return diag.Enable(mp.Meter(scopeName, metric.WithInstrumentationVersion(Version())))
+}
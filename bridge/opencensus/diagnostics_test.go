@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opencensus // import "go.opentelemetry.io/otel/bridge/opencensus"
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ocmetricdata "go.opencensus.io/metric/metricdata"
+	"go.opencensus.io/metric/metricproducer"
+	octrace "go.opencensus.io/trace"
+
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// untranslated returns the untranslated counts collected by r per reason.
+func untranslated(t *testing.T, r metric.Reader) map[string]int64 {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, r.Collect(context.Background(), &rm))
+
+	got := make(map[string]int64)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "opencensus.bridge.untranslated" {
+				continue
+			}
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				reason, _ := dp.Attributes.Value("reason")
+				got[reason.AsString()] = dp.Value
+			}
+		}
+	}
+	return got
+}
+
+func TestEnableDiagnostics(t *testing.T) {
+	reader := metric.NewManualReader()
+	require.NoError(t, EnableDiagnostics(metric.NewMeterProvider(metric.WithReader(reader))))
+	t.Cleanup(func() { require.NoError(t, EnableDiagnostics(nil)) })
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := trace.NewTracerProvider(trace.WithSyncer(exporter))
+	bridge := newTraceBridge([]TraceOption{WithTracerProvider(tp)})
+
+	// Only samples the spans with an unsampled parent.
+	custom := func(p octrace.SamplingParameters) octrace.SamplingDecision {
+		return octrace.SamplingDecision{Sample: !p.ParentContext.IsSampled()}
+	}
+	_, span := bridge.StartSpan(context.Background(), "span", octrace.WithSampler(custom))
+	span.AddLink(octrace.Link{Attributes: map[string]any{
+		"supported":   "value",
+		"unsupported": struct{}{},
+	}})
+	span.End()
+
+	now := time.Now()
+	fake := &fakeOCProducer{metrics: []*ocmetricdata.Metric{
+		{Descriptor: ocmetricdata.Descriptor{Type: typeUnsupported}},
+		{
+			Descriptor: ocmetricdata.Descriptor{Type: ocmetricdata.TypeCumulativeDistribution},
+			TimeSeries: []*ocmetricdata.TimeSeries{{
+				StartTime: now,
+				Points: []ocmetricdata.Point{
+					ocmetricdata.NewDistributionPoint(now, &ocmetricdata.Distribution{
+						Count:                 1,
+						Sum:                   1,
+						SumOfSquaredDeviation: 1,
+						BucketOptions:         &ocmetricdata.BucketOptions{},
+					}),
+					ocmetricdata.NewInt64Point(now, 1),
+				},
+			}},
+		},
+	}}
+	metricproducer.GlobalManager().AddProducer(fake)
+	t.Cleanup(func() { metricproducer.GlobalManager().DeleteProducer(fake) })
+	_, err := NewMetricProducer().Produce(context.Background())
+	assert.Error(t, err)
+
+	assert.Equal(t, map[string]int64{
+		"sampler":                  1,
+		"attribute_type":           1,
+		"metric_type":              1,
+		"data_point":               1,
+		"sum_of_squared_deviation": 1,
+	}, untranslated(t, reader))
+}
+
+func TestEnableDiagnosticsDisabled(t *testing.T) {
+	reader := metric.NewManualReader()
+	require.NoError(t, EnableDiagnostics(metric.NewMeterProvider(metric.WithReader(reader))))
+	require.NoError(t, EnableDiagnostics(nil))
+
+	bridge := newTraceBridge([]TraceOption{WithTracerProvider(trace.NewTracerProvider())})
+	_, span := bridge.StartSpan(context.Background(), "span")
+	span.AddLink(octrace.Link{Attributes: map[string]any{"unsupported": struct{}{}}})
+	span.End()
+
+	assert.Empty(t, untranslated(t, reader))
+}
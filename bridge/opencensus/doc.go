@@ -25,6 +25,18 @@
 // With this approach, you can migrate your telemetry, including in dependent
 // libraries over time without disruption.
 //
+// # Context
+//
+// Once the trace bridge is installed, OpenCensus and OpenTelemetry share the
+// span held by a context. A span started with OpenCensus can be retrieved
+// with the SpanFromContext function of [go.opentelemetry.io/otel/trace], and
+// a span started with OpenTelemetry can be retrieved with the FromContext
+// function of [go.opencensus.io/trace]. This preserves the parent-child
+// relationships of spans in code mixing both APIs.
+//
+// OpenCensus tags and OpenTelemetry baggage are not shared. Use
+// OCTagsToOTelBaggage and OTelBaggageToOCTags to copy one into the other.
+//
 // # Warnings
 //
 // Installing a metric or tracing bridge will cause OpenCensus telemetry to be
@@ -37,12 +49,23 @@
 //
 // There are known limitations to the trace bridge:
 //
-//   - The NewContext method of the OpenCensus Tracer cannot embed an OpenCensus
-//     Span in a context unless that Span was created by that Tracer.
-//   - Conversion of custom OpenCensus Samplers to OpenTelemetry is not
+//   - The NewContext method of the OpenCensus Tracer only embeds the
+//     SpanContext of an OpenCensus Span in a context if that Span was not
+//     created by the bridge. Spans started from the context are children of
+//     that Span, but the Span cannot be retrieved from the context.
+//   - The AlwaysSample, NeverSample, and ProbabilitySampler Samplers of this
+//     package passed when starting a span are only added as attributes of the
+//     span, and only if the bridge is configured WithSamplerAttributes. Use
+//     NewSampler to configure the OpenTelemetry SDK to honor them.
+//   - Conversion of other OpenCensus Samplers to OpenTelemetry is not
 //     implemented, and An error will be sent to the OpenTelemetry ErrorHandler.
 //
 // There are known limitations to the metric bridge:
-//   - GaugeDistribution-typed metrics are dropped
+//   - GaugeDistribution-typed metrics are dropped, as OpenTelemetry has no
+//     gauge histogram. Use WithGaugeDistributionConversion to convert them.
+//     Each data point of the converted histogram is the distribution
+//     reported by OpenCensus at the time of the point, with the start time
+//     of its OpenCensus time series. The data points of consecutive
+//     collections are not increments, they must not be added together
 //   - Histogram's SumOfSquaredDeviation field is dropped
 package opencensus // import "go.opentelemetry.io/otel/bridge/opencensus"
@@ -4,6 +4,8 @@
 package opencensus_test
 
 import (
+	"context"
+
 	"go.opentelemetry.io/otel/bridge/opencensus"
 	"go.opentelemetry.io/otel/sdk/metric"
 )
@@ -20,3 +22,18 @@ func ExampleNewMetricProducer() {
 	// Add the reader to your MeterProvider.
 	_ = metric.NewMeterProvider(metric.WithReader(reader))
 }
+
+func ExampleInstallMetricBridge() {
+	reader := metric.NewManualReader()
+	_ = metric.NewMeterProvider(metric.WithReader(reader))
+
+	// Install the OpenCensus Metric bridge with the reader of your
+	// MeterProvider.
+	bridge, err := opencensus.InstallMetricBridge(reader)
+	if err != nil {
+		panic(err)
+	}
+
+	// Uninstall the bridge when OpenCensus metrics are no longer needed.
+	_ = bridge.Shutdown(context.Background())
+}
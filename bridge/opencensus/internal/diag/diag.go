@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package diag counts the OpenCensus telemetry the bridge cannot faithfully
+// translate to OpenTelemetry.
+package diag // import "go.opentelemetry.io/otel/bridge/opencensus/internal/diag"
+
+import (
+	"context"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// CounterName is the name of the counter of untranslated telemetry.
+	CounterName = "opencensus.bridge.untranslated"
+	// ReasonKey is the key of the attribute describing why the counted
+	// telemetry was not faithfully translated.
+	ReasonKey = attribute.Key("reason")
+)
+
+// Reasons telemetry is not faithfully translated.
+const (
+	// ReasonAttributeType is an attribute value of an unsupported type
+	// converted to its string representation.
+	ReasonAttributeType = "attribute_type"
+	// ReasonSampler is a span start Sampler that could not be translated.
+	ReasonSampler = "sampler"
+	// ReasonSpanContext is a span that could not be added to a context.
+	ReasonSpanContext = "span_context"
+	// ReasonMetricType is a metric of an unsupported type that is dropped.
+	ReasonMetricType = "metric_type"
+	// ReasonDataPoint is an invalid metric data point that is dropped.
+	ReasonDataPoint = "data_point"
+	// ReasonSumOfSquaredDeviation is a distribution sum of squared deviation
+	// that is dropped.
+	ReasonSumOfSquaredDeviation = "sum_of_squared_deviation"
+)
+
+var counter atomic.Pointer[metric.Int64Counter]
+
+// Enable starts counting the untranslated telemetry with a counter created
+// by meter.
+func Enable(meter metric.Meter) error {
+	c, err := meter.Int64Counter(
+		CounterName,
+		metric.WithUnit("{item}"),
+		metric.WithDescription("The number of OpenCensus telemetry items not faithfully translated to OpenTelemetry."),
+	)
+	if err != nil {
+		return err
+	}
+	counter.Store(&c)
+	return nil
+}
+
+// Disable stops counting the untranslated telemetry.
+func Disable() {
+	counter.Store(nil)
+}
+
+// Enabled returns whether the untranslated telemetry is counted.
+func Enabled() bool {
+	return counter.Load() != nil
+}
+
+// Record counts n items not faithfully translated for reason. It does
+// nothing if counting is not enabled.
+func Record(reason string, n int) {
+	c := counter.Load()
+	if c == nil || n <= 0 {
+		return
+	}
+	(*c).Add(context.Background(), int64(n), metric.WithAttributes(ReasonKey.String(reason)))
+}
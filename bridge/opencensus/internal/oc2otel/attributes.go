@@ -5,9 +5,12 @@
 package oc2otel // import "go.opentelemetry.io/otel/bridge/opencensus/internal/oc2otel"
 
 import (
+	"fmt"
+
 	octrace "go.opencensus.io/trace"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/bridge/opencensus/internal/diag"
 )
 
 func Attributes(attr []octrace.Attribute) []attribute.KeyValue {
@@ -36,13 +39,20 @@ func AttributeValue(ocval interface{}) attribute.Value {
 	switch v := ocval.(type) {
 	case bool:
 		return attribute.BoolValue(v)
+	case int:
+		return attribute.IntValue(v)
+	case int32:
+		return attribute.Int64Value(int64(v))
 	case int64:
 		return attribute.Int64Value(v)
+	case float32:
+		return attribute.Float64Value(float64(v))
 	case float64:
 		return attribute.Float64Value(v)
 	case string:
 		return attribute.StringValue(v)
 	default:
-		return attribute.StringValue("unknown")
+		diag.Record(diag.ReasonAttributeType, 1)
+		return attribute.StringValue(fmt.Sprint(v))
 	}
 }
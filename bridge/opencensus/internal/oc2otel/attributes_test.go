@@ -43,6 +43,9 @@ func TestAttributesFromMap(t *testing.T) {
 		"int64":   int64(49),
 		"float64": float64(1.618),
 		"key":     "val",
+		"int":     int(7),
+		"int32":   int32(8),
+		"float32": float32(0.5),
 	}
 
 	want := []attribute.KeyValue{
@@ -50,6 +53,9 @@ func TestAttributesFromMap(t *testing.T) {
 		attribute.Int64("int64", 49),
 		attribute.Float64("float64", 1.618),
 		attribute.String("key", "val"),
+		attribute.Int("int", 7),
+		attribute.Int64("int32", 8),
+		attribute.Float64("float32", 0.5),
 	}
 	got := AttributesFromMap(in)
 
@@ -65,8 +71,8 @@ func TestAttributesFromMap(t *testing.T) {
 }
 
 func TestAttributeValueUnknown(t *testing.T) {
-	got := AttributeValue([]byte{})
-	if got != attribute.StringValue("unknown") {
+	got := AttributeValue([]string{"a", "b"})
+	if got != attribute.StringValue("[a b]") {
 		t.Errorf("AttributeValue of unknown wrong: %#v", got)
 	}
 }
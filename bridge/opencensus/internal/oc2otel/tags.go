@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oc2otel // import "go.opentelemetry.io/otel/bridge/opencensus/internal/oc2otel"
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"go.opencensus.io/tag"
+
+	"go.opentelemetry.io/otel/baggage"
+)
+
+var errTagEncoding = errors.New("invalid OpenCensus tag encoding")
+
+// Tags returns the propagated tags of m as baggage members. An error is
+// returned for the tags that are not valid baggage members.
+func Tags(m *tag.Map) ([]baggage.Member, error) {
+	// The Map does not expose its tags. Decode them from its binary encoding,
+	// which conveniently only contains the propagated tags: a version byte,
+	// then for each tag a key type byte, the varint length prefixed key, and
+	// the varint length prefixed value.
+	buf := tag.Encode(m)
+	if len(buf) == 0 {
+		return nil, nil
+	}
+	buf = buf[1:]
+
+	var members []baggage.Member
+	var err error
+	for len(buf) > 0 {
+		var k, v string
+		var ok bool
+		// Skip the key type, only string keys exist.
+		if k, buf, ok = readString(buf[1:]); !ok {
+			return members, errors.Join(err, errTagEncoding)
+		}
+		if v, buf, ok = readString(buf); !ok {
+			return members, errors.Join(err, errTagEncoding)
+		}
+		member, mErr := baggage.NewMemberRaw(k, v)
+		if mErr != nil {
+			err = errors.Join(err, fmt.Errorf("tag %q: %w", k, mErr))
+			continue
+		}
+		members = append(members, member)
+	}
+	return members, err
+}
+
+func readString(buf []byte) (s string, rest []byte, ok bool) {
+	n, l := binary.Uvarint(buf)
+	if l <= 0 || n > uint64(len(buf)-l) {
+		return "", nil, false
+	}
+	buf = buf[l:]
+	return string(buf[:n]), buf[n:], true
+}
@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oc2otel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/tag"
+
+	"go.opentelemetry.io/otel/baggage"
+)
+
+func TestTags(t *testing.T) {
+	k1 := tag.MustNewKey("k1")
+	k2 := tag.MustNewKey("k2")
+	local := tag.MustNewKey("local")
+	ctx, err := tag.New(context.Background(),
+		tag.Upsert(k1, "v1"),
+		tag.Upsert(k2, "v 2"),
+		tag.Upsert(local, "v3", tag.WithTTL(tag.TTLNoPropagation)),
+	)
+	require.NoError(t, err)
+
+	members, err := Tags(tag.FromContext(ctx))
+	require.NoError(t, err)
+
+	got := make(map[string]string, len(members))
+	for _, m := range members {
+		got[m.Key()] = m.Value()
+	}
+	assert.Equal(t, map[string]string{"k1": "v1", "k2": "v 2"}, got)
+}
+
+func TestTagsEmpty(t *testing.T) {
+	members, err := Tags(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, members)
+
+	members, err = Tags(tag.FromContext(context.Background()))
+	assert.NoError(t, err)
+	assert.Empty(t, members)
+}
+
+func TestTagsSpecialCharacters(t *testing.T) {
+	k := tag.MustNewKey("key with spaces")
+	ctx, err := tag.New(context.Background(), tag.Upsert(k, "a=b,c;d"))
+	require.NoError(t, err)
+
+	members, err := Tags(tag.FromContext(ctx))
+	require.NoError(t, err)
+
+	want, err := baggage.NewMemberRaw("key with spaces", "a=b,c;d")
+	require.NoError(t, err)
+	assert.Equal(t, []baggage.Member{want}, members)
+}
@@ -4,14 +4,51 @@
 package oc2otel // import "go.opentelemetry.io/otel/bridge/opencensus/internal/oc2otel"
 
 import (
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 
 	octrace "go.opencensus.io/trace"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
-func StartOptions(optFuncs []octrace.StartOption) ([]trace.SpanStartOption, error) {
+const (
+	// SamplerKey is the key of the span start attribute identifying the
+	// OpenCensus Sampler the span was started with.
+	SamplerKey = attribute.Key("opencensus.sampler")
+	// SamplerProbabilityKey is the key of the span start attribute holding
+	// the fraction of a ProbabilitySampler the span was started with.
+	SamplerProbabilityKey = attribute.Key("opencensus.sampler.probability")
+)
+
+// Values of the SamplerKey attribute, named after the OpenCensus functions
+// returning the Samplers.
+const (
+	AlwaysSample       = "AlwaysSample"
+	NeverSample        = "NeverSample"
+	ProbabilitySampler = "ProbabilitySampler"
+)
+
+var errUnsupportedSampler = errors.New("unsupported sampler")
+
+// probeName is the span name of the SamplingParameters passed to a Sampler
+// to identify it. The Samplers returned by Sampler record their attributes
+// in probes, keyed by the probe trace ID, instead of deciding.
+const probeName = "go.opentelemetry.io/otel/bridge/opencensus/sampler-probe"
+
+var (
+	probes  sync.Map // map[octrace.TraceID][]attribute.KeyValue
+	probeID atomic.Uint64
+)
+
+// StartOptions returns the OpenTelemetry start options of the OpenCensus
+// ones. If samplerAttributes is true, the Sampler option is converted into
+// the attributes identifying the Sampler, otherwise it is unsupported.
+func StartOptions(optFuncs []octrace.StartOption, samplerAttributes bool) ([]trace.SpanStartOption, error) {
 	var ocOpts octrace.StartOptions
 	for _, fn := range optFuncs {
 		fn(&ocOpts)
@@ -29,7 +66,41 @@ func StartOptions(optFuncs []octrace.StartOption) ([]trace.SpanStartOption, erro
 
 	var err error
 	if ocOpts.Sampler != nil {
-		err = fmt.Errorf("unsupported sampler: %v", ocOpts.Sampler)
+		if !samplerAttributes {
+			return otelOpts, fmt.Errorf("%w: %v", errUnsupportedSampler, ocOpts.Sampler)
+		}
+		var attrs []attribute.KeyValue
+		if attrs, err = SamplerAttributes(ocOpts.Sampler); err == nil {
+			otelOpts = append(otelOpts, trace.WithAttributes(attrs...))
+		}
 	}
 	return otelOpts, err
 }
+
+// Sampler returns an OpenCensus Sampler deciding as s and identified by
+// SamplerAttributes with attrs.
+func Sampler(s octrace.Sampler, attrs ...attribute.KeyValue) octrace.Sampler {
+	return func(p octrace.SamplingParameters) octrace.SamplingDecision {
+		if p.Name == probeName {
+			probes.Store(p.TraceID, attrs)
+			return octrace.SamplingDecision{}
+		}
+		return s(p)
+	}
+}
+
+// SamplerAttributes returns the attributes identifying s.
+//
+// OpenCensus Samplers are functions and cannot be compared, s is called once
+// with a probe to identify it. An error is returned if s was not returned by
+// Sampler.
+func SamplerAttributes(s octrace.Sampler) ([]attribute.KeyValue, error) {
+	p := octrace.SamplingParameters{Name: probeName}
+	binary.BigEndian.PutUint64(p.TraceID[8:], probeID.Add(1))
+	s(p)
+	attrs, ok := probes.LoadAndDelete(p.TraceID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", errUnsupportedSampler, s)
+	}
+	return attrs.([]attribute.KeyValue), nil
+}
@@ -6,8 +6,11 @@ package oc2otel
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	octrace "go.opencensus.io/trace"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -20,7 +23,7 @@ func TestStartOptionsSpanKind(t *testing.T) {
 
 	for oc, otel := range conv {
 		ocOpts := []octrace.StartOption{octrace.WithSpanKind(oc)}
-		otelOpts, err := StartOptions(ocOpts)
+		otelOpts, err := StartOptions(ocOpts, false)
 		if err != nil {
 			t.Errorf("StartOptions errored: %v", err)
 			continue
@@ -32,10 +35,41 @@ func TestStartOptionsSpanKind(t *testing.T) {
 	}
 }
 
+func TestStartOptionsSampler(t *testing.T) {
+	attrs := []attribute.KeyValue{
+		SamplerKey.String(ProbabilitySampler),
+		SamplerProbabilityKey.Float64(0.25),
+	}
+	sampler := Sampler(octrace.ProbabilitySampler(0.25), attrs...)
+
+	otelOpts, err := StartOptions([]octrace.StartOption{octrace.WithSampler(sampler)}, true)
+	require.NoError(t, err)
+	c := trace.NewSpanStartConfig(otelOpts...)
+	assert.Equal(t, attrs, c.Attributes())
+
+	_, err = StartOptions([]octrace.StartOption{octrace.WithSampler(sampler)}, false)
+	assert.ErrorIs(t, err, errUnsupportedSampler, "sampler attributes disabled")
+}
+
+func TestSamplerDecisions(t *testing.T) {
+	sampler := Sampler(octrace.NeverSample(), SamplerKey.String(NeverSample))
+	assert.False(t, sampler(octrace.SamplingParameters{}).Sample)
+
+	sampler = Sampler(octrace.AlwaysSample(), SamplerKey.String(AlwaysSample))
+	assert.True(t, sampler(octrace.SamplingParameters{}).Sample)
+}
+
 func TestStartOptionsSamplerErrors(t *testing.T) {
-	ocOpts := []octrace.StartOption{octrace.WithSampler(octrace.AlwaysSample())}
-	_, err := StartOptions(ocOpts)
-	if err == nil {
-		t.Error("StartOptions should error Sampler option")
+	calls := 0
+	custom := func(octrace.SamplingParameters) octrace.SamplingDecision {
+		calls++
+		return octrace.SamplingDecision{Sample: true}
 	}
+	ocOpts := []octrace.StartOption{octrace.WithSampler(custom)}
+	_, err := StartOptions(ocOpts, true)
+	assert.ErrorIs(t, err, errUnsupportedSampler)
+	assert.Equal(t, 1, calls, "Sampler calls")
+
+	_, err = StartOptions([]octrace.StartOption{octrace.WithSampler(octrace.AlwaysSample())}, true)
+	assert.ErrorIs(t, err, errUnsupportedSampler, "OpenCensus AlwaysSample")
 }
@@ -17,6 +17,7 @@ import (
 	octrace "go.opencensus.io/trace"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/bridge/opencensus/internal/diag"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
@@ -31,19 +32,33 @@ var (
 	)
 )
 
+// GaugeDistributionFunc converts the histogram of an OpenCensus
+// GaugeDistribution metric to an OpenTelemetry aggregation. The temporality
+// of the histogram is not set. The metric is dropped if nil is returned.
+type GaugeDistributionFunc func(metricdata.Histogram[float64]) metricdata.Aggregation
+
 // ConvertMetrics converts metric data from OpenCensus to OpenTelemetry.
-func ConvertMetrics(ocmetrics []*ocmetricdata.Metric) ([]metricdata.Metrics, error) {
+//
+// GaugeDistribution metrics have no OpenTelemetry equivalent, they are
+// converted with gaugeDist. If gaugeDist is nil, they are dropped.
+func ConvertMetrics(
+	ocmetrics []*ocmetricdata.Metric,
+	gaugeDist GaugeDistributionFunc,
+) ([]metricdata.Metrics, error) {
 	otelMetrics := make([]metricdata.Metrics, 0, len(ocmetrics))
 	var err error
 	for _, ocm := range ocmetrics {
 		if ocm == nil {
 			continue
 		}
-		agg, aggregationErr := convertAggregation(ocm)
+		agg, aggregationErr := convertAggregation(ocm, gaugeDist)
 		if aggregationErr != nil {
 			err = errors.Join(err, fmt.Errorf("error converting metric %v: %w", ocm.Descriptor.Name, aggregationErr))
 			continue
 		}
+		if agg == nil { // Dropped by gaugeDist.
+			continue
+		}
 		otelMetrics = append(otelMetrics, metricdata.Metrics{
 			Name:        ocm.Descriptor.Name,
 			Description: ocm.Descriptor.Description,
@@ -58,7 +73,10 @@ func ConvertMetrics(ocmetrics []*ocmetricdata.Metric) ([]metricdata.Metrics, err
 }
 
 // convertAggregation produces an aggregation based on the OpenCensus Metric.
-func convertAggregation(metric *ocmetricdata.Metric) (metricdata.Aggregation, error) {
+func convertAggregation(
+	metric *ocmetricdata.Metric,
+	gaugeDist GaugeDistributionFunc,
+) (metricdata.Aggregation, error) {
 	labelKeys := metric.Descriptor.LabelKeys
 	switch metric.Descriptor.Type {
 	case ocmetricdata.TypeGaugeInt64:
@@ -70,10 +88,23 @@ func convertAggregation(metric *ocmetricdata.Metric) (metricdata.Aggregation, er
 	case ocmetricdata.TypeCumulativeFloat64:
 		return convertSum[float64](labelKeys, metric.TimeSeries)
 	case ocmetricdata.TypeCumulativeDistribution:
-		return convertHistogram(labelKeys, metric.TimeSeries)
+		return convertHistogram(labelKeys, metric.TimeSeries, metricdata.CumulativeTemporality)
+	case ocmetricdata.TypeGaugeDistribution:
+		// OpenTelemetry has no gauge histogram. Neither temporality describes
+		// the distributions reported by OpenCensus, only the user can decide
+		// how to convert them. They are dropped otherwise.
+		if gaugeDist != nil {
+			h, err := convertHistogram(labelKeys, metric.TimeSeries, metricdata.Temporality(0))
+			if agg := gaugeDist(h); agg != nil {
+				return agg, err
+			}
+			diag.Record(diag.ReasonMetricType, 1)
+			return nil, err
+		}
 	case ocmetricdata.TypeSummary:
 		return convertSummary(labelKeys, metric.TimeSeries)
 	}
+	diag.Record(diag.ReasonMetricType, 1)
 	return nil, fmt.Errorf("%w: %q", errAggregationType, metric.Descriptor.Type)
 }
 
@@ -106,12 +137,14 @@ func convertNumberDataPoints[N int64 | float64](
 	for _, t := range ts {
 		attrs, attrsErr := convertAttrs(labelKeys, t.LabelValues)
 		if attrsErr != nil {
+			diag.Record(diag.ReasonDataPoint, len(t.Points))
 			err = errors.Join(err, attrsErr)
 			continue
 		}
 		for _, p := range t.Points {
 			v, ok := p.Value.(N)
 			if !ok {
+				diag.Record(diag.ReasonDataPoint, 1)
 				err = errors.Join(err, fmt.Errorf("%w: %q", errMismatchedValueTypes, p.Value))
 				continue
 			}
@@ -127,34 +160,42 @@ func convertNumberDataPoints[N int64 | float64](
 }
 
 // convertHistogram converts OpenCensus Distribution timeseries to an
-// OpenTelemetry Histogram aggregation.
+// OpenTelemetry Histogram aggregation with temporality.
 func convertHistogram(
 	labelKeys []ocmetricdata.LabelKey,
 	ts []*ocmetricdata.TimeSeries,
+	temporality metricdata.Temporality,
 ) (metricdata.Histogram[float64], error) {
 	points := make([]metricdata.HistogramDataPoint[float64], 0, len(ts))
 	var err error
 	for _, t := range ts {
 		attrs, attrsErr := convertAttrs(labelKeys, t.LabelValues)
 		if attrsErr != nil {
+			diag.Record(diag.ReasonDataPoint, len(t.Points))
 			err = errors.Join(err, attrsErr)
 			continue
 		}
 		for _, p := range t.Points {
 			dist, ok := p.Value.(*ocmetricdata.Distribution)
 			if !ok {
+				diag.Record(diag.ReasonDataPoint, 1)
 				err = errors.Join(err, fmt.Errorf("%w: %d", errMismatchedValueTypes, p.Value))
 				continue
 			}
 			bucketCounts, exemplars, bucketErr := convertBuckets(dist.Buckets)
 			if bucketErr != nil {
+				diag.Record(diag.ReasonDataPoint, 1)
 				err = errors.Join(err, bucketErr)
 				continue
 			}
 			if dist.Count < 0 {
+				diag.Record(diag.ReasonDataPoint, 1)
 				err = errors.Join(err, fmt.Errorf("%w: %d", errNegativeCount, dist.Count))
 				continue
 			}
+			if dist.SumOfSquaredDeviation != 0 {
+				diag.Record(diag.ReasonSumOfSquaredDeviation, 1)
+			}
 			points = append(points, metricdata.HistogramDataPoint[float64]{
 				Attributes:   attrs,
 				StartTime:    t.StartTime,
@@ -167,7 +208,7 @@ func convertHistogram(
 			})
 		}
 	}
-	return metricdata.Histogram[float64]{DataPoints: points, Temporality: metricdata.CumulativeTemporality}, err
+	return metricdata.Histogram[float64]{DataPoints: points, Temporality: temporality}, err
 }
 
 // convertBuckets converts from OpenCensus bucket counts to slice of uint64,
@@ -300,6 +341,7 @@ func convertKV(key string, value any) attribute.KeyValue {
 	case fmt.Stringer:
 		return attribute.Stringer(key, typedVal)
 	default:
+		diag.Record(diag.ReasonAttributeType, 1)
 		return attribute.String(key, fmt.Sprintf("unhandled attribute value: %+v", value))
 	}
 }
@@ -355,16 +397,19 @@ func convertSummary(labelKeys []ocmetricdata.LabelKey, ts []*ocmetricdata.TimeSe
 	for _, t := range ts {
 		attrs, attrErr := convertAttrs(labelKeys, t.LabelValues)
 		if attrErr != nil {
+			diag.Record(diag.ReasonDataPoint, len(t.Points))
 			err = errors.Join(err, attrErr)
 			continue
 		}
 		for _, p := range t.Points {
 			summary, ok := p.Value.(*ocmetricdata.Summary)
 			if !ok {
+				diag.Record(diag.ReasonDataPoint, 1)
 				err = errors.Join(err, fmt.Errorf("%w: %d", errMismatchedValueTypes, p.Value))
 				continue
 			}
 			if summary.Count < 0 {
+				diag.Record(diag.ReasonDataPoint, 1)
 				err = errors.Join(err, fmt.Errorf("%w: %d", errNegativeCount, summary.Count))
 				continue
 			}
@@ -22,6 +22,10 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 )
 
+// typeUnsupported is an OpenCensus metric type the bridge does not convert.
+// It is the first value after the last type defined by OpenCensus.
+const typeUnsupported = ocmetricdata.TypeSummary + 1
+
 func TestConvertMetrics(t *testing.T) {
 	endTime1 := time.Now()
 	exemplarTime := endTime1.Add(-10 * time.Second)
@@ -30,6 +34,7 @@ func TestConvertMetrics(t *testing.T) {
 	for _, tc := range []struct {
 		desc        string
 		input       []*ocmetricdata.Metric
+		gaugeDist   GaugeDistributionFunc
 		expected    []metricdata.Metrics
 		expectedErr error
 	}{
@@ -613,6 +618,138 @@ func TestConvertMetrics(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "gauge distribution",
+			input: []*ocmetricdata.Metric{
+				{
+					Descriptor: ocmetricdata.Descriptor{
+						Name:        "foo.com/gauge-histogram-a",
+						Description: "a testing gauge histogram",
+						Unit:        ocmetricdata.UnitDimensionless,
+						Type:        ocmetricdata.TypeGaugeDistribution,
+						LabelKeys: []ocmetricdata.LabelKey{
+							{Key: "a"},
+						},
+					},
+					TimeSeries: []*ocmetricdata.TimeSeries{
+						{
+							LabelValues: []ocmetricdata.LabelValue{
+								{Value: "hello", Present: true},
+							},
+							Points: []ocmetricdata.Point{
+								ocmetricdata.NewDistributionPoint(endTime1, &ocmetricdata.Distribution{
+									Count: 3,
+									Sum:   6,
+									BucketOptions: &ocmetricdata.BucketOptions{
+										Bounds: []float64{2.0},
+									},
+									Buckets: []ocmetricdata.Bucket{{Count: 1}, {Count: 2}},
+								}),
+							},
+							StartTime: startTime,
+						},
+					},
+				},
+			},
+			gaugeDist: func(h metricdata.Histogram[float64]) metricdata.Aggregation {
+				h.Temporality = metricdata.DeltaTemporality
+				return h
+			},
+			expected: []metricdata.Metrics{
+				{
+					Name:        "foo.com/gauge-histogram-a",
+					Description: "a testing gauge histogram",
+					Unit:        "1",
+					Data: metricdata.Histogram[float64]{
+						Temporality: metricdata.DeltaTemporality,
+						DataPoints: []metricdata.HistogramDataPoint[float64]{
+							{
+								Attributes:   attribute.NewSet(attribute.String("a", "hello")),
+								StartTime:    startTime,
+								Time:         endTime1,
+								Count:        3,
+								Sum:          6,
+								Bounds:       []float64{2.0},
+								BucketCounts: []uint64{1, 2},
+								Exemplars:    []metricdata.Exemplar[float64]{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			desc: "gauge distribution dropped by default",
+			input: []*ocmetricdata.Metric{
+				{
+					Descriptor: ocmetricdata.Descriptor{
+						Name:        "foo.com/gauge-histogram-a",
+						Description: "a testing gauge histogram",
+						Unit:        ocmetricdata.UnitDimensionless,
+						Type:        ocmetricdata.TypeGaugeDistribution,
+						LabelKeys: []ocmetricdata.LabelKey{
+							{Key: "a"},
+						},
+					},
+					TimeSeries: []*ocmetricdata.TimeSeries{
+						{
+							LabelValues: []ocmetricdata.LabelValue{
+								{Value: "hello", Present: true},
+							},
+							Points: []ocmetricdata.Point{
+								ocmetricdata.NewDistributionPoint(endTime1, &ocmetricdata.Distribution{
+									Count: 3,
+									Sum:   6,
+									BucketOptions: &ocmetricdata.BucketOptions{
+										Bounds: []float64{2.0},
+									},
+									Buckets: []ocmetricdata.Bucket{{Count: 1}, {Count: 2}},
+								}),
+							},
+							StartTime: startTime,
+						},
+					},
+				},
+			},
+			expectedErr: errAggregationType,
+		},
+		{
+			desc: "gauge distribution dropped by conversion",
+			input: []*ocmetricdata.Metric{
+				{
+					Descriptor: ocmetricdata.Descriptor{
+						Name:        "foo.com/gauge-histogram-a",
+						Description: "a testing gauge histogram",
+						Unit:        ocmetricdata.UnitDimensionless,
+						Type:        ocmetricdata.TypeGaugeDistribution,
+						LabelKeys: []ocmetricdata.LabelKey{
+							{Key: "a"},
+						},
+					},
+					TimeSeries: []*ocmetricdata.TimeSeries{
+						{
+							LabelValues: []ocmetricdata.LabelValue{
+								{Value: "hello", Present: true},
+							},
+							Points: []ocmetricdata.Point{
+								ocmetricdata.NewDistributionPoint(endTime1, &ocmetricdata.Distribution{
+									Count: 3,
+									Sum:   6,
+									BucketOptions: &ocmetricdata.BucketOptions{
+										Bounds: []float64{2.0},
+									},
+									Buckets: []ocmetricdata.Bucket{{Count: 1}, {Count: 2}},
+								}),
+							},
+							StartTime: startTime,
+						},
+					},
+				},
+			},
+			gaugeDist: func(metricdata.Histogram[float64]) metricdata.Aggregation {
+				return nil
+			},
+		},
 		{
 			desc: "sum without data points",
 			input: []*ocmetricdata.Metric{
@@ -918,14 +1055,14 @@ func TestConvertMetrics(t *testing.T) {
 			expectedErr: errMismatchedValueTypes,
 		},
 		{
-			desc: "unsupported Gauge Distribution type",
+			desc: "unsupported type",
 			input: []*ocmetricdata.Metric{
 				{
 					Descriptor: ocmetricdata.Descriptor{
 						Name:        "foo.com/bad-point",
 						Description: "a bad type",
 						Unit:        ocmetricdata.UnitDimensionless,
-						Type:        ocmetricdata.TypeGaugeDistribution,
+						Type:        typeUnsupported,
 					},
 				},
 			},
@@ -933,7 +1070,7 @@ func TestConvertMetrics(t *testing.T) {
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
-			output, err := ConvertMetrics(tc.input)
+			output, err := ConvertMetrics(tc.input, tc.gaugeDist)
 			if !errors.Is(err, tc.expectedErr) {
 				t.Errorf("ConvertMetrics(%+v) = err(%v), want err(%v)", tc.input, err, tc.expectedErr)
 			}
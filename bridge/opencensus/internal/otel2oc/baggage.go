@@ -0,0 +1,30 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otel2oc // import "go.opentelemetry.io/otel/bridge/opencensus/internal/otel2oc"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opencensus.io/tag"
+
+	"go.opentelemetry.io/otel/baggage"
+)
+
+// Baggage returns the mutators upserting the members of b as OpenCensus tags.
+// An error is returned for the members that are not valid tags.
+func Baggage(b baggage.Baggage) ([]tag.Mutator, error) {
+	members := b.Members()
+	mutators := make([]tag.Mutator, 0, len(members))
+	var err error
+	for _, m := range members {
+		k, kErr := tag.NewKey(m.Key())
+		if kErr != nil {
+			err = errors.Join(err, fmt.Errorf("baggage member %q: %w", m.Key(), kErr))
+			continue
+		}
+		mutators = append(mutators, tag.Upsert(k, m.Value()))
+	}
+	return mutators, err
+}
@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otel2oc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/tag"
+
+	"go.opentelemetry.io/otel/baggage"
+)
+
+func newBaggage(t *testing.T, kv ...string) baggage.Baggage {
+	t.Helper()
+	var members []baggage.Member
+	for i := 0; i < len(kv); i += 2 {
+		m, err := baggage.NewMemberRaw(kv[i], kv[i+1])
+		require.NoError(t, err)
+		members = append(members, m)
+	}
+	b, err := baggage.New(members...)
+	require.NoError(t, err)
+	return b
+}
+
+func TestBaggage(t *testing.T) {
+	mutators, err := Baggage(newBaggage(t, "k1", "v1", "k2", "v2"))
+	require.NoError(t, err)
+
+	ctx, err := tag.New(context.Background(), mutators...)
+	require.NoError(t, err)
+
+	m := tag.FromContext(ctx)
+	v, ok := m.Value(tag.MustNewKey("k1"))
+	assert.True(t, ok)
+	assert.Equal(t, "v1", v)
+	v, ok = m.Value(tag.MustNewKey("k2"))
+	assert.True(t, ok)
+	assert.Equal(t, "v2", v)
+}
+
+func TestBaggageEmpty(t *testing.T) {
+	mutators, err := Baggage(baggage.Baggage{})
+	assert.NoError(t, err)
+	assert.Empty(t, mutators)
+}
+
+func TestBaggageInvalidKey(t *testing.T) {
+	mutators, err := Baggage(newBaggage(t, "valid", "v1", "ünïcode", "v2"))
+	assert.Error(t, err)
+	assert.Len(t, mutators, 1)
+}
@@ -12,22 +12,19 @@ import (
 	"go.opentelemetry.io/otel/bridge/opencensus/internal/oc2otel"
 	"go.opentelemetry.io/otel/bridge/opencensus/internal/otel2oc"
 	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
 const (
-	// MessageSendEvent is the name of the message send event.
-	MessageSendEvent = "message send"
-	// MessageReceiveEvent is the name of the message receive event.
-	MessageReceiveEvent = "message receive"
+	// MessageEvent is the name of the event a message send or receive event
+	// is translated to, as defined by the RPC semantic conventions.
+	MessageEvent = "message"
 )
 
-var (
-	// UncompressedKey is used for the uncompressed byte size attribute.
-	UncompressedKey = attribute.Key("uncompressed byte size")
-	// CompressedKey is used for the compressed byte size attribute.
-	CompressedKey = attribute.Key("compressed byte size")
-)
+// LinkTypeKey is the key of the link attribute describing the OpenCensus
+// LinkType of a link.
+const LinkTypeKey = attribute.Key("opencensus.link.type")
 
 // Span is an OpenCensus SpanInterface wrapper for an OpenTelemetry Span.
 type Span struct {
@@ -81,37 +78,38 @@ func (s *Span) Annotatef(attributes []octrace.Attribute, format string, a ...int
 
 // AddMessageSendEvent adds a message send event to this span.
 func (s *Span) AddMessageSendEvent(messageID, uncompressedByteSize, compressedByteSize int64) {
-	s.otelSpan.AddEvent(MessageSendEvent,
-		trace.WithAttributes(
-			attribute.KeyValue{
-				Key:   UncompressedKey,
-				Value: attribute.Int64Value(uncompressedByteSize),
-			},
-			attribute.KeyValue{
-				Key:   CompressedKey,
-				Value: attribute.Int64Value(compressedByteSize),
-			}),
-	)
+	s.addMessageEvent(semconv.RPCMessageTypeSent, messageID, uncompressedByteSize, compressedByteSize)
 }
 
 // AddMessageReceiveEvent adds a message receive event to this span.
 func (s *Span) AddMessageReceiveEvent(messageID, uncompressedByteSize, compressedByteSize int64) {
-	s.otelSpan.AddEvent(MessageReceiveEvent,
+	s.addMessageEvent(semconv.RPCMessageTypeReceived, messageID, uncompressedByteSize, compressedByteSize)
+}
+
+// addMessageEvent adds a message event with the attributes of the RPC
+// semantic conventions to this span.
+func (s *Span) addMessageEvent(msgType attribute.KeyValue, messageID, uncompressedByteSize, compressedByteSize int64) {
+	s.otelSpan.AddEvent(MessageEvent,
 		trace.WithAttributes(
-			attribute.KeyValue{
-				Key:   UncompressedKey,
-				Value: attribute.Int64Value(uncompressedByteSize),
-			},
-			attribute.KeyValue{
-				Key:   CompressedKey,
-				Value: attribute.Int64Value(compressedByteSize),
-			}),
+			msgType,
+			semconv.RPCMessageIDKey.Int64(messageID),
+			semconv.RPCMessageUncompressedSizeKey.Int64(uncompressedByteSize),
+			semconv.RPCMessageCompressedSizeKey.Int64(compressedByteSize),
+		),
 	)
 }
 
 // AddLink adds a link to this span.
-// This drops the OpenCensus LinkType because there is no such concept in OpenTelemetry.
+// The OpenCensus LinkType, a concept OpenTelemetry does not have, is added as
+// the LinkTypeKey attribute of the link if it is specified.
 func (s *Span) AddLink(l octrace.Link) {
+	attrs := oc2otel.AttributesFromMap(l.Attributes)
+	switch l.Type {
+	case octrace.LinkTypeChild:
+		attrs = append(attrs, LinkTypeKey.String("CHILD_LINKED_SPAN"))
+	case octrace.LinkTypeParent:
+		attrs = append(attrs, LinkTypeKey.String("PARENT_LINKED_SPAN"))
+	}
 	s.otelSpan.AddLink(trace.Link{
 		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
 			TraceID: trace.TraceID(l.TraceID),
@@ -122,7 +120,7 @@ func (s *Span) AddLink(l octrace.Link) {
 			// https://www.w3.org/TR/trace-context/#sampled-flag
 			TraceFlags: trace.FlagsSampled,
 		}),
-		Attributes: oc2otel.AttributesFromMap(l.Attributes),
+		Attributes: attrs,
 	})
 }
 
@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/otel/bridge/opencensus/internal/oc2otel"
 	"go.opentelemetry.io/otel/bridge/opencensus/internal/otel2oc"
 	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -207,60 +208,62 @@ func TestSpanAnnotatef(t *testing.T) {
 }
 
 func TestSpanAddMessageSendEvent(t *testing.T) {
-	var u, c int64 = 1, 2
+	var id, u, c int64 = 1, 1, 2
 
 	// OpenCensus does not set events if not recording.
 	s := &span{recording: true}
 	ocS := internal.NewSpan(s)
-	ocS.AddMessageSendEvent(0, u, c)
+	ocS.AddMessageSendEvent(id, u, c)
 
-	if s.eName != internal.MessageSendEvent {
+	if s.eName != internal.MessageEvent {
 		t.Error("span.AddMessageSendEvent did not set event name")
 	}
 
 	config := trace.NewEventConfig(s.eOpts...)
 	got := config.Attributes()
-	if len(got) != 2 {
-		t.Fatalf("span.AddMessageSendEvent set %d attributes, want 2", len(got))
+	want := []attribute.KeyValue{
+		semconv.RPCMessageTypeSent,
+		semconv.RPCMessageIDKey.Int64(id),
+		semconv.RPCMessageUncompressedSizeKey.Int64(u),
+		semconv.RPCMessageCompressedSizeKey.Int64(c),
 	}
-
-	want := attribute.KeyValue{Key: internal.UncompressedKey, Value: attribute.Int64Value(u)}
-	if got[0] != want {
-		t.Errorf("span.AddMessageSendEvent wrong uncompressed attribute: %v", got[0])
+	if len(got) != len(want) {
+		t.Fatalf("span.AddMessageSendEvent set %d attributes, want %d", len(got), len(want))
 	}
-
-	want = attribute.KeyValue{Key: internal.CompressedKey, Value: attribute.Int64Value(c)}
-	if got[1] != want {
-		t.Errorf("span.AddMessageSendEvent wrong compressed attribute: %v", got[1])
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("span.AddMessageSendEvent wrong attribute: got %v, want %v", got[i], want[i])
+		}
 	}
 }
 
 func TestSpanAddMessageReceiveEvent(t *testing.T) {
-	var u, c int64 = 3, 4
+	var id, u, c int64 = 1, 3, 4
 
 	// OpenCensus does not set events if not recording.
 	s := &span{recording: true}
 	ocS := internal.NewSpan(s)
-	ocS.AddMessageReceiveEvent(0, u, c)
+	ocS.AddMessageReceiveEvent(id, u, c)
 
-	if s.eName != internal.MessageReceiveEvent {
+	if s.eName != internal.MessageEvent {
 		t.Error("span.AddMessageReceiveEvent did not set event name")
 	}
 
 	config := trace.NewEventConfig(s.eOpts...)
 	got := config.Attributes()
-	if len(got) != 2 {
-		t.Fatalf("span.AddMessageReceiveEvent set %d attributes, want 2", len(got))
+	want := []attribute.KeyValue{
+		semconv.RPCMessageTypeReceived,
+		semconv.RPCMessageIDKey.Int64(id),
+		semconv.RPCMessageUncompressedSizeKey.Int64(u),
+		semconv.RPCMessageCompressedSizeKey.Int64(c),
 	}
-
-	want := attribute.KeyValue{Key: internal.UncompressedKey, Value: attribute.Int64Value(u)}
-	if got[0] != want {
-		t.Errorf("span.AddMessageReceiveEvent wrong uncompressed attribute: %v", got[0])
+	if len(got) != len(want) {
+		t.Fatalf("span.AddMessageReceiveEvent set %d attributes, want %d", len(got), len(want))
 	}
-
-	want = attribute.KeyValue{Key: internal.CompressedKey, Value: attribute.Int64Value(c)}
-	if got[1] != want {
-		t.Errorf("span.AddMessageReceiveEvent wrong compressed attribute: %v", got[1])
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("span.AddMessageReceiveEvent wrong attribute: got %v, want %v", got[i], want[i])
+		}
 	}
 }
 
@@ -272,6 +275,7 @@ func TestSpanAddLinkFails(t *testing.T) {
 	ocS.AddLink(octrace.Link{
 		TraceID: octrace.TraceID([16]byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}),
 		SpanID:  octrace.SpanID([8]byte{2, 0, 0, 0, 0, 0, 0, 0}),
+		Type:    octrace.LinkTypeParent,
 		Attributes: map[string]interface{}{
 			"foo":    "bar",
 			"number": int64(3),
@@ -293,6 +297,7 @@ func TestSpanAddLinkFails(t *testing.T) {
 			Attributes: []attribute.KeyValue{
 				attribute.String("foo", "bar"),
 				attribute.Int64("number", 3),
+				internal.LinkTypeKey.String("PARENT_LINKED_SPAN"),
 			},
 		},
 	}
@@ -9,18 +9,22 @@ import (
 
 	octrace "go.opencensus.io/trace"
 
+	"go.opentelemetry.io/otel/bridge/opencensus/internal/diag"
 	"go.opentelemetry.io/otel/bridge/opencensus/internal/oc2otel"
 	"go.opentelemetry.io/otel/trace"
 )
 
 // Tracer is an OpenCensus Tracer that wraps an OpenTelemetry Tracer.
 type Tracer struct {
-	otelTracer trace.Tracer
+	otelTracer        trace.Tracer
+	samplerAttributes bool
 }
 
 // NewTracer returns an OpenCensus Tracer that wraps the OpenTelemetry tracer.
-func NewTracer(tracer trace.Tracer) octrace.Tracer {
-	return &Tracer{otelTracer: tracer}
+// If samplerAttributes is true, the spans started with an OpenCensus Sampler
+// are given the attributes identifying it.
+func NewTracer(tracer trace.Tracer, samplerAttributes bool) octrace.Tracer {
+	return &Tracer{otelTracer: tracer, samplerAttributes: samplerAttributes}
 }
 
 // StartSpan starts a new child span of the current span in the context. If
@@ -30,8 +34,9 @@ func (o *Tracer) StartSpan(
 	name string,
 	s ...octrace.StartOption,
 ) (context.Context, *octrace.Span) {
-	otelOpts, err := oc2otel.StartOptions(s)
+	otelOpts, err := oc2otel.StartOptions(s, o.samplerAttributes)
 	if err != nil {
+		diag.Record(diag.ReasonSampler, 1)
 		Handle(fmt.Errorf("starting span %q: %w", name, err))
 	}
 	ctx, sp := o.otelTracer.Start(ctx, name, otelOpts...)
@@ -58,12 +63,25 @@ func (o *Tracer) FromContext(ctx context.Context) *octrace.Span {
 }
 
 // NewContext returns a new context with the given Span attached.
+//
+// If s was not created by a Tracer of the bridge, e.g. it was started before
+// the bridge was installed, only its SpanContext is attached. Spans started
+// from the returned context are still children of s.
+//
+// If s is nil, the returned context holds no span.
 func (o *Tracer) NewContext(parent context.Context, s *octrace.Span) context.Context {
+	if s == nil {
+		return trace.ContextWithSpan(parent, nil)
+	}
 	if otSpan, ok := s.Internal().(*Span); ok {
 		return trace.ContextWithSpan(parent, otSpan.otelSpan)
 	}
+	if sc := oc2otel.SpanContext(s.SpanContext()); sc.IsValid() {
+		return trace.ContextWithSpanContext(parent, sc)
+	}
+	diag.Record(diag.ReasonSpanContext, 1)
 	Handle(
-		fmt.Errorf("unable to create context with span %q, since it was created using a different tracer", s.String()),
+		fmt.Errorf("unable to create context with span %q, since it was created using a different tracer and has an invalid span context", s.String()),
 	)
 	return parent
 }
@@ -36,6 +36,10 @@ type tracer struct {
 	opts []trace.SpanStartOption
 }
 
+func (t *tracer) Enabled(context.Context, trace.EnabledParameters) bool {
+	return true
+}
+
 func (t *tracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
 	t.ctx, t.name, t.opts = ctx, name, opts
 	sub := noop.NewTracerProvider().Tracer("testing")
@@ -49,7 +53,7 @@ func TestTracerStartSpan(t *testing.T) {
 	defer restore()
 
 	otelTracer := &tracer{}
-	ocTracer := internal.NewTracer(otelTracer)
+	ocTracer := internal.NewTracer(otelTracer, false)
 
 	ctx := context.WithValue(context.Background(), ctxKey("key"), "value")
 	name := "testing span"
@@ -75,7 +79,7 @@ func TestTracerStartSpanReportsErrors(t *testing.T) {
 	h, restore := withHandler()
 	defer restore()
 
-	ocTracer := internal.NewTracer(&tracer{})
+	ocTracer := internal.NewTracer(&tracer{}, false)
 	ocTracer.StartSpan(context.Background(), "", octrace.WithSampler(octrace.AlwaysSample()))
 	if h.err == nil {
 		t.Error("OC tracer.StartSpan no error when converting Sampler")
@@ -84,7 +88,7 @@ func TestTracerStartSpanReportsErrors(t *testing.T) {
 
 func TestTracerStartSpanWithRemoteParent(t *testing.T) {
 	otelTracer := new(tracer)
-	ocTracer := internal.NewTracer(otelTracer)
+	ocTracer := internal.NewTracer(otelTracer, false)
 	sc := octrace.SpanContext{TraceID: [16]byte{1}, SpanID: [8]byte{1}}
 	converted := oc2otel.SpanContext(sc).WithRemote(true)
 
@@ -107,7 +111,7 @@ func TestTracerFromContext(t *testing.T) {
 	// Test using the fact that the No-Op span will propagate a span context .
 	ctx, _ = tracer.Start(ctx, "test")
 
-	got := internal.NewTracer(tracer).FromContext(ctx).SpanContext()
+	got := internal.NewTracer(tracer, false).FromContext(ctx).SpanContext()
 	// Do not test the conversion, only the propagation.
 	want := otel2oc.SpanContext(sc)
 	if got != want {
@@ -126,7 +130,7 @@ func TestTracerNewContext(t *testing.T) {
 	// Test using the fact that the No-Op span will propagate a span context .
 	_, s := tracer.Start(ctx, "test")
 
-	ocTracer := internal.NewTracer(tracer)
+	ocTracer := internal.NewTracer(tracer, false)
 	ctx = ocTracer.NewContext(context.Background(), internal.NewSpan(s))
 	got := trace.SpanContextFromContext(ctx)
 
@@ -141,14 +145,59 @@ type differentSpan struct {
 
 func (s *differentSpan) String() string { return "testing span" }
 
+func (s *differentSpan) SpanContext() octrace.SpanContext { return octrace.SpanContext{} }
+
 func TestTracerNewContextErrors(t *testing.T) {
 	h, restore := withHandler()
 	defer restore()
 
-	ocTracer := internal.NewTracer(&tracer{})
+	ocTracer := internal.NewTracer(&tracer{}, false)
 	ocSpan := octrace.NewSpan(&differentSpan{})
 	ocTracer.NewContext(context.Background(), ocSpan)
 	if h.err == nil {
 		t.Error("tracer.NewContext did not error for unrecognized span")
 	}
 }
+
+type foreignSpan struct {
+	octrace.SpanInterface
+
+	sc octrace.SpanContext
+}
+
+func (s *foreignSpan) SpanContext() octrace.SpanContext { return s.sc }
+
+func TestTracerNewContextForeignSpan(t *testing.T) {
+	h, restore := withHandler()
+	defer restore()
+
+	sc := octrace.SpanContext{
+		TraceID:      octrace.TraceID([16]byte{1}),
+		SpanID:       octrace.SpanID([8]byte{1}),
+		TraceOptions: octrace.TraceOptions(1),
+	}
+	ocTracer := internal.NewTracer(&tracer{}, false)
+	ctx := ocTracer.NewContext(context.Background(), octrace.NewSpan(&foreignSpan{sc: sc}))
+	if h.err != nil {
+		t.Errorf("tracer.NewContext errored for span with valid span context: %v", h.err)
+	}
+
+	got := trace.SpanContextFromContext(ctx)
+	if got.TraceID() != trace.TraceID(sc.TraceID) || got.SpanID() != trace.SpanID(sc.SpanID) || !got.IsSampled() {
+		t.Errorf("tracer.NewContext did not attach span context: %#v", got)
+	}
+}
+
+func TestTracerNewContextNilSpan(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: [16]byte{1},
+		SpanID:  [8]byte{1},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	ocTracer := internal.NewTracer(&tracer{}, false)
+	ctx = ocTracer.NewContext(ctx, nil)
+	if got := trace.SpanContextFromContext(ctx); got.IsValid() {
+		t.Errorf("tracer.NewContext did not remove span from context: %#v", got)
+	}
+}
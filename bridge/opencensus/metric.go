@@ -5,6 +5,8 @@ package opencensus // import "go.opentelemetry.io/otel/bridge/opencensus"
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 
 	ocmetricdata "go.opencensus.io/metric/metricdata"
 	"go.opencensus.io/metric/metricproducer"
@@ -18,14 +20,22 @@ import (
 // MetricProducer implements the [go.opentelemetry.io/otel/sdk/metric.Producer] to provide metrics
 // from OpenCensus to the OpenTelemetry SDK.
 type MetricProducer struct {
-	manager *metricproducer.Manager
+	manager   *metricproducer.Manager
+	gaugeDist internal.GaugeDistributionFunc
+
+	isShutdown atomic.Bool
 }
 
 // NewMetricProducer returns a metric.Producer that fetches metrics from
 // OpenCensus.
+//
+// See the package documentation for how OpenCensus metric types without an
+// OpenTelemetry equivalent, like GaugeDistribution, are converted.
 func NewMetricProducer(opts ...MetricOption) *MetricProducer {
+	conf := newMetricConfig(opts)
 	return &MetricProducer{
-		manager: metricproducer.GlobalManager(),
+		manager:   metricproducer.GlobalManager(),
+		gaugeDist: conf.gaugeDistribution,
 	}
 }
 
@@ -33,13 +43,18 @@ var _ metric.Producer = (*MetricProducer)(nil)
 
 // Produce fetches metrics from the OpenCensus manager,
 // translates them to OpenTelemetry's data model, and returns them.
+//
+// No metrics are returned once the MetricProducer is shut down.
 func (p *MetricProducer) Produce(context.Context) ([]metricdata.ScopeMetrics, error) {
+	if p.isShutdown.Load() {
+		return nil, nil
+	}
 	producers := p.manager.GetAll()
 	data := []*ocmetricdata.Metric{}
 	for _, ocProducer := range producers {
 		data = append(data, ocProducer.Read()...)
 	}
-	otelmetrics, err := internal.ConvertMetrics(data)
+	otelmetrics, err := internal.ConvertMetrics(data, p.gaugeDist)
 	if len(otelmetrics) == 0 {
 		return nil, err
 	}
@@ -51,3 +66,40 @@ func (p *MetricProducer) Produce(context.Context) ([]metricdata.ScopeMetrics, er
 		Metrics: otelmetrics,
 	}}, err
 }
+
+// Shutdown stops p from providing OpenCensus metrics. The readers p is
+// registered with are not shut down.
+func (p *MetricProducer) Shutdown(context.Context) error {
+	p.isShutdown.Store(true)
+	return nil
+}
+
+// MetricBridge is a handle on an installed OpenCensus metric bridge.
+type MetricBridge struct {
+	producer   *MetricProducer
+	unregister func()
+	once       sync.Once
+}
+
+// InstallMetricBridge installs the OpenCensus metric bridge with reader:
+// metrics recorded using OpenCensus are collected by reader until the
+// returned MetricBridge is shut down.
+//
+// The reader needs to be a ManualReader or a PeriodicReader of the
+// OpenTelemetry SDK, an error is returned otherwise. Use NewMetricProducer
+// with the producer option of other readers, e.g. the Prometheus exporter.
+func InstallMetricBridge(reader metric.Reader, opts ...MetricOption) (*MetricBridge, error) {
+	p := NewMetricProducer(opts...)
+	unregister, err := metric.RegisterProducer(reader, p)
+	if err != nil {
+		return nil, err
+	}
+	return &MetricBridge{producer: p, unregister: unregister}, nil
+}
+
+// Shutdown uninstalls the bridge. The reader it was installed with no longer
+// collects OpenCensus metrics, but is not shut down.
+func (b *MetricBridge) Shutdown(ctx context.Context) error {
+	b.once.Do(b.unregister)
+	return b.producer.Shutdown(ctx)
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	ocmetricdata "go.opencensus.io/metric/metricdata"
 	"go.opencensus.io/metric/metricproducer"
@@ -15,10 +16,15 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 )
 
+// typeUnsupported is an OpenCensus metric type the bridge does not convert.
+// It is the first value after the last type defined by OpenCensus.
+const typeUnsupported = ocmetricdata.TypeSummary + 1
+
 func TestMetricProducer(t *testing.T) {
 	now := time.Now()
 	for _, tc := range []struct {
@@ -80,7 +86,7 @@ func TestMetricProducer(t *testing.T) {
 						Name:        "foo.com/bad-point",
 						Description: "a bad type",
 						Unit:        ocmetricdata.UnitDimensionless,
-						Type:        ocmetricdata.TypeGaugeDistribution,
+						Type:        typeUnsupported,
 					},
 				},
 				{
@@ -148,3 +154,35 @@ type fakeOCProducer struct {
 func (f *fakeOCProducer) Read() []*ocmetricdata.Metric {
 	return f.metrics
 }
+
+func TestInstallMetricBridge(t *testing.T) {
+	now := time.Now()
+	manager := metricproducer.GlobalManager()
+	fake := &fakeOCProducer{metrics: []*ocmetricdata.Metric{{
+		TimeSeries: []*ocmetricdata.TimeSeries{{
+			StartTime: now,
+			Points:    []ocmetricdata.Point{{Value: int64(1), Time: now}},
+		}},
+	}}}
+	manager.AddProducer(fake)
+	t.Cleanup(func() { manager.DeleteProducer(fake) })
+
+	ctx := context.Background()
+	reader := metric.NewManualReader()
+	_ = metric.NewMeterProvider(metric.WithReader(reader))
+	bridge, err := InstallMetricBridge(reader)
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	assert.Equal(t, scopeName, rm.ScopeMetrics[0].Scope.Name)
+
+	require.NoError(t, bridge.Shutdown(ctx))
+	require.NoError(t, bridge.Shutdown(ctx), "second shutdown")
+	require.NoError(t, reader.Collect(ctx, &rm))
+	assert.Empty(t, rm.ScopeMetrics, "metrics collected after shutdown")
+
+	_, err = InstallMetricBridge(struct{ metric.Reader }{metric.NewManualReader()})
+	assert.Error(t, err, "unsupported reader")
+}
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opencensus // import "go.opentelemetry.io/otel/bridge/opencensus"
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	octrace "go.opencensus.io/trace"
+
+	"go.opentelemetry.io/otel/bridge/opencensus/internal/oc2otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// SamplerKey is the key of the attribute identifying the OpenCensus
+	// Sampler passed with the WithSampler start option of a span. Its value
+	// is the name of the function returning the Sampler: "AlwaysSample",
+	// "NeverSample", or "ProbabilitySampler".
+	SamplerKey = oc2otel.SamplerKey
+	// SamplerProbabilityKey is the key of the attribute holding the fraction
+	// of the ProbabilitySampler passed with the WithSampler start option of a
+	// span.
+	SamplerProbabilityKey = oc2otel.SamplerProbabilityKey
+)
+
+// AlwaysSample returns an OpenCensus Sampler sampling every span, as the
+// OpenCensus AlwaysSample does. The trace bridge identifies it when
+// configured WithSamplerAttributes.
+func AlwaysSample() octrace.Sampler {
+	return oc2otel.Sampler(octrace.AlwaysSample(), SamplerKey.String(oc2otel.AlwaysSample))
+}
+
+// NeverSample returns an OpenCensus Sampler sampling no span, as the
+// OpenCensus NeverSample does. The trace bridge identifies it when configured
+// WithSamplerAttributes.
+func NeverSample() octrace.Sampler {
+	return oc2otel.Sampler(octrace.NeverSample(), SamplerKey.String(oc2otel.NeverSample))
+}
+
+// ProbabilitySampler returns an OpenCensus Sampler sampling the given
+// fraction of the spans, as the OpenCensus ProbabilitySampler does. The trace
+// bridge identifies it when configured WithSamplerAttributes.
+func ProbabilitySampler(fraction float64) octrace.Sampler {
+	if !(fraction >= 0) {
+		fraction = 0
+	} else if fraction >= 1 {
+		return AlwaysSample()
+	}
+	return oc2otel.Sampler(
+		octrace.ProbabilitySampler(fraction),
+		SamplerKey.String(oc2otel.ProbabilitySampler),
+		SamplerProbabilityKey.Float64(fraction),
+	)
+}
+
+// NewSampler returns an OpenTelemetry Sampler honoring the OpenCensus
+// Samplers passed with the WithSampler start option of the spans started by
+// a trace bridge configured WithSamplerAttributes. The sampling decision of
+// the other spans is delegated to fallback.
+//
+// The spans started with a Sampler are sampled as OpenCensus does, using the
+// SamplerKey and SamplerProbabilityKey attributes added by the bridge:
+// AlwaysSample and NeverSample decide regardless of the parent, a
+// ProbabilitySampler samples the spans whose parent is sampled and the given
+// fraction of the others.
+func NewSampler(fallback sdktrace.Sampler) sdktrace.Sampler {
+	return sampler{fallback: fallback}
+}
+
+type sampler struct {
+	fallback sdktrace.Sampler
+}
+
+func (s sampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	var (
+		name     string
+		fraction float64
+	)
+	for _, a := range p.Attributes {
+		switch a.Key {
+		case SamplerKey:
+			name = a.Value.AsString()
+		case SamplerProbabilityKey:
+			fraction = a.Value.AsFloat64()
+		}
+	}
+
+	psc := trace.SpanContextFromContext(p.ParentContext)
+	var sample bool
+	switch name {
+	case oc2otel.AlwaysSample:
+		sample = true
+	case oc2otel.NeverSample:
+		sample = false
+	case oc2otel.ProbabilitySampler:
+		// Match the OpenCensus ProbabilitySampler decisions.
+		upperBound := uint64(fraction * (1 << 63))
+		x := binary.BigEndian.Uint64(p.TraceID[0:8]) >> 1
+		sample = psc.IsSampled() || x < upperBound
+	default:
+		return s.fallback.ShouldSample(p)
+	}
+
+	decision := sdktrace.Drop
+	if sample {
+		decision = sdktrace.RecordAndSample
+	}
+	return sdktrace.SamplingResult{
+		Decision:   decision,
+		Tracestate: psc.TraceState(),
+	}
+}
+
+func (s sampler) Description() string {
+	return fmt.Sprintf("OpenCensusSampler{%s}", s.fallback.Description())
+}
@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opencensus // import "go.opentelemetry.io/otel/bridge/opencensus"
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	octrace "go.opencensus.io/trace"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func TestSamplerStartOptions(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+		trace.WithSampler(NewSampler(trace.NeverSample())),
+	)
+	bridge := newTraceBridge([]TraceOption{WithTracerProvider(tp), WithSamplerAttributes()})
+
+	ctx := context.Background()
+	_, span := bridge.StartSpan(ctx, "dropped")
+	span.End()
+	_, span = bridge.StartSpan(ctx, "sampled", octrace.WithSampler(AlwaysSample()))
+	span.End()
+	_, span = bridge.StartSpan(ctx, "never", octrace.WithSampler(NeverSample()))
+	span.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "sampled", spans[0].Name)
+	assert.Contains(t, spans[0].Attributes, SamplerKey.String("AlwaysSample"))
+}
+
+func TestSamplerStartOptionsDisabled(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := trace.NewTracerProvider(trace.WithSyncer(exporter))
+	bridge := newTraceBridge([]TraceOption{WithTracerProvider(tp)})
+
+	_, span := bridge.StartSpan(context.Background(), "span", octrace.WithSampler(AlwaysSample()))
+	span.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Empty(t, spans[0].Attributes)
+}
+
+func TestProbabilitySamplerBounds(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := trace.NewTracerProvider(trace.WithSyncer(exporter))
+	ocTracer := newTraceBridge([]TraceOption{WithTracerProvider(tp), WithSamplerAttributes()})
+
+	for _, fraction := range []float64{-1, 0.5, 2} {
+		_, span := ocTracer.StartSpan(context.Background(), "span", octrace.WithSampler(ProbabilitySampler(fraction)))
+		span.End()
+	}
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 3)
+	assert.Equal(t, []attribute.KeyValue{
+		SamplerKey.String("ProbabilitySampler"),
+		SamplerProbabilityKey.Float64(0),
+	}, spans[0].Attributes)
+	assert.Equal(t, []attribute.KeyValue{
+		SamplerKey.String("ProbabilitySampler"),
+		SamplerProbabilityKey.Float64(0.5),
+	}, spans[1].Attributes)
+	assert.Equal(t, []attribute.KeyValue{SamplerKey.String("AlwaysSample")}, spans[2].Attributes)
+}
+
+func TestSamplerProbability(t *testing.T) {
+	s := NewSampler(trace.AlwaysSample())
+	attrs := []attribute.KeyValue{
+		SamplerKey.String("ProbabilitySampler"),
+		SamplerProbabilityKey.Float64(0.5),
+	}
+
+	// The first 63 bits of the trace ID are compared to the upper bound.
+	low := oteltrace.TraceID{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	high := oteltrace.TraceID{0x80}
+	ctx := context.Background()
+
+	res := s.ShouldSample(trace.SamplingParameters{ParentContext: ctx, TraceID: low, Attributes: attrs})
+	assert.Equal(t, trace.RecordAndSample, res.Decision)
+	res = s.ShouldSample(trace.SamplingParameters{ParentContext: ctx, TraceID: high, Attributes: attrs})
+	assert.Equal(t, trace.Drop, res.Decision)
+
+	// Spans with a sampled parent are sampled.
+	parent := oteltrace.NewSpanContext(oteltrace.SpanContextConfig{
+		TraceID:    high,
+		SpanID:     oteltrace.SpanID{1},
+		TraceFlags: oteltrace.FlagsSampled,
+	})
+	ctx = oteltrace.ContextWithSpanContext(ctx, parent)
+	res = s.ShouldSample(trace.SamplingParameters{ParentContext: ctx, TraceID: high, Attributes: attrs})
+	assert.Equal(t, trace.RecordAndSample, res.Decision)
+}
+
+func TestSamplerDescription(t *testing.T) {
+	assert.Equal(t, "OpenCensusSampler{AlwaysOffSampler}", NewSampler(trace.NeverSample()).Description())
+}
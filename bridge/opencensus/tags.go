@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opencensus // import "go.opentelemetry.io/otel/bridge/opencensus"
+
+import (
+	"context"
+	"errors"
+
+	"go.opencensus.io/tag"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/bridge/opencensus/internal/oc2otel"
+	"go.opentelemetry.io/otel/bridge/opencensus/internal/otel2oc"
+)
+
+// OCTagsToOTelBaggage returns a copy of ctx whose OpenTelemetry baggage
+// contains the OpenCensus tags of ctx, in addition to its members. The tags
+// take precedence over the members with the same key. Tags that are not
+// propagated, i.e. with a TTL of zero, are not added.
+//
+// An error is returned for the tags that are not valid baggage members. The
+// other tags are still added.
+func OCTagsToOTelBaggage(ctx context.Context) (context.Context, error) {
+	members, err := oc2otel.Tags(tag.FromContext(ctx))
+	if len(members) == 0 {
+		return ctx, err
+	}
+
+	b := baggage.FromContext(ctx)
+	for _, m := range members {
+		var mErr error
+		if b, mErr = b.SetMember(m); mErr != nil {
+			err = errors.Join(err, mErr)
+		}
+	}
+	return baggage.ContextWithBaggage(ctx, b), err
+}
+
+// OTelBaggageToOCTags returns a copy of ctx whose OpenCensus tags contain the
+// members of the OpenTelemetry baggage of ctx, in addition to its tags. The
+// members take precedence over the tags with the same key.
+//
+// An error is returned for the members that are not valid tags. The other
+// members are still added.
+func OTelBaggageToOCTags(ctx context.Context) (context.Context, error) {
+	mutators, err := otel2oc.Baggage(baggage.FromContext(ctx))
+	for _, m := range mutators {
+		// Apply the mutators one at a time, an invalid value fails the whole
+		// mutation.
+		mCtx, mErr := tag.New(ctx, m)
+		if mErr != nil {
+			err = errors.Join(err, mErr)
+			continue
+		}
+		ctx = mCtx
+	}
+	return ctx, err
+}
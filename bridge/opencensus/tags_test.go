@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opencensus // import "go.opentelemetry.io/otel/bridge/opencensus"
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/tag"
+
+	"go.opentelemetry.io/otel/baggage"
+)
+
+func TestOCTagsToOTelBaggage(t *testing.T) {
+	m, err := baggage.NewMemberRaw("k1", "baggage")
+	require.NoError(t, err)
+	kept, err := baggage.NewMemberRaw("k2", "kept")
+	require.NoError(t, err)
+	b, err := baggage.New(m, kept)
+	require.NoError(t, err)
+	ctx := baggage.ContextWithBaggage(context.Background(), b)
+
+	ctx, err = tag.New(ctx, tag.Upsert(tag.MustNewKey("k1"), "tag"))
+	require.NoError(t, err)
+
+	ctx, err = OCTagsToOTelBaggage(ctx)
+	require.NoError(t, err)
+
+	got := baggage.FromContext(ctx)
+	assert.Equal(t, "tag", got.Member("k1").Value(), "tag does not take precedence")
+	assert.Equal(t, "kept", got.Member("k2").Value())
+}
+
+func TestOCTagsToOTelBaggageNoTags(t *testing.T) {
+	ctx := context.Background()
+	got, err := OCTagsToOTelBaggage(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, ctx, got)
+}
+
+func TestOTelBaggageToOCTags(t *testing.T) {
+	k1, k2 := tag.MustNewKey("k1"), tag.MustNewKey("k2")
+	ctx, err := tag.New(context.Background(),
+		tag.Upsert(k1, "tag"),
+		tag.Upsert(k2, "kept"),
+	)
+	require.NoError(t, err)
+
+	m, err := baggage.NewMemberRaw("k1", "baggage")
+	require.NoError(t, err)
+	// Not printable ASCII, so not a valid tag value.
+	invalid, err := baggage.NewMemberRaw("k3", "ünïcode")
+	require.NoError(t, err)
+	b, err := baggage.New(m, invalid)
+	require.NoError(t, err)
+	ctx = baggage.ContextWithBaggage(ctx, b)
+
+	ctx, err = OTelBaggageToOCTags(ctx)
+	assert.Error(t, err)
+
+	tags := tag.FromContext(ctx)
+	v, _ := tags.Value(k1)
+	assert.Equal(t, "baggage", v, "baggage member does not take precedence")
+	v, _ = tags.Value(k2)
+	assert.Equal(t, "kept", v)
+	_, ok := tags.Value(tag.MustNewKey("k3"))
+	assert.False(t, ok, "invalid member added")
+}
+
+func TestTagsBaggageRoundTrip(t *testing.T) {
+	ctx, err := tag.New(context.Background(), tag.Upsert(tag.MustNewKey("key"), "value"))
+	require.NoError(t, err)
+
+	ctx, err = OCTagsToOTelBaggage(ctx)
+	require.NoError(t, err)
+
+	// Start from a context with the baggage only.
+	ctx = baggage.ContextWithBaggage(context.Background(), baggage.FromContext(ctx))
+	ctx, err = OTelBaggageToOCTags(ctx)
+	require.NoError(t, err)
+
+	v, ok := tag.FromContext(ctx).Value(tag.MustNewKey("key"))
+	assert.True(t, ok)
+	assert.Equal(t, "value", v)
+}
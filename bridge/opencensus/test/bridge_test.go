@@ -15,6 +15,7 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -149,6 +150,52 @@ func TestToFromContext(t *testing.T) {
 	}
 }
 
+func TestSpanFromContextInterop(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	ocbridge.InstallTraceBridge(ocbridge.WithTracerProvider(tp))
+	tracer := tp.Tracer("spanfromcontext")
+
+	ctx, ocSpan := octrace.StartSpan(context.Background(), "OpenCensusSpan")
+	defer ocSpan.End()
+	if got := trace.SpanFromContext(ctx).SpanContext(); got.SpanID() != trace.SpanID(ocSpan.SpanContext().SpanID) {
+		t.Errorf("SpanFromContext returned %v, expected the OpenCensus span", got)
+	}
+
+	ctx, otSpan := tracer.Start(ctx, "OpenTelemetrySpan")
+	defer otSpan.End()
+	if got := octrace.FromContext(ctx).SpanContext(); got.SpanID != octrace.SpanID(otSpan.SpanContext().SpanID()) {
+		t.Errorf("FromContext returned %v, expected the OpenTelemetry span", got)
+	}
+
+	// A span not created by the bridge, e.g. started before it was
+	// installed, is still the parent of the spans started from its context.
+	foreign := octrace.SpanContext{
+		TraceID:      octrace.TraceID([16]byte{1}),
+		SpanID:       octrace.SpanID([8]byte{1}),
+		TraceOptions: octrace.TraceOptions(1),
+	}
+	ctx = octrace.NewContext(context.Background(), octrace.NewSpan(&foreignSpan{sc: foreign}))
+	_, child := tracer.Start(ctx, "ChildOfForeignSpan")
+	child.End()
+
+	spans := sr.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("Got %d spans, expected %d.", len(spans), 1)
+	}
+	if got := spans[0].Parent(); !got.Equal(ocbridge.OCSpanContextToOTel(foreign)) {
+		t.Errorf("Span %v had parent %v. Expected %v", spans[0].Name(), got, foreign)
+	}
+}
+
+type foreignSpan struct {
+	octrace.SpanInterface
+
+	sc octrace.SpanContext
+}
+
+func (s *foreignSpan) SpanContext() octrace.SpanContext { return s.sc }
+
 func TestIsRecordingEvents(t *testing.T) {
 	sr := tracetest.NewSpanRecorder()
 	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
@@ -261,22 +308,34 @@ func TestSetThings(t *testing.T) {
 	}
 	seAttrs := attrsMap(sendEvent.Attributes)
 	reAttrs := attrsMap(receiveEvent.Attributes)
-	if sendEvent.Name != internal.MessageSendEvent {
-		t.Errorf("Got sendEvent.Name = %v, expected message send", sendEvent.Name)
+	if sendEvent.Name != internal.MessageEvent {
+		t.Errorf("Got sendEvent.Name = %v, expected message", sendEvent.Name)
+	}
+	if v := seAttrs[semconv.RPCMessageTypeKey]; v.AsString() != "SENT" {
+		t.Errorf("Got sendEvent.Attributes[rpc.message.type] = %v, expected SENT", v.AsString())
+	}
+	if v := seAttrs[semconv.RPCMessageIDKey]; v.AsInt64() != 123 {
+		t.Errorf("Got sendEvent.Attributes[rpc.message.id] = %v, expected 123", v.AsInt64())
+	}
+	if v := seAttrs[semconv.RPCMessageUncompressedSizeKey]; v.AsInt64() != 456 {
+		t.Errorf("Got sendEvent.Attributes[rpc.message.uncompressed_size] = %v, expected 456", v.AsInt64())
+	}
+	if v := seAttrs[semconv.RPCMessageCompressedSizeKey]; v.AsInt64() != 789 {
+		t.Errorf("Got sendEvent.Attributes[rpc.message.compressed_size] = %v, expected 789", v.AsInt64())
 	}
-	if v := seAttrs[internal.UncompressedKey]; v.AsInt64() != 456 {
-		t.Errorf("Got sendEvent.Attributes[uncompressedKey] = %v, expected 456", v.AsInt64())
+	if receiveEvent.Name != internal.MessageEvent {
+		t.Errorf("Got receiveEvent.Name = %v, expected message", receiveEvent.Name)
 	}
-	if v := seAttrs[internal.CompressedKey]; v.AsInt64() != 789 {
-		t.Errorf("Got sendEvent.Attributes[compressedKey] = %v, expected 789", v.AsInt64())
+	if v := reAttrs[semconv.RPCMessageTypeKey]; v.AsString() != "RECEIVED" {
+		t.Errorf("Got receiveEvent.Attributes[rpc.message.type] = %v, expected RECEIVED", v.AsString())
 	}
-	if receiveEvent.Name != internal.MessageReceiveEvent {
-		t.Errorf("Got receiveEvent.Name = %v, expected message receive", receiveEvent.Name)
+	if v := reAttrs[semconv.RPCMessageIDKey]; v.AsInt64() != 246 {
+		t.Errorf("Got receiveEvent.Attributes[rpc.message.id] = %v, expected 246", v.AsInt64())
 	}
-	if v := reAttrs[internal.UncompressedKey]; v.AsInt64() != 135 {
-		t.Errorf("Got receiveEvent.Attributes[uncompressedKey] = %v, expected 135", v.AsInt64())
+	if v := reAttrs[semconv.RPCMessageUncompressedSizeKey]; v.AsInt64() != 135 {
+		t.Errorf("Got receiveEvent.Attributes[rpc.message.uncompressed_size] = %v, expected 135", v.AsInt64())
 	}
-	if v := reAttrs[internal.CompressedKey]; v.AsInt64() != 369 {
-		t.Errorf("Got receiveEvent.Attributes[compressedKey] = %v, expected 369", v.AsInt64())
+	if v := reAttrs[semconv.RPCMessageCompressedSizeKey]; v.AsInt64() != 369 {
+		t.Errorf("Got receiveEvent.Attributes[rpc.message.compressed_size] = %v, expected 369", v.AsInt64())
 	}
 }
@@ -23,6 +23,7 @@ func newTraceBridge(opts []TraceOption) octrace.Tracer {
 	cfg := newTraceConfig(opts)
 	return internal.NewTracer(
 		cfg.tp.Tracer(scopeName, trace.WithInstrumentationVersion(Version())),
+		cfg.samplerAttributes,
 	)
 }
 
@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opentracing // import "go.opentelemetry.io/otel/bridge/opentracing"
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"slices"
+
+	ot "github.com/opentracing/opentracing-go"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// The Binary format carries the fields of a TextMapPropagator. It is encoded
+// as the number of fields, followed by the key and the value of each field.
+// The number of fields and the length of each key and value are encoded as
+// unsigned varints.
+
+const (
+	// maxBinaryFields is the maximum number of fields read from a Binary
+	// carrier.
+	maxBinaryFields = 64
+	// maxBinaryFieldLen is the maximum length of a key or a value read from
+	// a Binary carrier.
+	maxBinaryFieldLen = 8192
+)
+
+// writeBinaryCarrier writes the fields of m to w in the Binary format.
+func writeBinaryCarrier(w io.Writer, m propagation.MapCarrier) error {
+	keys := m.Keys()
+	slices.Sort(keys)
+
+	buf := binary.AppendUvarint(nil, uint64(len(keys)))
+	for _, k := range keys {
+		buf = appendBinaryString(buf, k)
+		buf = appendBinaryString(buf, m[k])
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+func appendBinaryString(buf []byte, s string) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(s)))
+	return append(buf, s...)
+}
+
+// readBinaryCarrier reads the fields written by writeBinaryCarrier from r.
+// ot.ErrSpanContextCorrupted is returned if the data read is not valid.
+func readBinaryCarrier(r io.Reader) (propagation.MapCarrier, error) {
+	br := bufio.NewReader(r)
+	n, err := binary.ReadUvarint(br)
+	if err != nil || n > maxBinaryFields {
+		return nil, ot.ErrSpanContextCorrupted
+	}
+
+	m := make(propagation.MapCarrier, n)
+	for i := uint64(0); i < n; i++ {
+		k, err := readBinaryString(br)
+		if err != nil {
+			return nil, err
+		}
+		v, err := readBinaryString(br)
+		if err != nil {
+			return nil, err
+		}
+		m[k] = v
+	}
+	return m, nil
+}
+
+func readBinaryString(r *bufio.Reader) (string, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil || n > maxBinaryFieldLen {
+		return "", ot.ErrSpanContextCorrupted
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", ot.ErrSpanContextCorrupted
+	}
+	return string(buf), nil
+}
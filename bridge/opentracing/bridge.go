@@ -6,9 +6,12 @@ package opentracing // import "go.opentelemetry.io/otel/bridge/opentracing"
 import (
 	"context"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	ot "github.com/opentracing/opentracing-go"
 	otext "github.com/opentracing/opentracing-go/ext"
@@ -21,6 +24,7 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	iBaggage "go.opentelemetry.io/otel/internal/baggage"
 	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/noop"
 )
@@ -62,16 +66,36 @@ func (c *bridgeSpanContext) ForeachBaggageItem(handler func(k, v string) bool) {
 	}
 }
 
-func (c *bridgeSpanContext) setBaggageItem(restrictedKey, value string) {
+// setBaggageItem sets the baggage item restrictedKey to value. OpenTracing
+// baggage keys are case-insensitive, an item with a key only differing in case
+// is replaced. It returns false if the item is invalid and was not set.
+func (c *bridgeSpanContext) setBaggageItem(restrictedKey, value string) bool {
 	m, err := baggage.NewMemberRaw(restrictedKey, value)
 	if err != nil {
-		return
+		return false
+	}
+	for _, old := range c.bag.Members() {
+		if old.Key() != restrictedKey && strings.EqualFold(old.Key(), restrictedKey) {
+			c.bag = c.bag.DeleteMember(old.Key())
+		}
 	}
-	c.bag, _ = c.bag.SetMember(m)
+	var setErr error
+	c.bag, setErr = c.bag.SetMember(m)
+	return setErr == nil
 }
 
+// baggageItem returns the baggage item with the case-insensitive
+// restrictedKey. An item with the exact key takes precedence.
 func (c *bridgeSpanContext) baggageItem(restrictedKey string) baggage.Member {
-	return c.bag.Member(restrictedKey)
+	if m := c.bag.Member(restrictedKey); m.Key() != "" {
+		return m
+	}
+	for _, m := range c.bag.Members() {
+		if strings.EqualFold(m.Key(), restrictedKey) {
+			return m
+		}
+	}
+	return baggage.Member{}
 }
 
 type bridgeSpan struct {
@@ -114,11 +138,24 @@ func (s *bridgeSpan) FinishWithOptions(opts ot.FinishOptions) {
 }
 
 func (s *bridgeSpan) logRecord(record ot.LogRecord) {
-	s.otelSpan.AddEvent(
-		"",
-		trace.WithTimestamp(record.Timestamp),
-		trace.WithAttributes(otLogFieldsToOTelAttrs(record.Fields)...),
-	)
+	s.logFields(record.Timestamp, record.Fields)
+}
+
+func (s *bridgeSpan) logFields(timestamp time.Time, fields []otlog.Field) {
+	if s.tracer != nil && s.tracer.logFieldsMapper != nil {
+		s.tracer.logFieldsMapper(s.otelSpan, timestamp, fields)
+		return
+	}
+	s.diag().recordLogFields(fields)
+	DefaultLogFieldsMapper(s.otelSpan, timestamp, fields)
+}
+
+// diag returns the diagnostics of the tracer of s.
+func (s *bridgeSpan) diag() *diagnostics {
+	if s.tracer == nil {
+		return nil
+	}
+	return s.tracer.diag.Load()
 }
 
 func (s *bridgeSpan) Context() ot.SpanContext {
@@ -143,25 +180,30 @@ func (s *bridgeSpan) SetTag(key string, value interface{}) ot.Span {
 	switch key {
 	case string(otext.SpanKind):
 		// TODO: Should we ignore it?
+		s.diag().record(reasonSpanKind, 1)
 	case string(otext.Error):
 		if b, ok := value.(bool); ok && b {
 			s.otelSpan.SetStatus(codes.Error, "")
 		}
 	default:
-		s.otelSpan.SetAttributes(otTagToOTelAttr(key, value))
+		attr, ok := otTagToOTelAttrChecked(key, value)
+		if !ok {
+			s.diag().record(reasonAttributeType, 1)
+		}
+		s.otelSpan.SetAttributes(attr)
 	}
 	return s
 }
 
 func (s *bridgeSpan) LogFields(fields ...otlog.Field) {
-	s.otelSpan.AddEvent(
-		"",
-		trace.WithAttributes(otLogFieldsToOTelAttrs(fields)...),
-	)
+	s.logFields(time.Time{}, fields)
 }
 
 type bridgeFieldEncoder struct {
 	pairs []attribute.KeyValue
+	// untranslated is the number of values converted to their string
+	// representation.
+	untranslated int
 }
 
 var _ otlog.Encoder = &bridgeFieldEncoder{}
@@ -211,7 +253,11 @@ func (e *bridgeFieldEncoder) EmitLazyLogger(value otlog.LazyLogger) {
 }
 
 func (e *bridgeFieldEncoder) emitCommon(key string, value interface{}) {
-	e.pairs = append(e.pairs, otTagToOTelAttr(key, value))
+	attr, ok := otTagToOTelAttrChecked(key, value)
+	if !ok {
+		e.untranslated++
+	}
+	e.pairs = append(e.pairs, attr)
 }
 
 func otLogFieldsToOTelAttrs(fields []otlog.Field) []attribute.KeyValue {
@@ -222,9 +268,37 @@ func otLogFieldsToOTelAttrs(fields []otlog.Field) []attribute.KeyValue {
 	return encoder.pairs
 }
 
+// LogFieldsToAttributes converts OpenTracing log fields to OpenTelemetry
+// attributes the same way the BridgeTracer does by default. It is meant to be
+// used by a LogFieldsMapper.
+func LogFieldsToAttributes(fields []otlog.Field) []attribute.KeyValue {
+	return otLogFieldsToOTelAttrs(fields)
+}
+
+// LogFieldsMapper records the fields of an OpenTracing log entry with the
+// OpenTelemetry span, e.g. as a span event. It can be used to select the name
+// of the event, to detect errors, or to handle stack fields.
+//
+// The timestamp is the time of the log entry. It is zero if the entry was
+// logged with the LogFields or LogKV methods, in which case the current time
+// should be used.
+type LogFieldsMapper func(span trace.Span, timestamp time.Time, fields []otlog.Field)
+
+// DefaultLogFieldsMapper is the LogFieldsMapper used by a BridgeTracer by
+// default. It adds an unnamed event to span with the fields converted to
+// attributes using LogFieldsToAttributes.
+func DefaultLogFieldsMapper(span trace.Span, timestamp time.Time, fields []otlog.Field) {
+	opts := []trace.EventOption{trace.WithAttributes(otLogFieldsToOTelAttrs(fields)...)}
+	if !timestamp.IsZero() {
+		opts = append(opts, trace.WithTimestamp(timestamp))
+	}
+	span.AddEvent("", opts...)
+}
+
 func (s *bridgeSpan) LogKV(alternatingKeyValues ...interface{}) {
 	fields, err := otlog.InterleavedKVToFields(alternatingKeyValues...)
 	if err != nil {
+		s.diag().record(reasonLogKV, 1)
 		return
 	}
 	s.LogFields(fields...)
@@ -237,13 +311,20 @@ func (s *bridgeSpan) SetBaggageItem(restrictedKey, value string) ot.Span {
 }
 
 func (s *bridgeSpan) setBaggageItemOnly(restrictedKey, value string) {
-	s.ctx.setBaggageItem(restrictedKey, value)
+	if !s.ctx.setBaggageItem(restrictedKey, value) {
+		s.diag().record(reasonBaggage, 1)
+	}
 }
 
 func (s *bridgeSpan) updateOTelContext(restrictedKey, value string) {
 	if s.extraBaggageItems == nil {
 		s.extraBaggageItems = make(map[string]string)
 	}
+	for k := range s.extraBaggageItems {
+		if k != restrictedKey && strings.EqualFold(k, restrictedKey) {
+			delete(s.extraBaggageItems, k)
+		}
+	}
 	s.extraBaggageItems[restrictedKey] = value
 }
 
@@ -305,6 +386,10 @@ type BridgeTracer struct {
 	warnOnce       sync.Once
 
 	propagator propagation.TextMapPropagator
+
+	logFieldsMapper LogFieldsMapper
+
+	diag atomic.Pointer[diagnostics]
 }
 
 var (
@@ -342,6 +427,13 @@ func (t *BridgeTracer) SetOpenTelemetryTracer(tracer trace.Tracer) {
 	t.setTracer.isSet = true
 }
 
+// SetLogFieldsMapper overrides how the fields logged with OpenTracing spans
+// are recorded with the OpenTelemetry spans. DefaultLogFieldsMapper is used if
+// mapper is nil, which is the default.
+func (t *BridgeTracer) SetLogFieldsMapper(mapper LogFieldsMapper) {
+	t.logFieldsMapper = mapper
+}
+
 // SetTextMapPropagator sets propagator as the TextMapPropagator to use by the
 // BridgeTracer.
 func (t *BridgeTracer) SetTextMapPropagator(propagator propagation.TextMapPropagator) {
@@ -405,6 +497,13 @@ func (t *BridgeTracer) baggageGetHook(ctx context.Context, list iBaggage.List) i
 	}
 
 	for k, v := range items {
+		// OpenTracing baggage keys are case-insensitive. Remove the items
+		// the OpenTracing item overwrites.
+		for mk := range merged {
+			if mk != k && strings.EqualFold(mk, k) {
+				delete(merged, mk)
+			}
+		}
 		// Overwrite according to OpenTelemetry specification.
 		merged[k] = iBaggage.Item{Value: v}
 	}
@@ -419,6 +518,7 @@ func (t *BridgeTracer) StartSpan(operationName string, opts ...ot.StartSpanOptio
 	for _, opt := range opts {
 		opt.Apply(&sso)
 	}
+	t.diag.Load().recordStartSpanOptions(sso)
 	parentBridgeSC, links := otSpanReferencesToParentAndLinks(sso.References)
 	attributes, kind, hadTrueErrorTag := otTagsToOTelAttributesKindAndError(sso.Tags)
 	checkCtx := migration.WithDeferredSetup(context.Background())
@@ -504,20 +604,7 @@ func otTagsToOTelAttributesKindAndError(tags map[string]interface{}) ([]attribut
 	for k, v := range tags {
 		switch k {
 		case string(otext.SpanKind):
-			sk := v
-			if s, ok := v.(string); ok {
-				sk = otext.SpanKindEnum(strings.ToLower(s))
-			}
-			switch sk {
-			case otext.SpanKindRPCClientEnum:
-				kind = trace.SpanKindClient
-			case otext.SpanKindRPCServerEnum:
-				kind = trace.SpanKindServer
-			case otext.SpanKindProducerEnum:
-				kind = trace.SpanKindProducer
-			case otext.SpanKindConsumerEnum:
-				kind = trace.SpanKindConsumer
-			}
+			kind, _ = otSpanKindToOTel(v)
 		case string(otext.Error):
 			if b, ok := v.(bool); ok && b {
 				err = true
@@ -529,6 +616,26 @@ func otTagsToOTelAttributesKindAndError(tags map[string]interface{}) ([]attribut
 	return pairs, kind, err
 }
 
+// otSpanKindToOTel returns the span kind matching the value of a span.kind
+// tag. It returns SpanKindInternal and false if the value is unknown.
+func otSpanKindToOTel(v interface{}) (trace.SpanKind, bool) {
+	sk := v
+	if s, ok := v.(string); ok {
+		sk = otext.SpanKindEnum(strings.ToLower(s))
+	}
+	switch sk {
+	case otext.SpanKindRPCClientEnum:
+		return trace.SpanKindClient, true
+	case otext.SpanKindRPCServerEnum:
+		return trace.SpanKindServer, true
+	case otext.SpanKindProducerEnum:
+		return trace.SpanKindProducer, true
+	case otext.SpanKindConsumerEnum:
+		return trace.SpanKindConsumer, true
+	}
+	return trace.SpanKindInternal, false
+}
+
 // otTagToOTelAttr converts given key-value into attribute.KeyValue.
 // Note that some conversions are not obvious:
 // - int -> int64
@@ -538,38 +645,46 @@ func otTagsToOTelAttributesKindAndError(tags map[string]interface{}) ([]attribut
 // - uint64 -> string
 // - float32 -> float64
 func otTagToOTelAttr(k string, v interface{}) attribute.KeyValue {
+	attr, _ := otTagToOTelAttrChecked(k, v)
+	return attr
+}
+
+// otTagToOTelAttrChecked converts given key-value into attribute.KeyValue
+// as otTagToOTelAttr. It returns false if v has an unsupported type and was
+// converted to its string representation.
+func otTagToOTelAttrChecked(k string, v interface{}) (attribute.KeyValue, bool) {
 	key := otTagToOTelAttrKey(k)
 	switch val := v.(type) {
 	case bool:
-		return key.Bool(val)
+		return key.Bool(val), true
 	case int64:
-		return key.Int64(val)
+		return key.Int64(val), true
 	case uint64:
-		return key.String(strconv.FormatUint(val, 10))
+		return key.String(strconv.FormatUint(val, 10)), true
 	case float64:
-		return key.Float64(val)
+		return key.Float64(val), true
 	case int8:
-		return key.Int64(int64(val))
+		return key.Int64(int64(val)), true
 	case uint8:
-		return key.Int64(int64(val))
+		return key.Int64(int64(val)), true
 	case int16:
-		return key.Int64(int64(val))
+		return key.Int64(int64(val)), true
 	case uint16:
-		return key.Int64(int64(val))
+		return key.Int64(int64(val)), true
 	case int32:
-		return key.Int64(int64(val))
+		return key.Int64(int64(val)), true
 	case uint32:
-		return key.Int64(int64(val))
+		return key.Int64(int64(val)), true
 	case float32:
-		return key.Float64(float64(val))
+		return key.Float64(float64(val)), true
 	case int:
-		return key.Int(val)
+		return key.Int(val), true
 	case uint:
-		return key.String(strconv.FormatUint(uint64(val), 10))
+		return key.String(strconv.FormatUint(uint64(val), 10)), true
 	case string:
-		return key.String(val)
+		return key.String(val), true
 	default:
-		return key.String(fmt.Sprint(v))
+		return key.String(fmt.Sprint(v)), false
 	}
 }
 
@@ -577,9 +692,14 @@ func otTagToOTelAttrKey(k string) attribute.Key {
 	return attribute.Key(k)
 }
 
+// otSpanReferencesToParentAndLinks returns the parent of a span started with
+// references, and its links. As defined by the OpenTracing compatibility
+// specification, the parent is the first ChildOf reference or, if there is
+// none, the first reference. All the references are converted to links.
 func otSpanReferencesToParentAndLinks(references []ot.SpanReference) (*bridgeSpanContext, []trace.Link) {
 	var (
 		parent *bridgeSpanContext
+		first  *bridgeSpanContext
 		links  []trace.Link
 	)
 	for _, reference := range references {
@@ -593,15 +713,16 @@ func otSpanReferencesToParentAndLinks(references []ot.SpanReference) (*bridgeSpa
 			// valid OTel SpanContext.
 			continue
 		}
-		if parent != nil {
-			links = append(links, otSpanReferenceToOTelLink(bridgeSC, reference.Type))
-		} else {
-			if reference.Type == ot.ChildOfRef {
-				parent = bridgeSC
-			} else {
-				links = append(links, otSpanReferenceToOTelLink(bridgeSC, reference.Type))
-			}
+		if first == nil {
+			first = bridgeSC
 		}
+		if parent == nil && reference.Type == ot.ChildOfRef {
+			parent = bridgeSC
+		}
+		links = append(links, otSpanReferenceToOTelLink(bridgeSC, reference.Type))
+	}
+	if parent == nil {
+		parent = first
 	}
 	return parent, links
 }
@@ -609,27 +730,18 @@ func otSpanReferencesToParentAndLinks(references []ot.SpanReference) (*bridgeSpa
 func otSpanReferenceToOTelLink(bridgeSC *bridgeSpanContext, refType ot.SpanReferenceType) trace.Link {
 	return trace.Link{
 		SpanContext: bridgeSC.SpanContext,
-		Attributes:  otSpanReferenceTypeToOTelLinkAttributes(refType),
-	}
-}
-
-func otSpanReferenceTypeToOTelLinkAttributes(refType ot.SpanReferenceType) []attribute.KeyValue {
-	return []attribute.KeyValue{
-		attribute.String("ot-span-reference-type", otSpanReferenceTypeToString(refType)),
+		Attributes:  []attribute.KeyValue{otSpanReferenceTypeToOTelAttribute(refType)},
 	}
 }
 
-func otSpanReferenceTypeToString(refType ot.SpanReferenceType) string {
+func otSpanReferenceTypeToOTelAttribute(refType ot.SpanReferenceType) attribute.KeyValue {
 	switch refType {
 	case ot.ChildOfRef:
-		// "extra", because first child-of reference is used
-		// as a parent, so this function isn't even called for
-		// it.
-		return "extra-child-of"
+		return semconv.OpenTracingRefTypeChildOf
 	case ot.FollowsFromRef:
-		return "follows-from-ref"
+		return semconv.OpenTracingRefTypeFollowsFrom
 	default:
-		return fmt.Sprintf("unknown-%d", int(refType))
+		return semconv.OpenTracingRefTypeKey.String(fmt.Sprintf("unknown-%d", int(refType)))
 	}
 }
 
@@ -647,7 +759,9 @@ func (s fakeSpan) SpanContext() trace.SpanContext {
 // Inject is a part of the implementation of the OpenTracing Tracer
 // interface.
 //
-// Currently only the HTTPHeaders and TextMap formats are supported.
+// The HTTPHeaders, TextMap, and Binary formats are supported. The carrier of
+// the Binary format needs to be an io.Writer. The fields of the
+// TextMapPropagator of the BridgeTracer are written to it.
 func (t *BridgeTracer) Inject(sm ot.SpanContext, format interface{}, carrier interface{}) error {
 	bridgeSC, ok := sm.(*bridgeSpanContext)
 	if !ok {
@@ -663,6 +777,7 @@ func (t *BridgeTracer) Inject(sm ot.SpanContext, format interface{}, carrier int
 	}
 
 	var textCarrier propagation.TextMapCarrier
+	var binaryWriter io.Writer
 	var err error
 
 	switch builtinFormat {
@@ -676,6 +791,12 @@ func (t *BridgeTracer) Inject(sm ot.SpanContext, format interface{}, carrier int
 		if textCarrier, ok = carrier.(propagation.TextMapCarrier); !ok {
 			textCarrier, err = newTextMapWrapperForInject(carrier)
 		}
+	case ot.Binary:
+		if binaryWriter, ok = carrier.(io.Writer); ok {
+			textCarrier = propagation.MapCarrier{}
+		} else {
+			err = ot.ErrInvalidCarrier
+		}
 	default:
 		err = ot.ErrUnsupportedFormat
 	}
@@ -690,13 +811,17 @@ func (t *BridgeTracer) Inject(sm ot.SpanContext, format interface{}, carrier int
 	ctx := trace.ContextWithSpan(context.Background(), fs)
 	ctx = baggage.ContextWithBaggage(ctx, bridgeSC.bag)
 	t.getPropagator().Inject(ctx, textCarrier)
+	if binaryWriter != nil {
+		return writeBinaryCarrier(binaryWriter, textCarrier.(propagation.MapCarrier))
+	}
 	return nil
 }
 
 // Extract is a part of the implementation of the OpenTracing Tracer
 // interface.
 //
-// Currently only the HTTPHeaders and TextMap formats are supported.
+// The HTTPHeaders, TextMap, and Binary formats are supported. The carrier of
+// the Binary format needs to be an io.Reader of the data written by Inject.
 func (t *BridgeTracer) Extract(format interface{}, carrier interface{}) (ot.SpanContext, error) {
 	builtinFormat, ok := format.(ot.BuiltinFormat)
 	if !ok {
@@ -717,6 +842,12 @@ func (t *BridgeTracer) Extract(format interface{}, carrier interface{}) (ot.Span
 		if textCarrier, ok = carrier.(propagation.TextMapCarrier); !ok {
 			textCarrier, err = newTextMapWrapperForExtract(carrier)
 		}
+	case ot.Binary:
+		if r, ok := carrier.(io.Reader); ok {
+			textCarrier, err = readBinaryCarrier(r)
+		} else {
+			err = ot.ErrInvalidCarrier
+		}
 	default:
 		err = ot.ErrUnsupportedFormat
 	}
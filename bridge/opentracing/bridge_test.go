@@ -4,6 +4,7 @@
 package opentracing
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	ot "github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/ext"
@@ -20,8 +22,11 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
 	"go.opentelemetry.io/otel/bridge/opentracing/internal"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -257,6 +262,7 @@ func TestBridgeTracer_ExtractAndInject(t *testing.T) {
 	shareMap := map[string]string{}
 	otTextMap := ot.TextMapCarrier{}
 	httpHeader := ot.HTTPHeadersCarrier(http.Header{})
+	binaryBuf := new(bytes.Buffer)
 
 	testCases := []struct {
 		name               string
@@ -324,17 +330,33 @@ func TestBridgeTracer_ExtractAndInject(t *testing.T) {
 			extractErr:         ot.ErrInvalidCarrier,
 		},
 		{
-			name:              "inject: unsupported format type",
+			name:               "support for Binary",
+			injectCarrierType:  ot.Binary,
+			injectCarrier:      binaryBuf,
+			extractCarrierType: ot.Binary,
+			extractCarrier:     binaryBuf,
+		},
+		{
+			name:              "inject: format type is Binary, but carrier is not io.Writer",
 			injectCarrierType: ot.Binary,
-			injectErr:         ot.ErrUnsupportedFormat,
+			injectCarrier:     struct{}{},
+			injectErr:         ot.ErrInvalidCarrier,
 		},
 		{
-			name:               "extract: unsupported format type",
+			name:               "extract: format type is Binary, but carrier is not io.Reader",
 			injectCarrierType:  ot.TextMap,
 			injectCarrier:      otTextMap,
 			extractCarrierType: ot.Binary,
 			extractCarrier:     struct{}{},
-			extractErr:         ot.ErrUnsupportedFormat,
+			extractErr:         ot.ErrInvalidCarrier,
+		},
+		{
+			name:               "extract: format type is Binary, but data is corrupted",
+			injectCarrierType:  ot.TextMap,
+			injectCarrier:      otTextMap,
+			extractCarrierType: ot.Binary,
+			extractCarrier:     bytes.NewReader([]byte{2, 3, 'k'}),
+			extractErr:         ot.ErrSpanContextCorrupted,
 		},
 	}
 
@@ -434,6 +456,58 @@ func TestBridgeTracer_StartSpan(t *testing.T) {
 	}
 }
 
+func TestBridgeTracer_StartSpanReferences(t *testing.T) {
+	tracer := internal.NewMockTracer()
+	b, _ := NewTracerPair(tracer)
+	a := b.StartSpan("a")
+	c := b.StartSpan("c")
+	scA := a.(*bridgeSpan).otelSpan.SpanContext()
+	scC := c.(*bridgeSpan).otelSpan.SpanContext()
+
+	childOf := func(sc trace.SpanContext) internal.MockLink {
+		return internal.MockLink{SpanContext: sc, Attributes: []attribute.KeyValue{semconv.OpenTracingRefTypeChildOf}}
+	}
+	followsFrom := func(sc trace.SpanContext) internal.MockLink {
+		return internal.MockLink{SpanContext: sc, Attributes: []attribute.KeyValue{semconv.OpenTracingRefTypeFollowsFrom}}
+	}
+
+	testCases := []struct {
+		name       string
+		opts       []ot.StartSpanOption
+		wantParent trace.SpanContext
+		wantLinks  []internal.MockLink
+	}{
+		{
+			name:       "FollowsFrom only",
+			opts:       []ot.StartSpanOption{ot.FollowsFrom(a.Context())},
+			wantParent: scA,
+			wantLinks:  []internal.MockLink{followsFrom(scA)},
+		},
+		{
+			name:       "ChildOf after FollowsFrom",
+			opts:       []ot.StartSpanOption{ot.FollowsFrom(a.Context()), ot.ChildOf(c.Context())},
+			wantParent: scC,
+			wantLinks:  []internal.MockLink{followsFrom(scA), childOf(scC)},
+		},
+		{
+			name:       "multiple ChildOf",
+			opts:       []ot.StartSpanOption{ot.ChildOf(a.Context()), ot.ChildOf(c.Context())},
+			wantParent: scA,
+			wantLinks:  []internal.MockLink{childOf(scA), childOf(scC)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			span := b.StartSpan("test", tc.opts...)
+			mockSpan := span.(*bridgeSpan).otelSpan.(*internal.MockSpan)
+			assert.Equal(t, tc.wantParent.TraceID(), mockSpan.SpanContext().TraceID())
+			assert.Equal(t, tc.wantParent.SpanID(), mockSpan.ParentSpanID)
+			assert.Equal(t, tc.wantLinks, mockSpan.Links)
+		})
+	}
+}
+
 func Test_otTagToOTelAttr(t *testing.T) {
 	key := attribute.Key("test")
 	testCases := []struct {
@@ -994,6 +1068,42 @@ func TestBridgeSpan_LogKV(t *testing.T) {
 	}
 }
 
+func TestBridgeTracer_SetLogFieldsMapper(t *testing.T) {
+	tracer := internal.NewMockTracer()
+	b, _ := NewTracerPair(tracer)
+	b.SetLogFieldsMapper(func(span trace.Span, timestamp time.Time, fields []otlog.Field) {
+		name := "log"
+		var attrs []attribute.KeyValue
+		for _, f := range fields {
+			switch f.Key() {
+			case "event":
+				name = fmt.Sprint(f.Value())
+			case "error.object":
+				span.SetStatus(codes.Error, fmt.Sprint(f.Value()))
+			default:
+				attrs = append(attrs, LogFieldsToAttributes([]otlog.Field{f})...)
+			}
+		}
+		span.AddEvent(name, trace.WithTimestamp(timestamp), trace.WithAttributes(attrs...))
+	})
+
+	ts := time.Unix(1, 0)
+	span := b.StartSpan("test")
+	span.LogKV("event", "error", "error.object", "failed", "key", "value")
+	span.FinishWithOptions(ot.FinishOptions{
+		LogRecords: []ot.LogRecord{{Timestamp: ts, Fields: []otlog.Field{otlog.Int("n", 1)}}},
+	})
+
+	mockSpan := span.(*bridgeSpan).otelSpan.(*internal.MockSpan)
+	require.Len(t, mockSpan.Events, 2)
+	assert.Equal(t, "error", mockSpan.Events[0].Name)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("key", "value")}, mockSpan.Events[0].Attributes)
+	assert.Contains(t, mockSpan.Attributes, internal.StatusCodeKey.Int(int(codes.Error)))
+	assert.Equal(t, "log", mockSpan.Events[1].Name)
+	assert.Equal(t, ts, mockSpan.Events[1].Timestamp)
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("n", 1)}, mockSpan.Events[1].Attributes)
+}
+
 func TestBridgeSpan_BaggageItem(t *testing.T) {
 	tracer := NewBridgeTracer()
 
@@ -1013,3 +1123,54 @@ func TestBridgeSpan_BaggageItem(t *testing.T) {
 		return true
 	})
 }
+
+func TestBridgeSpan_BaggageItemCaseInsensitive(t *testing.T) {
+	tracer := NewBridgeTracer()
+	span := tracer.StartSpan("span")
+
+	span.SetBaggageItem("Key", "val")
+	assert.Equal(t, "val", span.BaggageItem("key"))
+	assert.Equal(t, "val", span.BaggageItem("KEY"))
+
+	span.SetBaggageItem("key", "other")
+	assert.Equal(t, "other", span.BaggageItem("Key"))
+
+	got := map[string]string{}
+	span.Context().ForeachBaggageItem(func(k, v string) bool {
+		got[k] = v
+		return true
+	})
+	assert.Equal(t, map[string]string{"key": "other"}, got)
+}
+
+func TestBridgeSpan_ForeachBaggageItemOTelBaggage(t *testing.T) {
+	tracer := NewBridgeTracer()
+	span := tracer.StartSpan("span")
+	span.SetBaggageItem("ot-key", "ot")
+
+	ctx := tracer.NewHookedContext(ot.ContextWithSpan(context.Background(), span))
+
+	// Set on the OpenTelemetry side after the span was created.
+	m, err := baggage.NewMemberRaw("OTel-Key", "otel")
+	require.NoError(t, err)
+	b, err := baggage.FromContext(ctx).SetMember(m)
+	require.NoError(t, err)
+	ctx = baggage.ContextWithBaggage(ctx, b)
+
+	got := map[string]string{}
+	span.Context().ForeachBaggageItem(func(k, v string) bool {
+		got[k] = v
+		return true
+	})
+	assert.Equal(t, map[string]string{"ot-key": "ot", "OTel-Key": "otel"}, got)
+	assert.Equal(t, "otel", span.BaggageItem("otel-key"))
+
+	// An OpenTracing item overwrites the OpenTelemetry one with the same
+	// case-insensitive key.
+	span.SetBaggageItem("otel-key", "ot")
+	got = map[string]string{}
+	for _, m := range baggage.FromContext(ctx).Members() {
+		got[m.Key()] = m.Value()
+	}
+	assert.Equal(t, map[string]string{"ot-key": "ot", "otel-key": "ot"}, got)
+}
@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opentracing // import "go.opentelemetry.io/otel/bridge/opentracing"
+
+import (
+	"context"
+
+	ot "github.com/opentracing/opentracing-go"
+	otext "github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	diagnosticsScopeName   = "go.opentelemetry.io/otel/bridge/opentracing"
+	diagnosticsCounterName = "opentracing.bridge.untranslated"
+	diagnosticsReasonKey   = attribute.Key("reason")
+)
+
+// Reasons OpenTracing telemetry is not faithfully translated.
+const (
+	reasonAttributeType = "attribute_type"
+	reasonSpanKind      = "span_kind"
+	reasonSpanReference = "span_reference"
+	reasonLogKV         = "log_kv"
+	reasonBaggage       = "baggage"
+)
+
+// diagnostics counts the OpenTracing telemetry not faithfully translated to
+// OpenTelemetry. A nil diagnostics counts nothing.
+type diagnostics struct {
+	counter metric.Int64Counter
+}
+
+// EnableDiagnostics enables the diagnostic mode of the BridgeTracer. In this
+// mode, every piece of OpenTracing telemetry the BridgeTracer cannot
+// faithfully translate is counted by the opentracing.bridge.untranslated
+// counter created with mp. The reason attribute of the counter describes the
+// translation issue:
+//
+//   - attribute_type: a tag or a log field value of an unsupported type was
+//     converted to its string representation. Log fields are only checked
+//     when the DefaultLogFieldsMapper is used.
+//   - span_kind: a span kind tag was ignored, because its value is unknown or
+//     it was set after the span started.
+//   - span_reference: a reference to a span context not created by the
+//     BridgeTracer was ignored.
+//   - log_kv: a LogKV call with invalid key-value pairs was ignored.
+//   - baggage: an invalid baggage item was ignored.
+//
+// This is meant to quantify the fidelity loss of a migration.
+//
+// Passing a nil mp disables the diagnostic mode. It is safe to call
+// EnableDiagnostics concurrently with the use of the BridgeTracer and its
+// spans.
+func (t *BridgeTracer) EnableDiagnostics(mp metric.MeterProvider) error {
+	if mp == nil {
+		t.diag.Store(nil)
+		return nil
+	}
+	meter := mp.Meter(diagnosticsScopeName, metric.WithInstrumentationVersion(otel.Version()))
+	counter, err := meter.Int64Counter(
+		diagnosticsCounterName,
+		metric.WithUnit("{item}"),
+		metric.WithDescription("The number of OpenTracing telemetry items not faithfully translated to OpenTelemetry."),
+	)
+	if err != nil {
+		return err
+	}
+	t.diag.Store(&diagnostics{counter: counter})
+	return nil
+}
+
+// record counts n items not faithfully translated for reason.
+func (d *diagnostics) record(reason string, n int) {
+	if d == nil || n <= 0 {
+		return
+	}
+	d.counter.Add(context.Background(), int64(n), metric.WithAttributes(diagnosticsReasonKey.String(reason)))
+}
+
+// recordStartSpanOptions counts the issues of translating sso.
+func (d *diagnostics) recordStartSpanOptions(sso ot.StartSpanOptions) {
+	if d == nil {
+		return
+	}
+	for k, v := range sso.Tags {
+		switch k {
+		case string(otext.SpanKind):
+			if _, ok := otSpanKindToOTel(v); !ok {
+				d.record(reasonSpanKind, 1)
+			}
+		case string(otext.Error):
+		default:
+			if _, ok := otTagToOTelAttrChecked(k, v); !ok {
+				d.record(reasonAttributeType, 1)
+			}
+		}
+	}
+	for _, reference := range sso.References {
+		if _, ok := reference.ReferencedContext.(*bridgeSpanContext); !ok {
+			d.record(reasonSpanReference, 1)
+		}
+	}
+}
+
+// recordLogFields counts the fields values converted to their string
+// representation by the DefaultLogFieldsMapper.
+func (d *diagnostics) recordLogFields(fields []otlog.Field) {
+	if d == nil {
+		return
+	}
+	encoder := &bridgeFieldEncoder{}
+	for _, field := range fields {
+		field.Marshal(encoder)
+	}
+	d.record(reasonAttributeType, encoder.untranslated)
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opentracing
+
+import (
+	"context"
+	"testing"
+
+	ot "github.com/opentracing/opentracing-go"
+	otext "github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/bridge/opentracing/internal"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+// testCounter counts the values added per reason.
+type testCounter struct {
+	noop.Int64Counter
+
+	counts map[string]int64
+}
+
+func (c *testCounter) Add(_ context.Context, n int64, opts ...metric.AddOption) {
+	attrs := metric.NewAddConfig(opts).Attributes()
+	reason, _ := attrs.Value(diagnosticsReasonKey)
+	c.counts[reason.AsString()] += n
+}
+
+type testMeterProvider struct {
+	noop.MeterProvider
+
+	counter *testCounter
+}
+
+func (p testMeterProvider) Meter(string, ...metric.MeterOption) metric.Meter {
+	return testMeter{counter: p.counter}
+}
+
+type testMeter struct {
+	noop.Meter
+
+	counter *testCounter
+}
+
+func (m testMeter) Int64Counter(string, ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return m.counter, nil
+}
+
+type foreignSpanContext struct{}
+
+func (foreignSpanContext) ForeachBaggageItem(func(k, v string) bool) {}
+
+func TestBridgeTracer_EnableDiagnostics(t *testing.T) {
+	counter := &testCounter{counts: make(map[string]int64)}
+	b, _ := NewTracerPair(internal.NewMockTracer())
+	require.NoError(t, b.EnableDiagnostics(testMeterProvider{counter: counter}))
+
+	span := b.StartSpan(
+		"test",
+		ot.Tag{Key: "supported", Value: 1},
+		ot.Tag{Key: "unsupported", Value: struct{}{}},
+		ot.Tag{Key: string(otext.SpanKind), Value: "unknown"},
+		ot.FollowsFrom(foreignSpanContext{}),
+	)
+	span.SetTag(string(otext.SpanKind), "client")
+	span.SetTag("unsupported", []int{1})
+	span.LogFields(otlog.String("supported", "v"), otlog.Object("unsupported", struct{}{}))
+	span.LogKV("odd")
+	span.SetBaggageItem("", "invalid")
+	span.Finish()
+
+	assert.Equal(t, map[string]int64{
+		reasonAttributeType: 3,
+		reasonSpanKind:      2,
+		reasonSpanReference: 1,
+		reasonLogKV:         1,
+		reasonBaggage:       1,
+	}, counter.counts)
+
+	require.NoError(t, b.EnableDiagnostics(nil))
+	b.StartSpan("test", ot.Tag{Key: "unsupported", Value: struct{}{}}).Finish()
+	assert.Equal(t, int64(3), counter.counts[reasonAttributeType], "counted when disabled")
+}
+
+func TestBridgeTracer_DiagnosticsLogFieldsMapper(t *testing.T) {
+	counter := &testCounter{counts: make(map[string]int64)}
+	b, _ := NewTracerPair(internal.NewMockTracer())
+	require.NoError(t, b.EnableDiagnostics(testMeterProvider{counter: counter}))
+	b.SetLogFieldsMapper(DefaultLogFieldsMapper)
+
+	span := b.StartSpan("test")
+	span.LogFields(otlog.Object("unsupported", struct{}{}))
+	span.Finish()
+
+	assert.Empty(t, counter.counts, "custom mapper fields counted")
+}
+
+func TestBridgeTracer_EnableDiagnosticsConcurrentSafe(t *testing.T) {
+	b, _ := NewTracerPair(internal.NewMockTracer())
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			b.StartSpan("test", ot.Tag{Key: string(otext.SpanKind), Value: "unknown"}).Finish()
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, b.EnableDiagnostics(noop.NewMeterProvider()))
+		assert.NoError(t, b.EnableDiagnostics(nil))
+	}
+	<-done
+}
@@ -65,6 +65,10 @@ func NewMockTracer() *MockTracer {
 	}
 }
 
+func (t *MockTracer) Enabled(context.Context, trace.EnabledParameters) bool {
+	return true
+}
+
 func (t *MockTracer) Start(
 	ctx context.Context,
 	name string,
@@ -91,6 +95,9 @@ func (t *MockTracer) Start(
 		Events:         nil,
 		SpanKind:       trace.ValidateSpanKind(config.SpanKind()),
 	}
+	for _, link := range config.Links() {
+		span.AddLink(link)
+	}
 	if !migration.SkipContextSetup(ctx) {
 		ctx = trace.ContextWithSpan(ctx, span)
 		ctx = t.addSpareContextValue(ctx)
@@ -91,6 +91,11 @@ func (t *WrapperTracer) Start(
 	return ctx, span
 }
 
+// Enabled forwards the call to the wrapped tracer.
+func (t *WrapperTracer) Enabled(ctx context.Context, param trace.EnabledParameters) bool {
+	return t.otelTracer().Enabled(ctx, param)
+}
+
 // DeferredContextSetupHook is a part of the implementation of the
 // DeferredContextSetupTracerExtension interface. It will try to
 // forward the call to the wrapped tracer if it implements the
@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelslog // import "go.opentelemetry.io/otel/bridge/otelslog"
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"reflect"
+	"strconv"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// appendAttrs appends attrs converted to log attributes to kvs.
+func appendAttrs(kvs []log.KeyValue, attrs []slog.Attr) []log.KeyValue {
+	for _, a := range attrs {
+		kvs = appendAttr(kvs, a)
+	}
+	return kvs
+}
+
+// appendAttr appends a converted to log attributes to kvs. As done by the
+// slog handlers, empty attributes and empty groups are ignored, and the
+// attributes of a group with an empty key are inlined.
+func appendAttr(kvs []log.KeyValue, a slog.Attr) []log.KeyValue {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return kvs
+	}
+
+	if a.Value.Kind() == slog.KindGroup {
+		attrs := a.Value.Group()
+		if len(attrs) == 0 {
+			return kvs
+		}
+		if a.Key == "" {
+			return appendAttrs(kvs, attrs)
+		}
+		return append(kvs, log.Map(a.Key, appendAttrs(nil, attrs)...))
+	}
+	return append(kvs, log.KeyValue{Key: a.Key, Value: convertValue(a.Value)})
+}
+
+// convertValue returns v converted to a log value.
+func convertValue(v slog.Value) log.Value {
+	switch v.Kind() {
+	case slog.KindAny:
+		return convertAny(v.Any())
+	case slog.KindBool:
+		return log.BoolValue(v.Bool())
+	case slog.KindDuration:
+		return log.DurationValue(v.Duration())
+	case slog.KindFloat64:
+		return log.Float64Value(v.Float64())
+	case slog.KindInt64:
+		return log.Int64Value(v.Int64())
+	case slog.KindString:
+		return log.StringValue(v.String())
+	case slog.KindTime:
+		return log.TimeValue(v.Time())
+	case slog.KindUint64:
+		return convertUint64(v.Uint64())
+	case slog.KindGroup:
+		return log.MapValue(appendAttrs(nil, v.Group())...)
+	case slog.KindLogValuer:
+		return convertValue(v.Resolve())
+	default:
+		return log.StringValue(v.String())
+	}
+}
+
+func convertUint64(v uint64) log.Value {
+	if v > math.MaxInt64 {
+		return log.StringValue(strconv.FormatUint(v, 10))
+	}
+	return log.Int64Value(int64(v)) // nolint:gosec // Overflow checked above.
+}
+
+// convertAny returns v converted to a log value based on its dynamic type.
+func convertAny(v any) log.Value {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || (rv.Kind() == reflect.Pointer && rv.IsNil()) {
+		// Do not call the methods of a nil pointer.
+		return log.Value{}
+	}
+
+	switch v := v.(type) {
+	case log.Value:
+		return v
+	case []byte:
+		return log.BytesValue(v)
+	case error:
+		return log.StringValue(v.Error())
+	case fmt.Stringer:
+		return log.StringValue(v.String())
+	case slog.LogValuer:
+		return convertValue(slog.AnyValue(v).Resolve())
+	}
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		return log.BoolValue(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return log.Int64Value(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return convertUint64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return log.Float64Value(rv.Float())
+	case reflect.String:
+		return log.StringValue(rv.String())
+	case reflect.Pointer:
+		return convertAny(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return log.Value{}
+		}
+		values := make([]log.Value, rv.Len())
+		for i := range values {
+			values[i] = convertAny(rv.Index(i).Interface())
+		}
+		return log.SliceValue(values...)
+	case reflect.Map:
+		if rv.IsNil() {
+			return log.Value{}
+		}
+		kvs := make([]log.KeyValue, 0, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			kvs = append(kvs, log.KeyValue{
+				Key:   fmt.Sprint(iter.Key().Interface()),
+				Value: convertAny(iter.Value().Interface()),
+			})
+		}
+		return log.MapValue(kvs...)
+	default:
+		return log.StringValue(fmt.Sprintf("%+v", v))
+	}
+}
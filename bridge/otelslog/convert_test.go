@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelslog
+
+import (
+	"errors"
+	"log/slog"
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+type myInt int
+
+type point struct{ X, Y int }
+
+func TestConvertValue(t *testing.T) {
+	now := time.Now()
+	var nilPtr *net.IP
+	for _, tc := range []struct {
+		name  string
+		value slog.Value
+		want  log.Value
+	}{
+		{"Bool", slog.BoolValue(true), log.BoolValue(true)},
+		{"Duration", slog.DurationValue(time.Second), log.DurationValue(time.Second)},
+		{"Float64", slog.Float64Value(1.5), log.Float64Value(1.5)},
+		{"Int64", slog.Int64Value(-1), log.Int64Value(-1)},
+		{"String", slog.StringValue("s"), log.StringValue("s")},
+		{"Time", slog.TimeValue(now), log.TimeValue(now)},
+		{"Uint64", slog.Uint64Value(1), log.Int64Value(1)},
+		{"Uint64Overflow", slog.Uint64Value(math.MaxUint64), log.StringValue("18446744073709551615")},
+		{
+			"Group",
+			slog.GroupValue(slog.Int("a", 1), slog.Attr{}),
+			log.MapValue(log.Int64("a", 1)),
+		},
+		{"Nil", slog.AnyValue(nil), log.Value{}},
+		{"NilPointer", slog.AnyValue(nilPtr), log.Value{}},
+		{"Bytes", slog.AnyValue([]byte("b")), log.BytesValue([]byte("b"))},
+		{"Error", slog.AnyValue(errors.New("e")), log.StringValue("e")},
+		{"Stringer", slog.AnyValue(net.IPv4(127, 0, 0, 1)), log.StringValue("127.0.0.1")},
+		{"NamedInt", slog.AnyValue(myInt(2)), log.Int64Value(2)},
+		{"Pointer", slog.AnyValue(&point{1, 2}), log.StringValue("{X:1 Y:2}")},
+		{
+			"Slice",
+			slog.AnyValue([]any{"a", 1, nil}),
+			log.SliceValue(log.StringValue("a"), log.Int64Value(1), log.Value{}),
+		},
+		{
+			"Array",
+			slog.AnyValue([2]float32{1, 2}),
+			log.SliceValue(log.Float64Value(1), log.Float64Value(2)),
+		},
+		{
+			"Map",
+			slog.AnyValue(map[int]string{1: "a"}),
+			log.MapValue(log.String("1", "a")),
+		},
+		{"Struct", slog.AnyValue(point{1, 2}), log.StringValue("{X:1 Y:2}")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, convertValue(tc.value))
+		})
+	}
+}
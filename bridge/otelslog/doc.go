@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package otelslog provides a [log/slog] Handler that bridges records to
+OpenTelemetry logs.
+
+Use [NewLogger] to create a [slog.Logger] emitting to OpenTelemetry, or
+[NewHandler] to create a [Handler] wrapped by other handlers.
+
+# Record conversion
+
+A [slog.Record] is converted to a [log.Record] as follows:
+
+  - Time is the Timestamp.
+  - Message is the string Body.
+  - Level is the Severity, with [slog.LevelDebug], [slog.LevelInfo],
+    [slog.LevelWarn] and [slog.LevelError] mapped to [log.SeverityDebug],
+    [log.SeverityInfo], [log.SeverityWarn] and [log.SeverityError]
+    respectively. Intermediate levels are mapped to the matching intermediate
+    severities. The level string representation is the SeverityText.
+  - Attributes added with [Handler.WithAttrs] and the record attributes are
+    the Attributes. Attributes added after a [Handler.WithGroup] call are
+    nested in a [log.KindMap] value keyed by the group name. Empty groups are
+    omitted.
+  - The source location, unless disabled with [WithSource], is added as the
+    code.file.path, code.line.number and code.function.name attributes.
+
+Attribute values are converted to the [log.Value] of matching kind. A
+[slog.KindUint64] value greater than [math.MaxInt64] is converted to a string.
+[slog.KindAny] values are converted based on their dynamic type: byte slices
+to bytes, errors and [fmt.Stringer] to strings, slices and arrays to
+[log.KindSlice], maps to [log.KindMap], pointers to the value they point to,
+and any other type to its string representation.
+
+The attributes are converted lazily: a [slog.LogValuer] is only resolved if
+the attributes of the emitted record are accessed, e.g. the record is not
+filtered out by the OpenTelemetry processors.
+
+[Handler.Enabled] passes through to the [log.Logger] Enabled method.
+*/
+package otelslog // import "go.opentelemetry.io/otel/bridge/otelslog"
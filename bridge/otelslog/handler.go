@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelslog // import "go.opentelemetry.io/otel/bridge/otelslog"
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+	"slices"
+
+	"go.opentelemetry.io/otel/log"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+// NewLogger returns a new [slog.Logger] backed by a [Handler] created with
+// name and options.
+func NewLogger(name string, options ...Option) *slog.Logger {
+	return slog.New(NewHandler(name, options...))
+}
+
+// Handler is a [slog.Handler] that emits the records it handles with an
+// OpenTelemetry [log.Logger].
+type Handler struct {
+	logger log.Logger
+	source bool
+
+	// attrs are the attributes added outside of any group.
+	attrs []slog.Attr
+	// groups are the open groups, from the outermost to the innermost.
+	groups []group
+}
+
+// group is a group opened with WithGroup and the attributes added to it.
+type group struct {
+	name  string
+	attrs []slog.Attr
+}
+
+// Compile-time check Handler implements slog.Handler.
+var _ slog.Handler = (*Handler)(nil)
+
+// NewHandler returns a new [Handler] emitting with a [log.Logger] named name
+// and configured with options.
+//
+// The name should be the package import path that is being logged.
+func NewHandler(name string, options ...Option) *Handler {
+	cfg := newConfig(options)
+	return &Handler{
+		logger: cfg.logger(name),
+		source: cfg.source,
+	}
+}
+
+// Enabled returns whether the [log.Logger] of h emits records of level in
+// ctx.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.logger.Enabled(ctx, log.EnabledParameters{Severity: convertLevel(level)})
+}
+
+// Handle emits r as an OpenTelemetry log record.
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	var record log.Record
+	record.SetTimestamp(r.Time)
+	record.SetBody(log.StringValue(r.Message))
+	record.SetSeverity(convertLevel(r.Level))
+	record.SetSeverityText(r.Level.String())
+
+	if len(h.attrs) > 0 || len(h.groups) > 0 || r.NumAttrs() > 0 || (h.source && r.PC != 0) {
+		// The record attributes are shared with the caller, clone them as
+		// they are converted after Handle returns if the record is held.
+		r = r.Clone()
+		record.AddAttributesFunc(func() []log.KeyValue {
+			return h.attributes(r)
+		})
+	}
+
+	h.logger.Emit(ctx, record)
+	return nil
+}
+
+// attributes returns the attributes of h and r converted to log attributes.
+func (h *Handler) attributes(r slog.Record) []log.KeyValue {
+	inner := make([]log.KeyValue, 0, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		inner = appendAttr(inner, a)
+		return true
+	})
+
+	for i := len(h.groups) - 1; i >= 0; i-- {
+		g := h.groups[i]
+		kvs := appendAttrs(make([]log.KeyValue, 0, len(g.attrs)+len(inner)), g.attrs)
+		kvs = append(kvs, inner...)
+		if len(kvs) == 0 {
+			// Empty groups are omitted.
+			inner = nil
+			continue
+		}
+		inner = []log.KeyValue{log.Map(g.name, kvs...)}
+	}
+
+	kvs := appendAttrs(make([]log.KeyValue, 0, len(h.attrs)+len(inner)+3), h.attrs)
+	kvs = append(kvs, inner...)
+	if h.source && r.PC != 0 {
+		kvs = appendSource(kvs, r.PC)
+	}
+	return kvs
+}
+
+// WithAttrs returns a new [Handler] adding attrs, in the current group, to
+// the records it handles.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+
+	h2 := *h
+	if len(h.groups) == 0 {
+		h2.attrs = append(slices.Clip(h.attrs), attrs...)
+		return &h2
+	}
+	h2.groups = slices.Clone(h.groups)
+	g := &h2.groups[len(h2.groups)-1]
+	g.attrs = append(slices.Clip(g.attrs), attrs...)
+	return &h2
+}
+
+// WithGroup returns a new [Handler] nesting the attributes added afterward in
+// the group name.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	h2 := *h
+	h2.groups = append(slices.Clip(h.groups), group{name: name})
+	return &h2
+}
+
+// convertLevel returns the severity matching level. The slog levels are
+// spaced by 4, as the severity ranges.
+func convertLevel(level slog.Level) log.Severity {
+	return log.Severity(level + 9) // nolint:gosec // Levels are small.
+}
+
+// appendSource appends the source location of pc to kvs.
+func appendSource(kvs []log.KeyValue, pc uintptr) []log.KeyValue {
+	frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+	return append(kvs,
+		log.String(string(semconv.CodeFilePathKey), frame.File),
+		log.Int(string(semconv.CodeLineNumberKey), frame.Line),
+		log.String(string(semconv.CodeFunctionNameKey), frame.Function),
+	)
+}
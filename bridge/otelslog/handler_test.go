@@ -0,0 +1,250 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelslog
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"testing/slogtest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/embedded"
+	"go.opentelemetry.io/otel/log/logtest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+const name = "go.opentelemetry.io/otel/bridge/otelslog/test"
+
+// records returns the single scope records of rec.
+func records(t *testing.T, rec *logtest.Recorder) []logtest.Record {
+	t.Helper()
+	result := rec.Result()
+	require.Len(t, result, 1)
+	for _, r := range result {
+		return r
+	}
+	return nil
+}
+
+func TestNewLogger(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger(
+		name,
+		WithLoggerProvider(rec),
+		WithVersion("v1"),
+		WithSchemaURL(semconv.SchemaURL),
+		WithAttributes(attribute.String("k", "v")),
+	)
+	l.Info("msg")
+
+	result := rec.Result()
+	require.Len(t, result, 1)
+	for scope := range result {
+		assert.Equal(t, logtest.Scope{
+			Name:       name,
+			Version:    "v1",
+			SchemaURL:  semconv.SchemaURL,
+			Attributes: attribute.NewSet(attribute.String("k", "v")),
+		}, scope)
+	}
+}
+
+func TestHandlerRecord(t *testing.T) {
+	rec := logtest.NewRecorder()
+	h := NewHandler(name, WithLoggerProvider(rec), WithSource(false))
+
+	now := time.Now()
+	r := slog.NewRecord(now, slog.LevelWarn+1, "msg", 0)
+	r.AddAttrs(slog.String("k", "v"))
+	require.NoError(t, h.Handle(context.Background(), r))
+
+	got := records(t, rec)
+	require.Len(t, got, 1)
+	assert.Equal(t, now, got[0].Timestamp)
+	assert.Equal(t, log.SeverityWarn2, got[0].Severity)
+	assert.Equal(t, "WARN+1", got[0].SeverityText)
+	assert.Equal(t, log.StringValue("msg"), got[0].Body)
+	assert.Equal(t, []log.KeyValue{log.String("k", "v")}, got[0].Attributes)
+}
+
+func TestConvertLevel(t *testing.T) {
+	assert.Equal(t, log.SeverityDebug, convertLevel(slog.LevelDebug))
+	assert.Equal(t, log.SeverityInfo, convertLevel(slog.LevelInfo))
+	assert.Equal(t, log.SeverityWarn, convertLevel(slog.LevelWarn))
+	assert.Equal(t, log.SeverityError, convertLevel(slog.LevelError))
+	assert.Equal(t, log.SeverityError4, convertLevel(slog.LevelError+3))
+}
+
+func TestHandlerGroups(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger(name, WithLoggerProvider(rec), WithSource(false))
+
+	l = l.With("a", 1).WithGroup("g").With("b", 2).WithGroup("h").WithGroup("empty")
+	l.Info("msg", "c", 3)
+	l.WithGroup("").Info("msg", slog.Group("", "d", 4), slog.Group("none"))
+	l.Info("msg")
+
+	got := records(t, rec)
+	require.Len(t, got, 3)
+	assert.Equal(t, []log.KeyValue{
+		log.Int64("a", 1),
+		log.Map("g",
+			log.Int64("b", 2),
+			log.Map("h", log.Map("empty", log.Int64("c", 3))),
+		),
+	}, got[0].Attributes)
+	assert.Equal(t, []log.KeyValue{
+		log.Int64("a", 1),
+		log.Map("g",
+			log.Int64("b", 2),
+			log.Map("h", log.Map("empty", log.Int64("d", 4))),
+		),
+	}, got[1].Attributes)
+	assert.Equal(t, []log.KeyValue{
+		log.Int64("a", 1),
+		log.Map("g", log.Int64("b", 2)),
+	}, got[2].Attributes, "empty groups not omitted")
+}
+
+func TestHandlerWithAttrsIsolation(t *testing.T) {
+	rec := logtest.NewRecorder()
+	h := NewHandler(name, WithLoggerProvider(rec), WithSource(false))
+
+	base := h.WithAttrs(make([]slog.Attr, 0, 4)).WithAttrs([]slog.Attr{slog.Int("a", 1)})
+	l1 := slog.New(base.WithAttrs([]slog.Attr{slog.Int("b", 2)}))
+	l2 := slog.New(base.WithAttrs([]slog.Attr{slog.Int("c", 3)}))
+	l1.Info("msg")
+	l2.Info("msg")
+
+	got := records(t, rec)
+	require.Len(t, got, 2)
+	assert.Equal(t, []log.KeyValue{log.Int64("a", 1), log.Int64("b", 2)}, got[0].Attributes)
+	assert.Equal(t, []log.KeyValue{log.Int64("a", 1), log.Int64("c", 3)}, got[1].Attributes)
+}
+
+func TestHandlerSource(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger(name, WithLoggerProvider(rec))
+	l.Info("msg")
+
+	got := records(t, rec)
+	require.Len(t, got, 1)
+	attrs := make(map[string]log.Value)
+	for _, kv := range got[0].Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+	assert.True(t, strings.HasSuffix(attrs[string(semconv.CodeFilePathKey)].AsString(), "handler_test.go"))
+	assert.Positive(t, attrs[string(semconv.CodeLineNumberKey)].AsInt64())
+	assert.True(t, strings.HasSuffix(attrs[string(semconv.CodeFunctionNameKey)].AsString(), "TestHandlerSource"))
+}
+
+func TestHandlerEnabled(t *testing.T) {
+	rec := logtest.NewRecorder(logtest.WithEnabledFunc(func(_ context.Context, p log.EnabledParameters) bool {
+		return p.Severity >= log.SeverityWarn
+	}))
+	l := NewLogger(name, WithLoggerProvider(rec))
+
+	ctx := context.Background()
+	assert.False(t, l.Enabled(ctx, slog.LevelInfo))
+	assert.True(t, l.Enabled(ctx, slog.LevelWarn))
+
+	l.Info("dropped")
+	l.Warn("kept")
+	got := records(t, rec)
+	require.Len(t, got, 1)
+	assert.Equal(t, log.StringValue("kept"), got[0].Body)
+}
+
+type countingValuer struct{ calls int }
+
+func (v *countingValuer) LogValue() slog.Value {
+	v.calls++
+	return slog.StringValue("value")
+}
+
+// heldLogger holds the emitted records without accessing them.
+type heldLogger struct {
+	embedded.Logger
+
+	records []log.Record
+}
+
+func (l *heldLogger) Emit(_ context.Context, r log.Record) {
+	l.records = append(l.records, r)
+}
+
+func (*heldLogger) Enabled(context.Context, log.EnabledParameters) bool { return true }
+
+type heldProvider struct {
+	embedded.LoggerProvider
+
+	logger *heldLogger
+}
+
+func (p heldProvider) Logger(string, ...log.LoggerOption) log.Logger { return p.logger }
+
+func TestHandlerLogValuerLazy(t *testing.T) {
+	held := &heldLogger{}
+	l := NewLogger(name, WithLoggerProvider(heldProvider{logger: held}))
+
+	valuer := &countingValuer{}
+	l.With("with", valuer).Info("msg", "attr", valuer)
+	assert.Equal(t, 0, valuer.calls, "LogValuer resolved before attributes accessed")
+
+	require.Len(t, held.records, 1)
+	var got []log.KeyValue
+	held.records[0].WalkAttributes(func(kv log.KeyValue) bool {
+		got = append(got, kv)
+		return true
+	})
+	assert.Equal(t, 2, valuer.calls)
+	assert.Equal(t, log.String("with", "value"), got[0])
+	assert.Equal(t, log.String("attr", "value"), got[1])
+}
+
+func TestSlogtest(t *testing.T) {
+	var rec *logtest.Recorder
+	slogtest.Run(t, func(*testing.T) slog.Handler {
+		rec = logtest.NewRecorder()
+		return NewHandler(name, WithLoggerProvider(rec), WithSource(false))
+	}, func(t *testing.T) map[string]any {
+		got := records(t, rec)
+		require.Len(t, got, 1)
+		r := got[0]
+
+		m := map[string]any{
+			slog.LevelKey:   r.SeverityText,
+			slog.MessageKey: r.Body.AsString(),
+		}
+		if !r.Timestamp.IsZero() {
+			m[slog.TimeKey] = r.Timestamp
+		}
+		for _, kv := range r.Attributes {
+			m[kv.Key] = valueToAny(kv.Value)
+		}
+		return m
+	})
+}
+
+func valueToAny(v log.Value) any {
+	switch v.Kind() {
+	case log.KindMap:
+		m := make(map[string]any)
+		for _, kv := range v.AsMap() {
+			m[kv.Key] = valueToAny(kv.Value)
+		}
+		return m
+	case log.KindInt64:
+		return v.AsInt64()
+	default:
+		return v.String()
+	}
+}
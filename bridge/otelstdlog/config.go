@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelstdlog // import "go.opentelemetry.io/otel/bridge/otelstdlog"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+)
+
+type severityTag struct {
+	tag      string
+	severity log.Severity
+}
+
+type config struct {
+	provider  log.LoggerProvider
+	version   string
+	schemaURL string
+	attrs     []attribute.KeyValue
+
+	prefix   string
+	location *time.Location
+	severity log.Severity
+	tags     []severityTag
+}
+
+func newConfig(options []Option) config {
+	c := config{severity: log.SeverityInfo}
+	for _, opt := range options {
+		c = opt.apply(c)
+	}
+
+	if c.provider == nil {
+		c.provider = global.GetLoggerProvider()
+	}
+	if c.location == nil {
+		c.location = time.Local
+	}
+	return c
+}
+
+func (c config) logger(name string) log.Logger {
+	var opts []log.LoggerOption
+	if c.version != "" {
+		opts = append(opts, log.WithInstrumentationVersion(c.version))
+	}
+	if c.schemaURL != "" {
+		opts = append(opts, log.WithSchemaURL(c.schemaURL))
+	}
+	if len(c.attrs) > 0 {
+		opts = append(opts, log.WithInstrumentationAttributes(c.attrs...))
+	}
+	return c.provider.Logger(name, opts...)
+}
+
+// Option configures a [Writer].
+type Option interface {
+	apply(config) config
+}
+
+type optFunc func(config) config
+
+func (f optFunc) apply(c config) config { return f(c) }
+
+// WithVersion returns an [Option] that configures the version of the
+// [log.Logger] used by a [Writer]. The version should be the version of the
+// package that is being logged.
+func WithVersion(version string) Option {
+	return optFunc(func(c config) config {
+		c.version = version
+		return c
+	})
+}
+
+// WithSchemaURL returns an [Option] that configures the semantic convention
+// schema URL of the [log.Logger] used by a [Writer].
+func WithSchemaURL(schemaURL string) Option {
+	return optFunc(func(c config) config {
+		c.schemaURL = schemaURL
+		return c
+	})
+}
+
+// WithAttributes returns an [Option] that configures the instrumentation
+// scope attributes of the [log.Logger] used by a [Writer].
+func WithAttributes(attributes ...attribute.KeyValue) Option {
+	return optFunc(func(c config) config {
+		c.attrs = attributes
+		return c
+	})
+}
+
+// WithLoggerProvider returns an [Option] that configures the
+// [log.LoggerProvider] used by a [Writer] to create its [log.Logger].
+//
+// By default, the global LoggerProvider is used.
+func WithLoggerProvider(provider log.LoggerProvider) Option {
+	return optFunc(func(c config) config {
+		c.provider = provider
+		return c
+	})
+}
+
+// WithPrefix returns an [Option] that configures the prefix of the standard
+// library logger writing to a [Writer]. The prefix is removed from the
+// output, whether it is at the beginning of the line or before the message.
+func WithPrefix(prefix string) Option {
+	return optFunc(func(c config) config {
+		c.prefix = prefix
+		return c
+	})
+}
+
+// WithLocation returns an [Option] that configures the location of the
+// timestamps written to a [Writer]. It should be [time.UTC] if the standard
+// library logger writing to the Writer uses the [stdlog.LUTC] flag.
+//
+// By default, [time.Local] is used.
+//
+// [stdlog.LUTC]: https://pkg.go.dev/log#LUTC
+func WithLocation(loc *time.Location) Option {
+	return optFunc(func(c config) config {
+		c.location = loc
+		return c
+	})
+}
+
+// WithSeverity returns an [Option] that configures the severity of the
+// records whose message does not start with a severity tag.
+//
+// By default, [log.SeverityInfo] is used.
+func WithSeverity(severity log.Severity) Option {
+	return optFunc(func(c config) config {
+		c.severity = severity
+		return c
+	})
+}
+
+// WithSeverityTag returns an [Option] that configures a [Writer] to emit the
+// records whose message starts with tag (e.g. "ERROR" or "[warn]") with
+// severity. The tag is removed from the message and used as the severity
+// text.
+//
+// This option can be used multiple times. Tags are matched in the order they
+// are configured.
+func WithSeverityTag(tag string, severity log.Severity) Option {
+	return optFunc(func(c config) config {
+		if tag != "" {
+			c.tags = append(c.tags, severityTag{tag: tag, severity: severity})
+		}
+		return c
+	})
+}
@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package otelstdlog provides a bridge from the standard library [log] package
+to OpenTelemetry logs.
+
+It is intended for codebases that cannot quickly switch from the standard
+library logging API. Set a [Writer] as the output of an existing standard
+library Logger, or use [NewLogger] to create a new one.
+
+# Parsing
+
+A Writer parses each write into a [go.opentelemetry.io/otel/log.Record]. The
+header written by a standard library Logger is detected from its format, the
+Logger flags are not needed:
+
+  - The prefix configured with [WithPrefix] is removed, both at the beginning
+    of the line and before the message.
+  - A leading date (Ldate flag) and time (Ltime and Lmicroseconds flags) are
+    parsed as the record Timestamp, in the location configured with
+    [WithLocation]. If only the time is written, today's date is used. If
+    none is written, the time of the write is used.
+  - A leading source location (Lshortfile and Llongfile flags) is added as the
+    code.file.path and code.line.number attributes. File paths containing
+    spaces are not detected.
+  - The rest of the line is the record Body.
+
+The record Severity is the one configured with [WithSeverity], unless the
+message starts with a tag configured with [WithSeverityTag].
+*/
+package otelstdlog // import "go.opentelemetry.io/otel/bridge/otelstdlog"
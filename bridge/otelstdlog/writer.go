@@ -0,0 +1,207 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelstdlog // import "go.opentelemetry.io/otel/bridge/otelstdlog"
+
+import (
+	"context"
+	"io"
+	stdlog "log"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+// NewLogger returns a new standard library [stdlog.Logger] writing to a
+// [Writer] created with name and options. The Logger adds the source location
+// to its output, the Writer parses it into the record attributes.
+func NewLogger(name string, options ...Option) *stdlog.Logger {
+	return stdlog.New(NewWriter(name, options...), "", stdlog.Llongfile)
+}
+
+// Writer is an [io.Writer] that parses the output of a standard library
+// [stdlog.Logger] into log records emitted with an OpenTelemetry
+// [log.Logger].
+//
+// Each call to Write is parsed into a single record, as a standard library
+// Logger writes each of its entries with a single call.
+type Writer struct {
+	logger log.Logger
+
+	prefix   string
+	location *time.Location
+	severity log.Severity
+	tags     []severityTag
+
+	// now returns the current time. It is replaced in tests.
+	now func() time.Time
+}
+
+// Compile-time check Writer implements io.Writer.
+var _ io.Writer = (*Writer)(nil)
+
+// NewWriter returns a new [Writer] emitting with a [log.Logger] named name
+// and configured with options.
+//
+// The name should be the package import path that is being logged.
+func NewWriter(name string, options ...Option) *Writer {
+	cfg := newConfig(options)
+	return &Writer{
+		logger:   cfg.logger(name),
+		prefix:   cfg.prefix,
+		location: cfg.location,
+		severity: cfg.severity,
+		tags:     cfg.tags,
+		now:      time.Now,
+	}
+}
+
+// Write parses p into a log record and emits it. It always returns len(p)
+// and a nil error.
+func (w *Writer) Write(p []byte) (int, error) {
+	e := w.parse(string(p))
+
+	ctx := context.Background()
+	if !w.logger.Enabled(ctx, log.EnabledParameters{Severity: e.severity}) {
+		return len(p), nil
+	}
+
+	var record log.Record
+	record.SetTimestamp(e.timestamp)
+	record.SetSeverity(e.severity)
+	record.SetSeverityText(e.severityText)
+	record.SetBody(log.StringValue(e.message))
+	if e.file != "" {
+		record.AddAttributes(
+			log.String(string(semconv.CodeFilePathKey), e.file),
+			log.Int(string(semconv.CodeLineNumberKey), e.line),
+		)
+	}
+	w.logger.Emit(ctx, record)
+	return len(p), nil
+}
+
+// entry is a parsed standard library log entry.
+type entry struct {
+	timestamp    time.Time
+	file         string
+	line         int
+	severity     log.Severity
+	severityText string
+	message      string
+}
+
+// parse parses s formatted by a standard library Logger. The header written
+// according to the Logger flags is detected from its format:
+//
+//	prefix 2009/01/23 01:23:23.123123 /a/b/c/d.go:23: prefix message
+//
+// All the header parts are optional.
+func (w *Writer) parse(s string) entry {
+	s = strings.TrimSuffix(s, "\n")
+	if w.prefix != "" {
+		s = strings.TrimPrefix(s, w.prefix)
+	}
+
+	e := entry{severity: w.severity}
+	var ok bool
+	if e.timestamp, s, ok = w.parseTimestamp(s); !ok {
+		e.timestamp = w.now()
+	}
+	e.file, e.line, s = parseSource(s)
+	if w.prefix != "" {
+		// The prefix is before the message with the Lmsgprefix flag.
+		s = strings.TrimPrefix(s, w.prefix)
+	}
+
+	for _, t := range w.tags {
+		if rest, found := strings.CutPrefix(s, t.tag); found {
+			e.severity, e.severityText = t.severity, t.tag
+			s = strings.TrimLeft(rest, " :")
+			break
+		}
+	}
+	e.message = s
+	return e
+}
+
+const (
+	dateLayout = "2006/01/02 "
+	timeLayout = "15:04:05"
+	usLayout   = ".000000"
+)
+
+// parseTimestamp parses the date and the time at the beginning of s. The
+// returned timestamp has the date of today if only the time is written.
+func (w *Writer) parseTimestamp(s string) (time.Time, string, bool) {
+	var date time.Time
+	var hasDate bool
+	if match(s, "0000/00/00 ") {
+		var err error
+		date, err = time.ParseInLocation(dateLayout, s[:len(dateLayout)], w.location)
+		if err != nil {
+			return time.Time{}, s, false
+		}
+		s, hasDate = s[len(dateLayout):], true
+	}
+
+	layout := timeLayout
+	switch {
+	case match(s, "00:00:00.000000 "):
+		layout += usLayout
+	case match(s, "00:00:00 "):
+	default:
+		return date, s, hasDate
+	}
+	clock, err := time.Parse(layout, s[:len(layout)])
+	if err != nil {
+		return date, s, hasDate
+	}
+	s = s[len(layout)+1:]
+
+	if !hasDate {
+		date = w.now().In(w.location)
+	}
+	y, m, d := date.Date()
+	ts := time.Date(y, m, d, clock.Hour(), clock.Minute(), clock.Second(), clock.Nanosecond(), w.location)
+	return ts, s, true
+}
+
+// parseSource parses the "file.go:line: " source location at the beginning
+// of s.
+func parseSource(s string) (file string, line int, rest string) {
+	i := strings.Index(s, ".go:")
+	if i < 0 || strings.ContainsRune(s[:i], ' ') {
+		return "", 0, s
+	}
+	n, after, found := strings.Cut(s[i+len(".go:"):], ": ")
+	if !found {
+		return "", 0, s
+	}
+	line, err := strconv.Atoi(n)
+	if err != nil {
+		return "", 0, s
+	}
+	return s[:i+len(".go")], line, after
+}
+
+// match returns whether s starts with pattern, where each '0' in pattern
+// matches a digit.
+func match(s, pattern string) bool {
+	if len(s) < len(pattern) {
+		return false
+	}
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] == '0' {
+			if s[i] < '0' || s[i] > '9' {
+				return false
+			}
+		} else if s[i] != pattern[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,237 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelstdlog
+
+import (
+	"context"
+	stdlog "log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/logtest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+const name = "go.opentelemetry.io/otel/bridge/otelstdlog/test"
+
+// records returns the single scope records of rec.
+func records(t *testing.T, rec *logtest.Recorder) []logtest.Record {
+	t.Helper()
+	result := rec.Result()
+	require.Len(t, result, 1)
+	for _, r := range result {
+		return r
+	}
+	return nil
+}
+
+func TestNewWriterScope(t *testing.T) {
+	rec := logtest.NewRecorder()
+	w := NewWriter(
+		name,
+		WithLoggerProvider(rec),
+		WithVersion("v1"),
+		WithSchemaURL(semconv.SchemaURL),
+		WithAttributes(attribute.String("k", "v")),
+	)
+	_, err := w.Write([]byte("msg\n"))
+	require.NoError(t, err)
+
+	result := rec.Result()
+	require.Len(t, result, 1)
+	for scope := range result {
+		assert.Equal(t, logtest.Scope{
+			Name:       name,
+			Version:    "v1",
+			SchemaURL:  semconv.SchemaURL,
+			Attributes: attribute.NewSet(attribute.String("k", "v")),
+		}, scope)
+	}
+}
+
+func TestWriterParse(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	w := NewWriter(
+		name,
+		WithPrefix("app: "),
+		WithLocation(time.UTC),
+		WithSeverityTag("ERROR", log.SeverityError),
+		WithSeverityTag("[warn]", log.SeverityWarn),
+	)
+	w.now = func() time.Time { return now }
+
+	for _, tc := range []struct {
+		name string
+		in   string
+		want entry
+	}{
+		{
+			name: "Message",
+			in:   "msg\n",
+			want: entry{timestamp: now, severity: log.SeverityInfo, message: "msg"},
+		},
+		{
+			name: "StdFlags",
+			in:   "2009/01/23 01:23:23 msg\n",
+			want: entry{
+				timestamp: time.Date(2009, 1, 23, 1, 23, 23, 0, time.UTC),
+				severity:  log.SeverityInfo,
+				message:   "msg",
+			},
+		},
+		{
+			name: "Microseconds",
+			in:   "2009/01/23 01:23:23.123123 msg\n",
+			want: entry{
+				timestamp: time.Date(2009, 1, 23, 1, 23, 23, 123123000, time.UTC),
+				severity:  log.SeverityInfo,
+				message:   "msg",
+			},
+		},
+		{
+			name: "DateOnly",
+			in:   "2009/01/23 msg\n",
+			want: entry{
+				timestamp: time.Date(2009, 1, 23, 0, 0, 0, 0, time.UTC),
+				severity:  log.SeverityInfo,
+				message:   "msg",
+			},
+		},
+		{
+			name: "TimeOnly",
+			in:   "01:23:23 msg\n",
+			want: entry{
+				timestamp: time.Date(2024, 5, 6, 1, 23, 23, 0, time.UTC),
+				severity:  log.SeverityInfo,
+				message:   "msg",
+			},
+		},
+		{
+			name: "InvalidDate",
+			in:   "2009/13/45 msg\n",
+			want: entry{timestamp: now, severity: log.SeverityInfo, message: "2009/13/45 msg"},
+		},
+		{
+			name: "Prefix",
+			in:   "app: 2009/01/23 01:23:23 msg\n",
+			want: entry{
+				timestamp: time.Date(2009, 1, 23, 1, 23, 23, 0, time.UTC),
+				severity:  log.SeverityInfo,
+				message:   "msg",
+			},
+		},
+		{
+			name: "MsgPrefix",
+			in:   "2009/01/23 01:23:23 app: msg\n",
+			want: entry{
+				timestamp: time.Date(2009, 1, 23, 1, 23, 23, 0, time.UTC),
+				severity:  log.SeverityInfo,
+				message:   "msg",
+			},
+		},
+		{
+			name: "Source",
+			in:   "2009/01/23 01:23:23 /a/b/c/d.go:23: msg\n",
+			want: entry{
+				timestamp: time.Date(2009, 1, 23, 1, 23, 23, 0, time.UTC),
+				file:      "/a/b/c/d.go",
+				line:      23,
+				severity:  log.SeverityInfo,
+				message:   "msg",
+			},
+		},
+		{
+			name: "NotSource",
+			in:   "see main.go:12: for details\n",
+			want: entry{timestamp: now, severity: log.SeverityInfo, message: "see main.go:12: for details"},
+		},
+		{
+			name: "SeverityTag",
+			in:   "d.go:23: ERROR: failed\n",
+			want: entry{
+				timestamp:    now,
+				file:         "d.go",
+				line:         23,
+				severity:     log.SeverityError,
+				severityText: "ERROR",
+				message:      "failed",
+			},
+		},
+		{
+			name: "BracketSeverityTag",
+			in:   "[warn] slow\n",
+			want: entry{
+				timestamp:    now,
+				severity:     log.SeverityWarn,
+				severityText: "[warn]",
+				message:      "slow",
+			},
+		},
+		{
+			name: "MultiLine",
+			in:   "panic\ngoroutine 1\n",
+			want: entry{timestamp: now, severity: log.SeverityInfo, message: "panic\ngoroutine 1"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, w.parse(tc.in))
+		})
+	}
+}
+
+func TestNewLogger(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger(name, WithLoggerProvider(rec), WithSeverity(log.SeverityDebug))
+	l.Print("msg")
+
+	got := records(t, rec)
+	require.Len(t, got, 1)
+	assert.Equal(t, log.SeverityDebug, got[0].Severity)
+	assert.Equal(t, log.StringValue("msg"), got[0].Body)
+	assert.False(t, got[0].Timestamp.IsZero(), "timestamp not set")
+
+	require.Len(t, got[0].Attributes, 2)
+	assert.Equal(t, string(semconv.CodeFilePathKey), got[0].Attributes[0].Key)
+	assert.True(t, strings.HasSuffix(got[0].Attributes[0].Value.AsString(), "writer_test.go"))
+	assert.Equal(t, string(semconv.CodeLineNumberKey), got[0].Attributes[1].Key)
+}
+
+func TestWriterStdlibOutput(t *testing.T) {
+	rec := logtest.NewRecorder()
+	w := NewWriter(name, WithLoggerProvider(rec), WithPrefix("svc "))
+
+	l := stdlog.New(w, "svc ", stdlog.LstdFlags|stdlog.Lmicroseconds|stdlog.Lshortfile|stdlog.Lmsgprefix)
+	before := time.Now().Truncate(time.Microsecond)
+	l.Print("msg")
+
+	got := records(t, rec)
+	require.Len(t, got, 1)
+	assert.Equal(t, log.StringValue("msg"), got[0].Body)
+	assert.False(t, got[0].Timestamp.Before(before), "timestamp not parsed")
+	assert.Equal(t, log.String(string(semconv.CodeFilePathKey), "writer_test.go"), got[0].Attributes[0])
+}
+
+func TestWriterEnabled(t *testing.T) {
+	rec := logtest.NewRecorder(logtest.WithEnabledFunc(func(_ context.Context, p log.EnabledParameters) bool {
+		return p.Severity >= log.SeverityWarn
+	}))
+	w := NewWriter(name, WithLoggerProvider(rec), WithSeverityTag("WARN", log.SeverityWarn))
+
+	n, err := w.Write([]byte("dropped\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, len("dropped\n"), n)
+	_, err = w.Write([]byte("WARN kept\n"))
+	assert.NoError(t, err)
+
+	got := records(t, rec)
+	require.Len(t, got, 1)
+	assert.Equal(t, log.StringValue("kept"), got[0].Body)
+	assert.Equal(t, "WARN", got[0].SeverityText)
+}
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package codes // import "go.opentelemetry.io/otel/codes"
+
+// The mappings of this file conform to the semantic conventions of the span
+// status of HTTP
+// (https://github.com/open-telemetry/semantic-conventions/blob/v1.34.0/docs/http/http-spans.md#status)
+// and gRPC
+// (https://github.com/open-telemetry/semantic-conventions/blob/v1.34.0/docs/rpc/grpc.md#grpc-status)
+// spans.
+
+// FromHTTPClient returns the Code of a client span for the HTTP response
+// status code status. Error is returned for the 4xx and 5xx status codes and
+// for invalid status codes, Unset is returned otherwise.
+func FromHTTPClient(status int) Code {
+	if status < 100 || status >= 400 {
+		return Error
+	}
+	return Unset
+}
+
+// FromHTTPServer returns the Code of a server span for the HTTP response
+// status code status. Error is returned for the 5xx status codes and for
+// invalid status codes, Unset is returned otherwise. The 4xx status codes are
+// not errors of the server.
+func FromHTTPServer(status int) Code {
+	if status < 100 || status >= 500 {
+		return Error
+	}
+	return Unset
+}
+
+// gRPC status codes (https://github.com/grpc/grpc/blob/master/doc/statuscodes.md).
+const (
+	grpcOK               = 0
+	grpcUnknown          = 2
+	grpcDeadlineExceeded = 4
+	grpcUnimplemented    = 12
+	grpcInternal         = 13
+	grpcUnavailable      = 14
+	grpcDataLoss         = 15
+	grpcMaxCode          = 16
+)
+
+// FromGRPCClient returns the Code of a client span for the gRPC status code
+// code. Error is returned for all the codes other than OK, Unset is returned
+// otherwise.
+//
+// The code of the google.golang.org/grpc/codes package can be passed
+// converted to an uint32.
+func FromGRPCClient(code uint32) Code {
+	if code == grpcOK {
+		return Unset
+	}
+	return Error
+}
+
+// FromGRPCServer returns the Code of a server span for the gRPC status code
+// code. Error is returned for the UNKNOWN, DEADLINE_EXCEEDED, UNIMPLEMENTED,
+// INTERNAL, UNAVAILABLE, and DATA_LOSS codes and for invalid codes, Unset is
+// returned otherwise. The other codes are caused by the client.
+//
+// The code of the google.golang.org/grpc/codes package can be passed
+// converted to an uint32.
+func FromGRPCServer(code uint32) Code {
+	switch code {
+	case grpcUnknown, grpcDeadlineExceeded, grpcUnimplemented, grpcInternal, grpcUnavailable, grpcDataLoss:
+		return Error
+	}
+	if code > grpcMaxCode {
+		return Error
+	}
+	return Unset
+}
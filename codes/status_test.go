@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package codes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromHTTP(t *testing.T) {
+	for _, tc := range []struct {
+		status         int
+		client, server Code
+	}{
+		{0, Error, Error},
+		{99, Error, Error},
+		{100, Unset, Unset},
+		{200, Unset, Unset},
+		{302, Unset, Unset},
+		{399, Unset, Unset},
+		{400, Error, Unset},
+		{404, Error, Unset},
+		{499, Error, Unset},
+		{500, Error, Error},
+		{503, Error, Error},
+		{599, Error, Error},
+		{600, Error, Error},
+	} {
+		assert.Equalf(t, tc.client, FromHTTPClient(tc.status), "client status %d", tc.status)
+		assert.Equalf(t, tc.server, FromHTTPServer(tc.status), "server status %d", tc.status)
+	}
+}
+
+func TestFromGRPC(t *testing.T) {
+	serverErrors := map[uint32]bool{2: true, 4: true, 12: true, 13: true, 14: true, 15: true}
+	for code := uint32(0); code <= 16; code++ {
+		wantClient, wantServer := Error, Unset
+		if code == 0 {
+			wantClient = Unset
+		}
+		if serverErrors[code] {
+			wantServer = Error
+		}
+		assert.Equalf(t, wantClient, FromGRPCClient(code), "client code %d", code)
+		assert.Equalf(t, wantServer, FromGRPCServer(code), "server code %d", code)
+	}
+
+	assert.Equal(t, Error, FromGRPCClient(17), "invalid client code")
+	assert.Equal(t, Error, FromGRPCServer(17), "invalid server code")
+}
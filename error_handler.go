@@ -3,6 +3,13 @@
 
 package otel // import "go.opentelemetry.io/otel"
 
+import (
+	"context"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
 // ErrorHandler handles irremediable events.
 type ErrorHandler interface {
 	// DO NOT CHANGE: any modification will not be backwards compatible and
@@ -25,3 +32,71 @@ var _ ErrorHandler = ErrorHandlerFunc(nil)
 func (f ErrorHandlerFunc) Handle(err error) {
 	f(err)
 }
+
+// Severity is the severity of a Diagnostic.
+type Severity int
+
+const (
+	// SeverityError is the severity of an event that resulted in the loss of
+	// telemetry, e.g. a failed export. It is the default Severity.
+	SeverityError Severity = iota
+	// SeverityWarning is the severity of an event an OpenTelemetry component
+	// recovered from, possibly with a degraded behavior, e.g. an invalid
+	// configuration value replaced by its default.
+	SeverityWarning
+)
+
+// String returns the name of the Severity.
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	}
+	return "Severity(" + strconv.Itoa(int(s)) + ")"
+}
+
+// Diagnostic is an irremediable event reported by an OpenTelemetry
+// component.
+type Diagnostic struct {
+	// Component is the name of the component reporting the event, e.g. the
+	// import path of its package.
+	Component string
+	// Severity is the severity of the event.
+	Severity Severity
+	// Err is the error describing the event.
+	Err error
+	// Attributes describe the event.
+	Attributes []attribute.KeyValue
+}
+
+// DiagnosticHandler is an ErrorHandler that also handles structured
+// Diagnostics. If the global ErrorHandler is a DiagnosticHandler, the
+// Diagnostics passed to HandleDiagnostic are sent to its HandleDiagnostic
+// method instead of only their error being sent to its Handle method.
+type DiagnosticHandler interface {
+	ErrorHandler
+
+	// HandleDiagnostic handles a Diagnostic reported by an OpenTelemetry
+	// component.
+	HandleDiagnostic(context.Context, Diagnostic)
+}
+
+// DiagnosticHandlerFunc is a convenience adapter to allow the use of a
+// function as a DiagnosticHandler.
+type DiagnosticHandlerFunc func(context.Context, Diagnostic)
+
+var _ DiagnosticHandler = DiagnosticHandlerFunc(nil)
+
+// Handle handles the irremediable error by calling the DiagnosticHandlerFunc
+// itself with a Diagnostic of SeverityError holding err.
+func (f DiagnosticHandlerFunc) Handle(err error) {
+	f(context.Background(), Diagnostic{Severity: SeverityError, Err: err})
+}
+
+// HandleDiagnostic handles the Diagnostic by calling the
+// DiagnosticHandlerFunc itself.
+func (f DiagnosticHandlerFunc) HandleDiagnostic(ctx context.Context, d Diagnostic) {
+	f(ctx, d)
+}
@@ -26,6 +26,9 @@ var (
 	attrFloat64Slice = attribute.Float64Slice("float64 slice", []float64{-1, 1})
 	attrString       = attribute.String("string", "o")
 	attrStringSlice  = attribute.StringSlice("string slice", []string{"o", "n"})
+	attrBytes        = attribute.Bytes("bytes", []byte("test"))
+	attrSlice        = attribute.Slice("slice", attribute.BoolValue(true))
+	attrMap          = attribute.Map("map", attrString)
 	attrInvalid      = attribute.KeyValue{
 		Key:   attribute.Key("invalid"),
 		Value: attribute.Value{},
@@ -143,6 +146,21 @@ func TestAttrTransforms(t *testing.T) {
 			[]attribute.KeyValue{attrStringSlice},
 			[]*cpb.KeyValue{kvStringSlice},
 		},
+		{
+			"bytes",
+			[]attribute.KeyValue{attrBytes},
+			[]*cpb.KeyValue{kvBytes},
+		},
+		{
+			"slice",
+			[]attribute.KeyValue{attrSlice},
+			[]*cpb.KeyValue{kvSlice},
+		},
+		{
+			"map",
+			[]attribute.KeyValue{attrMap},
+			[]*cpb.KeyValue{kvMap},
+		},
 		{
 			"all",
 			[]attribute.KeyValue{
@@ -156,6 +174,9 @@ func TestAttrTransforms(t *testing.T) {
 				attrFloat64Slice,
 				attrString,
 				attrStringSlice,
+				attrBytes,
+				attrSlice,
+				attrMap,
 				attrInvalid,
 			},
 			[]*cpb.KeyValue{
@@ -169,6 +190,9 @@ func TestAttrTransforms(t *testing.T) {
 				kvFloat64Slice,
 				kvString,
 				kvStringSlice,
+				kvBytes,
+				kvSlice,
+				kvMap,
 				kvInvalid,
 			},
 		},
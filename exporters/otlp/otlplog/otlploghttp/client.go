@@ -25,6 +25,7 @@ import (
 	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/retry"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 type client struct {
@@ -275,9 +276,12 @@ type request struct {
 }
 
 // reset reinitializes the request Body and uses ctx for the request.
+//
+// Injection of trace context into the request is suppressed so an
+// instrumented HTTP client does not propagate it to the telemetry backend.
 func (r *request) reset(ctx context.Context) {
 	r.Body = r.bodyReader()
-	r.Request = r.WithContext(ctx)
+	r.Request = r.WithContext(propagation.WithSuppressedInjection(ctx))
 }
 
 // retryableError represents a request failure that can be retried.
@@ -19,6 +19,7 @@ import (
 	api "go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
 )
 
 // ResourceLogs returns an slice of OTLP ResourceLogs generated from records.
@@ -67,6 +68,7 @@ func ResourceLogs(records []log.Record) []*lpb.ResourceLogs {
 			if res.Len() > 0 {
 				rl.Resource = &rpb.Resource{
 					Attributes: AttrIter(res.Iter()),
+					EntityRefs: EntityRefs(&res),
 				}
 			}
 			rl.SchemaUrl = res.SchemaURL()
@@ -139,6 +141,31 @@ func AttrIter(iter attribute.Iterator) []*cpb.KeyValue {
 	return out
 }
 
+// EntityRefs transforms the entities of res into OTLP entity references.
+func EntityRefs(res *resource.Resource) []*cpb.EntityRef {
+	entities := res.Entities()
+	if len(entities) == 0 {
+		return nil
+	}
+
+	out := make([]*cpb.EntityRef, 0, len(entities))
+	for _, e := range entities {
+		ref := &cpb.EntityRef{
+			SchemaUrl: e.SchemaURL,
+			Type:      e.Type,
+			IdKeys:    make([]string, 0, len(e.ID)),
+		}
+		for _, kv := range e.ID {
+			ref.IdKeys = append(ref.IdKeys, string(kv.Key))
+		}
+		for _, kv := range e.Description {
+			ref.DescriptionKeys = append(ref.DescriptionKeys, string(kv.Key))
+		}
+		out = append(out, ref)
+	}
+	return out
+}
+
 // Attrs transforms a slice of [attribute.KeyValue] into OTLP key-values.
 func Attrs(attrs []attribute.KeyValue) []*cpb.KeyValue {
 	if len(attrs) == 0 {
@@ -201,6 +228,32 @@ func AttrValue(v attribute.Value) *cpb.AnyValue {
 				Values: stringSliceValues(v.AsStringSlice()),
 			},
 		}
+	case attribute.BYTES:
+		av.Value = &cpb.AnyValue_BytesValue{
+			BytesValue: v.AsBytes(),
+		}
+	case attribute.SLICE:
+		vals := v.AsSlice()
+		values := make([]*cpb.AnyValue, len(vals))
+		for i, val := range vals {
+			values[i] = AttrValue(val)
+		}
+		av.Value = &cpb.AnyValue_ArrayValue{
+			ArrayValue: &cpb.ArrayValue{
+				Values: values,
+			},
+		}
+	case attribute.MAP:
+		kvs := v.AsMap()
+		values := make([]*cpb.KeyValue, len(kvs))
+		for i, kv := range kvs {
+			values[i] = Attr(kv)
+		}
+		av.Value = &cpb.AnyValue_KvlistValue{
+			KvlistValue: &cpb.KeyValueList{
+				Values: values,
+			},
+		}
 	default:
 		av.Value = &cpb.AnyValue_StringValue{
 			StringValue: "INVALID",
@@ -315,6 +368,16 @@ func LogAttrValue(v api.Value) *cpb.AnyValue {
 		av.Value = &cpb.AnyValue_BytesValue{
 			BytesValue: v.AsBytes(),
 		}
+	case api.KindTime:
+		// OTLP has no timestamp value, use the RFC 3339 representation.
+		av.Value = &cpb.AnyValue_StringValue{
+			StringValue: v.AsTime().Format(time.RFC3339Nano),
+		}
+	case api.KindDuration:
+		// OTLP has no duration value, use the number of nanoseconds.
+		av.Value = &cpb.AnyValue_IntValue{
+			IntValue: v.AsDuration().Nanoseconds(),
+		}
 	case api.KindSlice:
 		av.Value = &cpb.AnyValue_ArrayValue{
 			ArrayValue: &cpb.ArrayValue{
@@ -8,6 +8,7 @@ package transform
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -16,15 +17,17 @@ import (
 )
 
 var (
-	logAttrBool    = log.Bool("bool", true)
-	logAttrInt     = log.Int("int", 1)
-	logAttrInt64   = log.Int64("int64", 1)
-	logAttrFloat64 = log.Float64("float64", 1)
-	logAttrString  = log.String("string", "o")
-	logAttrBytes   = log.Bytes("bytes", []byte("test"))
-	logAttrSlice   = log.Slice("slice", log.BoolValue(true))
-	logAttrMap     = log.Map("map", logAttrString)
-	logAttrEmpty   = log.Empty("")
+	logAttrBool     = log.Bool("bool", true)
+	logAttrInt      = log.Int("int", 1)
+	logAttrInt64    = log.Int64("int64", 1)
+	logAttrFloat64  = log.Float64("float64", 1)
+	logAttrString   = log.String("string", "o")
+	logAttrBytes    = log.Bytes("bytes", []byte("test"))
+	logAttrTime     = log.Time("time", time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC))
+	logAttrDuration = log.Duration("duration", time.Millisecond)
+	logAttrSlice    = log.Slice("slice", log.BoolValue(true))
+	logAttrMap      = log.Map("map", logAttrString)
+	logAttrEmpty    = log.Empty("")
 
 	kvBytes = &cpb.KeyValue{
 		Key: "bytes",
@@ -34,6 +37,22 @@ var (
 			},
 		},
 	}
+	kvTime = &cpb.KeyValue{
+		Key: "time",
+		Value: &cpb.AnyValue{
+			Value: &cpb.AnyValue_StringValue{
+				StringValue: "2024-01-02T03:04:05.000000006Z",
+			},
+		},
+	}
+	kvDuration = &cpb.KeyValue{
+		Key: "duration",
+		Value: &cpb.AnyValue{
+			Value: &cpb.AnyValue_IntValue{
+				IntValue: 1e6,
+			},
+		},
+	}
 	kvSlice = &cpb.KeyValue{
 		Key: "slice",
 		Value: &cpb.AnyValue{
@@ -106,6 +125,16 @@ func TestLogAttrs(t *testing.T) {
 			[]log.KeyValue{logAttrBytes},
 			[]*cpb.KeyValue{kvBytes},
 		},
+		{
+			"time",
+			[]log.KeyValue{logAttrTime},
+			[]*cpb.KeyValue{kvTime},
+		},
+		{
+			"duration",
+			[]log.KeyValue{logAttrDuration},
+			[]*cpb.KeyValue{kvDuration},
+		},
 		{
 			"slice",
 			[]log.KeyValue{logAttrSlice},
@@ -125,6 +154,8 @@ func TestLogAttrs(t *testing.T) {
 				logAttrFloat64,
 				logAttrString,
 				logAttrBytes,
+				logAttrTime,
+				logAttrDuration,
 				logAttrSlice,
 				logAttrMap,
 				logAttrEmpty,
@@ -136,6 +167,8 @@ func TestLogAttrs(t *testing.T) {
 				kvFloat64,
 				kvString,
 				kvBytes,
+				kvTime,
+				kvDuration,
 				kvSlice,
 				kvMap,
 				kvEmpty,
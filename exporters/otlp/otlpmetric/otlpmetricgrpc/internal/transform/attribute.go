@@ -87,6 +87,32 @@ func Value(v attribute.Value) *cpb.AnyValue {
 				Values: stringSliceValues(v.AsStringSlice()),
 			},
 		}
+	case attribute.BYTES:
+		av.Value = &cpb.AnyValue_BytesValue{
+			BytesValue: v.AsBytes(),
+		}
+	case attribute.SLICE:
+		vals := v.AsSlice()
+		values := make([]*cpb.AnyValue, len(vals))
+		for i, val := range vals {
+			values[i] = Value(val)
+		}
+		av.Value = &cpb.AnyValue_ArrayValue{
+			ArrayValue: &cpb.ArrayValue{
+				Values: values,
+			},
+		}
+	case attribute.MAP:
+		kvs := v.AsMap()
+		values := make([]*cpb.KeyValue, len(kvs))
+		for i, kv := range kvs {
+			values[i] = KeyValue(kv)
+		}
+		av.Value = &cpb.AnyValue_KvlistValue{
+			KvlistValue: &cpb.KeyValueList{
+				Values: values,
+			},
+		}
 	default:
 		av.Value = &cpb.AnyValue_StringValue{
 			StringValue: "INVALID",
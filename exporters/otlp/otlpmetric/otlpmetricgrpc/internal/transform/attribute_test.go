@@ -26,6 +26,9 @@ var (
 	attrFloat64Slice = attribute.Float64Slice("float64 slice", []float64{-1, 1})
 	attrString       = attribute.String("string", "o")
 	attrStringSlice  = attribute.StringSlice("string slice", []string{"o", "n"})
+	attrBytes        = attribute.Bytes("bytes", []byte("o"))
+	attrSlice        = attribute.Slice("slice", attribute.StringValue("o"), attribute.Int64Value(1))
+	attrMap          = attribute.Map("map", attribute.String("string", "o"), attribute.Int("int", 1))
 	attrInvalid      = attribute.KeyValue{
 		Key:   attribute.Key("invalid"),
 		Value: attribute.Value{},
@@ -59,6 +62,17 @@ var (
 			Values: []*cpb.AnyValue{valStrO, valStrN},
 		},
 	}}
+	valBytes = &cpb.AnyValue{Value: &cpb.AnyValue_BytesValue{BytesValue: []byte("o")}}
+	valSlice = &cpb.AnyValue{Value: &cpb.AnyValue_ArrayValue{
+		ArrayValue: &cpb.ArrayValue{
+			Values: []*cpb.AnyValue{valStrO, valIntOne},
+		},
+	}}
+	valMap = &cpb.AnyValue{Value: &cpb.AnyValue_KvlistValue{
+		KvlistValue: &cpb.KeyValueList{
+			Values: []*cpb.KeyValue{kvInt, kvString},
+		},
+	}}
 
 	kvBool         = &cpb.KeyValue{Key: "bool", Value: valBoolTrue}
 	kvBoolSlice    = &cpb.KeyValue{Key: "bool slice", Value: valBoolSlice}
@@ -70,6 +84,9 @@ var (
 	kvFloat64Slice = &cpb.KeyValue{Key: "float64 slice", Value: valDblSlice}
 	kvString       = &cpb.KeyValue{Key: "string", Value: valStrO}
 	kvStringSlice  = &cpb.KeyValue{Key: "string slice", Value: valStrSlice}
+	kvBytes        = &cpb.KeyValue{Key: "bytes", Value: valBytes}
+	kvSlice        = &cpb.KeyValue{Key: "slice", Value: valSlice}
+	kvMap          = &cpb.KeyValue{Key: "map", Value: valMap}
 	kvInvalid      = &cpb.KeyValue{
 		Key: "invalid",
 		Value: &cpb.AnyValue{
@@ -143,6 +160,21 @@ func TestAttributeTransforms(t *testing.T) {
 			[]attribute.KeyValue{attrStringSlice},
 			[]*cpb.KeyValue{kvStringSlice},
 		},
+		{
+			"bytes",
+			[]attribute.KeyValue{attrBytes},
+			[]*cpb.KeyValue{kvBytes},
+		},
+		{
+			"slice",
+			[]attribute.KeyValue{attrSlice},
+			[]*cpb.KeyValue{kvSlice},
+		},
+		{
+			"map",
+			[]attribute.KeyValue{attrMap},
+			[]*cpb.KeyValue{kvMap},
+		},
 		{
 			"all",
 			[]attribute.KeyValue{
@@ -156,6 +188,9 @@ func TestAttributeTransforms(t *testing.T) {
 				attrFloat64Slice,
 				attrString,
 				attrStringSlice,
+				attrBytes,
+				attrSlice,
+				attrMap,
 				attrInvalid,
 			},
 			[]*cpb.KeyValue{
@@ -169,6 +204,9 @@ func TestAttributeTransforms(t *testing.T) {
 				kvFloat64Slice,
 				kvString,
 				kvStringSlice,
+				kvBytes,
+				kvSlice,
+				kvMap,
 				kvInvalid,
 			},
 		},
@@ -24,6 +24,7 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/oconf"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/retry"
+	"go.opentelemetry.io/otel/propagation"
 	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
 	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
 )
@@ -278,9 +279,12 @@ type request struct {
 }
 
 // reset reinitializes the request Body and uses ctx for the request.
+//
+// Injection of trace context into the request is suppressed so an
+// instrumented HTTP client does not propagate it to the telemetry backend.
 func (r *request) reset(ctx context.Context) {
 	r.Body = r.bodyReader()
-	r.Request = r.WithContext(ctx)
+	r.Request = r.WithContext(propagation.WithSuppressedInjection(ctx))
 }
 
 // retryableError represents a request failure that can be retried.
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
 	cpb "go.opentelemetry.io/proto/otlp/common/v1"
 	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
 	rpb "go.opentelemetry.io/proto/otlp/resource/v1"
@@ -26,12 +27,38 @@ func ResourceMetrics(rm *metricdata.ResourceMetrics) (*mpb.ResourceMetrics, erro
 	return &mpb.ResourceMetrics{
 		Resource: &rpb.Resource{
 			Attributes: AttrIter(rm.Resource.Iter()),
+			EntityRefs: EntityRefs(rm.Resource),
 		},
 		ScopeMetrics: sms,
 		SchemaUrl:    rm.Resource.SchemaURL(),
 	}, err
 }
 
+// EntityRefs transforms the entities of res into OTLP entity references.
+func EntityRefs(res *resource.Resource) []*cpb.EntityRef {
+	entities := res.Entities()
+	if len(entities) == 0 {
+		return nil
+	}
+
+	out := make([]*cpb.EntityRef, 0, len(entities))
+	for _, e := range entities {
+		ref := &cpb.EntityRef{
+			SchemaUrl: e.SchemaURL,
+			Type:      e.Type,
+			IdKeys:    make([]string, 0, len(e.ID)),
+		}
+		for _, kv := range e.ID {
+			ref.IdKeys = append(ref.IdKeys, string(kv.Key))
+		}
+		for _, kv := range e.Description {
+			ref.DescriptionKeys = append(ref.DescriptionKeys, string(kv.Key))
+		}
+		out = append(out, ref)
+	}
+	return out
+}
+
 // ScopeMetrics returns a slice of OTLP ScopeMetrics generated from sms. If
 // sms contains invalid metric values, an error will be returned along with a
 // slice that contains partial OTLP ScopeMetrics.
@@ -92,6 +92,32 @@ func Value(v attribute.Value) *commonpb.AnyValue {
 				Values: stringSliceValues(v.AsStringSlice()),
 			},
 		}
+	case attribute.BYTES:
+		av.Value = &commonpb.AnyValue_BytesValue{
+			BytesValue: v.AsBytes(),
+		}
+	case attribute.SLICE:
+		vals := v.AsSlice()
+		values := make([]*commonpb.AnyValue, len(vals))
+		for i, val := range vals {
+			values[i] = Value(val)
+		}
+		av.Value = &commonpb.AnyValue_ArrayValue{
+			ArrayValue: &commonpb.ArrayValue{
+				Values: values,
+			},
+		}
+	case attribute.MAP:
+		kvs := v.AsMap()
+		values := make([]*commonpb.KeyValue, len(kvs))
+		for i, kv := range kvs {
+			values[i] = KeyValue(kv)
+		}
+		av.Value = &commonpb.AnyValue_KvlistValue{
+			KvlistValue: &commonpb.KeyValueList{
+				Values: values,
+			},
+		}
 	default:
 		av.Value = &commonpb.AnyValue_StringValue{
 			StringValue: "INVALID",
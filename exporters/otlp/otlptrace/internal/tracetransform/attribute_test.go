@@ -160,6 +160,43 @@ func TestArrayAttributes(t *testing.T) {
 	}
 }
 
+func TestStructuredAttributes(t *testing.T) {
+	strVal := &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: "one"}}
+	intVal := &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: 2}}
+
+	attrs := []attribute.KeyValue{
+		attribute.Bytes("bytes", []byte("bytes")),
+		attribute.Slice("slice", attribute.StringValue("one"), attribute.Int64Value(2)),
+		attribute.Map("map",
+			attribute.Int("two", 2),
+			attribute.Slice("one", attribute.StringValue("one")),
+		),
+	}
+	want := []*commonpb.KeyValue{
+		{
+			Key: "bytes",
+			Value: &commonpb.AnyValue{
+				Value: &commonpb.AnyValue_BytesValue{BytesValue: []byte("bytes")},
+			},
+		},
+		newOTelArray("slice", []*commonpb.AnyValue{strVal, intVal}),
+		{
+			Key: "map",
+			Value: &commonpb.AnyValue{
+				Value: &commonpb.AnyValue_KvlistValue{
+					KvlistValue: &commonpb.KeyValueList{
+						Values: []*commonpb.KeyValue{
+							newOTelArray("one", []*commonpb.AnyValue{strVal}),
+							{Key: "two", Value: intVal},
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, want, KeyValues(attrs))
+}
+
 func assertExpectedArrayValues(t *testing.T, expectedValues, actualValues []*commonpb.AnyValue) {
 	for i, actual := range actualValues {
 		expected := expectedValues[i]
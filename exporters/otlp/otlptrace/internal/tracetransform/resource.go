@@ -5,6 +5,7 @@ package tracetransform // import "go.opentelemetry.io/otel/exporters/otlp/otlptr
 
 import (
 	"go.opentelemetry.io/otel/sdk/resource"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
 	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
 )
 
@@ -13,5 +14,33 @@ func Resource(r *resource.Resource) *resourcepb.Resource {
 	if r == nil {
 		return nil
 	}
-	return &resourcepb.Resource{Attributes: ResourceAttributes(r)}
+	return &resourcepb.Resource{
+		Attributes: ResourceAttributes(r),
+		EntityRefs: EntityRefs(r),
+	}
+}
+
+// EntityRefs transforms the entities of res into OTLP entity references.
+func EntityRefs(res *resource.Resource) []*commonpb.EntityRef {
+	entities := res.Entities()
+	if len(entities) == 0 {
+		return nil
+	}
+
+	out := make([]*commonpb.EntityRef, 0, len(entities))
+	for _, e := range entities {
+		ref := &commonpb.EntityRef{
+			SchemaUrl: e.SchemaURL,
+			Type:      e.Type,
+			IdKeys:    make([]string, 0, len(e.ID)),
+		}
+		for _, kv := range e.ID {
+			ref.IdKeys = append(ref.IdKeys, string(kv.Key))
+		}
+		for _, kv := range e.Description {
+			ref.DescriptionKeys = append(ref.DescriptionKeys, string(kv.Key))
+		}
+		out = append(out, ref)
+	}
+	return out
 }
@@ -35,3 +35,25 @@ func TestResourceAttributes(t *testing.T) {
 	}
 	assert.ElementsMatch(t, KeyValues(attrs), got)
 }
+
+func TestResourceEntityRefs(t *testing.T) {
+	res := resource.NewWithEntities("https://opentelemetry.io/schemas/1.26.0", resource.Entity{
+		Type:        "service",
+		SchemaURL:   "https://opentelemetry.io/schemas/1.26.0",
+		ID:          []attribute.KeyValue{attribute.String("service.name", "checkout")},
+		Description: []attribute.KeyValue{attribute.String("service.version", "1.0.0")},
+	})
+
+	got := Resource(res).GetEntityRefs()
+	if !assert.Len(t, got, 1) {
+		return
+	}
+	assert.Equal(t, "service", got[0].GetType())
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.26.0", got[0].GetSchemaUrl())
+	assert.Equal(t, []string{"service.name"}, got[0].GetIdKeys())
+	assert.Equal(t, []string{"service.version"}, got[0].GetDescriptionKeys())
+}
+
+func TestResourceWithoutEntityRefs(t *testing.T) {
+	assert.Nil(t, Resource(resource.NewSchemaless(attribute.Int("one", 1))).GetEntityRefs())
+}
@@ -4,6 +4,7 @@
 package tracetransform
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -157,6 +158,40 @@ func TestLinks(t *testing.T) {
 	assert.Equal(t, l[1].DroppedAttributeCount, int(got[1].DroppedAttributesCount))
 }
 
+func TestLinkAddedAfterStart(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	tp := tracesdk.NewTracerProvider(tracesdk.WithSpanProcessor(sr))
+
+	ts, err := trace.ParseTraceState("vendor=value")
+	require.NoError(t, err)
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+		TraceState: ts,
+		Remote:     true,
+	})
+
+	_, span := tp.Tracer("test").Start(context.Background(), "receive")
+	span.AddLink(trace.Link{SpanContext: sc, Attributes: []attribute.KeyValue{attribute.Int("index", 0)}})
+	span.End()
+
+	got := Spans(sr.Ended())
+	require.Len(t, got, 1)
+	require.Len(t, got[0].ScopeSpans, 1)
+	require.Len(t, got[0].ScopeSpans[0].Spans, 1)
+
+	tid, sid := sc.TraceID(), sc.SpanID()
+	want := []*tracepb.Span_Link{{
+		TraceId:    tid[:],
+		SpanId:     sid[:],
+		TraceState: "vendor=value",
+		Attributes: KeyValues([]attribute.KeyValue{attribute.Int("index", 0)}),
+		Flags:      0x300,
+	}}
+	assert.Equal(t, want, got[0].ScopeSpans[0].Spans[0].Links)
+}
+
 func TestStatus(t *testing.T) {
 	for _, test := range []struct {
 		code       codes.Code
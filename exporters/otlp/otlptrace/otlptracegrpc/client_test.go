@@ -29,7 +29,6 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/otlptracetest"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
-	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
 	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
 )
 
@@ -101,7 +100,7 @@ func TestWithEndpointURL(t *testing.T) {
 
 	ctx := context.Background()
 	exp := newGRPCExporter(t, ctx, "", []otlptracegrpc.Option{
-		otlptracegrpc.WithEndpointURL("http://" + mc.endpoint),
+		otlptracegrpc.WithEndpointURL("http://" + mc.Endpoint()),
 	}...)
 	t.Cleanup(func() {
 		ctx, cancel := contextWithTimeout(ctx, t, 10*time.Second)
@@ -114,6 +113,15 @@ func TestWithEndpointURL(t *testing.T) {
 	otlptracetest.RunEndToEndTest(ctx, t, exp, mc)
 }
 
+// runMockCollector returns a running collector listening on the localhost
+// interface.
+func runMockCollector(t *testing.T) *otlptracetest.GRPCCollector {
+	t.Helper()
+	mc, err := otlptracetest.NewGRPCCollector("")
+	require.NoError(t, err, "otlptracetest.NewGRPCCollector")
+	return mc
+}
+
 func newGRPCExporter(
 	t *testing.T,
 	ctx context.Context,
@@ -139,7 +147,7 @@ func newExporterEndToEndTest(t *testing.T, additionalOpts []otlptracegrpc.Option
 	mc := runMockCollector(t)
 
 	ctx := context.Background()
-	exp := newGRPCExporter(t, ctx, mc.endpoint, additionalOpts...)
+	exp := newGRPCExporter(t, ctx, mc.Endpoint(), additionalOpts...)
 	t.Cleanup(func() {
 		ctx, cancel := contextWithTimeout(ctx, t, 10*time.Second)
 		defer cancel()
@@ -152,12 +160,12 @@ func newExporterEndToEndTest(t *testing.T, additionalOpts []otlptracegrpc.Option
 }
 
 func TestExporterShutdown(t *testing.T) {
-	mc := runMockCollectorAtEndpoint(t, "localhost:0")
-	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+	mc := runMockCollector(t)
+	t.Cleanup(func() { require.NoError(t, mc.Stop()) })
 
 	factory := func() otlptrace.Client {
 		return otlptracegrpc.NewClient(
-			otlptracegrpc.WithEndpoint(mc.endpoint),
+			otlptracegrpc.WithEndpoint(mc.Endpoint()),
 			otlptracegrpc.WithInsecure(),
 		)
 	}
@@ -166,10 +174,10 @@ func TestExporterShutdown(t *testing.T) {
 
 func TestNewInvokeStartThenStopManyTimes(t *testing.T) {
 	mc := runMockCollector(t)
-	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+	t.Cleanup(func() { require.NoError(t, mc.Stop()) })
 
 	ctx := context.Background()
-	exp := newGRPCExporter(t, ctx, mc.endpoint)
+	exp := newGRPCExporter(t, ctx, mc.Endpoint())
 	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
 
 	// Invoke Start numerous times, should return errAlreadyStarted
@@ -214,26 +222,26 @@ func TestNewCollectorOnBadConnection(t *testing.T) {
 
 func TestNewWithEndpoint(t *testing.T) {
 	mc := runMockCollector(t)
-	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+	t.Cleanup(func() { require.NoError(t, mc.Stop()) })
 
 	ctx := context.Background()
-	exp := newGRPCExporter(t, ctx, mc.endpoint)
+	exp := newGRPCExporter(t, ctx, mc.Endpoint())
 	require.NoError(t, exp.Shutdown(ctx))
 }
 
 func TestNewWithHeaders(t *testing.T) {
 	mc := runMockCollector(t)
-	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+	t.Cleanup(func() { require.NoError(t, mc.Stop()) })
 
 	ctx := context.Background()
 	additionalKey := "additional-custom-header"
 	ctx = metadata.AppendToOutgoingContext(ctx, additionalKey, "additional-value")
-	exp := newGRPCExporter(t, ctx, mc.endpoint,
+	exp := newGRPCExporter(t, ctx, mc.Endpoint(),
 		otlptracegrpc.WithHeaders(map[string]string{"header1": "value1"}))
 	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
 	require.NoError(t, exp.ExportSpans(ctx, roSpans))
 
-	headers := mc.getHeaders()
+	headers := mc.Headers()
 	require.Regexp(t, "OTel OTLP Exporter Go/1\\..*", headers.Get("user-agent"))
 	require.Len(t, headers.Get("header1"), 1)
 	require.Len(t, headers.Get(additionalKey), 1)
@@ -246,13 +254,13 @@ func TestExportSpansTimeoutHonored(t *testing.T) {
 
 	mc := runMockCollector(t)
 	exportBlock := make(chan struct{})
-	mc.traceSvc.exportBlock = exportBlock
-	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+	mc.Respond(otlptracetest.Result{Block: exportBlock})
+	t.Cleanup(func() { require.NoError(t, mc.Stop()) })
 
 	exp := newGRPCExporter(
 		t,
 		ctx,
-		mc.endpoint,
+		mc.Endpoint(),
 		otlptracegrpc.WithTimeout(1*time.Nanosecond),
 		otlptracegrpc.WithRetry(otlptracegrpc.RetryConfig{Enabled: false}),
 	)
@@ -273,7 +281,7 @@ func TestNewWithMultipleAttributeTypes(t *testing.T) {
 	ctx, cancel := contextWithTimeout(context.Background(), t, 10*time.Second)
 	t.Cleanup(cancel)
 
-	exp := newGRPCExporter(t, ctx, mc.endpoint)
+	exp := newGRPCExporter(t, ctx, mc.Endpoint())
 	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
 
 	tp := sdktrace.NewTracerProvider(
@@ -314,10 +322,10 @@ func TestNewWithMultipleAttributeTypes(t *testing.T) {
 
 	// Shutdown the collector too so that we can begin
 	// verification checks of expected data back.
-	require.NoError(t, mc.stop())
+	require.NoError(t, mc.Stop())
 
 	// Now verify that we only got one span
-	rss := mc.getSpans()
+	rss := mc.GetSpans()
 	if got, want := len(rss), 1; got != want {
 		t.Fatalf("resource span count: got %d, want %d\n", got, want)
 	}
@@ -387,30 +395,26 @@ func TestNewWithMultipleAttributeTypes(t *testing.T) {
 
 func TestEmptyData(t *testing.T) {
 	mc := runMockCollector(t)
-	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+	t.Cleanup(func() { require.NoError(t, mc.Stop()) })
 
 	ctx := context.Background()
-	exp := newGRPCExporter(t, ctx, mc.endpoint)
+	exp := newGRPCExporter(t, ctx, mc.Endpoint())
 	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
 
 	assert.NoError(t, exp.ExportSpans(ctx, nil))
 }
 
 func TestPartialSuccess(t *testing.T) {
-	mc := runMockCollectorWithConfig(t, &mockConfig{
-		partial: &coltracepb.ExportTracePartialSuccess{
-			RejectedSpans: 2,
-			ErrorMessage:  "partially successful",
-		},
-	})
-	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+	mc := runMockCollector(t)
+	mc.Respond(otlptracetest.PartialSuccess(2, "partially successful"))
+	t.Cleanup(func() { require.NoError(t, mc.Stop()) })
 
 	errs := []error{}
 	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
 		errs = append(errs, err)
 	}))
 	ctx := context.Background()
-	exp := newGRPCExporter(t, ctx, mc.endpoint)
+	exp := newGRPCExporter(t, ctx, mc.Endpoint())
 	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
 	require.NoError(t, exp.ExportSpans(ctx, roSpans))
 
@@ -422,14 +426,14 @@ func TestPartialSuccess(t *testing.T) {
 func TestCustomUserAgent(t *testing.T) {
 	customUserAgent := "custom-user-agent"
 	mc := runMockCollector(t)
-	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+	t.Cleanup(func() { require.NoError(t, mc.Stop()) })
 
 	ctx := context.Background()
-	exp := newGRPCExporter(t, ctx, mc.endpoint,
+	exp := newGRPCExporter(t, ctx, mc.Endpoint(),
 		otlptracegrpc.WithDialOption(grpc.WithUserAgent(customUserAgent)))
 	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
 	require.NoError(t, exp.ExportSpans(ctx, roSpans))
 
-	headers := mc.getHeaders()
+	headers := mc.Headers()
 	require.Contains(t, headers.Get("user-agent")[0], customUserAgent)
 }
@@ -25,6 +25,7 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/otlpconfig"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/retry"
+	"go.opentelemetry.io/otel/propagation"
 	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
 	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
 )
@@ -302,9 +303,12 @@ type request struct {
 }
 
 // reset reinitializes the request Body and uses ctx for the request.
+//
+// Injection of trace context into the request is suppressed so an
+// instrumented HTTP client does not propagate it to the telemetry backend.
 func (r *request) reset(ctx context.Context) {
 	r.Body = r.bodyReader()
-	r.Request = r.WithContext(ctx)
+	r.Request = r.WithContext(propagation.WithSuppressedInjection(ctx))
 }
 
 // retryableError represents a request failure that can be retried.
@@ -5,6 +5,8 @@ package otlptracehttp_test
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net/http"
@@ -43,11 +45,46 @@ var (
 	}
 )
 
+// runMockCollector returns a running collector listening at the endpoint URL
+// and responding with results to the first requests. The collector is stopped
+// when t is done.
+func runMockCollector(t *testing.T, endpoint string, results ...otlptracetest.Result) *otlptracetest.HTTPCollector {
+	t.Helper()
+	mc, err := otlptracetest.NewHTTPCollector(endpoint)
+	require.NoError(t, err, "otlptracetest.NewHTTPCollector")
+	t.Cleanup(func() { assert.NoError(t, mc.Stop()) })
+	mc.Respond(results...)
+	return mc
+}
+
+// runTLSMockCollector returns a running collector serving TLS with a weak
+// certificate and responding with results to the first requests, and the
+// client TLS configuration trusting that certificate. The collector is
+// stopped when t is done.
+func runTLSMockCollector(t *testing.T, results ...otlptracetest.Result) (*otlptracetest.HTTPCollector, *tls.Config) {
+	t.Helper()
+	pemCert, err := generateWeakCertificate()
+	require.NoError(t, err, "generateWeakCertificate")
+	cert, err := tls.X509KeyPair(pemCert.Certificate, pemCert.PrivateKey)
+	require.NoError(t, err, "tls.X509KeyPair")
+
+	mc, err := otlptracetest.NewTLSHTTPCollector("", cert)
+	require.NoError(t, err, "otlptracetest.NewTLSHTTPCollector")
+	t.Cleanup(func() { assert.NoError(t, mc.Stop()) })
+	mc.Respond(results...)
+
+	pool := x509.NewCertPool()
+	require.True(t, pool.AppendCertsFromPEM(pemCert.Certificate), "AppendCertsFromPEM")
+	return mc, &tls.Config{RootCAs: pool}
+}
+
 func TestEndToEnd(t *testing.T) {
 	tests := []struct {
 		name            string
 		opts            []otlptracehttp.Option
-		mcCfg           mockCollectorConfig
+		collectorURL    string
+		results         []otlptracetest.Result
+		headers         map[string]string
 		tls             bool
 		withURLEndpoint bool
 	}{
@@ -76,8 +113,9 @@ func TestEndToEnd(t *testing.T) {
 					MaxElapsedTime: 0,
 				}),
 			},
-			mcCfg: mockCollectorConfig{
-				InjectHTTPStatus: []int{503, 429},
+			results: []otlptracetest.Result{
+				otlptracetest.Error(http.StatusServiceUnavailable),
+				otlptracetest.Error(http.StatusTooManyRequests),
 			},
 		},
 		{
@@ -92,8 +130,9 @@ func TestEndToEnd(t *testing.T) {
 					MaxElapsedTime: 0,
 				}),
 			},
-			mcCfg: mockCollectorConfig{
-				InjectHTTPStatus: []int{503, 502},
+			results: []otlptracetest.Result{
+				otlptracetest.Error(http.StatusServiceUnavailable),
+				otlptracetest.Error(http.StatusBadGateway),
 			},
 		},
 		{
@@ -107,12 +146,10 @@ func TestEndToEnd(t *testing.T) {
 					MaxElapsedTime: 0,
 				}),
 			},
-			mcCfg: mockCollectorConfig{
-				InjectHTTPStatus: []int{504},
-				InjectResponseHeader: []map[string]string{
-					{"Retry-After": "10"},
-				},
-			},
+			results: []otlptracetest.Result{{
+				StatusCode: http.StatusGatewayTimeout,
+				Header:     http.Header{"Retry-After": {"10"}},
+			}},
 		},
 		{
 			name: "with empty paths (forced to defaults)",
@@ -125,35 +162,26 @@ func TestEndToEnd(t *testing.T) {
 			opts: []otlptracehttp.Option{
 				otlptracehttp.WithURLPath(relOtherTracesPath),
 			},
-			mcCfg: mockCollectorConfig{
-				TracesURLPath: otherTracesPath,
-			},
+			collectorURL: "http://localhost:0" + otherTracesPath,
 		},
 		{
 			name: "with TLS",
 			opts: nil,
-			mcCfg: mockCollectorConfig{
-				WithTLS: true,
-			},
-			tls: true,
+			tls:  true,
 		},
 		{
 			name: "with extra headers",
 			opts: []otlptracehttp.Option{
 				otlptracehttp.WithHeaders(testHeaders),
 			},
-			mcCfg: mockCollectorConfig{
-				ExpectedHeaders: testHeaders,
-			},
+			headers: testHeaders,
 		},
 		{
 			name: "with custom user agent",
 			opts: []otlptracehttp.Option{
 				otlptracehttp.WithHeaders(customUserAgentHeader),
 			},
-			mcCfg: mockCollectorConfig{
-				ExpectedHeaders: customUserAgentHeader,
-			},
+			headers: customUserAgentHeader,
 		},
 		{
 			name: "with custom proxy",
@@ -165,16 +193,21 @@ func TestEndToEnd(t *testing.T) {
 					return r.URL, nil
 				}),
 			},
-			mcCfg: mockCollectorConfig{
-				ExpectedHeaders: customProxyHeader,
-			},
+			headers: customProxyHeader,
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			mc := runMockCollector(t, tc.mcCfg)
-			defer mc.MustStop(t)
+			var (
+				mc        *otlptracetest.HTTPCollector
+				tlsConfig *tls.Config
+			)
+			if tc.tls {
+				mc, tlsConfig = runTLSMockCollector(t, tc.results...)
+			} else {
+				mc = runMockCollector(t, tc.collectorURL, tc.results...)
+			}
 			allOpts := []otlptracehttp.Option{}
 
 			if tc.withURLEndpoint {
@@ -183,8 +216,6 @@ func TestEndToEnd(t *testing.T) {
 				allOpts = append(allOpts, otlptracehttp.WithEndpoint(mc.Endpoint()))
 			}
 			if tc.tls {
-				tlsConfig := mc.ClientTLSConfig()
-				require.NotNil(t, tlsConfig)
 				allOpts = append(allOpts, otlptracehttp.WithTLSClientConfig(tlsConfig))
 			} else {
 				allOpts = append(allOpts, otlptracehttp.WithInsecure())
@@ -199,31 +230,30 @@ func TestEndToEnd(t *testing.T) {
 				}()
 				otlptracetest.RunEndToEndTest(ctx, t, exporter, mc)
 			}
+			got := mc.Headers()
+			for k, v := range tc.headers {
+				assert.Equal(t, v, got.Get(k), k)
+			}
 		})
 	}
 }
 
 func TestExporterShutdown(t *testing.T) {
-	mc := runMockCollector(t, mockCollectorConfig{})
-	defer func() {
-		_ = mc.Stop()
-	}()
+	mc := runMockCollector(t, "")
 
 	<-time.After(5 * time.Millisecond)
 
 	otlptracetest.RunExporterShutdownTest(t, func() otlptrace.Client {
 		return otlptracehttp.NewClient(
 			otlptracehttp.WithInsecure(),
-			otlptracehttp.WithEndpoint(mc.endpoint),
+			otlptracehttp.WithEndpoint(mc.Endpoint()),
 		)
 	})
 }
 
 func TestTimeout(t *testing.T) {
 	delay := make(chan struct{})
-	mcCfg := mockCollectorConfig{Delay: delay}
-	mc := runMockCollector(t, mcCfg)
-	defer mc.MustStop(t)
+	mc := runMockCollector(t, "", otlptracetest.Result{Block: delay})
 	defer func() { close(delay) }()
 	client := otlptracehttp.NewClient(
 		otlptracehttp.WithEndpoint(mc.Endpoint()),
@@ -242,13 +272,14 @@ func TestTimeout(t *testing.T) {
 }
 
 func TestNoRetry(t *testing.T) {
-	mc := runMockCollector(t, mockCollectorConfig{
-		InjectHTTPStatus: []int{http.StatusBadRequest},
-		Partial: &coltracepb.ExportTracePartialSuccess{
-			ErrorMessage: "missing required attribute aaa",
+	mc := runMockCollector(t, "", otlptracetest.Result{
+		StatusCode: http.StatusBadRequest,
+		Response: &coltracepb.ExportTraceServiceResponse{
+			PartialSuccess: &coltracepb.ExportTracePartialSuccess{
+				ErrorMessage: "missing required attribute aaa",
+			},
 		},
 	})
-	defer mc.MustStop(t)
 	driver := otlptracehttp.NewClient(
 		otlptracehttp.WithEndpoint(mc.Endpoint()),
 		otlptracehttp.WithInsecure(),
@@ -274,7 +305,7 @@ func TestNoRetry(t *testing.T) {
 	assert.Contains(
 		t,
 		unwrapped.Error(),
-		fmt.Sprintf("failed to send to http://%s/v1/traces: 400 Bad Request", mc.endpoint),
+		fmt.Sprintf("failed to send to http://%s/v1/traces: 400 Bad Request", mc.Endpoint()),
 	)
 
 	unwrapped2 := errors.Unwrap(unwrapped)
@@ -284,9 +315,7 @@ func TestNoRetry(t *testing.T) {
 }
 
 func TestEmptyData(t *testing.T) {
-	mcCfg := mockCollectorConfig{}
-	mc := runMockCollector(t, mcCfg)
-	defer mc.MustStop(t)
+	mc := runMockCollector(t, "")
 	driver := otlptracehttp.NewClient(
 		otlptracehttp.WithEndpoint(mc.Endpoint()),
 		otlptracehttp.WithInsecure(),
@@ -304,9 +333,7 @@ func TestEmptyData(t *testing.T) {
 }
 
 func TestCancelledContext(t *testing.T) {
-	mcCfg := mockCollectorConfig{}
-	mc := runMockCollector(t, mcCfg)
-	defer mc.MustStop(t)
+	mc := runMockCollector(t, "")
 	driver := otlptracehttp.NewClient(
 		otlptracehttp.WithEndpoint(mc.Endpoint()),
 		otlptracehttp.WithInsecure(),
@@ -324,15 +351,11 @@ func TestCancelledContext(t *testing.T) {
 }
 
 func TestDeadlineContext(t *testing.T) {
-	statuses := make([]int, 0, 5)
-	for i := 0; i < cap(statuses); i++ {
-		statuses = append(statuses, http.StatusTooManyRequests)
-	}
-	mcCfg := mockCollectorConfig{
-		InjectHTTPStatus: statuses,
+	results := make([]otlptracetest.Result, 0, 5)
+	for i := 0; i < cap(results); i++ {
+		results = append(results, otlptracetest.Error(http.StatusTooManyRequests))
 	}
-	mc := runMockCollector(t, mcCfg)
-	defer mc.MustStop(t)
+	mc := runMockCollector(t, "", results...)
 	driver := otlptracehttp.NewClient(
 		otlptracehttp.WithEndpoint(mc.Endpoint()),
 		otlptracehttp.WithInsecure(),
@@ -358,15 +381,11 @@ func TestDeadlineContext(t *testing.T) {
 }
 
 func TestStopWhileExportingConcurrentSafe(t *testing.T) {
-	statuses := make([]int, 0, 5)
-	for i := 0; i < cap(statuses); i++ {
-		statuses = append(statuses, http.StatusTooManyRequests)
+	results := make([]otlptracetest.Result, 0, 5)
+	for i := 0; i < cap(results); i++ {
+		results = append(results, otlptracetest.Error(http.StatusTooManyRequests))
 	}
-	mcCfg := mockCollectorConfig{
-		InjectHTTPStatus: statuses,
-	}
-	mc := runMockCollector(t, mcCfg)
-	defer mc.MustStop(t)
+	mc := runMockCollector(t, "", results...)
 	driver := otlptracehttp.NewClient(
 		otlptracehttp.WithEndpoint(mc.Endpoint()),
 		otlptracehttp.WithInsecure(),
@@ -398,14 +417,7 @@ func TestStopWhileExportingConcurrentSafe(t *testing.T) {
 }
 
 func TestPartialSuccess(t *testing.T) {
-	mcCfg := mockCollectorConfig{
-		Partial: &coltracepb.ExportTracePartialSuccess{
-			RejectedSpans: 2,
-			ErrorMessage:  "partially successful",
-		},
-	}
-	mc := runMockCollector(t, mcCfg)
-	defer mc.MustStop(t)
+	mc := runMockCollector(t, "", otlptracetest.PartialSuccess(2, "partially successful"))
 	driver := otlptracehttp.NewClient(
 		otlptracehttp.WithEndpoint(mc.Endpoint()),
 		otlptracehttp.WithInsecure(),
@@ -432,11 +444,7 @@ func TestPartialSuccess(t *testing.T) {
 func TestOtherHTTPSuccess(t *testing.T) {
 	for code := 201; code <= 299; code++ {
 		t.Run(fmt.Sprintf("status_%d", code), func(t *testing.T) {
-			mcCfg := mockCollectorConfig{
-				InjectHTTPStatus: []int{code},
-			}
-			mc := runMockCollector(t, mcCfg)
-			defer mc.MustStop(t)
+			mc := runMockCollector(t, "", otlptracetest.Result{StatusCode: code})
 			driver := otlptracehttp.NewClient(
 				otlptracehttp.WithEndpoint(mc.Endpoint()),
 				otlptracehttp.WithInsecure(),
@@ -461,11 +469,9 @@ func TestOtherHTTPSuccess(t *testing.T) {
 }
 
 func TestCollectorRespondingNonProtobufContent(t *testing.T) {
-	mcCfg := mockCollectorConfig{
-		InjectContentType: "application/octet-stream",
-	}
-	mc := runMockCollector(t, mcCfg)
-	defer mc.MustStop(t)
+	mc := runMockCollector(t, "", otlptracetest.Result{
+		Header: http.Header{"Content-Type": {"application/octet-stream"}},
+	})
 	driver := otlptracehttp.NewClient(
 		otlptracehttp.WithEndpoint(mc.Endpoint()),
 		otlptracehttp.WithInsecure(),
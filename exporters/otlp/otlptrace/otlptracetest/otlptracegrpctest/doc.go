@@ -0,0 +1,12 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otlptracegrpctest provides an in-process OTLP gRPC trace
+// collector to test the export of spans without running a collector binary.
+// It records the requests it receives and can simulate errors, throttling,
+// partial success, and slow responses.
+package otlptracegrpctest // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracetest/otlptracegrpctest"
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlptrace/otlptracetest/collector.go.tmpl "--data={\"packageName\": \"otlptracegrpctest\"}" --out=collector.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlptrace/otlptracetest/grpccollector.go.tmpl "--data={\"packageName\": \"otlptracegrpctest\"}" --out=grpccollector.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlptrace/otlptracetest/grpccollector_test.go.tmpl "--data={\"packageName\": \"otlptracegrpctest\", \"packageImportPath\": \"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracetest/otlptracegrpctest\"}" --out=grpccollector_test.go
@@ -0,0 +1,12 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otlptracehttptest provides an in-process OTLP HTTP trace
+// collector to test the export of spans without running a collector binary.
+// It records the requests it receives and can simulate errors, throttling,
+// partial success, and slow responses.
+package otlptracehttptest // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracetest/otlptracehttptest"
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlptrace/otlptracetest/collector.go.tmpl "--data={\"packageName\": \"otlptracehttptest\"}" --out=collector.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlptrace/otlptracetest/httpcollector.go.tmpl "--data={\"packageName\": \"otlptracehttptest\"}" --out=httpcollector.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlptrace/otlptracetest/httpcollector_test.go.tmpl "--data={\"packageName\": \"otlptracehttptest\", \"packageImportPath\": \"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracetest/otlptracehttptest\"}" --out=httpcollector_test.go
@@ -407,6 +407,14 @@ func TestValueMarshalJSON(t *testing.T) {
 			// The base64 encoding of []byte{1, 2, 3} is "AQID".
 			want: `{"Type":"Bytes","Value":"AQID"}`,
 		},
+		{
+			value: log.TimeValue(time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)),
+			want:  `{"Type":"Time","Value":"2024-01-02T03:04:05.000000006Z"}`,
+		},
+		{
+			value: log.DurationValue(time.Millisecond),
+			want:  `{"Type":"Duration","Value":1000000}`,
+		},
 		{
 			value: log.SliceValue(
 				log.Empty("empty").Value,
@@ -42,6 +42,10 @@ func (v value) MarshalJSON() ([]byte, error) {
 		jsonVal.Value = v.AsBool()
 	case log.KindBytes:
 		jsonVal.Value = v.AsBytes()
+	case log.KindTime:
+		jsonVal.Value = v.AsTime()
+	case log.KindDuration:
+		jsonVal.Value = v.AsDuration()
 	case log.KindMap:
 		m := v.AsMap()
 		values := make([]keyValue, 0, len(m))
@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
@@ -426,6 +427,44 @@ func TestWithHeaders(t *testing.T) {
 	assert.Equal(t, headers["name2"], req.Header.Get("name2"))
 }
 
+// injectingTransport injects the trace context of the requests like an
+// instrumented HTTP client.
+type injectingTransport struct {
+	base http.RoundTripper
+}
+
+func (t injectingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	propagation.TraceContext{}.Inject(r.Context(), propagation.HeaderCarrier(r.Header))
+	return t.base.RoundTrip(r)
+}
+
+func TestExportSpansSuppressesInjection(t *testing.T) {
+	var header http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	client := srv.Client()
+	client.Transport = injectingTransport{base: client.Transport}
+	e, err := New(srv.URL, WithClient(client))
+	require.NoError(t, err)
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x01},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+	spans := tracetest.SpanStubs{{SpanContext: sc}}.Snapshots()
+
+	require.NoError(t, e.ExportSpans(ctx, spans))
+	require.NotNil(t, header)
+	assert.Empty(t, header.Get("traceparent"), "trace context injected into the export request")
+}
+
 func TestWithClient(t *testing.T) {
 	customClient := &http.Client{
 		Timeout: 1000,
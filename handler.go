@@ -4,6 +4,8 @@
 package otel // import "go.opentelemetry.io/otel"
 
 import (
+	"context"
+
 	"go.opentelemetry.io/otel/internal/global"
 )
 
@@ -31,3 +33,19 @@ func SetErrorHandler(h ErrorHandler) { global.SetErrorHandler(h) }
 
 // Handle is a convenience function for GetErrorHandler().Handle(err).
 func Handle(err error) { global.GetErrorHandler().Handle(err) }
+
+// HandleDiagnostic sends d to the global ErrorHandler.
+//
+// If the global ErrorHandler is a DiagnosticHandler, d is passed to its
+// HandleDiagnostic method. Otherwise, the error of d is passed to its Handle
+// method, the same way Handle does.
+func HandleDiagnostic(ctx context.Context, d Diagnostic) {
+	h := global.GetErrorHandler()
+	if dh, ok := h.(DiagnosticHandler); ok {
+		dh.HandleDiagnostic(ctx, d)
+		return
+	}
+	if d.Err != nil {
+		h.Handle(d.Err)
+	}
+}
@@ -4,9 +4,12 @@
 package otel
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type testErrHandler struct {
@@ -32,3 +35,32 @@ func TestGlobalErrorHandler(t *testing.T) {
 	GetErrorHandler().Handle(assert.AnError)
 	assert.ErrorIs(t, e2.err, assert.AnError)
 }
+
+func TestHandleDiagnostic(t *testing.T) {
+	d := Diagnostic{
+		Component:  "test",
+		Severity:   SeverityWarning,
+		Err:        assert.AnError,
+		Attributes: []attribute.KeyValue{attribute.String("key", "value")},
+	}
+
+	eh := &testErrHandler{}
+	SetErrorHandler(eh)
+	HandleDiagnostic(context.Background(), d)
+	assert.ErrorIs(t, eh.err, assert.AnError, "ErrorHandler")
+
+	var got []Diagnostic
+	SetErrorHandler(DiagnosticHandlerFunc(func(_ context.Context, d Diagnostic) {
+		got = append(got, d)
+	}))
+	HandleDiagnostic(context.Background(), d)
+	Handle(assert.AnError)
+	want := []Diagnostic{d, {Severity: SeverityError, Err: assert.AnError}}
+	assert.Equal(t, want, got, "DiagnosticHandler")
+}
+
+func TestSeverityString(t *testing.T) {
+	assert.Equal(t, "error", SeverityError.String())
+	assert.Equal(t, "warning", SeverityWarning.String())
+	assert.Equal(t, "Severity(-1)", Severity(-1).String())
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
 	"go.opentelemetry.io/otel/metric/noop"
@@ -106,6 +107,10 @@ func (*altRegistration) Unregister() error {
 	return nil
 }
 
+func (*altRegistration) Replace(metric.Callback) error {
+	return nil
+}
+
 func (am *altMeter) Int64Counter(name string, _ ...metric.Int64CounterOption) (metric.Int64Counter, error) {
 	return noop.NewMeterProvider().Meter("noop").Int64Counter(name)
 }
@@ -216,6 +221,10 @@ func (am *altMeter) RegisterCallback(f metric.Callback, instruments ...metric.Ob
 	return &altRegistration{cb: f}, nil
 }
 
+func (am *altMeter) Registrations() []metric.Registration { return nil }
+
+func (am *altMeter) RecordBatch(context.Context, attribute.Set, ...metric.Measurement) {}
+
 func (ao *altObserver) ObserveFloat64(inst metric.Float64Observable, _ float64, _ ...metric.ObserveOption) {
 	ao.observe(inst)
 }
@@ -9,6 +9,7 @@ import (
 
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
+	"go.opentelemetry.io/otel/metric/noop"
 )
 
 // unwrapper unwraps to return the underlying instrument implementation.
@@ -16,6 +17,20 @@ type unwrapper interface {
 	unwrap() metric.Observable
 }
 
+// measurementUnwrapper unwraps a Measurement made by a synchronous instrument
+// to return one made by the underlying instrument implementation. It returns
+// false if there is no underlying implementation.
+type measurementUnwrapper interface {
+	unwrapMeasurement(metric.Measurement) (metric.Measurement, bool)
+}
+
+// isNoop returns whether delegate, the delegate of an instrument or meter,
+// performs no operations. A nil delegate is not yet set, meaning all
+// operations are dropped.
+func isNoop(delegate any) bool {
+	return delegate == nil || noop.IsNoop(delegate)
+}
+
 type afCounter struct {
 	embedded.Float64ObservableCounter
 	metric.Float64Observable
@@ -40,6 +55,12 @@ func (i *afCounter) setDelegate(m metric.Meter) {
 	i.delegate.Store(ctr)
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true.
+func (i *afCounter) IsNoop() bool {
+	return isNoop(i.delegate.Load())
+}
+
 func (i *afCounter) unwrap() metric.Observable {
 	if ctr := i.delegate.Load(); ctr != nil {
 		return ctr.(metric.Float64ObservableCounter)
@@ -71,6 +92,12 @@ func (i *afUpDownCounter) setDelegate(m metric.Meter) {
 	i.delegate.Store(ctr)
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true.
+func (i *afUpDownCounter) IsNoop() bool {
+	return isNoop(i.delegate.Load())
+}
+
 func (i *afUpDownCounter) unwrap() metric.Observable {
 	if ctr := i.delegate.Load(); ctr != nil {
 		return ctr.(metric.Float64ObservableUpDownCounter)
@@ -102,6 +129,12 @@ func (i *afGauge) setDelegate(m metric.Meter) {
 	i.delegate.Store(ctr)
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true.
+func (i *afGauge) IsNoop() bool {
+	return isNoop(i.delegate.Load())
+}
+
 func (i *afGauge) unwrap() metric.Observable {
 	if ctr := i.delegate.Load(); ctr != nil {
 		return ctr.(metric.Float64ObservableGauge)
@@ -133,6 +166,12 @@ func (i *aiCounter) setDelegate(m metric.Meter) {
 	i.delegate.Store(ctr)
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true.
+func (i *aiCounter) IsNoop() bool {
+	return isNoop(i.delegate.Load())
+}
+
 func (i *aiCounter) unwrap() metric.Observable {
 	if ctr := i.delegate.Load(); ctr != nil {
 		return ctr.(metric.Int64ObservableCounter)
@@ -164,6 +203,12 @@ func (i *aiUpDownCounter) setDelegate(m metric.Meter) {
 	i.delegate.Store(ctr)
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true.
+func (i *aiUpDownCounter) IsNoop() bool {
+	return isNoop(i.delegate.Load())
+}
+
 func (i *aiUpDownCounter) unwrap() metric.Observable {
 	if ctr := i.delegate.Load(); ctr != nil {
 		return ctr.(metric.Int64ObservableUpDownCounter)
@@ -195,6 +240,12 @@ func (i *aiGauge) setDelegate(m metric.Meter) {
 	i.delegate.Store(ctr)
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true.
+func (i *aiGauge) IsNoop() bool {
+	return isNoop(i.delegate.Load())
+}
+
 func (i *aiGauge) unwrap() metric.Observable {
 	if ctr := i.delegate.Load(); ctr != nil {
 		return ctr.(metric.Int64ObservableGauge)
@@ -223,12 +274,36 @@ func (i *sfCounter) setDelegate(m metric.Meter) {
 	i.delegate.Store(ctr)
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true.
+func (i *sfCounter) IsNoop() bool {
+	return isNoop(i.delegate.Load())
+}
+
 func (i *sfCounter) Add(ctx context.Context, incr float64, opts ...metric.AddOption) {
 	if ctr := i.delegate.Load(); ctr != nil {
 		ctr.(metric.Float64Counter).Add(ctx, incr, opts...)
 	}
 }
 
+func (i *sfCounter) Enabled(ctx context.Context) bool {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Float64Counter).Enabled(ctx)
+	}
+	return false
+}
+
+func (i *sfCounter) Measurement(value float64) metric.Measurement {
+	return metric.NewFloat64Measurement(i, value)
+}
+
+func (i *sfCounter) unwrapMeasurement(m metric.Measurement) (metric.Measurement, bool) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Float64Counter).Measurement(m.Float64Value()), true
+	}
+	return m, false
+}
+
 type sfUpDownCounter struct {
 	embedded.Float64UpDownCounter
 
@@ -249,12 +324,36 @@ func (i *sfUpDownCounter) setDelegate(m metric.Meter) {
 	i.delegate.Store(ctr)
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true.
+func (i *sfUpDownCounter) IsNoop() bool {
+	return isNoop(i.delegate.Load())
+}
+
 func (i *sfUpDownCounter) Add(ctx context.Context, incr float64, opts ...metric.AddOption) {
 	if ctr := i.delegate.Load(); ctr != nil {
 		ctr.(metric.Float64UpDownCounter).Add(ctx, incr, opts...)
 	}
 }
 
+func (i *sfUpDownCounter) Enabled(ctx context.Context) bool {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Float64UpDownCounter).Enabled(ctx)
+	}
+	return false
+}
+
+func (i *sfUpDownCounter) Measurement(value float64) metric.Measurement {
+	return metric.NewFloat64Measurement(i, value)
+}
+
+func (i *sfUpDownCounter) unwrapMeasurement(m metric.Measurement) (metric.Measurement, bool) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Float64UpDownCounter).Measurement(m.Float64Value()), true
+	}
+	return m, false
+}
+
 type sfHistogram struct {
 	embedded.Float64Histogram
 
@@ -275,12 +374,46 @@ func (i *sfHistogram) setDelegate(m metric.Meter) {
 	i.delegate.Store(ctr)
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true.
+func (i *sfHistogram) IsNoop() bool {
+	return isNoop(i.delegate.Load())
+}
+
 func (i *sfHistogram) Record(ctx context.Context, x float64, opts ...metric.RecordOption) {
 	if ctr := i.delegate.Load(); ctr != nil {
 		ctr.(metric.Float64Histogram).Record(ctx, x, opts...)
 	}
 }
 
+func (i *sfHistogram) RecordBuckets(
+	ctx context.Context,
+	buckets metric.HistogramBuckets,
+	opts ...metric.RecordOption,
+) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		ctr.(metric.Float64Histogram).RecordBuckets(ctx, buckets, opts...)
+	}
+}
+
+func (i *sfHistogram) Enabled(ctx context.Context) bool {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Float64Histogram).Enabled(ctx)
+	}
+	return false
+}
+
+func (i *sfHistogram) Measurement(value float64) metric.Measurement {
+	return metric.NewFloat64Measurement(i, value)
+}
+
+func (i *sfHistogram) unwrapMeasurement(m metric.Measurement) (metric.Measurement, bool) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Float64Histogram).Measurement(m.Float64Value()), true
+	}
+	return m, false
+}
+
 type sfGauge struct {
 	embedded.Float64Gauge
 
@@ -301,12 +434,36 @@ func (i *sfGauge) setDelegate(m metric.Meter) {
 	i.delegate.Store(ctr)
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true.
+func (i *sfGauge) IsNoop() bool {
+	return isNoop(i.delegate.Load())
+}
+
 func (i *sfGauge) Record(ctx context.Context, x float64, opts ...metric.RecordOption) {
 	if ctr := i.delegate.Load(); ctr != nil {
 		ctr.(metric.Float64Gauge).Record(ctx, x, opts...)
 	}
 }
 
+func (i *sfGauge) Enabled(ctx context.Context) bool {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Float64Gauge).Enabled(ctx)
+	}
+	return false
+}
+
+func (i *sfGauge) Measurement(value float64) metric.Measurement {
+	return metric.NewFloat64Measurement(i, value)
+}
+
+func (i *sfGauge) unwrapMeasurement(m metric.Measurement) (metric.Measurement, bool) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Float64Gauge).Measurement(m.Float64Value()), true
+	}
+	return m, false
+}
+
 type siCounter struct {
 	embedded.Int64Counter
 
@@ -327,12 +484,36 @@ func (i *siCounter) setDelegate(m metric.Meter) {
 	i.delegate.Store(ctr)
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true.
+func (i *siCounter) IsNoop() bool {
+	return isNoop(i.delegate.Load())
+}
+
 func (i *siCounter) Add(ctx context.Context, x int64, opts ...metric.AddOption) {
 	if ctr := i.delegate.Load(); ctr != nil {
 		ctr.(metric.Int64Counter).Add(ctx, x, opts...)
 	}
 }
 
+func (i *siCounter) Enabled(ctx context.Context) bool {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Int64Counter).Enabled(ctx)
+	}
+	return false
+}
+
+func (i *siCounter) Measurement(value int64) metric.Measurement {
+	return metric.NewInt64Measurement(i, value)
+}
+
+func (i *siCounter) unwrapMeasurement(m metric.Measurement) (metric.Measurement, bool) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Int64Counter).Measurement(m.Int64Value()), true
+	}
+	return m, false
+}
+
 type siUpDownCounter struct {
 	embedded.Int64UpDownCounter
 
@@ -353,12 +534,36 @@ func (i *siUpDownCounter) setDelegate(m metric.Meter) {
 	i.delegate.Store(ctr)
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true.
+func (i *siUpDownCounter) IsNoop() bool {
+	return isNoop(i.delegate.Load())
+}
+
 func (i *siUpDownCounter) Add(ctx context.Context, x int64, opts ...metric.AddOption) {
 	if ctr := i.delegate.Load(); ctr != nil {
 		ctr.(metric.Int64UpDownCounter).Add(ctx, x, opts...)
 	}
 }
 
+func (i *siUpDownCounter) Enabled(ctx context.Context) bool {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Int64UpDownCounter).Enabled(ctx)
+	}
+	return false
+}
+
+func (i *siUpDownCounter) Measurement(value int64) metric.Measurement {
+	return metric.NewInt64Measurement(i, value)
+}
+
+func (i *siUpDownCounter) unwrapMeasurement(m metric.Measurement) (metric.Measurement, bool) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Int64UpDownCounter).Measurement(m.Int64Value()), true
+	}
+	return m, false
+}
+
 type siHistogram struct {
 	embedded.Int64Histogram
 
@@ -379,12 +584,46 @@ func (i *siHistogram) setDelegate(m metric.Meter) {
 	i.delegate.Store(ctr)
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true.
+func (i *siHistogram) IsNoop() bool {
+	return isNoop(i.delegate.Load())
+}
+
 func (i *siHistogram) Record(ctx context.Context, x int64, opts ...metric.RecordOption) {
 	if ctr := i.delegate.Load(); ctr != nil {
 		ctr.(metric.Int64Histogram).Record(ctx, x, opts...)
 	}
 }
 
+func (i *siHistogram) RecordBuckets(
+	ctx context.Context,
+	buckets metric.HistogramBuckets,
+	opts ...metric.RecordOption,
+) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		ctr.(metric.Int64Histogram).RecordBuckets(ctx, buckets, opts...)
+	}
+}
+
+func (i *siHistogram) Enabled(ctx context.Context) bool {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Int64Histogram).Enabled(ctx)
+	}
+	return false
+}
+
+func (i *siHistogram) Measurement(value int64) metric.Measurement {
+	return metric.NewInt64Measurement(i, value)
+}
+
+func (i *siHistogram) unwrapMeasurement(m metric.Measurement) (metric.Measurement, bool) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Int64Histogram).Measurement(m.Int64Value()), true
+	}
+	return m, false
+}
+
 type siGauge struct {
 	embedded.Int64Gauge
 
@@ -405,8 +644,32 @@ func (i *siGauge) setDelegate(m metric.Meter) {
 	i.delegate.Store(ctr)
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true.
+func (i *siGauge) IsNoop() bool {
+	return isNoop(i.delegate.Load())
+}
+
 func (i *siGauge) Record(ctx context.Context, x int64, opts ...metric.RecordOption) {
 	if ctr := i.delegate.Load(); ctr != nil {
 		ctr.(metric.Int64Gauge).Record(ctx, x, opts...)
 	}
 }
+
+func (i *siGauge) Enabled(ctx context.Context) bool {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Int64Gauge).Enabled(ctx)
+	}
+	return false
+}
+
+func (i *siGauge) Measurement(value int64) metric.Measurement {
+	return metric.NewInt64Measurement(i, value)
+}
+
+func (i *siGauge) unwrapMeasurement(m metric.Measurement) (metric.Measurement, bool) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Int64Gauge).Measurement(m.Int64Value()), true
+	}
+	return m, false
+}
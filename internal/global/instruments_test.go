@@ -7,6 +7,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
 	"go.opentelemetry.io/otel/metric/noop"
@@ -179,6 +181,18 @@ func (i *testCountingFloatInstrument) Record(context.Context, float64, ...metric
 	i.count++
 }
 
+func (i *testCountingFloatInstrument) Enabled(context.Context) bool {
+	return true
+}
+
+func (i *testCountingFloatInstrument) RecordBuckets(context.Context, metric.HistogramBuckets, ...metric.RecordOption) {
+	i.count++
+}
+
+func (i *testCountingFloatInstrument) Measurement(value float64) metric.Measurement {
+	return metric.NewFloat64Measurement(i, value)
+}
+
 type testCountingIntInstrument struct {
 	count int
 
@@ -203,3 +217,58 @@ func (i *testCountingIntInstrument) Add(context.Context, int64, ...metric.AddOpt
 func (i *testCountingIntInstrument) Record(context.Context, int64, ...metric.RecordOption) {
 	i.count++
 }
+
+func (i *testCountingIntInstrument) Enabled(context.Context) bool {
+	return true
+}
+
+func (i *testCountingIntInstrument) RecordBuckets(context.Context, metric.HistogramBuckets, ...metric.RecordOption) {
+	i.count++
+}
+
+func (i *testCountingIntInstrument) Measurement(value int64) metric.Measurement {
+	return metric.NewInt64Measurement(i, value)
+}
+
+func TestSyncInstrumentEnabledDelegates(t *testing.T) {
+	type instrument interface {
+		Enabled(context.Context) bool
+		setDelegate(metric.Meter)
+	}
+
+	instruments := map[string]instrument{
+		"Float64Counter":       &sfCounter{},
+		"Float64UpDownCounter": &sfUpDownCounter{},
+		"Float64Histogram":     &sfHistogram{},
+		"Float64Gauge":         &sfGauge{},
+		"Int64Counter":         &siCounter{},
+		"Int64UpDownCounter":   &siUpDownCounter{},
+		"Int64Histogram":       &siHistogram{},
+		"Int64Gauge":           &siGauge{},
+	}
+	for name, inst := range instruments {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			assert.False(t, inst.Enabled(ctx), "enabled before delegation")
+			inst.setDelegate(&testMeter{})
+			assert.True(t, inst.Enabled(ctx), "not delegated")
+		})
+	}
+}
+
+func TestHistogramRecordBucketsDelegates(t *testing.T) {
+	ctx := context.Background()
+	b := metric.HistogramBuckets{Bounds: []float64{1}, Counts: []uint64{1, 2}, Sum: 5}
+
+	fHist := &sfHistogram{name: "float64.histogram"}
+	fHist.RecordBuckets(ctx, b) // Dropped before delegation.
+	fHist.setDelegate(&testMeter{})
+	fHist.RecordBuckets(ctx, b)
+	assert.Equal(t, 1, fHist.delegate.Load().(*testCountingFloatInstrument).count)
+
+	iHist := &siHistogram{name: "int64.histogram"}
+	iHist.RecordBuckets(ctx, b) // Dropped before delegation.
+	iHist.setDelegate(&testMeter{})
+	iHist.RecordBuckets(ctx, b)
+	assert.Equal(t, 1, iHist.delegate.Load().(*testCountingIntInstrument).count)
+}
@@ -6,9 +6,11 @@ package global // import "go.opentelemetry.io/otel/internal/global"
 import (
 	"container/list"
 	"context"
+	"errors"
 	"reflect"
 	"sync"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
 )
@@ -83,6 +85,15 @@ func (p *meterProvider) Meter(name string, opts ...metric.MeterOption) metric.Me
 	return t
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true.
+func (p *meterProvider) IsNoop() bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	return isNoop(p.delegate)
+}
+
 // meter is a placeholder for a metric.Meter.
 //
 // All Meter functionality is forwarded to a delegate once configured.
@@ -495,6 +506,15 @@ func (m *meter) Float64ObservableGauge(
 	return i, nil
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true.
+func (m *meter) IsNoop() bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return isNoop(m.delegate)
+}
+
 // RegisterCallback captures the function that will be called during Collect.
 func (m *meter) RegisterCallback(f metric.Callback, insts ...metric.Observable) (metric.Registration, error) {
 	m.mtx.Lock()
@@ -515,6 +535,47 @@ func (m *meter) RegisterCallback(f metric.Callback, insts ...metric.Observable)
 	return reg, nil
 }
 
+// Registrations returns the registrations of the delegate once configured.
+// Otherwise, the registrations made with m that have not been unregistered
+// are returned.
+func (m *meter) Registrations() []metric.Registration {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.delegate != nil {
+		return m.delegate.Registrations()
+	}
+
+	regs := make([]metric.Registration, 0, m.registry.Len())
+	for e := m.registry.Front(); e != nil; e = e.Next() {
+		regs = append(regs, e.Value.(*registration))
+	}
+	return regs
+}
+
+// RecordBatch forwards the measurements to the delegate once configured.
+// Otherwise, they are dropped.
+func (m *meter) RecordBatch(ctx context.Context, attrs attribute.Set, measurements ...metric.Measurement) {
+	m.mtx.Lock()
+	del := m.delegate
+	m.mtx.Unlock()
+
+	if del == nil {
+		return
+	}
+
+	out := make([]metric.Measurement, 0, len(measurements))
+	for _, meas := range measurements {
+		if in, ok := meas.Instrument().(measurementUnwrapper); ok {
+			if meas, ok = in.unwrapMeasurement(meas); !ok {
+				continue
+			}
+		}
+		out = append(out, meas)
+	}
+	del.RecordBatch(ctx, attrs, out...)
+}
+
 func unwrapInstruments(instruments []metric.Observable) []metric.Observable {
 	out := make([]metric.Observable, 0, len(instruments))
 
@@ -535,6 +596,10 @@ type registration struct {
 	instruments []metric.Observable
 	function    metric.Callback
 
+	// delegate is the registration of function with the delegate meter
+	// once configured.
+	delegate metric.Registration
+
 	unreg   func() error
 	unregMu sync.Mutex
 }
@@ -608,6 +673,7 @@ func (c *registration) setDelegate(m metric.Meter) {
 		return
 	}
 
+	c.delegate = reg
 	c.unreg = reg.Unregister
 }
 
@@ -623,3 +689,19 @@ func (c *registration) Unregister() error {
 	err, c.unreg = c.unreg(), nil
 	return err
 }
+
+var errUnregistered = errors.New("callback unregistered")
+
+func (c *registration) Replace(f metric.Callback) error {
+	c.unregMu.Lock()
+	defer c.unregMu.Unlock()
+	if c.unreg == nil {
+		return errUnregistered
+	}
+
+	if c.delegate != nil {
+		return c.delegate.Replace(unwrapCallback(f))
+	}
+	c.function = f
+	return nil
+}
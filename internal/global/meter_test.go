@@ -400,6 +400,44 @@ func TestRegistrationDelegation(t *testing.T) {
 	})
 }
 
+func TestRegistrationReplaceDelegation(t *testing.T) {
+	globalMeterProvider := &meterProvider{}
+	m := globalMeterProvider.Meter("go.opentelemetry.io/otel/metric/internal/global/meter_test")
+
+	actr, err := m.Float64ObservableCounter("test_Async_Counter")
+	require.NoError(t, err)
+
+	var calls []string
+	callback := func(name string) metric.Callback {
+		return func(context.Context, metric.Observer) error {
+			calls = append(calls, name)
+			return nil
+		}
+	}
+	reg, err := m.RegisterCallback(callback("original"), actr)
+	require.NoError(t, err)
+	assert.Equal(t, []metric.Registration{reg}, m.Registrations())
+
+	// Replaced before delegation.
+	require.NoError(t, reg.Replace(callback("first")))
+
+	mp := &testMeterProvider{}
+	globalMeterProvider.setDelegate(mp)
+	assert.Len(t, m.Registrations(), 1, "delegate registrations")
+
+	testCollect(t, m)
+	assert.Equal(t, []string{"first"}, calls)
+
+	// Replaced after delegation.
+	require.NoError(t, reg.Replace(callback("second")))
+	testCollect(t, m)
+	assert.Equal(t, []string{"first", "second"}, calls)
+
+	require.NoError(t, reg.Unregister())
+	assert.Empty(t, m.Registrations())
+	assert.ErrorIs(t, reg.Replace(callback("third")), errUnregistered)
+}
+
 func TestMeterIdentity(t *testing.T) {
 	type id struct{ name, ver, url, attr string }
 
@@ -459,3 +497,88 @@ func TestRegistrationDelegateFailingCallback(t *testing.T) {
 		r.setDelegate(m)
 	})
 }
+
+func TestMeterRecordBatchDelegation(t *testing.T) {
+	ctx := context.Background()
+	globalMeterProvider := &meterProvider{}
+	m := globalMeterProvider.Meter("TestMeterRecordBatchDelegation")
+
+	ctr, err := m.Int64Counter("int64.counter")
+	require.NoError(t, err)
+	hist, err := m.Float64Histogram("float64.histogram")
+	require.NoError(t, err)
+
+	// Measurements are dropped before delegation.
+	m.RecordBatch(ctx, *attribute.EmptySet(), ctr.Measurement(1), hist.Measurement(1))
+
+	globalMeterProvider.setDelegate(&testMeterProvider{})
+
+	// Measurements of instruments created both before and after delegation
+	// are recorded with the delegate.
+	gauge, err := m.Int64Gauge("int64.gauge")
+	require.NoError(t, err)
+	m.RecordBatch(ctx, *attribute.EmptySet(), ctr.Measurement(1), hist.Measurement(1), gauge.Measurement(1))
+
+	assert.Equal(t, 1, ctr.(*siCounter).delegate.Load().(*testCountingIntInstrument).count)
+	assert.Equal(t, 1, hist.(*sfHistogram).delegate.Load().(*testCountingFloatInstrument).count)
+	assert.Equal(t, 1, gauge.(*testCountingIntInstrument).count)
+}
+
+func testAllInstruments(t *testing.T, m metric.Meter) []any {
+	t.Helper()
+
+	var insts []any
+	add := func(inst any, err error) {
+		require.NoError(t, err)
+		insts = append(insts, inst)
+	}
+	add(m.Float64ObservableCounter("afCounter"))
+	add(m.Float64ObservableUpDownCounter("afUpDownCounter"))
+	add(m.Float64ObservableGauge("afGauge"))
+	add(m.Int64ObservableCounter("aiCounter"))
+	add(m.Int64ObservableUpDownCounter("aiUpDownCounter"))
+	add(m.Int64ObservableGauge("aiGauge"))
+	add(m.Float64Counter("sfCounter"))
+	add(m.Float64UpDownCounter("sfUpDownCounter"))
+	add(m.Float64Histogram("sfHistogram"))
+	add(m.Float64Gauge("sfGauge"))
+	add(m.Int64Counter("siCounter"))
+	add(m.Int64UpDownCounter("siUpDownCounter"))
+	add(m.Int64Histogram("siHistogram"))
+	add(m.Int64Gauge("siGauge"))
+	return insts
+}
+
+func TestMeterProviderIsNoop(t *testing.T) {
+	globalMeterProvider := &meterProvider{}
+	m := globalMeterProvider.Meter("go.opentelemetry.io/otel/metric/internal/global/meter_test")
+	insts := testAllInstruments(t, m)
+
+	assert.True(t, noop.IsNoop(globalMeterProvider), "provider before delegation")
+	assert.True(t, noop.IsNoop(m), "meter before delegation")
+	for _, inst := range insts {
+		assert.Truef(t, noop.IsNoop(inst), "%T before delegation", inst)
+	}
+
+	globalMeterProvider.setDelegate(&testMeterProvider{})
+
+	assert.False(t, noop.IsNoop(globalMeterProvider), "provider not delegated")
+	assert.False(t, noop.IsNoop(m), "meter not delegated")
+	for _, inst := range insts {
+		assert.Falsef(t, noop.IsNoop(inst), "%T not delegated", inst)
+	}
+}
+
+func TestMeterProviderIsNoopDelegatesToNoop(t *testing.T) {
+	globalMeterProvider := &meterProvider{}
+	m := globalMeterProvider.Meter("go.opentelemetry.io/otel/metric/internal/global/meter_test")
+	insts := testAllInstruments(t, m)
+
+	globalMeterProvider.setDelegate(noop.NewMeterProvider())
+
+	assert.True(t, noop.IsNoop(globalMeterProvider), "provider")
+	assert.True(t, noop.IsNoop(m), "meter")
+	for _, inst := range insts {
+		assert.Truef(t, noop.IsNoop(inst), "%T", inst)
+	}
+}
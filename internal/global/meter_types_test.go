@@ -6,6 +6,7 @@ package global // import "go.opentelemetry.io/otel/internal/global"
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
 )
@@ -146,17 +147,44 @@ func (m *testMeter) Float64ObservableGauge(
 // RegisterCallback captures the function that will be called during Collect.
 func (m *testMeter) RegisterCallback(f metric.Callback, i ...metric.Observable) (metric.Registration, error) {
 	m.callbacks = append(m.callbacks, f)
+	return m.reg(len(m.callbacks) - 1), nil
+}
+
+func (m *testMeter) reg(idx int) testReg {
 	return testReg{
-		f: func(idx int) func() {
-			return func() { m.callbacks[idx] = nil }
-		}(len(m.callbacks) - 1),
-	}, nil
+		f:       func() { m.callbacks[idx] = nil },
+		replace: func(f metric.Callback) { m.callbacks[idx] = f },
+	}
+}
+
+// Registrations returns a registration for each registered callback.
+func (m *testMeter) Registrations() []metric.Registration {
+	var regs []metric.Registration
+	for i, f := range m.callbacks {
+		if f != nil {
+			regs = append(regs, m.reg(i))
+		}
+	}
+	return regs
+}
+
+// RecordBatch records each measurement made by a testCounting instrument.
+func (m *testMeter) RecordBatch(ctx context.Context, _ attribute.Set, measurements ...metric.Measurement) {
+	for _, meas := range measurements {
+		switch inst := meas.Instrument().(type) {
+		case *testCountingIntInstrument:
+			inst.Add(ctx, meas.Int64Value())
+		case *testCountingFloatInstrument:
+			inst.Add(ctx, meas.Float64Value())
+		}
+	}
 }
 
 type testReg struct {
 	embedded.Registration
 
-	f func()
+	f       func()
+	replace func(metric.Callback)
 }
 
 func (r testReg) Unregister() error {
@@ -164,6 +192,11 @@ func (r testReg) Unregister() error {
 	return nil
 }
 
+func (r testReg) Replace(f metric.Callback) error {
+	r.replace(f)
+	return nil
+}
+
 // This enables async collection.
 func (m *testMeter) collect() {
 	ctx := context.Background()
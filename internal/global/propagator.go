@@ -55,8 +55,17 @@ func (p *textMapPropagator) effectiveDelegate() propagation.TextMapPropagator {
 	return p.noop
 }
 
+// Unwrap returns the TextMapPropagator p currently delegates to. It allows
+// propagation.Components to inspect the global TextMapPropagator.
+func (p *textMapPropagator) Unwrap() propagation.TextMapPropagator {
+	return p.effectiveDelegate()
+}
+
 // Inject set cross-cutting concerns from the Context into the carrier.
 func (p *textMapPropagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	if propagation.IsInjectionSuppressed(ctx) {
+		return
+	}
 	p.effectiveDelegate().Inject(ctx, carrier)
 }
 
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"go.opentelemetry.io/otel/internal/internaltest"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 func TestTextMapPropagatorDelegation(t *testing.T) {
@@ -62,6 +63,19 @@ func TestTextMapPropagatorDelegationNil(t *testing.T) {
 	}
 }
 
+func TestTextMapPropagatorDelegationSuppressedInjection(t *testing.T) {
+	ResetForTest(t)
+	ctx := propagation.WithSuppressedInjection(context.Background())
+	carrier := internaltest.NewTextMapCarrier(nil)
+
+	initial := TextMapPropagator()
+	delegate := internaltest.NewTextMapPropagator("test")
+	SetTextMapPropagator(delegate)
+
+	initial.Inject(ctx, carrier)
+	delegate.InjectedN(t, carrier, 0)
+}
+
 func TestTextMapPropagatorFields(t *testing.T) {
 	ResetForTest(t)
 	initial := TextMapPropagator()
@@ -83,6 +97,21 @@ func TestTextMapPropagatorFields(t *testing.T) {
 	}
 }
 
+func TestTextMapPropagatorComponents(t *testing.T) {
+	ResetForTest(t)
+	initial := TextMapPropagator()
+	if got := propagation.Components(initial); len(got) != 0 {
+		t.Errorf("Components of the default TextMapPropagator: got %v, want none", got)
+	}
+
+	delegate := internaltest.NewTextMapPropagator("test")
+	SetTextMapPropagator(delegate)
+	got := propagation.Components(initial)
+	if len(got) != 1 || got[0] != delegate {
+		t.Errorf("Components of the delegating TextMapPropagator: got %v, want [%v]", got, delegate)
+	}
+}
+
 func fieldsEqual(f1, f2 []string) bool {
 	if len(f1) != len(f2) {
 		return false
@@ -5,6 +5,8 @@ package global // import "go.opentelemetry.io/otel/internal/global"
 
 import (
 	"errors"
+	"maps"
+	"slices"
 	"sync"
 	"sync/atomic"
 
@@ -41,6 +43,11 @@ var (
 	delegateTraceOnce             sync.Once
 	delegateTextMapPropagatorOnce sync.Once
 	delegateMeterOnce             sync.Once
+
+	// signalProviders are the global providers of the signals whose API is
+	// not part of this module (e.g. logs), keyed by signal name.
+	signalProviders   map[string]any
+	signalProvidersMu sync.Mutex
 )
 
 // GetErrorHandler returns the global ErrorHandler instance.
@@ -174,6 +181,33 @@ func SetMeterProvider(mp metric.MeterProvider) {
 	globalMeterProvider.Store(meterProviderHolder{mp: mp})
 }
 
+// SetSignalProvider records provider as the global provider of the signal.
+// It is used by the global implementations of the signals whose API is not
+// part of this module so their providers can be shut down by otel.Shutdown.
+func SetSignalProvider(signal string, provider any) {
+	signalProvidersMu.Lock()
+	defer signalProvidersMu.Unlock()
+
+	if signalProviders == nil {
+		signalProviders = make(map[string]any)
+	}
+	signalProviders[signal] = provider
+}
+
+// SignalProviders returns the providers recorded with SetSignalProvider,
+// sorted by signal name.
+func SignalProviders() []any {
+	signalProvidersMu.Lock()
+	defer signalProvidersMu.Unlock()
+
+	signals := slices.Sorted(maps.Keys(signalProviders))
+	providers := make([]any, 0, len(signals))
+	for _, signal := range signals {
+		providers = append(providers, signalProviders[signal])
+	}
+	return providers
+}
+
 func defaultErrorHandler() *atomic.Value {
 	v := &atomic.Value{}
 	v.Store(errorHandlerHolder{eh: &ErrDelegator{}})
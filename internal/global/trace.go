@@ -22,6 +22,7 @@ SDK prior to any Tracer creation.
 
 import (
 	"context"
+	"slices"
 	"sync"
 	"sync/atomic"
 
@@ -30,6 +31,7 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/embedded"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 // tracerProvider is a placeholder for a configured SDK TracerProvider.
@@ -41,9 +43,18 @@ type tracerProvider struct {
 
 	mtx      sync.Mutex
 	tracers  map[il]*tracer
+	hooks    []*shutdownHook
 	delegate trace.TracerProvider
 }
 
+// shutdownHook is a shutdown hook registered with a tracerProvider before its
+// delegate is set.
+type shutdownHook struct {
+	fn func(context.Context) error
+	// unregister unregisters fn from the delegate once it is set.
+	unregister func()
+}
+
 // Compile-time guarantee that tracerProvider implements the TracerProvider
 // interface.
 var _ trace.TracerProvider = &tracerProvider{}
@@ -61,6 +72,11 @@ func (p *tracerProvider) setDelegate(provider trace.TracerProvider) {
 
 	p.delegate = provider
 
+	for _, h := range p.hooks {
+		h.unregister, _ = trace.RegisterShutdownHook(provider, h.fn)
+	}
+	p.hooks = nil
+
 	if len(p.tracers) == 0 {
 		return
 	}
@@ -104,6 +120,44 @@ func (p *tracerProvider) Tracer(name string, opts ...trace.TracerOption) trace.T
 	return t
 }
 
+// RegisterShutdownHook registers hook with the delegate of p. If the
+// delegate is not yet set, hook is registered with it once it is.
+func (p *tracerProvider) RegisterShutdownHook(hook func(context.Context) error) (unregister func()) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.delegate != nil {
+		unregister, _ = trace.RegisterShutdownHook(p.delegate, hook)
+		return unregister
+	}
+
+	h := &shutdownHook{fn: hook}
+	p.hooks = append(p.hooks, h)
+	return func() {
+		p.mtx.Lock()
+		defer p.mtx.Unlock()
+
+		if h.unregister != nil {
+			h.unregister()
+			return
+		}
+		p.hooks = slices.DeleteFunc(p.hooks, func(e *shutdownHook) bool { return e == h })
+	}
+}
+
+// IsNoop implements noop.Reporter. It returns whether the delegate performs
+// no operations once it is set. Until then, p performs no operations unless
+// auto-instrumentation has attached to this process.
+func (p *tracerProvider) IsNoop() bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.delegate != nil {
+		return noop.IsNoop(p.delegate)
+	}
+	return !*autoInstEnabled
+}
+
 type il struct {
 	name    string
 	version string
@@ -149,6 +203,31 @@ func (t *tracer) Start(ctx context.Context, name string, opts ...trace.SpanStart
 	return t.newSpan(ctx, autoInstEnabled, name, opts)
 }
 
+// Enabled implements trace.Tracer by forwarding the call to t.delegate if
+// set. Otherwise, it returns whether auto-instrumentation has attached to
+// this process: no recording spans are created before a delegate is set
+// without it.
+func (t *tracer) Enabled(ctx context.Context, param trace.EnabledParameters) bool {
+	delegate := t.delegate.Load()
+	if delegate != nil {
+		return delegate.(trace.Tracer).Enabled(ctx, param)
+	}
+
+	return *autoInstEnabled
+}
+
+// IsNoop implements noop.Reporter by forwarding the call to t.delegate if
+// set. Otherwise, t performs no operations unless auto-instrumentation has
+// attached to this process.
+func (t *tracer) IsNoop() bool {
+	delegate := t.delegate.Load()
+	if delegate != nil {
+		return noop.IsNoop(delegate)
+	}
+
+	return !*autoInstEnabled
+}
+
 // autoInstEnabled determines if the auto-instrumentation SDK span is returned
 // from the tracer when not backed by a delegate and auto-instrumentation has
 // attached to this process.
@@ -228,4 +307,7 @@ func (nonRecordingSpan) AddLink(trace.Link) {}
 // SetName does nothing.
 func (nonRecordingSpan) SetName(string) {}
 
+// IsNoop returns true. The Span performs no operations.
+func (nonRecordingSpan) IsNoop() bool { return true }
+
 func (s nonRecordingSpan) TracerProvider() trace.TracerProvider { return s.tracer.provider }
@@ -34,6 +34,10 @@ type fnTracer struct {
 	start func(ctx context.Context, spanName string, opts ...trace.SpanStartOption) (context.Context, trace.Span)
 }
 
+func (fnTracer) Enabled(context.Context, trace.EnabledParameters) bool {
+	return true
+}
+
 func (fn fnTracer) Start(
 	ctx context.Context,
 	spanName string,
@@ -116,6 +120,117 @@ func TestTraceProviderDelegates(t *testing.T) {
 	assert.True(t, called, "expected configured TraceProvider to be called")
 }
 
+func TestTracerEnabledDelegates(t *testing.T) {
+	ResetForTest(t)
+
+	ctx := context.Background()
+	param := trace.EnabledParameters{SpanKind: trace.SpanKindClient}
+	tracer := TracerProvider().Tracer("abc")
+	assert.False(t, tracer.Enabled(ctx, param), "enabled before delegation")
+
+	var got trace.EnabledParameters
+	SetTracerProvider(fnTracerProvider{
+		tracer: func(string, ...trace.TracerOption) trace.Tracer {
+			return enabledTracer{Tracer: noop.NewTracerProvider().Tracer(""), param: &got}
+		},
+	})
+	assert.True(t, tracer.Enabled(ctx, param), "not delegated")
+	assert.Equal(t, param, got)
+}
+
+func TestTracerProviderIsNoop(t *testing.T) {
+	ResetForTest(t)
+
+	ctx := context.Background()
+	tp := TracerProvider()
+	tracer := tp.Tracer("abc")
+	_, span := tracer.Start(ctx, "span")
+	assert.True(t, noop.IsNoop(tp), "provider before delegation")
+	assert.True(t, noop.IsNoop(tracer), "tracer before delegation")
+	assert.True(t, noop.IsNoop(span), "span before delegation")
+
+	orig := *autoInstEnabled
+	*autoInstEnabled = true
+	assert.False(t, noop.IsNoop(tp), "provider with auto-instrumentation")
+	assert.False(t, noop.IsNoop(tracer), "tracer with auto-instrumentation")
+	*autoInstEnabled = orig
+
+	SetTracerProvider(fnTracerProvider{
+		tracer: func(string, ...trace.TracerOption) trace.Tracer {
+			return fnTracer{}
+		},
+	})
+	assert.False(t, noop.IsNoop(tp), "provider not delegated")
+	assert.False(t, noop.IsNoop(tracer), "tracer not delegated")
+	assert.True(t, noop.IsNoop(span), "span started before delegation")
+}
+
+func TestTracerProviderIsNoopDelegatesToNoop(t *testing.T) {
+	ResetForTest(t)
+
+	tp := TracerProvider()
+	tracer := tp.Tracer("abc")
+	SetTracerProvider(noop.NewTracerProvider())
+	assert.True(t, noop.IsNoop(tp), "provider")
+	assert.True(t, noop.IsNoop(tracer), "tracer")
+}
+
+// hookTracerProvider is a TracerProvider that supports shutdown hooks.
+type hookTracerProvider struct {
+	trace.TracerProvider
+
+	hooks map[int]func(context.Context) error
+	next  int
+}
+
+func (p *hookTracerProvider) RegisterShutdownHook(hook func(context.Context) error) func() {
+	id := p.next
+	p.next++
+	p.hooks[id] = hook
+	return func() { delete(p.hooks, id) }
+}
+
+func TestRegisterShutdownHookDelegates(t *testing.T) {
+	ResetForTest(t)
+
+	hook := func(context.Context) error { return nil }
+	gtp := TracerProvider()
+	_, ok := trace.RegisterShutdownHook(gtp, hook)
+	assert.True(t, ok)
+	unregister, ok := trace.RegisterShutdownHook(gtp, hook)
+	assert.True(t, ok)
+	removed, ok := trace.RegisterShutdownHook(gtp, hook)
+	assert.True(t, ok)
+	removed()
+
+	delegate := &hookTracerProvider{
+		TracerProvider: noop.NewTracerProvider(),
+		hooks:          make(map[int]func(context.Context) error),
+	}
+	SetTracerProvider(delegate)
+	assert.Len(t, delegate.hooks, 2, "hooks not forwarded")
+
+	unregister()
+	assert.Len(t, delegate.hooks, 1, "hook not unregistered from delegate")
+
+	unregister, ok = trace.RegisterShutdownHook(gtp, hook)
+	assert.True(t, ok)
+	assert.Len(t, delegate.hooks, 2, "hook not registered with delegate")
+	unregister()
+	assert.Len(t, delegate.hooks, 1)
+}
+
+type enabledTracer struct {
+	trace.Tracer
+
+	param *trace.EnabledParameters
+}
+
+func (t enabledTracer) Enabled(_ context.Context, param trace.EnabledParameters) bool {
+	*t.param = param
+	return true
+}
+
 func TestTraceProviderDelegatesConcurrentSafe(t *testing.T) {
 	ResetForTest(t)
 
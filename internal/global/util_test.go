@@ -20,5 +20,6 @@ func ResetForTest(t testing.TB) {
 		delegateTraceOnce = sync.Once{}
 		delegateTextMapPropagatorOnce = sync.Once{}
 		delegateMeterOnce = sync.Once{}
+		signalProviders = nil
 	})
 }
@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package semconvkeysgen generates the set of attribute keys defined by a
+// versioned go.opentelemetry.io/otel/semconv package.
+//
+// The keys are the values of the attribute.Key constants and variables
+// declared by the package, e.g. HTTPRequestMethodKey.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+var (
+	dir  = flag.String("semconv", "", "directory of the semconv package")
+	pkg  = flag.String("package", "", "package name of the generated file")
+	name = flag.String("name", "semconvKeys", "name of the generated variable")
+	out  = flag.String("output", "semconv_keys.go", "output file")
+)
+
+// keys returns the sorted attribute keys declared by the Go package in dir.
+func keys(dir string) ([]string, error) {
+	fset := token.NewFileSet()
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	var ks []string
+	for _, m := range matches {
+		if strings.HasSuffix(m, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, m, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || (gen.Tok != token.CONST && gen.Tok != token.VAR) {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				for _, v := range spec.(*ast.ValueSpec).Values {
+					if k, ok := attributeKey(v); ok {
+						ks = append(ks, k)
+					}
+				}
+			}
+		}
+	}
+	if len(ks) == 0 {
+		return nil, fmt.Errorf("no attribute key declared in %s", dir)
+	}
+	slices.Sort(ks)
+	return slices.Compact(ks), nil
+}
+
+// attributeKey returns the key of an attribute.Key("...") conversion.
+func attributeKey(e ast.Expr) (string, bool) {
+	call, ok := e.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return "", false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Key" {
+		return "", false
+	}
+	if id, ok := sel.X.(*ast.Ident); !ok || id.Name != "attribute" {
+		return "", false
+	}
+	lit, ok := call.Args[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	k, err := strconv.Unquote(lit.Value)
+	return k, err == nil
+}
+
+var tmpl = template.Must(template.New("keys").Parse(`// Code generated by semconvkeysgen. DO NOT EDIT.
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package {{.Package}}
+
+import "go.opentelemetry.io/otel/attribute"
+
+// {{.Name}} are the attribute keys defined by
+// go.opentelemetry.io/otel/semconv/{{.Version}}.
+var {{.Name}} = map[attribute.Key]struct{}{
+{{- range .Keys}}
+	"{{.}}": {},
+{{- end}}
+}
+`))
+
+func main() {
+	flag.Parse()
+
+	if *dir == "" {
+		log.Fatal("missing semconv")
+	}
+	if *pkg == "" {
+		log.Fatal("missing package")
+	}
+	ks, err := keys(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, struct {
+		Package, Name, Version string
+		Keys                   []string
+	}{
+		Package: *pkg,
+		Name:    *name,
+		Version: path.Base(filepath.ToSlash(filepath.Clean(*dir))),
+		Keys:    ks,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	//nolint:gosec // Generated source is world readable.
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package semconvmigrategen generates the attribute rename table of
+// go.opentelemetry.io/otel/semconv/semconvmigrate from an OpenTelemetry
+// schema file (https://opentelemetry.io/schemas/).
+//
+// Only the attribute renames of the "all" section of each version are
+// included, as they apply to the attributes of every signal.
+//
+// With the -extract flag, the "all" sections holding attribute renames are
+// written to the output as a schema file instead. This file is the one the
+// renames are generated from.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	schema  = flag.String("schema", "", "schema file path or URL")
+	out     = flag.String("output", "renames.go", "output file")
+	extract = flag.Bool("extract", false, "write the \"all\" sections of the schema file instead of the renames")
+)
+
+// schemaFile is the part of a schema file the renames are generated from.
+type schemaFile struct {
+	FileFormat string `yaml:"file_format"`
+	SchemaURL  string `yaml:"schema_url"`
+	Versions   map[string]struct {
+		All struct {
+			Changes []struct {
+				RenameAttributes struct {
+					AttributeMap map[string]string `yaml:"attribute_map"`
+				} `yaml:"rename_attributes"`
+			} `yaml:"changes"`
+		} `yaml:"all"`
+	} `yaml:"versions"`
+}
+
+type version [3]int
+
+func parseVersion(s string) (version, error) {
+	var v version
+	if _, err := fmt.Sscanf(s, "%d.%d.%d", &v[0], &v[1], &v[2]); err != nil {
+		return v, fmt.Errorf("invalid version %q: %w", s, err)
+	}
+	return v, nil
+}
+
+type rename struct {
+	From, To string
+}
+
+type versionRenames struct {
+	Version version
+	Renames []rename
+}
+
+type data struct {
+	Latest   string
+	Versions []versionRenames
+}
+
+func load(src string) (*schemaFile, error) {
+	var r io.Reader
+	if strings.HasPrefix(src, "http://") || strings.HasPrefix(src, "https://") {
+		resp, err := http.Get(src) //nolint:gosec // The URL is provided by the user running the tool.
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("fetching %s: %s", src, resp.Status)
+		}
+		r = resp.Body
+	} else {
+		f, err := os.Open(src)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var s schemaFile
+	if err := yaml.Unmarshal(b, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func generate(s *schemaFile) (*data, error) {
+	latest := s.SchemaURL[strings.LastIndexByte(s.SchemaURL, '/')+1:]
+	if _, err := parseVersion(latest); err != nil {
+		return nil, fmt.Errorf("schema URL %q: %w", s.SchemaURL, err)
+	}
+
+	d := &data{Latest: latest}
+	for k, def := range s.Versions {
+		v, err := parseVersion(k)
+		if err != nil {
+			return nil, err
+		}
+		var renames []rename
+		for _, c := range def.All.Changes {
+			for from, to := range c.RenameAttributes.AttributeMap {
+				renames = append(renames, rename{From: from, To: to})
+			}
+		}
+		if len(renames) == 0 {
+			continue
+		}
+		slices.SortFunc(renames, func(a, b rename) int { return strings.Compare(a.From, b.From) })
+		d.Versions = append(d.Versions, versionRenames{Version: v, Renames: renames})
+	}
+	slices.SortFunc(d.Versions, func(a, b versionRenames) int {
+		return slices.Compare(a.Version[:], b.Version[:])
+	})
+	return d, nil
+}
+
+// extractAll returns a schema file holding the "all" sections of the
+// versions of s with attribute renames, in descending version order.
+func extractAll(s *schemaFile) ([]byte, error) {
+	d, err := generate(s)
+	if err != nil {
+		return nil, err
+	}
+
+	str := func(v string) *yaml.Node { return &yaml.Node{Kind: yaml.ScalarNode, Value: v} }
+	mapping := func(kv ...*yaml.Node) *yaml.Node { return &yaml.Node{Kind: yaml.MappingNode, Content: kv} }
+
+	versions := mapping()
+	for _, v := range slices.Backward(d.Versions) {
+		attrs := mapping()
+		for _, r := range v.Renames {
+			attrs.Content = append(attrs.Content, str(r.From), str(r.To))
+		}
+		changes := &yaml.Node{Kind: yaml.SequenceNode, Content: []*yaml.Node{
+			mapping(str("rename_attributes"), mapping(str("attribute_map"), attrs)),
+		}}
+		name := fmt.Sprintf("%d.%d.%d", v.Version[0], v.Version[1], v.Version[2])
+		versions.Content = append(versions.Content, str(name), mapping(str("all"), mapping(str("changes"), changes)))
+	}
+	doc := mapping(
+		str("file_format"), str(s.FileFormat),
+		str("schema_url"), str(s.SchemaURL),
+		str("versions"), versions,
+	)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# Code generated by semconvmigrategen -extract. DO NOT EDIT.\n#\n")
+	fmt.Fprintf(&buf, "# The \"all\" sections with attribute renames of %s.\n", s.SchemaURL)
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+var tmpl = template.Must(template.New("renames").Parse(`// Code generated by semconvmigrategen. DO NOT EDIT.
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package semconvmigrate // import "go.opentelemetry.io/otel/semconv/semconvmigrate"
+
+import "go.opentelemetry.io/otel/attribute"
+
+// LatestVersion is the latest semantic conventions version attributes can be
+// upgraded to.
+const LatestVersion = "{{.Latest}}"
+
+// renames are the attribute renames introduced by each version of the
+// semantic conventions, in ascending version order.
+var renames = []versionRenames{
+{{- range .Versions}}
+	{
+		version: version{ {{- index .Version 0}}, {{index .Version 1}}, {{index .Version 2 -}} },
+		keys: map[attribute.Key]attribute.Key{
+		{{- range .Renames}}
+			"{{.From}}": "{{.To}}",
+		{{- end}}
+		},
+	},
+{{- end}}
+}
+`))
+
+func main() {
+	flag.Parse()
+
+	if *schema == "" {
+		log.Fatal("missing schema")
+	}
+	s, err := load(*schema)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *extract {
+		b, err := extractAll(s)
+		if err != nil {
+			log.Fatal(err)
+		}
+		//nolint:gosec // Generated file is world readable.
+		if err := os.WriteFile(*out, b, 0o644); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	d, err := generate(s)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, d); err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	//nolint:gosec // Generated source is world readable.
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
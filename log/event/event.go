@@ -0,0 +1,204 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package event provides typed constructors of log records representing
+// events defined by the OpenTelemetry semantic conventions.
+//
+// The constructors validate the fields required by the semantic conventions
+// so malformed events are reported to the caller instead of being emitted.
+package event // import "go.opentelemetry.io/otel/log/event"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+// ErrInvalidEvent is returned when an event does not conform to the semantic
+// conventions.
+var ErrInvalidEvent = errors.New("invalid event")
+
+// FeatureFlagEvaluationEventName is the name of the event describing a
+// feature flag evaluation.
+const FeatureFlagEvaluationEventName = "feature_flag.evaluation"
+
+// Event is an event defined by the OpenTelemetry semantic conventions.
+type Event interface {
+	// Record returns the log record representing the event. An error wrapping
+	// ErrInvalidEvent is returned if the event does not conform to the
+	// semantic conventions.
+	Record() (log.Record, error)
+}
+
+// Emit emits the log record of e with logger. Nothing is emitted if logger is
+// not enabled for the record.
+//
+// An error wrapping ErrInvalidEvent is returned if e does not conform to the
+// semantic conventions.
+func Emit(ctx context.Context, logger log.Logger, e Event) error {
+	r, err := e.Record()
+	if err != nil {
+		return err
+	}
+	param := log.EnabledParameters{Severity: r.Severity(), EventName: r.EventName()}
+	if logger.Enabled(ctx, param) {
+		logger.Emit(ctx, r)
+	}
+	return nil
+}
+
+// Exception is an event describing an exception.
+type Exception struct {
+	// Type is the type of the exception. It is required if Message is empty.
+	Type string
+	// Message is the message of the exception. It is required if Type is
+	// empty.
+	Message string
+	// Stacktrace is the stacktrace of the exception. It is optional.
+	Stacktrace string
+	// Severity is the severity of the event. SeverityError is used if it is
+	// not set.
+	Severity log.Severity
+}
+
+var _ Event = Exception{}
+
+// NewException returns an Exception describing err. If err is nil, the
+// returned Exception has no type nor message, so its Record method returns
+// an error.
+func NewException(err error) Exception {
+	if err == nil {
+		return Exception{}
+	}
+	return Exception{Type: typeStr(err), Message: err.Error()}
+}
+
+// Record returns the log record representing e. An error wrapping
+// ErrInvalidEvent is returned if both the Type and the Message of e are
+// empty.
+func (e Exception) Record() (log.Record, error) {
+	if e.Type == "" && e.Message == "" {
+		return log.Record{}, invalid(semconv.ExceptionEventName, "type or message required")
+	}
+
+	r := newRecord(semconv.ExceptionEventName, e.Severity, log.SeverityError)
+	r.AddAttributes(nonEmpty(nil,
+		log.String(string(semconv.ExceptionTypeKey), e.Type),
+		log.String(string(semconv.ExceptionMessageKey), e.Message),
+		log.String(string(semconv.ExceptionStacktraceKey), e.Stacktrace),
+	)...)
+	return r, nil
+}
+
+// FeatureFlagEvaluation is an event describing the evaluation of a feature
+// flag.
+type FeatureFlagEvaluation struct {
+	// Key is the unique identifier of the feature flag. It is required.
+	Key string
+	// ProviderName is the name of the feature flag provider. It is optional.
+	ProviderName string
+	// Variant is the name of the variant the flag evaluated to. It is
+	// optional.
+	Variant string
+	// Value is the value the flag evaluated to. It is optional.
+	Value log.Value
+	// Reason is the reason of the evaluated value, e.g. "static" or
+	// "targeting_match". It is optional.
+	Reason string
+	// ContextID is the identifier of the evaluation context. It is optional.
+	ContextID string
+	// SetID is the identifier of the flag set the flag belongs to. It is
+	// optional.
+	SetID string
+	// Version is the version of the ruleset used during the evaluation. It is
+	// optional.
+	Version string
+	// ErrorType describes the class of error the evaluation ended with. It is
+	// required if the evaluation failed, as reported by Reason being "error"
+	// or ErrorMessage being set.
+	ErrorType string
+	// ErrorMessage is a message explaining why the evaluation failed. It is
+	// optional.
+	ErrorMessage string
+	// Severity is the severity of the event. SeverityInfo is used if it is
+	// not set.
+	Severity log.Severity
+}
+
+var _ Event = FeatureFlagEvaluation{}
+
+// Record returns the log record representing e. An error wrapping
+// ErrInvalidEvent is returned if the Key of e is empty, or if the evaluation
+// failed but the ErrorType of e is empty.
+func (e FeatureFlagEvaluation) Record() (log.Record, error) {
+	if e.Key == "" {
+		return log.Record{}, invalid(FeatureFlagEvaluationEventName, "key required")
+	}
+	failed := e.ErrorMessage != "" ||
+		e.Reason == semconv.FeatureFlagResultReasonError.Value.AsString()
+	if failed && e.ErrorType == "" {
+		return log.Record{}, invalid(FeatureFlagEvaluationEventName, "error type required for failed evaluation")
+	}
+
+	r := newRecord(FeatureFlagEvaluationEventName, e.Severity, log.SeverityInfo)
+	attrs := nonEmpty(make([]log.KeyValue, 0, 9),
+		log.String(string(semconv.FeatureFlagKeyKey), e.Key),
+		log.String(string(semconv.FeatureFlagProviderNameKey), e.ProviderName),
+		log.String(string(semconv.FeatureFlagResultVariantKey), e.Variant),
+		log.String(string(semconv.FeatureFlagResultReasonKey), e.Reason),
+		log.String(string(semconv.FeatureFlagContextIDKey), e.ContextID),
+		log.String(string(semconv.FeatureFlagSetIDKey), e.SetID),
+		log.String(string(semconv.FeatureFlagVersionKey), e.Version),
+		log.String(string(semconv.ErrorTypeKey), e.ErrorType),
+	)
+	if !e.Value.Empty() {
+		attrs = append(attrs, log.KeyValue{Key: string(semconv.FeatureFlagResultValueKey), Value: e.Value})
+	}
+	r.AddAttributes(attrs...)
+	if e.ErrorMessage != "" {
+		r.SetBody(log.StringValue(e.ErrorMessage))
+	}
+	return r, nil
+}
+
+func newRecord(name string, severity, defaultSeverity log.Severity) log.Record {
+	if severity == log.SeverityUndefined {
+		severity = defaultSeverity
+	}
+
+	var r log.Record
+	r.SetEventName(name)
+	r.SetTimestamp(time.Now())
+	r.SetSeverity(severity)
+	r.SetSeverityText(severity.String())
+	return r
+}
+
+// nonEmpty appends the attributes of kvs with a non-empty string value to
+// dst.
+func nonEmpty(dst []log.KeyValue, kvs ...log.KeyValue) []log.KeyValue {
+	for _, kv := range kvs {
+		if kv.Value.AsString() != "" {
+			dst = append(dst, kv)
+		}
+	}
+	return dst
+}
+
+func invalid(name, msg string) error {
+	return fmt.Errorf("%w: %s: %s", ErrInvalidEvent, name, msg)
+}
+
+func typeStr(i any) string {
+	t := reflect.TypeOf(i)
+	if t.PkgPath() == "" && t.Name() == "" {
+		// Likely a builtin type.
+		return t.String()
+	}
+	return fmt.Sprintf("%s.%s", t.PkgPath(), t.Name())
+}
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/embedded"
+)
+
+type recordingLogger struct {
+	embedded.Logger
+
+	minSeverity log.Severity
+	records     []log.Record
+}
+
+func (l *recordingLogger) Emit(_ context.Context, r log.Record) {
+	l.records = append(l.records, r)
+}
+
+func (l *recordingLogger) Enabled(_ context.Context, param log.EnabledParameters) bool {
+	return param.Severity >= l.minSeverity
+}
+
+func attributes(r log.Record) []log.KeyValue {
+	var kvs []log.KeyValue
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		kvs = append(kvs, kv)
+		return true
+	})
+	return kvs
+}
+
+func TestException(t *testing.T) {
+	r, err := Exception{Type: "io.EOF", Stacktrace: "main.go:1"}.Record()
+	require.NoError(t, err)
+	assert.Equal(t, "exception", r.EventName())
+	assert.Equal(t, log.SeverityError, r.Severity())
+	assert.Equal(t, "ERROR", r.SeverityText())
+	assert.False(t, r.Timestamp().IsZero(), "timestamp")
+	assert.Equal(t, []log.KeyValue{
+		log.String("exception.type", "io.EOF"),
+		log.String("exception.stacktrace", "main.go:1"),
+	}, attributes(r))
+
+	r, err = Exception{Message: "failed", Severity: log.SeverityWarn}.Record()
+	require.NoError(t, err)
+	assert.Equal(t, log.SeverityWarn, r.Severity())
+	assert.Equal(t, []log.KeyValue{log.String("exception.message", "failed")}, attributes(r))
+
+	_, err = Exception{Stacktrace: "main.go:1"}.Record()
+	assert.ErrorIs(t, err, ErrInvalidEvent)
+}
+
+func TestNewException(t *testing.T) {
+	e := NewException(errors.New("failed"))
+	assert.Equal(t, Exception{Type: "*errors.errorString", Message: "failed"}, e)
+
+	e = NewException(nil)
+	assert.Equal(t, Exception{}, e)
+	_, err := e.Record()
+	assert.ErrorIs(t, err, ErrInvalidEvent)
+}
+
+func TestFeatureFlagEvaluation(t *testing.T) {
+	r, err := FeatureFlagEvaluation{
+		Key:          "logo-color",
+		ProviderName: "Flag Manager",
+		Variant:      "red",
+		Value:        log.StringValue("#ff0000"),
+		Reason:       "targeting_match",
+	}.Record()
+	require.NoError(t, err)
+	assert.Equal(t, "feature_flag.evaluation", r.EventName())
+	assert.Equal(t, log.SeverityInfo, r.Severity())
+	assert.Equal(t, log.Value{}, r.Body())
+	assert.Equal(t, []log.KeyValue{
+		log.String("feature_flag.key", "logo-color"),
+		log.String("feature_flag.provider.name", "Flag Manager"),
+		log.String("feature_flag.result.variant", "red"),
+		log.String("feature_flag.result.reason", "targeting_match"),
+		log.String("feature_flag.result.value", "#ff0000"),
+	}, attributes(r))
+
+	r, err = FeatureFlagEvaluation{
+		Key:          "logo-color",
+		Reason:       "error",
+		ErrorType:    "flag_not_found",
+		ErrorMessage: "flag not found",
+	}.Record()
+	require.NoError(t, err)
+	assert.Equal(t, log.StringValue("flag not found"), r.Body())
+	assert.Equal(t, []log.KeyValue{
+		log.String("feature_flag.key", "logo-color"),
+		log.String("feature_flag.result.reason", "error"),
+		log.String("error.type", "flag_not_found"),
+	}, attributes(r))
+}
+
+func TestFeatureFlagEvaluationInvalid(t *testing.T) {
+	for name, e := range map[string]FeatureFlagEvaluation{
+		"MissingKey":            {Variant: "red"},
+		"ErrorReason":           {Key: "logo-color", Reason: "error"},
+		"ErrorMessage":          {Key: "logo-color", ErrorMessage: "flag not found"},
+		"ErrorReasonAndMessage": {Key: "logo-color", Reason: "error", ErrorMessage: "flag not found"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := e.Record()
+			assert.ErrorIs(t, err, ErrInvalidEvent)
+		})
+	}
+}
+
+func TestEmit(t *testing.T) {
+	ctx := context.Background()
+	l := &recordingLogger{minSeverity: log.SeverityError}
+
+	require.NoError(t, Emit(ctx, l, FeatureFlagEvaluation{Key: "logo-color"}))
+	assert.Empty(t, l.records, "disabled event emitted")
+
+	require.NoError(t, Emit(ctx, l, Exception{Message: "failed"}))
+	require.Len(t, l.records, 1)
+	assert.Equal(t, "exception", l.records[0].EventName())
+
+	err := Emit(ctx, l, Exception{})
+	assert.ErrorIs(t, err, ErrInvalidEvent)
+	assert.Len(t, l.records, 1, "invalid event emitted")
+}
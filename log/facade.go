@@ -0,0 +1,213 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/log"
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// badKey is the key used for the values of keyvals without a key.
+const badKey = "!BADKEY"
+
+// Facade is a convenience front-end to emit log records with a [Logger].
+//
+// It is intended for applications logging directly with the OpenTelemetry
+// Logs API. Applications using a logging library should use a bridge of that
+// library instead.
+//
+// The methods of Facade accept key-value pairs that are added to the emitted
+// records as attributes. A key is a string followed by its value, e.g.
+// "user", "alice". A [KeyValue] or an [attribute.KeyValue] can also be passed
+// in place of a pair. The values are converted to a [Value] based on their
+// type. A time.Time is converted to the number of nanoseconds since the Unix
+// epoch and a time.Duration to its number of nanoseconds. Other values that
+// are not a number, a string, a bool, a []byte, or a [Value] are converted to
+// their string representation.
+//
+// The zero value of Facade is not usable. Use NewFacade to create one.
+type Facade struct {
+	logger Logger
+	attrs  []KeyValue
+}
+
+// NewFacade returns a Facade that emits log records with logger.
+func NewFacade(logger Logger) Facade {
+	return Facade{logger: logger}
+}
+
+// With returns a copy of f that adds the attributes of keyvals to the log
+// records it emits, in addition to the ones of f.
+func (f Facade) With(keyvals ...any) Facade {
+	attrs := make([]KeyValue, 0, len(f.attrs)+len(keyvals))
+	attrs = append(attrs, f.attrs...)
+	f.attrs = appendKeyVals(attrs, keyvals)
+	return f
+}
+
+// Enabled returns whether a log record with severity emitted in ctx would be
+// emitted by the Logger of f.
+func (f Facade) Enabled(ctx context.Context, severity Severity) bool {
+	return f.logger.Enabled(ctx, EnabledParameters{Severity: severity})
+}
+
+// Log emits a log record with severity, msg as body, and the attributes of
+// keyvals.
+func (f Facade) Log(ctx context.Context, severity Severity, msg string, keyvals ...any) {
+	if !f.Enabled(ctx, severity) {
+		return
+	}
+	f.emit(ctx, severity, msg, keyvals)
+}
+
+// Logf emits a log record with severity and the message formatted with
+// fmt.Sprintf as body.
+func (f Facade) Logf(ctx context.Context, severity Severity, format string, args ...any) {
+	if !f.Enabled(ctx, severity) {
+		return
+	}
+	f.emit(ctx, severity, fmt.Sprintf(format, args...), nil)
+}
+
+// Debug emits a log record with [SeverityDebug].
+func (f Facade) Debug(ctx context.Context, msg string, keyvals ...any) {
+	f.Log(ctx, SeverityDebug, msg, keyvals...)
+}
+
+// Debugf emits a log record with [SeverityDebug] and a formatted message.
+func (f Facade) Debugf(ctx context.Context, format string, args ...any) {
+	f.Logf(ctx, SeverityDebug, format, args...)
+}
+
+// Info emits a log record with [SeverityInfo].
+func (f Facade) Info(ctx context.Context, msg string, keyvals ...any) {
+	f.Log(ctx, SeverityInfo, msg, keyvals...)
+}
+
+// Infof emits a log record with [SeverityInfo] and a formatted message.
+func (f Facade) Infof(ctx context.Context, format string, args ...any) {
+	f.Logf(ctx, SeverityInfo, format, args...)
+}
+
+// Warn emits a log record with [SeverityWarn].
+func (f Facade) Warn(ctx context.Context, msg string, keyvals ...any) {
+	f.Log(ctx, SeverityWarn, msg, keyvals...)
+}
+
+// Warnf emits a log record with [SeverityWarn] and a formatted message.
+func (f Facade) Warnf(ctx context.Context, format string, args ...any) {
+	f.Logf(ctx, SeverityWarn, format, args...)
+}
+
+// Error emits a log record with [SeverityError].
+func (f Facade) Error(ctx context.Context, msg string, keyvals ...any) {
+	f.Log(ctx, SeverityError, msg, keyvals...)
+}
+
+// Errorf emits a log record with [SeverityError] and a formatted message.
+func (f Facade) Errorf(ctx context.Context, format string, args ...any) {
+	f.Logf(ctx, SeverityError, format, args...)
+}
+
+func (f Facade) emit(ctx context.Context, severity Severity, msg string, keyvals []any) {
+	var r Record
+	r.SetTimestamp(time.Now())
+	r.SetSeverity(severity)
+	r.SetSeverityText(severity.String())
+	r.SetBody(StringValue(msg))
+	r.AddAttributes(f.attrs...)
+	if len(keyvals) > 0 {
+		r.AddAttributes(appendKeyVals(nil, keyvals)...)
+	}
+	f.logger.Emit(ctx, r)
+}
+
+// appendKeyVals appends the attributes of keyvals to dst. A value without a
+// key is added with the badKey key.
+func appendKeyVals(dst []KeyValue, keyvals []any) []KeyValue {
+	for len(keyvals) > 0 {
+		switch k := keyvals[0].(type) {
+		case KeyValue:
+			dst = append(dst, k)
+			keyvals = keyvals[1:]
+		case attribute.KeyValue:
+			dst = append(dst, KeyValueFromAttribute(k))
+			keyvals = keyvals[1:]
+		case string:
+			if len(keyvals) == 1 {
+				dst = append(dst, String(badKey, k))
+				return dst
+			}
+			dst = append(dst, KeyValue{Key: k, Value: valueOf(keyvals[1])})
+			keyvals = keyvals[2:]
+		default:
+			dst = append(dst, KeyValue{Key: badKey, Value: valueOf(k)})
+			keyvals = keyvals[1:]
+		}
+	}
+	return dst
+}
+
+// valueOf returns v as a Value.
+func valueOf(v any) Value {
+	switch v := v.(type) {
+	case nil:
+		return Value{}
+	case Value:
+		return v
+	case string:
+		return StringValue(v)
+	case bool:
+		return BoolValue(v)
+	case int:
+		return IntValue(v)
+	case int8:
+		return Int64Value(int64(v))
+	case int16:
+		return Int64Value(int64(v))
+	case int32:
+		return Int64Value(int64(v))
+	case int64:
+		return Int64Value(v)
+	case uint:
+		return uint64Value(uint64(v))
+	case uint8:
+		return Int64Value(int64(v))
+	case uint16:
+		return Int64Value(int64(v))
+	case uint32:
+		return Int64Value(int64(v))
+	case uint64:
+		return uint64Value(v)
+	case float32:
+		return Float64Value(float64(v))
+	case float64:
+		return Float64Value(v)
+	case []byte:
+		return BytesValue(v)
+	case time.Time:
+		return Int64Value(v.UnixNano())
+	case time.Duration:
+		return Int64Value(int64(v))
+	case error:
+		return StringValue(v.Error())
+	case fmt.Stringer:
+		return StringValue(v.String())
+	default:
+		return StringValue(fmt.Sprint(v))
+	}
+}
+
+// uint64Value returns v as an int64 Value, or as a string Value if it
+// overflows an int64.
+func uint64Value(v uint64) Value {
+	if v > math.MaxInt64 {
+		return StringValue(fmt.Sprint(v))
+	}
+	return Int64Value(int64(v)) // nolint:gosec // Overflow checked above.
+}
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/log"
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log/embedded"
+)
+
+type recordingLogger struct {
+	embedded.Logger
+
+	minSeverity Severity
+	records     []Record
+}
+
+func (l *recordingLogger) Emit(_ context.Context, r Record) {
+	l.records = append(l.records, r)
+}
+
+func (l *recordingLogger) Enabled(_ context.Context, param EnabledParameters) bool {
+	return param.Severity >= l.minSeverity
+}
+
+func attributes(r Record) []KeyValue {
+	var kvs []KeyValue
+	r.WalkAttributes(func(kv KeyValue) bool {
+		kvs = append(kvs, kv)
+		return true
+	})
+	return kvs
+}
+
+func TestFacade(t *testing.T) {
+	l := &recordingLogger{minSeverity: SeverityInfo}
+	f := NewFacade(l).With("service", "checkout")
+
+	ctx := context.Background()
+	before := time.Now()
+	f.Debug(ctx, "dropped")
+	f.Info(ctx, "user logged in", "user", "alice", "admin", true)
+	f.Warnf(ctx, "%d retries", 3)
+	f.Error(ctx, "failed", attribute.Int("code", 500))
+
+	require.Len(t, l.records, 3)
+
+	r := l.records[0]
+	assert.Equal(t, SeverityInfo, r.Severity())
+	assert.Equal(t, "INFO", r.SeverityText())
+	assert.Equal(t, StringValue("user logged in"), r.Body())
+	assert.False(t, r.Timestamp().Before(before), "timestamp")
+	assert.Equal(t, []KeyValue{
+		String("service", "checkout"),
+		String("user", "alice"),
+		Bool("admin", true),
+	}, attributes(r))
+
+	r = l.records[1]
+	assert.Equal(t, SeverityWarn, r.Severity())
+	assert.Equal(t, StringValue("3 retries"), r.Body())
+	assert.Equal(t, []KeyValue{String("service", "checkout")}, attributes(r))
+
+	r = l.records[2]
+	assert.Equal(t, SeverityError, r.Severity())
+	assert.Equal(t, []KeyValue{String("service", "checkout"), Int("code", 500)}, attributes(r))
+
+	assert.False(t, f.Enabled(ctx, SeverityDebug))
+	assert.True(t, f.Enabled(ctx, SeverityError))
+}
+
+func TestFacadeWithDoesNotModifyParent(t *testing.T) {
+	l := &recordingLogger{}
+	parent := NewFacade(l).With("a", 1)
+	_ = parent.With("b", 2)
+
+	parent.Info(context.Background(), "msg")
+	require.Len(t, l.records, 1)
+	assert.Equal(t, []KeyValue{Int("a", 1)}, attributes(l.records[0]))
+}
+
+type stringer struct{}
+
+func (stringer) String() string { return "stringer" }
+
+func TestAppendKeyVals(t *testing.T) {
+	got := appendKeyVals(nil, []any{
+		"str", "s",
+		"int8", int8(-1),
+		"uint", uint(1),
+		"uint64", uint64(math.MaxUint64),
+		"float32", float32(0.5),
+		"bytes", []byte("b"),
+		"err", errors.New("err"),
+		"stringer", stringer{},
+		"time", time.Unix(1, 5),
+		"duration", 2*time.Second,
+		"other", []int{1, 2},
+		"nil", nil,
+		"value", IntValue(1),
+		Bool("kv", true),
+		42,
+		"dangling",
+	})
+	assert.Equal(t, []KeyValue{
+		String("str", "s"),
+		Int64("int8", -1),
+		Int64("uint", 1),
+		String("uint64", "18446744073709551615"),
+		Float64("float32", 0.5),
+		Bytes("bytes", []byte("b")),
+		String("err", "err"),
+		String("stringer", "stringer"),
+		Int64("time", 1_000_000_005),
+		Int64("duration", 2_000_000_000),
+		String("other", "[1 2]"),
+		Empty("nil"),
+		Int("value", 1),
+		Bool("kv", true),
+		Int(badKey, 42),
+		String(badKey, "dangling"),
+	}, got)
+}
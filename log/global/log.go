@@ -39,11 +39,26 @@ func Logger(name string, options ...log.LoggerOption) log.Logger {
 // a global LoggerProvider is registered for the first time, the returned
 // LoggerProvider and all of its created Loggers are updated in-place. There is
 // no need to call this function again for an updated instance.
+//
+// Until then, the Loggers created drop the records they are passed to emit and
+// their Enabled method reports false, so callers can avoid building records
+// that would be dropped.
+//
+// GetLoggerProvider, SetLoggerProvider, and the returned LoggerProvider and
+// Loggers are safe for concurrent use. A Logger created concurrently with the
+// first call to SetLoggerProvider is guaranteed to be updated to use the
+// registered LoggerProvider, but the records it emits while the update is in
+// progress may be dropped.
 func GetLoggerProvider() log.LoggerProvider {
 	return global.GetLoggerProvider()
 }
 
 // SetLoggerProvider configures provider as the global [log.LoggerProvider].
+//
+// The first time it is called, the Loggers created from the LoggerProvider
+// previously returned by [GetLoggerProvider] are created from provider, in
+// the order they were originally created. Subsequent calls replace the global
+// LoggerProvider, but do not update the Loggers created before.
 func SetLoggerProvider(provider log.LoggerProvider) {
 	global.SetLoggerProvider(provider)
 }
@@ -7,12 +7,14 @@ package global // import "go.opentelemetry.io/otel/log/internal/global"
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"sync/atomic"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/log/embedded"
+	"go.opentelemetry.io/otel/log/noop"
 )
 
 // instLib defines the instrumentation library a logger is created for.
@@ -23,13 +25,21 @@ type instLib struct {
 	version   string
 	schemaURL string
 	attrs     attribute.Set
+
+	// baggage is whether the context baggage is added to the records.
+	baggage bool
+	// baggageKeys are the NUL separated keys of the baggage members added.
+	baggageKeys string
 }
 
 type loggerProvider struct {
 	embedded.LoggerProvider
 
-	mu       sync.Mutex
-	loggers  map[instLib]*logger
+	mu      sync.Mutex
+	loggers map[instLib]*logger
+	// ordered holds the loggers in the order they were created. Their
+	// delegates are created in this order once the delegate is set.
+	ordered  []*logger
 	delegate log.LoggerProvider
 }
 
@@ -45,37 +55,49 @@ func (p *loggerProvider) Logger(name string, options ...log.LoggerOption) log.Lo
 	}
 
 	cfg := log.NewLoggerConfig(options...)
+	baggageKeys, baggage := cfg.ContextBaggage()
 	key := instLib{
-		name:      name,
-		version:   cfg.InstrumentationVersion(),
-		schemaURL: cfg.SchemaURL(),
-		attrs:     cfg.InstrumentationAttributes(),
-	}
-
-	if p.loggers == nil {
-		l := &logger{name: name, options: options}
-		p.loggers = map[instLib]*logger{key: l}
-		return l
+		name:        name,
+		version:     cfg.InstrumentationVersion(),
+		schemaURL:   cfg.SchemaURL(),
+		attrs:       cfg.InstrumentationAttributes(),
+		baggage:     baggage,
+		baggageKeys: strings.Join(baggageKeys, "\x00"),
 	}
 
 	if l, ok := p.loggers[key]; ok {
 		return l
 	}
 
+	if p.loggers == nil {
+		p.loggers = make(map[instLib]*logger)
+	}
 	l := &logger{name: name, options: options}
 	p.loggers[key] = l
+	p.ordered = append(p.ordered, l)
 	return l
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true.
+func (p *loggerProvider) IsNoop() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.delegate == nil || noop.IsNoop(p.delegate)
+}
+
 func (p *loggerProvider) setDelegate(provider log.LoggerProvider) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
 	p.delegate = provider
-	for _, l := range p.loggers {
+	for _, l := range p.ordered {
 		l.setDelegate(provider)
 	}
-	p.loggers = nil // Only set logger delegates once.
+	// Only set logger delegates once.
+	p.loggers = nil
+	p.ordered = nil
 }
 
 type logger struct {
@@ -96,6 +118,9 @@ func (l *logger) Emit(ctx context.Context, r log.Record) {
 	}
 }
 
+// Enabled returns the answer of the delegate once configured. Otherwise, false
+// is returned because the records emitted before the delegate is configured
+// are dropped.
 func (l *logger) Enabled(ctx context.Context, param log.EnabledParameters) bool {
 	var enabled bool
 	if del, ok := l.delegate.Load().(log.Logger); ok {
@@ -104,6 +129,14 @@ func (l *logger) Enabled(ctx context.Context, param log.EnabledParameters) bool
 	return enabled
 }
 
+// IsNoop implements noop.Reporter by forwarding the call to the delegate if
+// set. Otherwise, it returns true because the records emitted before the
+// delegate is configured are dropped.
+func (l *logger) IsNoop() bool {
+	del, ok := l.delegate.Load().(log.Logger)
+	return !ok || noop.IsNoop(del)
+}
+
 func (l *logger) setDelegate(provider log.LoggerProvider) {
 	l.delegate.Store(provider.Logger(l.name, l.options...))
 }
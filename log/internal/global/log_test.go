@@ -165,6 +165,44 @@ func TestDelegation(t *testing.T) {
 	}
 }
 
+type orderedLoggerProvider struct {
+	embedded.LoggerProvider
+
+	names []string
+}
+
+func (p *orderedLoggerProvider) Logger(name string, _ ...log.LoggerOption) log.Logger {
+	p.names = append(p.names, name)
+	return &testLogger{}
+}
+
+func TestDelegationOrder(t *testing.T) {
+	provider := &loggerProvider{}
+
+	want := []string{"c", "a", "d", "b", "f", "e"}
+	for _, name := range want {
+		provider.Logger(name)
+		// Loggers already created are not created again.
+		provider.Logger(want[0])
+	}
+
+	delegate := &orderedLoggerProvider{}
+	provider.setDelegate(delegate)
+	assert.Equal(t, want, delegate.names, "Loggers not delegated in creation order")
+}
+
+func TestLoggerEnabledDelegation(t *testing.T) {
+	provider := &loggerProvider{}
+	l := provider.Logger("TestLoggerEnabledDelegation")
+
+	ctx := context.Background()
+	param := log.EnabledParameters{Severity: log.SeverityInfo, EventName: "event"}
+	assert.False(t, l.Enabled(ctx, param), "enabled before delegation")
+
+	provider.setDelegate(&testLoggerProvider{})
+	assert.True(t, l.Enabled(ctx, param), "delegate not used")
+}
+
 func TestLoggerIdentity(t *testing.T) {
 	type id struct{ name, ver, url string }
 
@@ -200,3 +238,44 @@ func TestLoggerIdentity(t *testing.T) {
 		}
 	}
 }
+
+func TestLoggerIdentityContextBaggage(t *testing.T) {
+	provider := &loggerProvider{}
+	loggers := []log.Logger{
+		provider.Logger("name"),
+		provider.Logger("name", log.WithContextBaggage()),
+		provider.Logger("name", log.WithContextBaggage("a")),
+		provider.Logger("name", log.WithContextBaggage("a", "b")),
+	}
+	for i, l0 := range loggers {
+		for j, l1 := range loggers {
+			if i != j {
+				assert.NotSamef(t, l0, l1, "logger %d == logger %d", i, j)
+			}
+		}
+	}
+	assert.Same(t, loggers[3], provider.Logger("name", log.WithContextBaggage("a", "b")))
+}
+
+func TestLoggerProviderIsNoop(t *testing.T) {
+	p := &loggerProvider{}
+	l := p.Logger("TestLoggerProviderIsNoop")
+
+	assert.True(t, noop.IsNoop(p), "provider before delegation")
+	assert.True(t, noop.IsNoop(l), "logger before delegation")
+
+	p.setDelegate(&testLoggerProvider{})
+
+	assert.False(t, noop.IsNoop(p), "provider not delegated")
+	assert.False(t, noop.IsNoop(l), "logger not delegated")
+}
+
+func TestLoggerProviderIsNoopDelegatesToNoop(t *testing.T) {
+	p := &loggerProvider{}
+	l := p.Logger("TestLoggerProviderIsNoopDelegatesToNoop")
+
+	p.setDelegate(noop.NewLoggerProvider())
+
+	assert.True(t, noop.IsNoop(p), "provider")
+	assert.True(t, noop.IsNoop(l), "logger")
+}
@@ -50,4 +50,5 @@ func SetLoggerProvider(provider log.LoggerProvider) {
 		}
 	})
 	globalLoggerProvider.Store(loggerProviderHolder{provider: provider})
+	global.SetSignalProvider("logs", provider)
 }
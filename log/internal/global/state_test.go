@@ -20,6 +20,7 @@ func TestSetLoggerProvider(t *testing.T) {
 	reset := func() {
 		globalLoggerProvider = defaultLoggerProvider()
 		delegateLoggerOnce = sync.Once{}
+		global.SetSignalProvider("logs", nil)
 	}
 
 	t.Run("Set With default is a noop", func(t *testing.T) {
@@ -49,6 +50,14 @@ func TestSetLoggerProvider(t *testing.T) {
 		}
 	})
 
+	t.Run("Set() should record the provider to shut down", func(t *testing.T) {
+		t.Cleanup(reset)
+
+		provider := noop.NewLoggerProvider()
+		SetLoggerProvider(provider)
+		assert.Contains(t, global.SignalProviders(), provider)
+	})
+
 	t.Run("Set() should delegate existing Logger Providers", func(t *testing.T) {
 		t.Cleanup(reset)
 
@@ -13,6 +13,7 @@ import (
 	"math"
 	"slices"
 	"strconv"
+	"time"
 	"unsafe"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -35,6 +36,8 @@ const (
 	KindBytes
 	KindSlice
 	KindMap
+	KindTime
+	KindDuration
 )
 
 // A Value represents a structured log value.
@@ -43,13 +46,15 @@ type Value struct {
 	// Ensure forward compatibility by explicitly making this not comparable.
 	noCmp [0]func() //nolint: unused  // This is indeed used.
 
-	// num holds the value for Int64, Float64, and Bool. It holds the length
-	// for String, Bytes, Slice, Map.
+	// num holds the value for Int64, Float64, Bool, and Duration, and the
+	// nanoseconds since the Unix epoch for Time. It holds the length for
+	// String, Bytes, Slice, Map.
 	num uint64
-	// any holds either the KindBool, KindInt64, KindFloat64, stringptr,
-	// bytesptr, sliceptr, or mapptr. If KindBool, KindInt64, or KindFloat64
-	// then the value of Value is in num as described above. Otherwise, it
-	// contains the value wrapped in the appropriate type.
+	// any holds either the KindBool, KindInt64, KindFloat64, KindDuration,
+	// stringptr, bytesptr, sliceptr, mapptr, timeLocation, or timeTime. If
+	// KindBool, KindInt64, KindFloat64, or KindDuration then the value of
+	// Value is in num as described above. Otherwise, it contains the value
+	// wrapped in the appropriate type.
 	any any
 }
 
@@ -62,6 +67,12 @@ type (
 	sliceptr *Value
 	// mapptr represents a value in Value.any for KindMap Values.
 	mapptr *KeyValue
+	// timeLocation represents the location of a value in Value.any for
+	// KindTime Values that can be represented as Unix nanoseconds.
+	timeLocation *time.Location
+	// timeTime represents a value in Value.any for KindTime Values that
+	// cannot be represented as Unix nanoseconds.
+	timeTime time.Time
 )
 
 // StringValue returns a new [Value] for a string.
@@ -95,6 +106,27 @@ func BoolValue(v bool) Value { //nolint:revive // Not a control flag.
 	return Value{num: n, any: KindBool}
 }
 
+// TimeValue returns a [Value] for a time.Time. The monotonic clock reading of
+// v is discarded.
+func TimeValue(v time.Time) Value {
+	if v.IsZero() {
+		// UnixNano is undefined for the zero time.
+		return Value{any: timeTime{}}
+	}
+	nsec := v.UnixNano()
+	if t := time.Unix(0, nsec); t.Equal(v) {
+		return Value{num: uint64(nsec), any: timeLocation(v.Location())} // nolint:gosec // Converted back in asTime.
+	}
+	// The time is outside the range of Unix nanoseconds.
+	return Value{any: timeTime(v.Round(0))}
+}
+
+// DurationValue returns a [Value] for a time.Duration.
+func DurationValue(v time.Duration) Value {
+	// This can be later converted back to time.Duration (overflow not checked).
+	return Value{num: uint64(v), any: KindDuration} // nolint:gosec
+}
+
 // BytesValue returns a [Value] for a byte slice. The passed slice must not be
 // changed after it is passed.
 func BytesValue(v []byte) Value {
@@ -113,6 +145,34 @@ func SliceValue(vs ...Value) Value {
 	}
 }
 
+// BoolSliceValue returns a [Value] of [KindSlice] for a slice of bool.
+func BoolSliceValue(v []bool) Value {
+	return sliceValue(v, BoolValue)
+}
+
+// Int64SliceValue returns a [Value] of [KindSlice] for a slice of int64.
+func Int64SliceValue(v []int64) Value {
+	return sliceValue(v, Int64Value)
+}
+
+// Float64SliceValue returns a [Value] of [KindSlice] for a slice of float64.
+func Float64SliceValue(v []float64) Value {
+	return sliceValue(v, Float64Value)
+}
+
+// StringSliceValue returns a [Value] of [KindSlice] for a slice of string.
+func StringSliceValue(v []string) Value {
+	return sliceValue(v, StringValue)
+}
+
+func sliceValue[T any](v []T, conv func(T) Value) Value {
+	res := make([]Value, 0, len(v))
+	for _, e := range v {
+		res = append(res, conv(e))
+	}
+	return SliceValue(res...)
+}
+
 // MapValue returns a new [Value] for a slice of key-value pairs. The passed
 // slice must not be changed after it is passed.
 func MapValue(kvs ...KeyValue) Value {
@@ -179,6 +239,41 @@ func (v Value) AsFloat64() float64 {
 // KindFloat64, this will return garbage.
 func (v Value) asFloat64() float64 { return math.Float64frombits(v.num) }
 
+// AsTime returns the value held by v as a time.Time.
+func (v Value) AsTime() time.Time {
+	if v.Kind() != KindTime {
+		global.Error(errKind, "AsTime", "Kind", v.Kind())
+		return time.Time{}
+	}
+	return v.asTime()
+}
+
+// asTime returns the value held by v as a time.Time. It will panic if the
+// Value is not KindTime.
+func (v Value) asTime() time.Time {
+	if t, ok := v.any.(timeTime); ok {
+		return time.Time(t)
+	}
+	// Assumes v.num was a valid int64 (overflow not checked).
+	return time.Unix(0, int64(v.num)).In(v.any.(timeLocation)) // nolint:gosec
+}
+
+// AsDuration returns the value held by v as a time.Duration.
+func (v Value) AsDuration() time.Duration {
+	if v.Kind() != KindDuration {
+		global.Error(errKind, "AsDuration", "Kind", v.Kind())
+		return 0
+	}
+	return v.asDuration()
+}
+
+// asDuration returns the value held by v as a time.Duration. If v is not of
+// KindDuration, this will return garbage.
+func (v Value) asDuration() time.Duration {
+	// Assumes v.num was a valid int64 (overflow not checked).
+	return time.Duration(v.num) // nolint:gosec
+}
+
 // AsBytes returns the value held by v as a []byte.
 func (v Value) AsBytes() []byte {
 	if sp, ok := v.any.(bytesptr); ok {
@@ -237,6 +332,8 @@ func (v Value) Kind() Kind {
 		return KindSlice
 	case mapptr:
 		return KindMap
+	case timeLocation, timeTime:
+		return KindTime
 	default:
 		return KindEmpty
 	}
@@ -253,8 +350,10 @@ func (v Value) Equal(w Value) bool {
 		return false
 	}
 	switch k1 {
-	case KindInt64, KindBool:
+	case KindInt64, KindBool, KindDuration:
 		return v.num == w.num
+	case KindTime:
+		return v.asTime().Equal(w.asTime())
 	case KindString:
 		return v.asString() == w.asString()
 	case KindFloat64:
@@ -300,6 +399,10 @@ func (v Value) String() string {
 		return strconv.FormatFloat(v.asFloat64(), 'g', -1, 64)
 	case KindBool:
 		return strconv.FormatBool(v.asBool())
+	case KindTime:
+		return v.asTime().String()
+	case KindDuration:
+		return v.asDuration().String()
 	case KindBytes:
 		return fmt.Sprint(v.asBytes()) // nolint:staticcheck  // Use fmt.Sprint to encode as slice.
 	case KindMap:
@@ -356,6 +459,16 @@ func Bool(key string, value bool) KeyValue {
 	return KeyValue{key, BoolValue(value)}
 }
 
+// Time returns a KeyValue for a time.Time value.
+func Time(key string, value time.Time) KeyValue {
+	return KeyValue{key, TimeValue(value)}
+}
+
+// Duration returns a KeyValue for a time.Duration value.
+func Duration(key string, value time.Duration) KeyValue {
+	return KeyValue{key, DurationValue(value)}
+}
+
 // Bytes returns a KeyValue for a []byte value.
 // The passed slice must not be changed after it is passed.
 func Bytes(key string, value []byte) KeyValue {
@@ -395,39 +508,35 @@ func ValueFromAttribute(value attribute.Value) Value {
 	case attribute.BOOL:
 		return BoolValue(value.AsBool())
 	case attribute.BOOLSLICE:
-		val := value.AsBoolSlice()
-		res := make([]Value, 0, len(val))
-		for _, v := range val {
-			res = append(res, BoolValue(v))
-		}
-		return SliceValue(res...)
+		return BoolSliceValue(value.AsBoolSlice())
 	case attribute.INT64:
 		return Int64Value(value.AsInt64())
 	case attribute.INT64SLICE:
-		val := value.AsInt64Slice()
-		res := make([]Value, 0, len(val))
-		for _, v := range val {
-			res = append(res, Int64Value(v))
-		}
-		return SliceValue(res...)
+		return Int64SliceValue(value.AsInt64Slice())
 	case attribute.FLOAT64:
 		return Float64Value(value.AsFloat64())
 	case attribute.FLOAT64SLICE:
-		val := value.AsFloat64Slice()
-		res := make([]Value, 0, len(val))
-		for _, v := range val {
-			res = append(res, Float64Value(v))
-		}
-		return SliceValue(res...)
+		return Float64SliceValue(value.AsFloat64Slice())
 	case attribute.STRING:
 		return StringValue(value.AsString())
 	case attribute.STRINGSLICE:
-		val := value.AsStringSlice()
-		res := make([]Value, 0, len(val))
-		for _, v := range val {
-			res = append(res, StringValue(v))
+		return StringSliceValue(value.AsStringSlice())
+	case attribute.BYTES:
+		return BytesValue(value.AsBytes())
+	case attribute.SLICE:
+		vals := value.AsSlice()
+		out := make([]Value, len(vals))
+		for i, v := range vals {
+			out[i] = ValueFromAttribute(v)
+		}
+		return SliceValue(out...)
+	case attribute.MAP:
+		kvs := value.AsMap()
+		out := make([]KeyValue, len(kvs))
+		for i, kv := range kvs {
+			out[i] = KeyValueFromAttribute(kv)
 		}
-		return SliceValue(res...)
+		return MapValue(out...)
 	}
 	// This code should never be reached
 	// as log attributes are a superset of standard attributes.
@@ -9,6 +9,7 @@ package log_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
@@ -34,6 +35,8 @@ func TestKind(t *testing.T) {
 		{log.KindSlice, "Slice", 6},
 		{log.KindMap, "Map", 7},
 		{log.KindString, "String", 4},
+		{log.KindTime, "Time", 8},
+		{log.KindDuration, "Duration", 9},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.str, func(t *testing.T) {
@@ -56,6 +59,12 @@ func TestValueEqual(t *testing.T) {
 		log.StringValue("hi"),
 		log.StringValue("bye"),
 		log.BytesValue([]byte{1, 3, 5}),
+		log.TimeValue(time.Unix(1, 0)),
+		log.TimeValue(time.Unix(2, 0)),
+		log.TimeValue(time.Time{}),
+		log.TimeValue(time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)),
+		log.DurationValue(time.Second),
+		log.DurationValue(time.Minute),
 		log.SliceValue(log.StringValue("foo")),
 		log.SliceValue(log.IntValue(3), log.StringValue("foo")),
 		log.MapValue(log.Bool("b", true), log.Int("i", 3)),
@@ -274,6 +283,63 @@ func TestMap(t *testing.T) {
 	})
 }
 
+func TestTime(t *testing.T) {
+	const key = "timeKey"
+	loc := time.FixedZone("UTC+1", 3600)
+	for _, val := range []time.Time{
+		time.Date(2024, 5, 6, 7, 8, 9, 10, loc),
+		// Outside of the range of Unix nanoseconds.
+		time.Date(3000, 1, 2, 3, 4, 5, 6, loc),
+		{},
+	} {
+		kv := log.Time(key, val)
+		testKV(t, key, kv)
+
+		v, k := kv.Value, log.KindTime
+		t.Run("AsBool", testErrKind(v.AsBool, "AsBool", k))
+		t.Run("AsInt64", testErrKind(v.AsInt64, "AsInt64", k))
+		t.Run("AsDuration", testErrKind(v.AsDuration, "AsDuration", k))
+		t.Run("AsString", testErrKind(v.AsString, "AsString", k))
+		t.Run("AsTime", func(t *testing.T) {
+			got := v.AsTime()
+			assert.True(t, val.Equal(got), "AsTime: %v != %v", val, got)
+			assert.Equal(t, val.Location().String(), got.Location().String(), "location")
+		})
+	}
+	t.Run("Monotonic", func(t *testing.T) {
+		now := time.Now()
+		assert.Equal(t, now.Round(0), log.TimeValue(now).AsTime())
+	})
+}
+
+func TestDuration(t *testing.T) {
+	const key = "durationKey"
+	val := 3 * time.Second
+	kv := log.Duration(key, val)
+	testKV(t, key, kv)
+
+	v, k := kv.Value, log.KindDuration
+	t.Run("AsBool", testErrKind(v.AsBool, "AsBool", k))
+	t.Run("AsInt64", testErrKind(v.AsInt64, "AsInt64", k))
+	t.Run("AsTime", testErrKind(v.AsTime, "AsTime", k))
+	t.Run("AsString", testErrKind(v.AsString, "AsString", k))
+	t.Run("AsDuration", func(t *testing.T) {
+		assert.Equal(t, val, v.AsDuration(), "AsDuration")
+	})
+}
+
+func TestTypedSliceValues(t *testing.T) {
+	assert.True(t, log.SliceValue(log.BoolValue(true), log.BoolValue(false)).Equal(
+		log.BoolSliceValue([]bool{true, false})), "BoolSliceValue")
+	assert.True(t, log.SliceValue(log.Int64Value(1), log.Int64Value(2)).Equal(
+		log.Int64SliceValue([]int64{1, 2})), "Int64SliceValue")
+	assert.True(t, log.SliceValue(log.Float64Value(1.5)).Equal(
+		log.Float64SliceValue([]float64{1.5})), "Float64SliceValue")
+	assert.True(t, log.SliceValue(log.StringValue("a"), log.StringValue("b")).Equal(
+		log.StringSliceValue([]string{"a", "b"})), "StringSliceValue")
+	assert.Equal(t, log.KindSlice, log.StringSliceValue(nil).Kind())
+}
+
 func TestEmpty(t *testing.T) {
 	const key = "key"
 	kv := log.Empty(key)
@@ -301,6 +367,8 @@ func TestValueString(t *testing.T) {
 		{log.BoolValue(true), "true"},
 		{log.StringValue("foo"), "foo"},
 		{log.BytesValue([]byte{2, 4, 6}), "[2 4 6]"},
+		{log.TimeValue(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)), "2024-05-06 07:08:09 +0000 UTC"},
+		{log.DurationValue(90 * time.Second), "1m30s"},
 		{log.SliceValue(log.IntValue(3), log.StringValue("foo")), "[3 foo]"},
 		{log.MapValue(log.Int("a", 1), log.Bool("b", true)), "[a:1 b:true]"},
 		{log.Value{}, "<nil>"},
@@ -361,6 +429,27 @@ func TestValueFromAttribute(t *testing.T) {
 			v:    attribute.StringSliceValue([]string{"foo", "bar"}),
 			want: log.SliceValue(log.StringValue("foo"), log.StringValue("bar")),
 		},
+		{
+			desc: "Bytes",
+			v:    attribute.BytesValue([]byte("foo")),
+			want: log.BytesValue([]byte("foo")),
+		},
+		{
+			desc: "Slice",
+			v:    attribute.SliceValue(attribute.StringValue("foo"), attribute.Int64Value(1)),
+			want: log.SliceValue(log.StringValue("foo"), log.Int64Value(1)),
+		},
+		{
+			desc: "Map",
+			v: attribute.MapValue(
+				attribute.String("foo", "bar"),
+				attribute.Slice("baz", attribute.BoolValue(true)),
+			),
+			want: log.MapValue(
+				log.Slice("baz", log.BoolValue(true)),
+				log.String("foo", "bar"),
+			),
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -488,6 +577,8 @@ func TestAllocationLimits(t *testing.T) {
 		s     string
 		slice []log.Value
 		m     []log.KeyValue
+		tm    time.Time
+		d     time.Duration
 	)
 
 	assert.Equal(t, 0.0, testing.AllocsPerRun(runs, func() {
@@ -525,6 +616,15 @@ func TestAllocationLimits(t *testing.T) {
 		m = log.Map(key, mapVal...).Value.AsMap()
 	}), "Map.AsMap")
 
+	timeVal := time.Unix(1, 2)
+	assert.Equal(t, 0.0, testing.AllocsPerRun(runs, func() {
+		tm = log.Time(key, timeVal).Value.AsTime()
+	}), "Time.AsTime")
+
+	assert.Equal(t, 0.0, testing.AllocsPerRun(runs, func() {
+		d = log.Duration(key, time.Second).Value.AsDuration()
+	}), "Duration.AsDuration")
+
 	// Convince the linter these values are used.
-	_, _, _, _, _, _, _ = i, f, b, by, s, slice, m
+	_, _, _, _, _, _, _, _, _ = i, f, b, by, s, slice, m, tm, d
 }
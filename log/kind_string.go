@@ -16,11 +16,13 @@ func _() {
 	_ = x[KindBytes-5]
 	_ = x[KindSlice-6]
 	_ = x[KindMap-7]
+	_ = x[KindTime-8]
+	_ = x[KindDuration-9]
 }
 
-const _Kind_name = "EmptyBoolFloat64Int64StringBytesSliceMap"
+const _Kind_name = "EmptyBoolFloat64Int64StringBytesSliceMapTimeDuration"
 
-var _Kind_index = [...]uint8{0, 5, 9, 16, 21, 27, 32, 37, 40}
+var _Kind_index = [...]uint8{0, 5, 9, 16, 21, 27, 32, 37, 40, 44, 52}
 
 func (i Kind) String() string {
 	if i < 0 || i >= Kind(len(_Kind_index)-1) {
@@ -5,6 +5,7 @@ package log // import "go.opentelemetry.io/otel/log"
 
 import (
 	"context"
+	"slices"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/log/embedded"
@@ -71,6 +72,9 @@ type LoggerConfig struct {
 	version   string
 	schemaURL string
 	attrs     attribute.Set
+
+	baggage     bool
+	baggageKeys []string
 }
 
 // NewLoggerConfig returns a new [LoggerConfig] with all the options applied.
@@ -99,6 +103,13 @@ func (cfg LoggerConfig) SchemaURL() string {
 	return cfg.schemaURL
 }
 
+// ContextBaggage returns whether members of the baggage in the context passed
+// to Emit are added to the emitted log records as attributes, and the keys of
+// the members to add. All members are added if keys is empty.
+func (cfg LoggerConfig) ContextBaggage() (keys []string, ok bool) {
+	return cfg.baggageKeys, cfg.baggage
+}
+
 type loggerOptionFunc func(LoggerConfig) LoggerConfig
 
 func (fn loggerOptionFunc) applyLogger(cfg LoggerConfig) LoggerConfig {
@@ -134,7 +145,29 @@ func WithSchemaURL(schemaURL string) LoggerOption {
 	})
 }
 
+// WithContextBaggage returns a [LoggerOption] that instructs the
+// implementation to add the members of the baggage in the context passed to
+// Emit to the emitted log records as attributes. Only the members with the
+// passed keys are added. If no keys are passed, all members are added.
+//
+// This can be used to consistently annotate the log records and the spans of
+// a request with request-scoped values propagated as baggage.
+//
+// Attributes of the emitted record take precedence over baggage members with
+// the same key.
+func WithContextBaggage(keys ...string) LoggerOption {
+	return loggerOptionFunc(func(config LoggerConfig) LoggerConfig {
+		config.baggage = true
+		config.baggageKeys = slices.Clone(keys)
+		return config
+	})
+}
+
 // EnabledParameters represents payload for [Logger]'s Enabled method.
 type EnabledParameters struct {
+	// Severity is the severity of the record that would be emitted.
 	Severity Severity
+	// EventName is the event name of the record that would be emitted. It
+	// is empty if the record is not an event.
+	EventName string
 }
@@ -29,4 +29,18 @@ func TestNewLoggerConfig(t *testing.T) {
 	assert.Equal(t, version, c.InstrumentationVersion(), "instrumentation version")
 	assert.Equal(t, schemaURL, c.SchemaURL(), "schema URL")
 	assert.Equal(t, attr, c.InstrumentationAttributes(), "instrumentation attributes")
+
+	keys, ok := c.ContextBaggage()
+	assert.False(t, ok, "context baggage")
+	assert.Empty(t, keys, "context baggage keys")
+}
+
+func TestWithContextBaggage(t *testing.T) {
+	keys, ok := log.NewLoggerConfig(log.WithContextBaggage()).ContextBaggage()
+	assert.True(t, ok, "all members")
+	assert.Empty(t, keys, "all members")
+
+	keys, ok = log.NewLoggerConfig(log.WithContextBaggage("user", "tenant")).ContextBaggage()
+	assert.True(t, ok, "selected members")
+	assert.Equal(t, []string{"user", "tenant"}, keys, "selected members")
 }
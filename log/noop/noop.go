@@ -11,6 +11,10 @@
 // [OpenTelemetry Logs API]. Doing so will mean the implementation
 // defaults to no operation for methods it does not implement.
 //
+// IsNoop reports whether an implementation performs no operations. Types
+// embedding the types of this package, like test doubles overriding some of
+// their methods, are not reported as no-op.
+//
 // [OpenTelemetry Logs API]: https://pkg.go.dev/go.opentelemetry.io/otel/log
 package noop // import "go.opentelemetry.io/otel/log/noop"
 
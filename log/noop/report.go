@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package noop // import "go.opentelemetry.io/otel/log/noop"
+
+import "reflect"
+
+// Reporter is an optional interface implemented by implementations of the
+// OpenTelemetry log API to report, at runtime, whether they perform no
+// operations. It allows implementations that are not defined in this
+// package, or that only become no-op at runtime, to be detected by IsNoop.
+type Reporter interface {
+	// IsNoop returns true if the implementation performs no operations.
+	IsNoop() bool
+}
+
+// IsNoop returns true if v is a LoggerProvider or Logger of this package, or
+// if v implements Reporter and reports it performs no operations.
+//
+// Instrumentation can use it to skip the computation of telemetry that would
+// be discarded. It is cheap enough to be called for each operation. Do not
+// cache its result for a Reporter, as it can stop performing no operations
+// at runtime, e.g. the global LoggerProvider and its Loggers once an SDK
+// LoggerProvider is set.
+//
+// Types embedding the types of this package, e.g. test doubles overriding
+// some methods, are not reported as no-op unless they implement Reporter.
+func IsNoop(v any) bool {
+	if r, ok := v.(Reporter); ok {
+		return r.IsNoop()
+	}
+	return isPkgType(v)
+}
+
+var pkgPath = reflect.TypeOf((*Reporter)(nil)).Elem().PkgPath()
+
+func isPkgType(v any) bool {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return false
+	}
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.PkgPath() == pkgPath
+}
@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package noop_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/noop"
+)
+
+type reporter bool
+
+func (r reporter) IsNoop() bool { return bool(r) }
+
+// testLogger overrides Emit and embeds noop.Logger for all other methods.
+type testLogger struct {
+	noop.Logger
+
+	records []log.Record
+}
+
+func (l *testLogger) Emit(_ context.Context, r log.Record) {
+	l.records = append(l.records, r)
+}
+
+func TestIsNoop(t *testing.T) {
+	assert.True(t, noop.IsNoop(noop.NewLoggerProvider()))
+	assert.True(t, noop.IsNoop(noop.NewLoggerProvider().Logger("")))
+	assert.True(t, noop.IsNoop(&noop.Logger{}))
+	assert.True(t, noop.IsNoop(reporter(true)))
+
+	assert.False(t, noop.IsNoop(nil))
+	assert.False(t, noop.IsNoop(reporter(false)))
+	assert.False(t, noop.IsNoop(&testLogger{}), "embedding type")
+}
@@ -26,6 +26,9 @@ type Record struct {
 	severity          Severity
 	severityText      string
 	body              Value
+	// bodyFunc, if not nil, returns the body. It is called, and cleared,
+	// the first time the body is accessed.
+	bodyFunc func() Value
 
 	// The fields below are for optimizing the implementation of Attributes and
 	// AddAttributes. This design is borrowed from the slog Record type:
@@ -44,6 +47,10 @@ type Record struct {
 	//   - len(back) > 0 if nFront == len(front)
 	//   - Unused array elements are zero-ed. Used to detect mistakes.
 	back []KeyValue
+
+	// attrFuncs return attributes added to the record the first time its
+	// attributes are accessed.
+	attrFuncs []func() []KeyValue
 }
 
 // EventName returns the event name.
@@ -101,18 +108,35 @@ func (r *Record) SetSeverityText(text string) {
 }
 
 // Body returns the body of the log record.
+//
+// If the body was set with SetBodyFunc, the function is called the first
+// time Body is called.
 func (r *Record) Body() Value {
+	if r.bodyFunc != nil {
+		r.body, r.bodyFunc = r.bodyFunc(), nil
+	}
 	return r.body
 }
 
 // SetBody sets the body of the log record.
 func (r *Record) SetBody(v Value) {
-	r.body = v
+	r.body, r.bodyFunc = v, nil
+}
+
+// SetBodyFunc sets f to lazily compute the body of the log record. f is only
+// called if the body is accessed, e.g. when the record is processed by an
+// implementation that did not filter it out. This avoids the cost of
+// computing a body that is dropped.
+//
+// f needs to be safe to call after SetBodyFunc returns.
+func (r *Record) SetBodyFunc(f func() Value) {
+	r.body, r.bodyFunc = Value{}, f
 }
 
 // WalkAttributes walks all attributes the log record holds by calling f for
 // each on each [KeyValue] in the [Record]. Iteration stops if f returns false.
 func (r *Record) WalkAttributes(f func(KeyValue) bool) {
+	r.resolveAttributes()
 	for i := 0; i < r.nFront; i++ {
 		if !f(r.front[i]) {
 			return
@@ -138,7 +162,34 @@ func (r *Record) AddAttributes(attrs ...KeyValue) {
 	r.back = append(r.back, attrs[i:]...)
 }
 
+// AddAttributesFunc adds f to lazily compute attributes of the log record. f
+// is only called if the attributes are accessed, e.g. when the record is
+// processed by an implementation that did not filter it out. This avoids the
+// cost of computing attributes that are dropped.
+//
+// The attributes returned by f are added after the ones added with
+// AddAttributes, in the order the functions are added.
+//
+// f needs to be safe to call after AddAttributesFunc returns.
+func (r *Record) AddAttributesFunc(f func() []KeyValue) {
+	r.attrFuncs = append(r.attrFuncs, f)
+}
+
+// resolveAttributes adds the attributes of the functions added with
+// AddAttributesFunc.
+func (r *Record) resolveAttributes() {
+	if len(r.attrFuncs) == 0 {
+		return
+	}
+	funcs := r.attrFuncs
+	r.attrFuncs = nil
+	for _, f := range funcs {
+		r.AddAttributes(f()...)
+	}
+}
+
 // AttributesLen returns the number of attributes in the log record.
 func (r *Record) AttributesLen() int {
+	r.resolveAttributes()
 	return r.nFront + len(r.back)
 }
@@ -101,6 +101,53 @@ func TestRecordAttributes(t *testing.T) {
 	})
 }
 
+func TestRecordBodyFunc(t *testing.T) {
+	body := log.StringValue("testing body value")
+
+	var calls int
+	var r log.Record
+	r.SetBodyFunc(func() log.Value {
+		calls++
+		return body
+	})
+	assert.Equal(t, 0, calls, "body resolved before access")
+	assert.Equal(t, body, r.Body())
+	assert.Equal(t, body, r.Body())
+	assert.Equal(t, 1, calls, "body not resolved once")
+
+	r.SetBodyFunc(func() log.Value {
+		calls++
+		return body
+	})
+	other := log.IntValue(1)
+	r.SetBody(other)
+	assert.Equal(t, other, r.Body())
+	assert.Equal(t, 1, calls, "overridden body resolved")
+}
+
+func TestRecordAttributesFunc(t *testing.T) {
+	eager := log.String("k1", "str")
+	lazy := []log.KeyValue{log.Int("k2", 2), log.Bool("k3", true)}
+
+	var calls int
+	var r log.Record
+	r.AddAttributesFunc(func() []log.KeyValue {
+		calls++
+		return lazy
+	})
+	r.AddAttributes(eager)
+	assert.Equal(t, 0, calls, "attributes resolved before access")
+
+	require.Equal(t, 3, r.AttributesLen())
+	var got []log.KeyValue
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		got = append(got, kv)
+		return true
+	})
+	assert.Equal(t, []log.KeyValue{eager, lazy[0], lazy[1]}, got)
+	assert.Equal(t, 1, calls, "attributes not resolved once")
+}
+
 func TestRecordAllocationLimits(t *testing.T) {
 	const runs = 5
 
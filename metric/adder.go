@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/metric"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+
+	"go.opentelemetry.io/otel/metric/embedded"
+)
+
+// Adder records increments of a value with type N.
+//
+// It is implemented by the counter and up-down counter instruments of the
+// matching numeric type. Code that only needs to add values can accept an
+// Adder instead of having a separate code path for each numeric type.
+type Adder[N int64 | float64] interface {
+	// Add records a change to the value.
+	Add(ctx context.Context, incr N, options ...AddOption)
+}
+
+var (
+	_ Adder[int64]   = Int64Counter(nil)
+	_ Adder[int64]   = Int64UpDownCounter(nil)
+	_ Adder[float64] = Float64Counter(nil)
+	_ Adder[float64] = Float64UpDownCounter(nil)
+)
+
+// AdapterOption applies options to the counter adapters.
+type AdapterOption interface {
+	applyAdapter(adapterConfig) adapterConfig
+}
+
+type adapterConfig struct {
+	handle func(error)
+}
+
+func newAdapterConfig(opts []AdapterOption) adapterConfig {
+	var cfg adapterConfig
+	for _, o := range opts {
+		cfg = o.applyAdapter(cfg)
+	}
+	if cfg.handle == nil {
+		cfg.handle = func(err error) { log.Print(err) }
+	}
+	return cfg
+}
+
+type adapterOptionFunc func(adapterConfig) adapterConfig
+
+func (fn adapterOptionFunc) applyAdapter(cfg adapterConfig) adapterConfig {
+	return fn(cfg)
+}
+
+// WithAdapterErrorHandler sets the function the adapter reports values that
+// cannot be represented by the numeric type of the adapted counter to.
+//
+// By default these errors are written to the standard logger. Use
+// otel.Handle to report them to the global error handler instead.
+func WithAdapterErrorHandler(handle func(error)) AdapterOption {
+	return adapterOptionFunc(func(cfg adapterConfig) adapterConfig {
+		cfg.handle = handle
+		return cfg
+	})
+}
+
+// Float64CounterFromInt64 returns a Float64Counter that records its
+// measurements with c.
+//
+// The fractional part of the float64 values is discarded when they are
+// converted to int64 values. Values outside of the int64 range are clamped
+// to the closest int64 value. NaN values are dropped by Add and converted to
+// 0 by Measurement. All of these are reported to the error handler of the adapter (see [WithAdapterErrorHandler]).
+func Float64CounterFromInt64(c Int64Counter, opts ...AdapterOption) Float64Counter {
+	return float64Counter{c: c, cfg: newAdapterConfig(opts)}
+}
+
+type float64Counter struct {
+	embedded.Float64Counter
+
+	c   Int64Counter
+	cfg adapterConfig
+}
+
+func (c float64Counter) Add(ctx context.Context, incr float64, options ...AddOption) {
+	if math.IsNaN(incr) {
+		c.cfg.handle(errors.New("metric: dropping NaN value added to an int64 counter"))
+		return
+	}
+	c.c.Add(ctx, c.toInt64(incr), options...)
+}
+
+func (c float64Counter) Enabled(ctx context.Context) bool {
+	return c.c.Enabled(ctx)
+}
+
+func (c float64Counter) Measurement(value float64) Measurement {
+	return c.c.Measurement(c.toInt64(value))
+}
+
+// toInt64 converts v to an int64, clamping the values outside of the int64
+// range. NaN is converted to 0.
+func (c float64Counter) toInt64(v float64) int64 {
+	switch {
+	case math.IsNaN(v):
+		c.cfg.handle(errors.New("metric: NaN value converted to 0 for an int64 counter"))
+		return 0
+	case v >= math.MaxInt64:
+		c.cfg.handle(fmt.Errorf("metric: value %g truncated to the int64 maximum", v))
+		return math.MaxInt64
+	case v < math.MinInt64:
+		c.cfg.handle(fmt.Errorf("metric: value %g truncated to the int64 minimum", v))
+		return math.MinInt64
+	}
+	return int64(v)
+}
+
+// Int64CounterFromFloat64 returns an Int64Counter that records its
+// measurements with c.
+//
+// Values with an absolute value greater than 2^53 may lose precision when
+// they are converted to float64 values. The loss is reported to the error
+// handler of the adapter (see [WithAdapterErrorHandler]).
+func Int64CounterFromFloat64(c Float64Counter, opts ...AdapterOption) Int64Counter {
+	return int64Counter{c: c, cfg: newAdapterConfig(opts)}
+}
+
+type int64Counter struct {
+	embedded.Int64Counter
+
+	c   Float64Counter
+	cfg adapterConfig
+}
+
+func (c int64Counter) Add(ctx context.Context, incr int64, options ...AddOption) {
+	c.c.Add(ctx, c.toFloat64(incr), options...)
+}
+
+func (c int64Counter) Enabled(ctx context.Context) bool {
+	return c.c.Enabled(ctx)
+}
+
+func (c int64Counter) Measurement(value int64) Measurement {
+	return c.c.Measurement(c.toFloat64(value))
+}
+
+// maxExactFloat64 is the largest magnitude of the integers float64 values
+// represent exactly.
+const maxExactFloat64 = 1 << 53
+
+// toFloat64 converts v to a float64 and reports the loss of precision.
+func (c int64Counter) toFloat64(v int64) float64 {
+	f := float64(v)
+	if (v > maxExactFloat64 || v < -maxExactFloat64) && (f >= math.MaxInt64 || int64(f) != v) {
+		c.cfg.handle(fmt.Errorf("metric: value %d rounded to %g for a float64 counter", v, f))
+	}
+	return f
+}
@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/metric"
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/metric/embedded"
+)
+
+type int64CounterRecorder struct {
+	embedded.Int64Counter
+
+	got []int64
+}
+
+func (c *int64CounterRecorder) Add(_ context.Context, incr int64, _ ...AddOption) {
+	c.got = append(c.got, incr)
+}
+
+func (c *int64CounterRecorder) Enabled(context.Context) bool { return true }
+
+func (c *int64CounterRecorder) Measurement(value int64) Measurement {
+	return NewInt64Measurement(c, value)
+}
+
+type float64CounterRecorder struct {
+	embedded.Float64Counter
+
+	got []float64
+}
+
+func (c *float64CounterRecorder) Add(_ context.Context, incr float64, _ ...AddOption) {
+	c.got = append(c.got, incr)
+}
+
+func (c *float64CounterRecorder) Enabled(context.Context) bool { return true }
+
+func (c *float64CounterRecorder) Measurement(value float64) Measurement {
+	return NewFloat64Measurement(c, value)
+}
+
+func add[N int64 | float64](a Adder[N], values ...N) {
+	for _, v := range values {
+		a.Add(context.Background(), v)
+	}
+}
+
+func TestFloat64CounterFromInt64(t *testing.T) {
+	rec := &int64CounterRecorder{}
+	c := Float64CounterFromInt64(rec)
+
+	add(c, 1, 2.9)
+	assert.Equal(t, []int64{1, 2}, rec.got)
+	assert.True(t, c.Enabled(context.Background()))
+
+	m := c.Measurement(3.5)
+	assert.Same(t, rec, m.Instrument())
+	assert.Equal(t, int64(3), m.Int64Value())
+}
+
+func TestInt64CounterFromFloat64(t *testing.T) {
+	rec := &float64CounterRecorder{}
+	c := Int64CounterFromFloat64(rec)
+
+	add(c, 1, 2)
+	assert.Equal(t, []float64{1, 2}, rec.got)
+	assert.True(t, c.Enabled(context.Background()))
+
+	m := c.Measurement(3)
+	assert.Same(t, rec, m.Instrument())
+	assert.Equal(t, 3.0, m.Float64Value())
+}
+
+func TestFloat64CounterFromInt64OutOfRange(t *testing.T) {
+	rec := &int64CounterRecorder{}
+	var errs []error
+	c := Float64CounterFromInt64(rec, WithAdapterErrorHandler(func(err error) {
+		errs = append(errs, err)
+	}))
+
+	add(c, math.MaxFloat64, math.Inf(-1), math.NaN(), 1)
+	assert.Equal(t, []int64{math.MaxInt64, math.MinInt64, 1}, rec.got)
+	assert.Len(t, errs, 3)
+
+	m := c.Measurement(math.NaN())
+	assert.Equal(t, int64(0), m.Int64Value())
+	assert.Len(t, errs, 4)
+}
+
+func TestInt64CounterFromFloat64PrecisionLoss(t *testing.T) {
+	rec := &float64CounterRecorder{}
+	var errs []error
+	c := Int64CounterFromFloat64(rec, WithAdapterErrorHandler(func(err error) {
+		errs = append(errs, err)
+	}))
+
+	add(c, 1<<53, 1<<60, 1<<53+1, math.MaxInt64)
+	assert.Equal(t, []float64{1 << 53, 1 << 60, 1 << 53, 1 << 63}, rec.got)
+	assert.Len(t, errs, 2)
+}
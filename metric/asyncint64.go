@@ -6,6 +6,7 @@ package metric // import "go.opentelemetry.io/otel/metric"
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric/embedded"
 )
 
@@ -40,9 +41,10 @@ type Int64ObservableCounter interface {
 // Int64ObservableCounterConfig contains options for asynchronous counter
 // instruments that record int64 values.
 type Int64ObservableCounterConfig struct {
-	description string
-	unit        string
-	callbacks   []Int64Callback
+	description   string
+	unit          string
+	callbacks     []Int64Callback
+	attributeKeys []attribute.Key
 }
 
 // NewInt64ObservableCounterConfig returns a new [Int64ObservableCounterConfig]
@@ -65,6 +67,11 @@ func (c Int64ObservableCounterConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys.
+func (c Int64ObservableCounterConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Callbacks returns the configured callbacks.
 func (c Int64ObservableCounterConfig) Callbacks() []Int64Callback {
 	return c.callbacks
@@ -98,9 +105,10 @@ type Int64ObservableUpDownCounter interface {
 // Int64ObservableUpDownCounterConfig contains options for asynchronous counter
 // instruments that record int64 values.
 type Int64ObservableUpDownCounterConfig struct {
-	description string
-	unit        string
-	callbacks   []Int64Callback
+	description   string
+	unit          string
+	callbacks     []Int64Callback
+	attributeKeys []attribute.Key
 }
 
 // NewInt64ObservableUpDownCounterConfig returns a new
@@ -125,6 +133,11 @@ func (c Int64ObservableUpDownCounterConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys.
+func (c Int64ObservableUpDownCounterConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Callbacks returns the configured callbacks.
 func (c Int64ObservableUpDownCounterConfig) Callbacks() []Int64Callback {
 	return c.callbacks
@@ -157,9 +170,10 @@ type Int64ObservableGauge interface {
 // Int64ObservableGaugeConfig contains options for asynchronous counter
 // instruments that record int64 values.
 type Int64ObservableGaugeConfig struct {
-	description string
-	unit        string
-	callbacks   []Int64Callback
+	description   string
+	unit          string
+	callbacks     []Int64Callback
+	attributeKeys []attribute.Key
 }
 
 // NewInt64ObservableGaugeConfig returns a new [Int64ObservableGaugeConfig]
@@ -182,6 +196,11 @@ func (c Int64ObservableGaugeConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys.
+func (c Int64ObservableGaugeConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Callbacks returns the configured callbacks.
 func (c Int64ObservableGaugeConfig) Callbacks() []Int64Callback {
 	return c.callbacks
@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/metric"
+
+// HistogramBuckets is a set of measurements that have already been aggregated
+// into explicit buckets (e.g. by an HDR histogram of another library).
+//
+// It is recorded with the RecordBuckets method of a histogram instrument.
+type HistogramBuckets struct {
+	// Bounds are the inclusive upper bounds of the buckets in increasing
+	// order.
+	Bounds []float64
+	// Counts are the number of measurements in each bucket. It needs to
+	// contain len(Bounds)+1 elements, the last one being the number of
+	// measurements greater than the last bound.
+	Counts []uint64
+	// Sum is the sum of the measurements.
+	Sum float64
+	// Min is the minimum measurement.
+	Min float64
+	// Max is the maximum measurement.
+	Max float64
+}
+
+// Count returns the number of measurements in b.
+func (b HistogramBuckets) Count() uint64 {
+	var n uint64
+	for _, c := range b.Counts {
+		n += c
+	}
+	return n
+}
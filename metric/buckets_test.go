@@ -0,0 +1,15 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/metric"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogramBucketsCount(t *testing.T) {
+	assert.Equal(t, uint64(0), HistogramBuckets{}.Count())
+	assert.Equal(t, uint64(9), HistogramBuckets{Counts: []uint64{2, 3, 4}}.Count())
+}
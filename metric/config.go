@@ -10,6 +10,7 @@ type MeterConfig struct {
 	instrumentationVersion string
 	schemaURL              string
 	attrs                  attribute.Set
+	meterAttrs             attribute.Set
 
 	// Ensure forward compatibility by explicitly making this not comparable.
 	noCmp [0]func() //nolint: unused  // This is indeed used.
@@ -27,6 +28,12 @@ func (cfg MeterConfig) InstrumentationAttributes() attribute.Set {
 	return cfg.attrs
 }
 
+// MeterAttributes returns the attributes added to every measurement made with
+// the Meter.
+func (cfg MeterConfig) MeterAttributes() attribute.Set {
+	return cfg.meterAttrs
+}
+
 // SchemaURL is the schema_url of the library providing instrumentation.
 func (cfg MeterConfig) SchemaURL() string {
 	return cfg.schemaURL
@@ -72,6 +79,25 @@ func WithInstrumentationAttributes(attr ...attribute.KeyValue) MeterOption {
 	})
 }
 
+// WithMeterAttributes sets constant attributes added to every measurement
+// made by the instruments of the Meter, including the observations made in
+// the callbacks of its asynchronous instruments. This can be used to
+// identify a component (e.g. component=cache) without passing its attributes
+// at every call site.
+//
+// Unlike the attributes set with WithInstrumentationAttributes, these
+// attributes are added to the attributes of the measurements. If a
+// measurement has an attribute with the same key, the value of the
+// measurement is used.
+//
+// The passed attributes will be de-duplicated.
+func WithMeterAttributes(attr ...attribute.KeyValue) MeterOption {
+	return meterOptionFunc(func(config MeterConfig) MeterConfig {
+		config.meterAttrs = attribute.NewSet(attr...)
+		return config
+	})
+}
+
 // WithSchemaURL sets the schema URL.
 func WithSchemaURL(schemaURL string) MeterOption {
 	return meterOptionFunc(func(config MeterConfig) MeterConfig {
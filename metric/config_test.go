@@ -24,9 +24,11 @@ func TestConfig(t *testing.T) {
 		metric.WithInstrumentationVersion(version),
 		metric.WithSchemaURL(schemaURL),
 		metric.WithInstrumentationAttributes(attr.ToSlice()...),
+		metric.WithMeterAttributes(attribute.String("component", "cache")),
 	)
 
 	assert.Equal(t, version, c.InstrumentationVersion(), "instrumentation version")
 	assert.Equal(t, schemaURL, c.SchemaURL(), "schema URL")
 	assert.Equal(t, attr, c.InstrumentationAttributes(), "instrumentation attributes")
+	assert.Equal(t, attribute.NewSet(attribute.String("component", "cache")), c.MeterAttributes(), "meter attributes")
 }
@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/metric"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type attributesKey struct{}
+
+// ContextWithAttributes returns a copy of parent with attrs added to the
+// measurement attributes stored in it. This can be used to attach attributes
+// once (e.g. in a middleware) instead of passing them to every measurement.
+//
+// SDKs can be configured to add these attributes to the measurements of
+// synchronous instruments made with the returned context, or a context
+// derived from it. If an attribute key is already stored in parent, the value
+// from attrs is used.
+func ContextWithAttributes(parent context.Context, attrs ...attribute.KeyValue) context.Context {
+	if existing := AttributesFromContext(parent); existing.Len() > 0 {
+		attrs = append(existing.ToSlice(), attrs...)
+	}
+	return context.WithValue(parent, attributesKey{}, attribute.NewSet(attrs...))
+}
+
+// AttributesFromContext returns the measurement attributes stored in ctx with
+// ContextWithAttributes. An empty set is returned if there are none.
+func AttributesFromContext(ctx context.Context) attribute.Set {
+	if s, ok := ctx.Value(attributesKey{}).(attribute.Set); ok {
+		return s
+	}
+	return *attribute.EmptySet()
+}
@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/metric"
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestContextWithAttributes(t *testing.T) {
+	ctx := context.Background()
+	empty := AttributesFromContext(ctx)
+	assert.Equal(t, 0, empty.Len())
+
+	ctx = ContextWithAttributes(ctx, attribute.String("a", "1"), attribute.String("b", "1"))
+	child := ContextWithAttributes(ctx, attribute.String("b", "2"), attribute.String("c", "2"))
+
+	assert.Equal(t, attribute.NewSet(
+		attribute.String("a", "1"),
+		attribute.String("b", "1"),
+	), AttributesFromContext(ctx), "parent modified")
+	assert.Equal(t, attribute.NewSet(
+		attribute.String("a", "1"),
+		attribute.String("b", "2"),
+		attribute.String("c", "2"),
+	), AttributesFromContext(child))
+}
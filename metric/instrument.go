@@ -3,7 +3,11 @@
 
 package metric // import "go.opentelemetry.io/otel/metric"
 
-import "go.opentelemetry.io/otel/attribute"
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
 
 // Observable is used as a grouping mechanism for all instruments that are
 // updated within a Callback.
@@ -194,6 +198,7 @@ func (o unitOpt) applyInt64ObservableGauge(c Int64ObservableGaugeConfig) Int64Ob
 // WithUnit sets the instrument unit.
 //
 // The unit u should be defined using the appropriate [UCUM](https://ucum.org) case-sensitive code.
+// Constants are provided for common units (e.g. [UnitSeconds]).
 func WithUnit(u string) InstrumentOption { return unitOpt(u) }
 
 // WithExplicitBucketBoundaries sets the instrument explicit bucket boundaries.
@@ -213,6 +218,104 @@ func (o bucketOpt) applyInt64Histogram(c Int64HistogramConfig) Int64HistogramCon
 	return c
 }
 
+// WithAttributeKeys sets the attribute keys the instrument is expected to be
+// recorded with.
+//
+// This option is considered "advisory", and may be ignored by API
+// implementations. The SDK uses these keys as the default attribute filter of
+// the instrument: attributes with other keys are dropped unless a View
+// configures a different filter. Instrumentation can use the same keys to
+// avoid constructing attributes that would be dropped.
+func WithAttributeKeys(keys ...attribute.Key) InstrumentOption {
+	return attrKeysOpt(keys)
+}
+
+type attrKeysOpt []attribute.Key
+
+func (o attrKeysOpt) applyFloat64Counter(c Float64CounterConfig) Float64CounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64UpDownCounter(
+	c Float64UpDownCounterConfig,
+) Float64UpDownCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64Histogram(c Float64HistogramConfig) Float64HistogramConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64Gauge(c Float64GaugeConfig) Float64GaugeConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64ObservableCounter(
+	c Float64ObservableCounterConfig,
+) Float64ObservableCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64ObservableUpDownCounter(
+	c Float64ObservableUpDownCounterConfig,
+) Float64ObservableUpDownCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64ObservableGauge(
+	c Float64ObservableGaugeConfig,
+) Float64ObservableGaugeConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64Counter(c Int64CounterConfig) Int64CounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64UpDownCounter(c Int64UpDownCounterConfig) Int64UpDownCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64Histogram(c Int64HistogramConfig) Int64HistogramConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64Gauge(c Int64GaugeConfig) Int64GaugeConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64ObservableCounter(
+	c Int64ObservableCounterConfig,
+) Int64ObservableCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64ObservableUpDownCounter(
+	c Int64ObservableUpDownCounterConfig,
+) Int64ObservableUpDownCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64ObservableGauge(
+	c Int64ObservableGaugeConfig,
+) Int64ObservableGaugeConfig {
+	c.attributeKeys = o
+	return c
+}
+
 // AddOption applies options to an addition measurement. See
 // [MeasurementOption] for other options that can be used as an AddOption.
 type AddOption interface {
@@ -246,7 +349,8 @@ type RecordOption interface {
 
 // RecordConfig contains options for a recorded measurement.
 type RecordConfig struct {
-	attrs attribute.Set
+	attrs     attribute.Set
+	timestamp time.Time
 }
 
 // NewRecordConfig returns a new [RecordConfig] with all opts applied.
@@ -263,6 +367,32 @@ func (c RecordConfig) Attributes() attribute.Set {
 	return c.attrs
 }
 
+// Timestamp returns the configured time the measurement was made at. The
+// zero value means the measurement was made when it was recorded.
+func (c RecordConfig) Timestamp() time.Time {
+	return c.timestamp
+}
+
+type timestampOpt time.Time
+
+func (o timestampOpt) applyRecord(c RecordConfig) RecordConfig {
+	c.timestamp = time.Time(o)
+	return c
+}
+
+// WithTimestamp sets the time a measurement recorded by a synchronous Gauge
+// was made at. This allows readings collected in batches (e.g. from IoT
+// devices) to be backfilled with the time they were taken at, instead of the
+// time they are recorded at.
+//
+// A time before the start of the collection interval the measurement is
+// reported in is reported as the start of that interval.
+//
+// Other instruments ignore this option.
+func WithTimestamp(t time.Time) RecordOption {
+	return timestampOpt(t)
+}
+
 // ObserveOption applies options to an addition measurement. See
 // [MeasurementOption] for other options that can be used as a ObserveOption.
 type ObserveOption interface {
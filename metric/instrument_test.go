@@ -6,6 +6,7 @@ package metric // import "go.opentelemetry.io/otel/metric"
 import (
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -139,3 +140,38 @@ func TestWithAttributesConcurrentSafe(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestRecordConfigTimestamp(t *testing.T) {
+	assert.True(t, NewRecordConfig(nil).Timestamp().IsZero())
+
+	ts := time.Unix(946684800, 0)
+	c := NewRecordConfig([]RecordOption{WithTimestamp(ts), WithAttributes(attribute.Int("a", 1))})
+	assert.Equal(t, ts, c.Timestamp())
+	assert.Equal(t, attribute.NewSet(attribute.Int("a", 1)), c.Attributes())
+}
+
+func TestWithAttributeKeys(t *testing.T) {
+	keys := []attribute.Key{"http.request.method", "http.response.status_code"}
+	opt := WithAttributeKeys(keys...)
+
+	for name, cfg := range map[string]interface{ AttributeKeys() []attribute.Key }{
+		"Float64Counter":                 NewFloat64CounterConfig(opt),
+		"Float64UpDownCounter":           NewFloat64UpDownCounterConfig(opt),
+		"Float64Histogram":               NewFloat64HistogramConfig(opt),
+		"Float64Gauge":                   NewFloat64GaugeConfig(opt),
+		"Float64ObservableCounter":       NewFloat64ObservableCounterConfig(opt),
+		"Float64ObservableUpDownCounter": NewFloat64ObservableUpDownCounterConfig(opt),
+		"Float64ObservableGauge":         NewFloat64ObservableGaugeConfig(opt),
+		"Int64Counter":                   NewInt64CounterConfig(opt),
+		"Int64UpDownCounter":             NewInt64UpDownCounterConfig(opt),
+		"Int64Histogram":                 NewInt64HistogramConfig(opt),
+		"Int64Gauge":                     NewInt64GaugeConfig(opt),
+		"Int64ObservableCounter":         NewInt64ObservableCounterConfig(opt),
+		"Int64ObservableUpDownCounter":   NewInt64ObservableUpDownCounterConfig(opt),
+		"Int64ObservableGauge":           NewInt64ObservableGaugeConfig(opt),
+	} {
+		assert.Equal(t, keys, cfg.AttributeKeys(), name)
+	}
+
+	assert.Nil(t, NewInt64CounterConfig().AttributeKeys(), "default")
+}
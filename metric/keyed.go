@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/metric"
+
+import (
+	"context"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// AttributeKeyRecorder is implemented by instruments that can report whether
+// their measurements are recorded with attributes of a key. For example, the
+// instruments of an SDK created with advisory attribute keys (see
+// [WithAttributeKeys]) may drop attributes with other keys.
+type AttributeKeyRecorder interface {
+	// RecordsAttributeKey returns false if attributes with key are dropped
+	// from all measurements made with the instrument. Otherwise, it returns
+	// true.
+	RecordsAttributeKey(key attribute.Key) bool
+}
+
+// Recorder records measurements of a value with type N.
+//
+// It is implemented by the histogram and gauge instruments of the matching
+// numeric type.
+type Recorder[N int64 | float64] interface {
+	// Record records a measurement of the value.
+	Record(ctx context.Context, value N, options ...RecordOption)
+}
+
+var (
+	_ Recorder[int64]   = Int64Histogram(nil)
+	_ Recorder[int64]   = Int64Gauge(nil)
+	_ Recorder[float64] = Float64Histogram(nil)
+	_ Recorder[float64] = Float64Gauge(nil)
+)
+
+// KeyedAdder adds values to a counter or up-down counter with the attributes
+// of a fixed list of keys. The attribute values are passed in the order of
+// the keys, and the attributes the instrument does not record are never
+// constructed.
+//
+// Use [NewKeyedAdder] to create a KeyedAdder.
+type KeyedAdder[N int64 | float64] struct {
+	keyed
+
+	a Adder[N]
+}
+
+// NewKeyedAdder returns a [KeyedAdder] that adds values to a with the
+// attributes of keys.
+//
+// If a implements [AttributeKeyRecorder], the attributes of keys a does not
+// record are omitted. It is queried once, when the KeyedAdder is created.
+func NewKeyedAdder[N int64 | float64](a Adder[N], keys ...attribute.Key) KeyedAdder[N] {
+	return KeyedAdder[N]{keyed: newKeyed(a, keys), a: a}
+}
+
+// Add adds incr with the attributes of values. The values are matched with
+// the keys of the KeyedAdder by position. Values of keys the instrument does
+// not record, invalid values, and values beyond the number of keys are
+// ignored.
+func (a KeyedAdder[N]) Add(ctx context.Context, incr N, values ...attribute.Value) {
+	a.a.Add(ctx, incr, a.option(values))
+}
+
+// KeyedRecorder records values with a histogram or gauge with the attributes
+// of a fixed list of keys. The attribute values are passed in the order of
+// the keys, and the attributes the instrument does not record are never
+// constructed.
+//
+// Use [NewKeyedRecorder] to create a KeyedRecorder.
+type KeyedRecorder[N int64 | float64] struct {
+	keyed
+
+	r Recorder[N]
+}
+
+// NewKeyedRecorder returns a [KeyedRecorder] that records values with r
+// with the attributes of keys.
+//
+// If r implements [AttributeKeyRecorder], the attributes of keys r does not
+// record are omitted. It is queried once, when the KeyedRecorder is created.
+func NewKeyedRecorder[N int64 | float64](r Recorder[N], keys ...attribute.Key) KeyedRecorder[N] {
+	return KeyedRecorder[N]{keyed: newKeyed(r, keys), r: r}
+}
+
+// Record records value with the attributes of values. The values are matched
+// with the keys of the KeyedRecorder by position. Values of keys the
+// instrument does not record, invalid values, and values beyond the number
+// of keys are ignored.
+func (r KeyedRecorder[N]) Record(ctx context.Context, value N, values ...attribute.Value) {
+	r.r.Record(ctx, value, r.option(values))
+}
+
+// keyed holds the attribute keys of a KeyedAdder or KeyedRecorder and
+// whether their instrument records them.
+type keyed struct {
+	keys     []attribute.Key
+	recorded []bool
+}
+
+func newKeyed(inst any, keys []attribute.Key) keyed {
+	k := keyed{keys: slices.Clone(keys), recorded: make([]bool, len(keys))}
+	r, ok := inst.(AttributeKeyRecorder)
+	for i, key := range k.keys {
+		k.recorded[i] = !ok || r.RecordsAttributeKey(key)
+	}
+	return k
+}
+
+// Records returns whether the instrument records the attribute with the key
+// at index i. Use it to avoid computing attribute values that are ignored.
+func (k keyed) Records(i int) bool {
+	return i >= 0 && i < len(k.recorded) && k.recorded[i]
+}
+
+func (k keyed) option(values []attribute.Value) MeasurementOption {
+	kvs := make([]attribute.KeyValue, 0, min(len(values), len(k.keys)))
+	for i, v := range values {
+		if i >= len(k.keys) {
+			break
+		}
+		if !k.recorded[i] || v.Type() == attribute.INVALID {
+			continue
+		}
+		kvs = append(kvs, attribute.KeyValue{Key: k.keys[i], Value: v})
+	}
+	return WithAttributeSet(attribute.NewSet(kvs...))
+}
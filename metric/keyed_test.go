@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/metric"
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type keyedInst struct {
+	keys []attribute.Key
+	got  []attribute.Set
+}
+
+func (i *keyedInst) Add(_ context.Context, _ int64, options ...AddOption) {
+	i.got = append(i.got, NewAddConfig(options).Attributes())
+}
+
+func (i *keyedInst) Record(_ context.Context, _ float64, options ...RecordOption) {
+	i.got = append(i.got, NewRecordConfig(options).Attributes())
+}
+
+type keyRecorderInst struct{ *keyedInst }
+
+func (i keyRecorderInst) RecordsAttributeKey(key attribute.Key) bool {
+	return slices.Contains(i.keys, key)
+}
+
+func TestKeyedAdder(t *testing.T) {
+	inst := &keyedInst{keys: []attribute.Key{"method", "status"}}
+	a := NewKeyedAdder[int64](keyRecorderInst{inst}, "method", "route", "status")
+
+	assert.True(t, a.Records(0))
+	assert.False(t, a.Records(1))
+	assert.True(t, a.Records(2))
+	assert.False(t, a.Records(3))
+	assert.False(t, a.Records(-1))
+
+	ctx := context.Background()
+	a.Add(ctx, 1, attribute.StringValue("GET"), attribute.StringValue("/"), attribute.IntValue(200))
+	// Invalid values are omitted, and values beyond the keys ignored.
+	a.Add(ctx, 1, attribute.StringValue("GET"), attribute.Value{}, attribute.Value{}, attribute.BoolValue(true))
+	a.Add(ctx, 1)
+
+	assert.Equal(t, []attribute.Set{
+		attribute.NewSet(attribute.String("method", "GET"), attribute.Int("status", 200)),
+		attribute.NewSet(attribute.String("method", "GET")),
+		*attribute.EmptySet(),
+	}, inst.got)
+}
+
+func TestKeyedRecorder(t *testing.T) {
+	inst := &keyedInst{keys: []attribute.Key{"method"}}
+	r := NewKeyedRecorder[float64](keyRecorderInst{inst}, "method", "route")
+
+	assert.True(t, r.Records(0))
+	assert.False(t, r.Records(1))
+
+	r.Record(context.Background(), 1, attribute.StringValue("GET"), attribute.StringValue("/"))
+	assert.Equal(t, []attribute.Set{
+		attribute.NewSet(attribute.String("method", "GET")),
+	}, inst.got)
+}
+
+func TestKeyedRecordsAllWithoutAttributeKeyRecorder(t *testing.T) {
+	inst := &keyedInst{}
+	keys := []attribute.Key{"method", "route"}
+	a := NewKeyedAdder[int64](inst, keys...)
+
+	// The keys are copied.
+	keys[0] = "changed"
+
+	assert.True(t, a.Records(0))
+	assert.True(t, a.Records(1))
+
+	a.Add(context.Background(), 1, attribute.StringValue("GET"), attribute.StringValue("/"))
+	assert.Equal(t, []attribute.Set{
+		attribute.NewSet(attribute.String("method", "GET"), attribute.String("route", "/")),
+	}, inst.got)
+}
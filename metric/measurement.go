@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/metric"
+
+// Measurement is a value measured by a synchronous instrument. Measurements
+// are recorded together using the RecordBatch method of the Meter that
+// created the instrument.
+//
+// Use the Measurement method of a synchronous instrument to create a
+// Measurement.
+type Measurement struct {
+	inst         any
+	int64Value   int64
+	float64Value float64
+}
+
+// NewInt64Measurement returns a Measurement of value for the int64
+// synchronous instrument inst.
+//
+// This function is intended for API implementations. Instrumentation should
+// use the Measurement method of the instrument instead.
+func NewInt64Measurement(inst any, value int64) Measurement {
+	return Measurement{inst: inst, int64Value: value}
+}
+
+// NewFloat64Measurement returns a Measurement of value for the float64
+// synchronous instrument inst.
+//
+// This function is intended for API implementations. Instrumentation should
+// use the Measurement method of the instrument instead.
+func NewFloat64Measurement(inst any, value float64) Measurement {
+	return Measurement{inst: inst, float64Value: value}
+}
+
+// Instrument returns the instrument that made the measurement.
+func (m Measurement) Instrument() any {
+	return m.inst
+}
+
+// Int64Value returns the value of a measurement made by an int64 instrument.
+func (m Measurement) Int64Value() int64 {
+	return m.int64Value
+}
+
+// Float64Value returns the value of a measurement made by a float64
+// instrument.
+func (m Measurement) Float64Value() float64 {
+	return m.float64Value
+}
@@ -6,6 +6,7 @@ package metric // import "go.opentelemetry.io/otel/metric"
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric/embedded"
 )
 
@@ -231,6 +232,25 @@ type Meter interface {
 	//
 	// The function f needs to be concurrent safe.
 	RegisterCallback(f Callback, instruments ...Observable) (Registration, error)
+
+	// Registrations returns the registrations of the callbacks registered
+	// with the RegisterCallback method of this Meter that have not been
+	// unregistered.
+	//
+	// This method needs to be concurrent safe.
+	Registrations() []Registration
+
+	// RecordBatch records the measurements with the attributes attrs.
+	//
+	// The attribute set is processed once for all the measurements, which
+	// is cheaper than recording each of them individually. This is useful
+	// to record multiple values describing the same operation (e.g. the
+	// duration and size of a request).
+	//
+	// The measurements need to be made by synchronous instruments created
+	// by this Meter. Measurements made by instruments of another API
+	// implementation may be dropped.
+	RecordBatch(ctx context.Context, attrs attribute.Set, measurements ...Measurement)
 }
 
 // Callback is a function registered with a Meter that makes observations for
@@ -281,4 +301,16 @@ type Registration interface {
 	//
 	// This method needs to be idempotent and concurrent safe.
 	Unregister() error
+
+	// Replace replaces the registered callback with f. The instruments f
+	// may observe values for are the ones the replaced callback was
+	// registered with.
+	//
+	// The replacement needs to be atomic: a collection calls either the
+	// replaced callback or f, never both nor none of them.
+	//
+	// An error is returned if the registration has been unregistered.
+	//
+	// This method needs to be concurrent safe.
+	Replace(f Callback) error
 }
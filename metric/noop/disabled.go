@@ -0,0 +1,18 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build otel_metrics_off
+
+package noop // import "go.opentelemetry.io/otel/metric/noop"
+
+// Disabled is true if the binary is built with the otel_metrics_off build
+// tag. It is a constant, so the compiler removes the instrumentation guarded
+// by it, including the computation of the measurement arguments:
+//
+//	if !noop.Disabled {
+//		counter.Add(ctx, 1, metric.WithAttributes(attrs...))
+//	}
+//
+// The Provider, Add, and Record helpers are compiled to no operation when
+// Disabled is true, but their arguments are still evaluated by the caller.
+const Disabled = true
@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package noop // import "go.opentelemetry.io/otel/metric/noop"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Provider returns mp, or a MeterProvider that performs no operations if the
+// binary is built with the otel_metrics_off build tag.
+func Provider(mp metric.MeterProvider) metric.MeterProvider {
+	if Disabled {
+		return MeterProvider{}
+	}
+	return mp
+}
+
+// Add calls the Add method of a with the passed arguments, unless the binary
+// is built with the otel_metrics_off build tag.
+func Add[N int64 | float64](ctx context.Context, a metric.Adder[N], incr N, options ...metric.AddOption) {
+	if Disabled {
+		return
+	}
+	a.Add(ctx, incr, options...)
+}
+
+// Record calls the Record method of r with the passed arguments, unless the
+// binary is built with the otel_metrics_off build tag.
+func Record[N int64 | float64](ctx context.Context, r metric.Recorder[N], value N, options ...metric.RecordOption) {
+	if Disabled {
+		return
+	}
+	r.Record(ctx, value, options...)
+}
@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package noop // import "go.opentelemetry.io/otel/metric/noop"
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+)
+
+type provider struct {
+	embedded.MeterProvider
+}
+
+func (provider) Meter(string, ...metric.MeterOption) metric.Meter { return Meter{} }
+
+type recorder struct {
+	Int64Counter
+	Int64Histogram
+
+	n int
+}
+
+func (r *recorder) Add(context.Context, int64, ...metric.AddOption) { r.n++ }
+
+func (r *recorder) Record(context.Context, int64, ...metric.RecordOption) { r.n++ }
+
+func TestProvider(t *testing.T) {
+	mp := provider{}
+	if Disabled {
+		assert.Equal(t, MeterProvider{}, Provider(mp))
+	} else {
+		assert.Equal(t, mp, Provider(mp))
+	}
+}
+
+func TestAddRecord(t *testing.T) {
+	r := &recorder{}
+	Add[int64](context.Background(), r, 1)
+	Record[int64](context.Background(), r, 1)
+
+	want := 2
+	if Disabled {
+		want = 0
+	}
+	assert.Equal(t, want, r.n)
+}
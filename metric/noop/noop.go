@@ -10,11 +10,16 @@
 // This implementation can be embedded in other implementations of the
 // OpenTelemetry metric API. Doing so will mean the implementation defaults to
 // no operation for methods it does not implement.
+//
+// IsNoop reports whether an implementation performs no operations. Types
+// embedding the types of this package, like test doubles overriding some of
+// their methods, are not reported as no-op.
 package noop // import "go.opentelemetry.io/otel/metric/noop"
 
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
 )
@@ -164,6 +169,13 @@ func (Meter) RegisterCallback(metric.Callback, ...metric.Observable) (metric.Reg
 	return Registration{}, nil
 }
 
+// Registrations returns nil because the No-Op Meter holds no record of
+// registrations.
+func (Meter) Registrations() []metric.Registration { return nil }
+
+// RecordBatch performs no operation.
+func (Meter) RecordBatch(context.Context, attribute.Set, ...metric.Measurement) {}
+
 // Observer acts as a recorder of measurements for multiple instruments in a
 // Callback, it performing no operation.
 type Observer struct{ embedded.Observer }
@@ -184,6 +196,11 @@ type Registration struct{ embedded.Registration }
 // operation, including hold any record of registrations.
 func (Registration) Unregister() error { return nil }
 
+// Replace replaces the Callback the Registration represents with the No-Op
+// Meter. This will always return nil because the No-Op Meter performs no
+// operation, including calling any Callback.
+func (Registration) Replace(metric.Callback) error { return nil }
+
 // Int64Counter is an OpenTelemetry Counter used to record int64 measurements.
 // It produces no telemetry.
 type Int64Counter struct{ embedded.Int64Counter }
@@ -191,6 +208,14 @@ type Int64Counter struct{ embedded.Int64Counter }
 // Add performs no operation.
 func (Int64Counter) Add(context.Context, int64, ...metric.AddOption) {}
 
+// Enabled returns false. No measurements are processed.
+func (Int64Counter) Enabled(context.Context) bool { return false }
+
+// Measurement returns a Measurement of value that is not recorded.
+func (i Int64Counter) Measurement(value int64) metric.Measurement {
+	return metric.NewInt64Measurement(i, value)
+}
+
 // Float64Counter is an OpenTelemetry Counter used to record float64
 // measurements. It produces no telemetry.
 type Float64Counter struct{ embedded.Float64Counter }
@@ -198,6 +223,14 @@ type Float64Counter struct{ embedded.Float64Counter }
 // Add performs no operation.
 func (Float64Counter) Add(context.Context, float64, ...metric.AddOption) {}
 
+// Enabled returns false. No measurements are processed.
+func (Float64Counter) Enabled(context.Context) bool { return false }
+
+// Measurement returns a Measurement of value that is not recorded.
+func (i Float64Counter) Measurement(value float64) metric.Measurement {
+	return metric.NewFloat64Measurement(i, value)
+}
+
 // Int64UpDownCounter is an OpenTelemetry UpDownCounter used to record int64
 // measurements. It produces no telemetry.
 type Int64UpDownCounter struct{ embedded.Int64UpDownCounter }
@@ -205,6 +238,14 @@ type Int64UpDownCounter struct{ embedded.Int64UpDownCounter }
 // Add performs no operation.
 func (Int64UpDownCounter) Add(context.Context, int64, ...metric.AddOption) {}
 
+// Enabled returns false. No measurements are processed.
+func (Int64UpDownCounter) Enabled(context.Context) bool { return false }
+
+// Measurement returns a Measurement of value that is not recorded.
+func (i Int64UpDownCounter) Measurement(value int64) metric.Measurement {
+	return metric.NewInt64Measurement(i, value)
+}
+
 // Float64UpDownCounter is an OpenTelemetry UpDownCounter used to record
 // float64 measurements. It produces no telemetry.
 type Float64UpDownCounter struct{ embedded.Float64UpDownCounter }
@@ -212,6 +253,14 @@ type Float64UpDownCounter struct{ embedded.Float64UpDownCounter }
 // Add performs no operation.
 func (Float64UpDownCounter) Add(context.Context, float64, ...metric.AddOption) {}
 
+// Enabled returns false. No measurements are processed.
+func (Float64UpDownCounter) Enabled(context.Context) bool { return false }
+
+// Measurement returns a Measurement of value that is not recorded.
+func (i Float64UpDownCounter) Measurement(value float64) metric.Measurement {
+	return metric.NewFloat64Measurement(i, value)
+}
+
 // Int64Histogram is an OpenTelemetry Histogram used to record int64
 // measurements. It produces no telemetry.
 type Int64Histogram struct{ embedded.Int64Histogram }
@@ -219,6 +268,18 @@ type Int64Histogram struct{ embedded.Int64Histogram }
 // Record performs no operation.
 func (Int64Histogram) Record(context.Context, int64, ...metric.RecordOption) {}
 
+// Enabled returns false. No measurements are processed.
+func (Int64Histogram) Enabled(context.Context) bool { return false }
+
+// Measurement returns a Measurement of value that is not recorded.
+func (i Int64Histogram) Measurement(value int64) metric.Measurement {
+	return metric.NewInt64Measurement(i, value)
+}
+
+// RecordBuckets performs no operation.
+func (Int64Histogram) RecordBuckets(context.Context, metric.HistogramBuckets, ...metric.RecordOption) {
+}
+
 // Float64Histogram is an OpenTelemetry Histogram used to record float64
 // measurements. It produces no telemetry.
 type Float64Histogram struct{ embedded.Float64Histogram }
@@ -226,6 +287,18 @@ type Float64Histogram struct{ embedded.Float64Histogram }
 // Record performs no operation.
 func (Float64Histogram) Record(context.Context, float64, ...metric.RecordOption) {}
 
+// Enabled returns false. No measurements are processed.
+func (Float64Histogram) Enabled(context.Context) bool { return false }
+
+// Measurement returns a Measurement of value that is not recorded.
+func (i Float64Histogram) Measurement(value float64) metric.Measurement {
+	return metric.NewFloat64Measurement(i, value)
+}
+
+// RecordBuckets performs no operation.
+func (Float64Histogram) RecordBuckets(context.Context, metric.HistogramBuckets, ...metric.RecordOption) {
+}
+
 // Int64Gauge is an OpenTelemetry Gauge used to record instantaneous int64
 // measurements. It produces no telemetry.
 type Int64Gauge struct{ embedded.Int64Gauge }
@@ -233,6 +306,14 @@ type Int64Gauge struct{ embedded.Int64Gauge }
 // Record performs no operation.
 func (Int64Gauge) Record(context.Context, int64, ...metric.RecordOption) {}
 
+// Enabled returns false. No measurements are processed.
+func (Int64Gauge) Enabled(context.Context) bool { return false }
+
+// Measurement returns a Measurement of value that is not recorded.
+func (i Int64Gauge) Measurement(value int64) metric.Measurement {
+	return metric.NewInt64Measurement(i, value)
+}
+
 // Float64Gauge is an OpenTelemetry Gauge used to record instantaneous float64
 // measurements. It produces no telemetry.
 type Float64Gauge struct{ embedded.Float64Gauge }
@@ -240,6 +321,14 @@ type Float64Gauge struct{ embedded.Float64Gauge }
 // Record performs no operation.
 func (Float64Gauge) Record(context.Context, float64, ...metric.RecordOption) {}
 
+// Enabled returns false. No measurements are processed.
+func (Float64Gauge) Enabled(context.Context) bool { return false }
+
+// Measurement returns a Measurement of value that is not recorded.
+func (i Float64Gauge) Measurement(value float64) metric.Measurement {
+	return metric.NewFloat64Measurement(i, value)
+}
+
 // Int64ObservableCounter is an OpenTelemetry ObservableCounter used to record
 // int64 measurements. It produces no telemetry.
 type Int64ObservableCounter struct {
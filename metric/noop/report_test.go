@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package noop_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+type reporter bool
+
+func (r reporter) IsNoop() bool { return bool(r) }
+
+// testCounter overrides Add and embeds noop.Int64Counter for all other methods.
+type testCounter struct {
+	noop.Int64Counter
+
+	sum int64
+}
+
+func (c *testCounter) Add(_ context.Context, incr int64, _ ...metric.AddOption) {
+	c.sum += incr
+}
+
+func TestIsNoop(t *testing.T) {
+	m := noop.NewMeterProvider().Meter("")
+	counter, err := m.Int64Counter("")
+	assert.NoError(t, err)
+
+	assert.True(t, noop.IsNoop(noop.NewMeterProvider()))
+	assert.True(t, noop.IsNoop(m))
+	assert.True(t, noop.IsNoop(counter))
+	assert.True(t, noop.IsNoop(&noop.Float64Histogram{}))
+	assert.True(t, noop.IsNoop(reporter(true)))
+
+	assert.False(t, noop.IsNoop(nil))
+	assert.False(t, noop.IsNoop(reporter(false)))
+	assert.False(t, noop.IsNoop(&testCounter{}), "embedding type")
+}
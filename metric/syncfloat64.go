@@ -6,6 +6,7 @@ package metric // import "go.opentelemetry.io/otel/metric"
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric/embedded"
 )
 
@@ -25,13 +26,25 @@ type Float64Counter interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Add(ctx context.Context, incr float64, options ...AddOption)
+	// Enabled reports whether the instrument will process measurements for
+	// the given context.
+	//
+	// This can be used to avoid computationally expensive operations, like
+	// building attribute sets, when the measurement would be dropped (e.g.
+	// by a view or because the MeterProvider is a no-op).
+	Enabled(ctx context.Context) bool
+	// Measurement returns a Measurement of value for the instrument. It is
+	// recorded with the RecordBatch method of the Meter that created the
+	// instrument.
+	Measurement(value float64) Measurement
 }
 
 // Float64CounterConfig contains options for synchronous counter instruments that
 // record float64 values.
 type Float64CounterConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewFloat64CounterConfig returns a new [Float64CounterConfig] with all opts
@@ -54,6 +67,11 @@ func (c Float64CounterConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys.
+func (c Float64CounterConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Float64CounterOption applies options to a [Float64CounterConfig]. See
 // [InstrumentOption] for other options that can be used as a
 // Float64CounterOption.
@@ -78,13 +96,25 @@ type Float64UpDownCounter interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Add(ctx context.Context, incr float64, options ...AddOption)
+	// Enabled reports whether the instrument will process measurements for
+	// the given context.
+	//
+	// This can be used to avoid computationally expensive operations, like
+	// building attribute sets, when the measurement would be dropped (e.g.
+	// by a view or because the MeterProvider is a no-op).
+	Enabled(ctx context.Context) bool
+	// Measurement returns a Measurement of value for the instrument. It is
+	// recorded with the RecordBatch method of the Meter that created the
+	// instrument.
+	Measurement(value float64) Measurement
 }
 
 // Float64UpDownCounterConfig contains options for synchronous counter
 // instruments that record float64 values.
 type Float64UpDownCounterConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewFloat64UpDownCounterConfig returns a new [Float64UpDownCounterConfig]
@@ -107,6 +137,11 @@ func (c Float64UpDownCounterConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys.
+func (c Float64UpDownCounterConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Float64UpDownCounterOption applies options to a
 // [Float64UpDownCounterConfig]. See [InstrumentOption] for other options that
 // can be used as a Float64UpDownCounterOption.
@@ -131,6 +166,23 @@ type Float64Histogram interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Record(ctx context.Context, incr float64, options ...RecordOption)
+	// Enabled reports whether the instrument will process measurements for
+	// the given context.
+	//
+	// This can be used to avoid computationally expensive operations, like
+	// building attribute sets, when the measurement would be dropped (e.g.
+	// by a view or because the MeterProvider is a no-op).
+	Enabled(ctx context.Context) bool
+	// Measurement returns a Measurement of value for the instrument. It is
+	// recorded with the RecordBatch method of the Meter that created the
+	// instrument.
+	Measurement(value float64) Measurement
+	// RecordBuckets records a set of measurements that have already been
+	// aggregated into explicit buckets, without recording each of them.
+	//
+	// Use the WithAttributeSet (or, if performance is not a concern,
+	// the WithAttributes) option to include measurement attributes.
+	RecordBuckets(ctx context.Context, buckets HistogramBuckets, options ...RecordOption)
 }
 
 // Float64HistogramConfig contains options for synchronous histogram
@@ -139,6 +191,7 @@ type Float64HistogramConfig struct {
 	description              string
 	unit                     string
 	explicitBucketBoundaries []float64
+	attributeKeys            []attribute.Key
 }
 
 // NewFloat64HistogramConfig returns a new [Float64HistogramConfig] with all
@@ -161,6 +214,11 @@ func (c Float64HistogramConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys.
+func (c Float64HistogramConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // ExplicitBucketBoundaries returns the configured explicit bucket boundaries.
 func (c Float64HistogramConfig) ExplicitBucketBoundaries() []float64 {
 	return c.explicitBucketBoundaries
@@ -189,13 +247,25 @@ type Float64Gauge interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Record(ctx context.Context, value float64, options ...RecordOption)
+	// Enabled reports whether the instrument will process measurements for
+	// the given context.
+	//
+	// This can be used to avoid computationally expensive operations, like
+	// building attribute sets, when the measurement would be dropped (e.g.
+	// by a view or because the MeterProvider is a no-op).
+	Enabled(ctx context.Context) bool
+	// Measurement returns a Measurement of value for the instrument. It is
+	// recorded with the RecordBatch method of the Meter that created the
+	// instrument.
+	Measurement(value float64) Measurement
 }
 
 // Float64GaugeConfig contains options for synchronous gauge instruments that
 // record float64 values.
 type Float64GaugeConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewFloat64GaugeConfig returns a new [Float64GaugeConfig] with all opts
@@ -218,6 +288,11 @@ func (c Float64GaugeConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys.
+func (c Float64GaugeConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Float64GaugeOption applies options to a [Float64GaugeConfig]. See
 // [InstrumentOption] for other options that can be used as a
 // Float64GaugeOption.
@@ -6,6 +6,7 @@ package metric // import "go.opentelemetry.io/otel/metric"
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric/embedded"
 )
 
@@ -25,13 +26,25 @@ type Int64Counter interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Add(ctx context.Context, incr int64, options ...AddOption)
+	// Enabled reports whether the instrument will process measurements for
+	// the given context.
+	//
+	// This can be used to avoid computationally expensive operations, like
+	// building attribute sets, when the measurement would be dropped (e.g.
+	// by a view or because the MeterProvider is a no-op).
+	Enabled(ctx context.Context) bool
+	// Measurement returns a Measurement of value for the instrument. It is
+	// recorded with the RecordBatch method of the Meter that created the
+	// instrument.
+	Measurement(value int64) Measurement
 }
 
 // Int64CounterConfig contains options for synchronous counter instruments that
 // record int64 values.
 type Int64CounterConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewInt64CounterConfig returns a new [Int64CounterConfig] with all opts
@@ -54,6 +67,11 @@ func (c Int64CounterConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys.
+func (c Int64CounterConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Int64CounterOption applies options to a [Int64CounterConfig]. See
 // [InstrumentOption] for other options that can be used as an
 // Int64CounterOption.
@@ -78,13 +96,25 @@ type Int64UpDownCounter interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Add(ctx context.Context, incr int64, options ...AddOption)
+	// Enabled reports whether the instrument will process measurements for
+	// the given context.
+	//
+	// This can be used to avoid computationally expensive operations, like
+	// building attribute sets, when the measurement would be dropped (e.g.
+	// by a view or because the MeterProvider is a no-op).
+	Enabled(ctx context.Context) bool
+	// Measurement returns a Measurement of value for the instrument. It is
+	// recorded with the RecordBatch method of the Meter that created the
+	// instrument.
+	Measurement(value int64) Measurement
 }
 
 // Int64UpDownCounterConfig contains options for synchronous counter
 // instruments that record int64 values.
 type Int64UpDownCounterConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewInt64UpDownCounterConfig returns a new [Int64UpDownCounterConfig] with
@@ -107,6 +137,11 @@ func (c Int64UpDownCounterConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys.
+func (c Int64UpDownCounterConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Int64UpDownCounterOption applies options to a [Int64UpDownCounterConfig].
 // See [InstrumentOption] for other options that can be used as an
 // Int64UpDownCounterOption.
@@ -131,6 +166,23 @@ type Int64Histogram interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Record(ctx context.Context, incr int64, options ...RecordOption)
+	// Enabled reports whether the instrument will process measurements for
+	// the given context.
+	//
+	// This can be used to avoid computationally expensive operations, like
+	// building attribute sets, when the measurement would be dropped (e.g.
+	// by a view or because the MeterProvider is a no-op).
+	Enabled(ctx context.Context) bool
+	// Measurement returns a Measurement of value for the instrument. It is
+	// recorded with the RecordBatch method of the Meter that created the
+	// instrument.
+	Measurement(value int64) Measurement
+	// RecordBuckets records a set of measurements that have already been
+	// aggregated into explicit buckets, without recording each of them.
+	//
+	// Use the WithAttributeSet (or, if performance is not a concern,
+	// the WithAttributes) option to include measurement attributes.
+	RecordBuckets(ctx context.Context, buckets HistogramBuckets, options ...RecordOption)
 }
 
 // Int64HistogramConfig contains options for synchronous histogram instruments
@@ -139,6 +191,7 @@ type Int64HistogramConfig struct {
 	description              string
 	unit                     string
 	explicitBucketBoundaries []float64
+	attributeKeys            []attribute.Key
 }
 
 // NewInt64HistogramConfig returns a new [Int64HistogramConfig] with all opts
@@ -161,6 +214,11 @@ func (c Int64HistogramConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys.
+func (c Int64HistogramConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // ExplicitBucketBoundaries returns the configured explicit bucket boundaries.
 func (c Int64HistogramConfig) ExplicitBucketBoundaries() []float64 {
 	return c.explicitBucketBoundaries
@@ -189,13 +247,25 @@ type Int64Gauge interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Record(ctx context.Context, value int64, options ...RecordOption)
+	// Enabled reports whether the instrument will process measurements for
+	// the given context.
+	//
+	// This can be used to avoid computationally expensive operations, like
+	// building attribute sets, when the measurement would be dropped (e.g.
+	// by a view or because the MeterProvider is a no-op).
+	Enabled(ctx context.Context) bool
+	// Measurement returns a Measurement of value for the instrument. It is
+	// recorded with the RecordBatch method of the Meter that created the
+	// instrument.
+	Measurement(value int64) Measurement
 }
 
 // Int64GaugeConfig contains options for synchronous gauge instruments that
 // record int64 values.
 type Int64GaugeConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewInt64GaugeConfig returns a new [Int64GaugeConfig] with all opts
@@ -218,6 +288,11 @@ func (c Int64GaugeConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys.
+func (c Int64GaugeConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Int64GaugeOption applies options to a [Int64GaugeConfig]. See
 // [InstrumentOption] for other options that can be used as a
 // Int64GaugeOption.
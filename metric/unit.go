@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/metric"
+
+// Common units of measure to use with WithUnit. They are
+// [UCUM](https://ucum.org) case-sensitive codes.
+//
+// Units that are not listed here can be built from UCUM atoms, e.g. "By/s"
+// for bytes per second. Curly braces denote annotations used for counts of
+// things, e.g. "{request}".
+const (
+	UnitDimensionless = "1"
+	UnitPercent       = "%"
+
+	UnitBits      = "bit"
+	UnitBytes     = "By"
+	UnitKibibytes = "KiBy"
+	UnitMebibytes = "MiBy"
+	UnitGibibytes = "GiBy"
+	UnitKilobytes = "kBy"
+	UnitMegabytes = "MBy"
+	UnitGigabytes = "GBy"
+
+	UnitBytesPerSecond = "By/s"
+
+	UnitDays         = "d"
+	UnitHours        = "h"
+	UnitMinutes      = "min"
+	UnitSeconds      = "s"
+	UnitMilliseconds = "ms"
+	UnitMicroseconds = "us"
+	UnitNanoseconds  = "ns"
+
+	UnitHertz   = "Hz"
+	UnitCelsius = "Cel"
+	UnitWatts   = "W"
+	UnitJoules  = "J"
+	UnitVolts   = "V"
+	UnitAmperes = "A"
+)
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelconf // import "go.opentelemetry.io/otel/otelconf"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+const (
+	protocolGRPC    = "grpc"
+	protocolHTTP    = "http/protobuf"
+	compressionGzip = "gzip"
+)
+
+var (
+	errUnsupportedProtocol    = errors.New("otelconf: unsupported OTLP protocol")
+	errUnsupportedCompression = errors.New("otelconf: unsupported OTLP compression")
+	errInvalidEndpoint        = errors.New("otelconf: invalid OTLP endpoint")
+)
+
+// builder builds the SDK components from a configuration.
+type builder struct {
+	ctx context.Context
+	cfg OpenTelemetryConfiguration
+	res *resource.Resource
+}
+
+// console returns the writer of the console exporters.
+func (builder) console() io.Writer {
+	return os.Stdout
+}
+
+// otlpOptions are the protocol-independent options of an OTLP exporter.
+type otlpOptions struct {
+	endpoint string
+	insecure bool
+	headers  map[string]string
+	gzip     bool
+	timeout  time.Duration
+}
+
+func newOTLPOptions(cfg *OTLP) (otlpOptions, error) {
+	var o otlpOptions
+	if cfg.Endpoint != nil && *cfg.Endpoint != "" {
+		u, err := url.Parse(*cfg.Endpoint)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return o, fmt.Errorf("%w: %q", errInvalidEndpoint, *cfg.Endpoint)
+		}
+		o.endpoint = *cfg.Endpoint
+		o.insecure = u.Scheme == "http"
+	}
+	if cfg.Insecure != nil {
+		o.insecure = *cfg.Insecure
+	}
+
+	if cfg.HeadersList != nil {
+		h, err := parseKeyValueList(*cfg.HeadersList)
+		if err != nil {
+			return o, err
+		}
+		o.headers = h
+	}
+	for _, h := range cfg.Headers {
+		if o.headers == nil {
+			o.headers = make(map[string]string)
+		}
+		if h.Value != nil {
+			o.headers[h.Name] = *h.Value
+		}
+	}
+
+	if cfg.Compression != nil {
+		switch *cfg.Compression {
+		case compressionGzip:
+			o.gzip = true
+		case "none", "":
+		default:
+			return o, fmt.Errorf("%w: %q", errUnsupportedCompression, *cfg.Compression)
+		}
+	}
+	if cfg.Timeout != nil {
+		o.timeout = millis(*cfg.Timeout)
+	}
+	return o, nil
+}
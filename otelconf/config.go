@@ -0,0 +1,411 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelconf // import "go.opentelemetry.io/otel/otelconf"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SupportedFileFormat is the version of the configuration file format
+// supported by this package.
+const SupportedFileFormat = "0.3"
+
+var errUnsupportedFileFormat = errors.New("otelconf: unsupported file format")
+
+// OpenTelemetryConfiguration is the root of a declarative configuration.
+type OpenTelemetryConfiguration struct {
+	// FileFormat is the version of the configuration file format. It is
+	// required.
+	FileFormat string `yaml:"file_format"`
+	// Disabled disables the SDK. All the providers are no-op if it is true.
+	Disabled *bool `yaml:"disabled,omitempty"`
+	// Resource configures the resource of all the providers.
+	Resource *Resource `yaml:"resource,omitempty"`
+	// AttributeLimits configures the default attribute limits of all the
+	// signals.
+	AttributeLimits *AttributeLimits `yaml:"attribute_limits,omitempty"`
+	// Propagator configures the propagator.
+	Propagator *Propagator `yaml:"propagator,omitempty"`
+	// TracerProvider configures the TracerProvider. A no-op TracerProvider
+	// is used if it is nil.
+	TracerProvider *TracerProvider `yaml:"tracer_provider,omitempty"`
+	// MeterProvider configures the MeterProvider. A no-op MeterProvider is
+	// used if it is nil.
+	MeterProvider *MeterProvider `yaml:"meter_provider,omitempty"`
+	// LoggerProvider configures the LoggerProvider. A no-op LoggerProvider
+	// is used if it is nil.
+	LoggerProvider *LoggerProvider `yaml:"logger_provider,omitempty"`
+}
+
+// Resource configures a resource.
+type Resource struct {
+	// Attributes are the attributes of the resource.
+	Attributes []AttributeNameValue `yaml:"attributes,omitempty"`
+	// AttributesList is a comma-separated list of key=value attributes, in
+	// the format of the OTEL_RESOURCE_ATTRIBUTES environment variable.
+	// Attributes have precedence over it.
+	AttributesList *string `yaml:"attributes_list,omitempty"`
+	// SchemaURL is the schema URL of the resource.
+	SchemaURL *string `yaml:"schema_url,omitempty"`
+}
+
+// AttributeNameValue is a typed attribute.
+type AttributeNameValue struct {
+	// Name is the attribute key.
+	Name string `yaml:"name"`
+	// Value is the attribute value.
+	Value any `yaml:"value"`
+	// Type is the type of Value. One of string, bool, int, double,
+	// string_array, bool_array, int_array, or double_array. The type is
+	// inferred from Value if it is nil.
+	Type *string `yaml:"type,omitempty"`
+}
+
+// AttributeLimits configures attribute limits.
+type AttributeLimits struct {
+	// AttributeValueLengthLimit is the maximum length of string attribute
+	// values.
+	AttributeValueLengthLimit *int `yaml:"attribute_value_length_limit,omitempty"`
+	// AttributeCountLimit is the maximum number of attributes.
+	AttributeCountLimit *int `yaml:"attribute_count_limit,omitempty"`
+}
+
+// Propagator configures the TextMapPropagator.
+type Propagator struct {
+	// Composite is the list of the names of the propagators to combine. The
+	// supported names are tracecontext, baggage, xray, and none.
+	Composite []string `yaml:"composite,omitempty"`
+}
+
+// TracerProvider configures a TracerProvider.
+type TracerProvider struct {
+	// Processors are the span processors, in registration order.
+	Processors []SpanProcessor `yaml:"processors,omitempty"`
+	// Limits are the span limits.
+	Limits *SpanLimits `yaml:"limits,omitempty"`
+	// Sampler is the sampler. A parent based always on sampler is used if it
+	// is nil.
+	Sampler *Sampler `yaml:"sampler,omitempty"`
+}
+
+// SpanProcessor configures a span processor. Exactly one field needs to be
+// set.
+type SpanProcessor struct {
+	// Batch configures a batch span processor.
+	Batch *BatchSpanProcessor `yaml:"batch,omitempty"`
+	// Simple configures a simple span processor.
+	Simple *SimpleSpanProcessor `yaml:"simple,omitempty"`
+}
+
+// BatchSpanProcessor configures a batch span processor. Durations are in
+// milliseconds.
+type BatchSpanProcessor struct {
+	// ScheduleDelay is the delay between two consecutive exports.
+	ScheduleDelay *int `yaml:"schedule_delay,omitempty"`
+	// ExportTimeout is the maximum duration of an export.
+	ExportTimeout *int `yaml:"export_timeout,omitempty"`
+	// MaxQueueSize is the maximum number of queued spans.
+	MaxQueueSize *int `yaml:"max_queue_size,omitempty"`
+	// MaxExportBatchSize is the maximum number of spans of an export.
+	MaxExportBatchSize *int `yaml:"max_export_batch_size,omitempty"`
+	// Exporter is the span exporter.
+	Exporter SpanExporter `yaml:"exporter"`
+}
+
+// SimpleSpanProcessor configures a simple span processor.
+type SimpleSpanProcessor struct {
+	// Exporter is the span exporter.
+	Exporter SpanExporter `yaml:"exporter"`
+}
+
+// SpanExporter configures a span exporter. Exactly one field needs to be
+// set.
+type SpanExporter struct {
+	// OTLP configures an OTLP exporter.
+	OTLP *OTLP `yaml:"otlp,omitempty"`
+	// Console configures an exporter writing to the standard output.
+	Console *Console `yaml:"console,omitempty"`
+}
+
+// OTLP configures an OTLP exporter. Durations are in milliseconds.
+type OTLP struct {
+	// Protocol is the transport protocol, grpc or http/protobuf.
+	Protocol string `yaml:"protocol"`
+	// Endpoint is the URL of the endpoint. For http/protobuf, it includes
+	// the signal path (e.g. http://localhost:4318/v1/traces).
+	Endpoint *string `yaml:"endpoint,omitempty"`
+	// Insecure disables the client transport security for the grpc
+	// protocol. The security of the http/protobuf protocol is determined by
+	// the scheme of Endpoint.
+	Insecure *bool `yaml:"insecure,omitempty"`
+	// Headers are the headers sent with all the requests.
+	Headers []NameStringValuePair `yaml:"headers,omitempty"`
+	// HeadersList is a comma-separated list of key=value headers, in the
+	// format of the OTEL_EXPORTER_OTLP_HEADERS environment variable.
+	// Headers have precedence over it.
+	HeadersList *string `yaml:"headers_list,omitempty"`
+	// Compression is the compression, gzip or none.
+	Compression *string `yaml:"compression,omitempty"`
+	// Timeout is the maximum duration of an export.
+	Timeout *int `yaml:"timeout,omitempty"`
+}
+
+// OTLPMetric configures an OTLP metric exporter.
+type OTLPMetric struct {
+	OTLP `yaml:",inline"`
+
+	// TemporalityPreference is the temporality of the exported data,
+	// cumulative, delta, or low_memory.
+	TemporalityPreference *string `yaml:"temporality_preference,omitempty"`
+}
+
+// NameStringValuePair is a name and string value pair.
+type NameStringValuePair struct {
+	// Name is the name.
+	Name string `yaml:"name"`
+	// Value is the value.
+	Value *string `yaml:"value"`
+}
+
+// Console configures an exporter writing to the standard output.
+type Console struct{}
+
+// SpanLimits configures the span limits.
+type SpanLimits struct {
+	AttributeLimits `yaml:",inline"`
+
+	// EventCountLimit is the maximum number of span events.
+	EventCountLimit *int `yaml:"event_count_limit,omitempty"`
+	// LinkCountLimit is the maximum number of span links.
+	LinkCountLimit *int `yaml:"link_count_limit,omitempty"`
+	// EventAttributeCountLimit is the maximum number of attributes per span
+	// event.
+	EventAttributeCountLimit *int `yaml:"event_attribute_count_limit,omitempty"`
+	// LinkAttributeCountLimit is the maximum number of attributes per span
+	// link.
+	LinkAttributeCountLimit *int `yaml:"link_attribute_count_limit,omitempty"`
+}
+
+// Sampler configures a sampler. Exactly one field needs to be set.
+type Sampler struct {
+	// AlwaysOn configures a sampler sampling all the spans.
+	AlwaysOn *struct{} `yaml:"always_on,omitempty"`
+	// AlwaysOff configures a sampler dropping all the spans.
+	AlwaysOff *struct{} `yaml:"always_off,omitempty"`
+	// TraceIDRatioBased configures a sampler sampling a ratio of the traces.
+	TraceIDRatioBased *TraceIDRatioBasedSampler `yaml:"trace_id_ratio_based,omitempty"`
+	// ParentBased configures a sampler following the parent decision.
+	ParentBased *ParentBasedSampler `yaml:"parent_based,omitempty"`
+}
+
+// TraceIDRatioBasedSampler configures a trace ID ratio based sampler.
+type TraceIDRatioBasedSampler struct {
+	// Ratio is the ratio of the sampled traces, between 0 and 1.
+	Ratio *float64 `yaml:"ratio,omitempty"`
+}
+
+// ParentBasedSampler configures a parent based sampler. The default samplers
+// of the SDK are used for the nil fields.
+type ParentBasedSampler struct {
+	// Root is the sampler of the spans without parent.
+	Root *Sampler `yaml:"root,omitempty"`
+	// RemoteParentSampled is the sampler of the spans with a sampled remote
+	// parent.
+	RemoteParentSampled *Sampler `yaml:"remote_parent_sampled,omitempty"`
+	// RemoteParentNotSampled is the sampler of the spans with a not sampled
+	// remote parent.
+	RemoteParentNotSampled *Sampler `yaml:"remote_parent_not_sampled,omitempty"`
+	// LocalParentSampled is the sampler of the spans with a sampled local
+	// parent.
+	LocalParentSampled *Sampler `yaml:"local_parent_sampled,omitempty"`
+	// LocalParentNotSampled is the sampler of the spans with a not sampled
+	// local parent.
+	LocalParentNotSampled *Sampler `yaml:"local_parent_not_sampled,omitempty"`
+}
+
+// MeterProvider configures a MeterProvider.
+type MeterProvider struct {
+	// Readers are the metric readers.
+	Readers []MetricReader `yaml:"readers,omitempty"`
+	// ExemplarFilter is the exemplar filter, trace_based, always_on, or
+	// always_off.
+	ExemplarFilter *string `yaml:"exemplar_filter,omitempty"`
+}
+
+// MetricReader configures a metric reader. Exactly one field needs to be
+// set.
+type MetricReader struct {
+	// Periodic configures a periodic reader.
+	Periodic *PeriodicMetricReader `yaml:"periodic,omitempty"`
+}
+
+// PeriodicMetricReader configures a periodic reader. Durations are in
+// milliseconds.
+type PeriodicMetricReader struct {
+	// Interval is the delay between two consecutive exports.
+	Interval *int `yaml:"interval,omitempty"`
+	// Timeout is the maximum duration of an export.
+	Timeout *int `yaml:"timeout,omitempty"`
+	// Exporter is the metric exporter.
+	Exporter MetricExporter `yaml:"exporter"`
+}
+
+// MetricExporter configures a metric exporter. Exactly one field needs to be
+// set.
+type MetricExporter struct {
+	// OTLP configures an OTLP exporter.
+	OTLP *OTLPMetric `yaml:"otlp,omitempty"`
+	// Console configures an exporter writing to the standard output.
+	Console *Console `yaml:"console,omitempty"`
+}
+
+// LoggerProvider configures a LoggerProvider.
+type LoggerProvider struct {
+	// Processors are the log record processors, in registration order.
+	Processors []LogRecordProcessor `yaml:"processors,omitempty"`
+	// Limits are the log record limits.
+	Limits *AttributeLimits `yaml:"limits,omitempty"`
+}
+
+// LogRecordProcessor configures a log record processor. Exactly one field
+// needs to be set.
+type LogRecordProcessor struct {
+	// Batch configures a batch processor.
+	Batch *BatchLogRecordProcessor `yaml:"batch,omitempty"`
+	// Simple configures a simple processor.
+	Simple *SimpleLogRecordProcessor `yaml:"simple,omitempty"`
+}
+
+// BatchLogRecordProcessor configures a batch log record processor. Durations
+// are in milliseconds.
+type BatchLogRecordProcessor struct {
+	// ScheduleDelay is the delay between two consecutive exports.
+	ScheduleDelay *int `yaml:"schedule_delay,omitempty"`
+	// ExportTimeout is the maximum duration of an export.
+	ExportTimeout *int `yaml:"export_timeout,omitempty"`
+	// MaxQueueSize is the maximum number of queued log records.
+	MaxQueueSize *int `yaml:"max_queue_size,omitempty"`
+	// MaxExportBatchSize is the maximum number of log records of an export.
+	MaxExportBatchSize *int `yaml:"max_export_batch_size,omitempty"`
+	// Exporter is the log record exporter.
+	Exporter LogRecordExporter `yaml:"exporter"`
+}
+
+// SimpleLogRecordProcessor configures a simple log record processor.
+type SimpleLogRecordProcessor struct {
+	// Exporter is the log record exporter.
+	Exporter LogRecordExporter `yaml:"exporter"`
+}
+
+// LogRecordExporter configures a log record exporter. Exactly one field
+// needs to be set.
+type LogRecordExporter struct {
+	// OTLP configures an OTLP exporter.
+	OTLP *OTLP `yaml:"otlp,omitempty"`
+	// Console configures an exporter writing to the standard output.
+	Console *Console `yaml:"console,omitempty"`
+}
+
+// ParseYAML parses a declarative configuration file in the YAML or JSON
+// format. An error is returned if the file holds a field unknown to this
+// package, e.g. one configuring an unsupported feature such as views.
+//
+// Environment variable references of the form ${NAME} or ${env:NAME} in
+// scalar values are replaced with the value of the environment variable
+// before the value is decoded. A default value can be given with
+// ${NAME:-default}. Use $$ to write a literal $. The type of an unquoted value
+// is resolved after substitution, so that ${PORT} can be decoded as an
+// integer, while a quoted value stays a string. References in keys are not
+// substituted.
+func ParseYAML(file []byte) (*OpenTelemetryConfiguration, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(file, &doc); err != nil {
+		return nil, fmt.Errorf("otelconf: %w", err)
+	}
+	if err := substituteEnvNode(&doc); err != nil {
+		return nil, err
+	}
+
+	var cfg OpenTelemetryConfiguration
+	if doc.Kind != 0 {
+		// Node.Decode cannot reject unknown fields, the substituted document
+		// is encoded again to be decoded strictly.
+		b, err := yaml.Marshal(&doc)
+		if err != nil {
+			return nil, fmt.Errorf("otelconf: %w", err)
+		}
+		dec := yaml.NewDecoder(bytes.NewReader(b))
+		dec.KnownFields(true)
+		if err := dec.Decode(&cfg); err != nil {
+			return nil, fmt.Errorf("otelconf: %w", err)
+		}
+	}
+	if cfg.FileFormat != SupportedFileFormat {
+		return nil, fmt.Errorf("%w: %q", errUnsupportedFileFormat, cfg.FileFormat)
+	}
+	return &cfg, nil
+}
+
+// substituteEnvNode replaces the environment variable references of the
+// scalar values of n.
+func substituteEnvNode(n *yaml.Node) error {
+	switch n.Kind {
+	case yaml.ScalarNode:
+		v, err := substituteEnv([]byte(n.Value))
+		if err != nil {
+			return err
+		}
+		if string(v) != n.Value {
+			n.Value = string(v)
+			if n.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle|yaml.LiteralStyle|yaml.FoldedStyle) == 0 {
+				// Resolve the type of the substituted plain value.
+				n.Tag = ""
+			}
+		}
+	case yaml.MappingNode:
+		// Only substitute the values, not the keys.
+		var err error
+		for i := 1; i < len(n.Content); i += 2 {
+			err = errors.Join(err, substituteEnvNode(n.Content[i]))
+		}
+		return err
+	default:
+		var err error
+		for _, c := range n.Content {
+			err = errors.Join(err, substituteEnvNode(c))
+		}
+		return err
+	}
+	return nil
+}
+
+var envRefRegexp = regexp.MustCompile(`\$\$|\$\{(?:env:)?([a-zA-Z_][a-zA-Z0-9_]*)(?::-([^\n}]*))?\}|\$\{[^}]*\}`)
+
+var errInvalidEnvRef = errors.New("otelconf: invalid environment variable reference")
+
+// substituteEnv replaces the environment variable references of value.
+func substituteEnv(value []byte) ([]byte, error) {
+	var err error
+	out := envRefRegexp.ReplaceAllFunc(value, func(ref []byte) []byte {
+		if string(ref) == "$$" {
+			return []byte("$")
+		}
+		m := envRefRegexp.FindSubmatch(ref)
+		if m[1] == nil {
+			err = errors.Join(err, fmt.Errorf("%w: %s", errInvalidEnvRef, ref))
+			return ref
+		}
+		if v, ok := os.LookupEnv(string(m[1])); ok && v != "" {
+			return []byte(v)
+		}
+		return m[2]
+	})
+	return out, err
+}
@@ -0,0 +1,172 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelconf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestParseYAML(t *testing.T) {
+	t.Setenv("SERVICE_NAME", "")
+
+	want := &OpenTelemetryConfiguration{
+		FileFormat: "0.3",
+		Disabled:   ptr(false),
+		Resource: &Resource{
+			Attributes: []AttributeNameValue{
+				{Name: "service.name", Value: "unknown_service"},
+				{Name: "int_array", Value: []any{1, 2}, Type: ptr("int_array")},
+				{Name: "double", Value: 1, Type: ptr("double")},
+			},
+			AttributesList: ptr("service.namespace=my-namespace,service.version=1.0.0"),
+			SchemaURL:      ptr("https://opentelemetry.io/schemas/1.26.0"),
+		},
+		AttributeLimits: &AttributeLimits{
+			AttributeValueLengthLimit: ptr(4096),
+			AttributeCountLimit:       ptr(128),
+		},
+		Propagator: &Propagator{Composite: []string{"tracecontext", "baggage", "xray"}},
+		TracerProvider: &TracerProvider{
+			Processors: []SpanProcessor{
+				{Batch: &BatchSpanProcessor{
+					ScheduleDelay:      ptr(5000),
+					ExportTimeout:      ptr(30000),
+					MaxQueueSize:       ptr(2048),
+					MaxExportBatchSize: ptr(512),
+					Exporter: SpanExporter{OTLP: &OTLP{
+						Protocol:    "http/protobuf",
+						Endpoint:    ptr("http://localhost:4318/v1/traces"),
+						Headers:     []NameStringValuePair{{Name: "api-key", Value: ptr("1234")}},
+						HeadersList: ptr("api-key=5678,other=value"),
+						Compression: ptr("gzip"),
+						Timeout:     ptr(10000),
+					}},
+				}},
+				{Simple: &SimpleSpanProcessor{Exporter: SpanExporter{Console: &Console{}}}},
+			},
+			Limits: &SpanLimits{
+				AttributeLimits:          AttributeLimits{AttributeCountLimit: ptr(64)},
+				EventCountLimit:          ptr(128),
+				LinkCountLimit:           ptr(128),
+				EventAttributeCountLimit: ptr(16),
+				LinkAttributeCountLimit:  ptr(16),
+			},
+			Sampler: &Sampler{ParentBased: &ParentBasedSampler{
+				Root:                   &Sampler{TraceIDRatioBased: &TraceIDRatioBasedSampler{Ratio: ptr(0.5)}},
+				RemoteParentNotSampled: &Sampler{AlwaysOff: &struct{}{}},
+			}},
+		},
+		MeterProvider: &MeterProvider{
+			Readers: []MetricReader{{Periodic: &PeriodicMetricReader{
+				Interval: ptr(5000),
+				Timeout:  ptr(30000),
+				Exporter: MetricExporter{OTLP: &OTLPMetric{
+					OTLP: OTLP{
+						Protocol: "grpc",
+						Endpoint: ptr("http://localhost:4317"),
+					},
+					TemporalityPreference: ptr("delta"),
+				}},
+			}}},
+			ExemplarFilter: ptr("trace_based"),
+		},
+		LoggerProvider: &LoggerProvider{
+			Processors: []LogRecordProcessor{{Batch: &BatchLogRecordProcessor{
+				ScheduleDelay: ptr(1000),
+				Exporter: LogRecordExporter{OTLP: &OTLP{
+					Protocol: "grpc",
+					Endpoint: ptr("http://localhost:4317"),
+					Insecure: ptr(true),
+				}},
+			}}},
+			Limits: &AttributeLimits{AttributeValueLengthLimit: ptr(1024)},
+		},
+	}
+
+	for _, file := range []string{"v0.3.yaml", "v0.3.json"} {
+		t.Run(file, func(t *testing.T) {
+			b, err := os.ReadFile(filepath.Join("testdata", file))
+			require.NoError(t, err)
+			got, err := ParseYAML(b)
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestParseYAMLErrors(t *testing.T) {
+	_, err := ParseYAML([]byte(`file_format: "0.1"`))
+	assert.ErrorIs(t, err, errUnsupportedFileFormat)
+
+	_, err = ParseYAML([]byte(`file_format: [`))
+	assert.Error(t, err)
+
+	_, err = ParseYAML([]byte("file_format: \"0.3\"\nresource:\n  schema_url: ${1INVALID}"))
+	assert.ErrorIs(t, err, errInvalidEnvRef)
+
+	_, err = ParseYAML([]byte("file_format: \"0.3\"\nmeter_provider:\n  views: []"))
+	assert.ErrorContains(t, err, "field views not found")
+}
+
+func TestParseYAMLSubstituteEnv(t *testing.T) {
+	t.Setenv("INTERVAL", "5000")
+	t.Setenv("STRUCTURED", "a: [b]")
+	t.Setenv("KEY", "key")
+
+	cfg, err := ParseYAML([]byte(`file_format: "0.3"
+resource:
+  schema_url: ${STRUCTURED}
+  attributes:
+    - name: ${KEY}
+      value: "${INTERVAL}"
+meter_provider:
+  readers:
+    - periodic:
+        interval: ${INTERVAL}
+        exporter:
+          console: {}
+# ${1INVALID} in a comment is ignored.
+`))
+	require.NoError(t, err)
+	assert.Equal(t, "a: [b]", *cfg.Resource.SchemaURL)
+	assert.Equal(t, "key", cfg.Resource.Attributes[0].Name)
+	assert.Equal(t, "5000", cfg.Resource.Attributes[0].Value)
+	assert.Equal(t, 5000, *cfg.MeterProvider.Readers[0].Periodic.Interval)
+
+	// Keys are not substituted.
+	_, err = ParseYAML([]byte("file_format: \"0.3\"\n${KEY}: value"))
+	assert.ErrorContains(t, err, "field ${KEY} not found")
+}
+
+func TestSubstituteEnv(t *testing.T) {
+	t.Setenv("STRING", "value")
+	t.Setenv("EMPTY", "")
+
+	tests := []struct {
+		in, want string
+	}{
+		{in: "${STRING}", want: "value"},
+		{in: "${env:STRING}", want: "value"},
+		{in: "a ${STRING} b ${STRING}", want: "a value b value"},
+		{in: "${UNDEFINED}", want: ""},
+		{in: "${UNDEFINED:-default}", want: "default"},
+		{in: "${EMPTY:-default}", want: "default"},
+		{in: "${STRING:-default}", want: "value"},
+		{in: "$${STRING}", want: "${STRING}"},
+		{in: "$$$$", want: "$$"},
+		{in: "$STRING", want: "$STRING"},
+	}
+	for _, tt := range tests {
+		got, err := substituteEnv([]byte(tt.in))
+		require.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, string(got), tt.in)
+	}
+}
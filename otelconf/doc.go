@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package otelconf builds the OpenTelemetry SDK from a declarative
+configuration, as defined by the [OpenTelemetry configuration] project. It
+allows configuring a deployment without code changes.
+
+Use [ParseYAML] to parse a configuration file in the YAML or JSON format, and
+[NewSDK] to build the providers and the propagator it describes:
+
+	b, err := os.ReadFile("otel.yaml")
+	if err != nil {
+		// Handle error.
+	}
+	cfg, err := otelconf.ParseYAML(b)
+	if err != nil {
+		// Handle error.
+	}
+	sdk, err := otelconf.NewSDK(otelconf.WithOpenTelemetryConfiguration(*cfg))
+	if err != nil {
+		// Handle error.
+	}
+	defer func() { _ = sdk.Shutdown(context.Background()) }()
+
+	otel.SetTracerProvider(sdk.TracerProvider())
+	otel.SetMeterProvider(sdk.MeterProvider())
+	global.SetLoggerProvider(sdk.LoggerProvider())
+	otel.SetTextMapPropagator(sdk.Propagator())
+
+Version [SupportedFileFormat] of the file format is supported. The OTLP and
+console exporters, the batch and simple processors, the periodic metric
+reader, and the samplers of the SDK are supported. Configuring an unsupported
+component, such as a pull metric reader, a Zipkin exporter, or views, results
+in an error.
+
+[OpenTelemetry configuration]: https://github.com/open-telemetry/opentelemetry-configuration
+*/
+package otelconf // import "go.opentelemetry.io/otel/otelconf"
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelconf // import "go.opentelemetry.io/otel/otelconf"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+func (b builder) loggerProvider(cfg *LoggerProvider) (*sdklog.LoggerProvider, error) {
+	opts := []sdklog.LoggerProviderOption{sdklog.WithResource(b.res)}
+	for _, lim := range []*AttributeLimits{b.cfg.AttributeLimits, cfg.Limits} {
+		if lim == nil {
+			continue
+		}
+		if n := lim.AttributeCountLimit; n != nil {
+			opts = append(opts, sdklog.WithAttributeCountLimit(*n))
+		}
+		if n := lim.AttributeValueLengthLimit; n != nil {
+			opts = append(opts, sdklog.WithAttributeValueLengthLimit(*n))
+		}
+	}
+
+	var processors []sdklog.Processor
+	for _, p := range cfg.Processors {
+		proc, err := b.logProcessor(p)
+		if err != nil {
+			for _, created := range processors {
+				_ = created.Shutdown(b.ctx)
+			}
+			return nil, err
+		}
+		processors = append(processors, proc)
+		opts = append(opts, sdklog.WithProcessor(proc))
+	}
+	return sdklog.NewLoggerProvider(opts...), nil
+}
+
+func (b builder) logProcessor(cfg LogRecordProcessor) (sdklog.Processor, error) {
+	switch {
+	case cfg.Batch != nil && cfg.Simple == nil:
+		exp, err := b.logExporter(cfg.Batch.Exporter)
+		if err != nil {
+			return nil, err
+		}
+		var opts []sdklog.BatchProcessorOption
+		if d := cfg.Batch.ScheduleDelay; d != nil {
+			opts = append(opts, sdklog.WithExportInterval(millis(*d)))
+		}
+		if d := cfg.Batch.ExportTimeout; d != nil {
+			opts = append(opts, sdklog.WithExportTimeout(millis(*d)))
+		}
+		if n := cfg.Batch.MaxQueueSize; n != nil {
+			opts = append(opts, sdklog.WithMaxQueueSize(*n))
+		}
+		if n := cfg.Batch.MaxExportBatchSize; n != nil {
+			opts = append(opts, sdklog.WithExportMaxBatchSize(*n))
+		}
+		return sdklog.NewBatchProcessor(exp, opts...), nil
+	case cfg.Simple != nil && cfg.Batch == nil:
+		exp, err := b.logExporter(cfg.Simple.Exporter)
+		if err != nil {
+			return nil, err
+		}
+		return sdklog.NewSimpleProcessor(exp), nil
+	}
+	return nil, errInvalidProcessor
+}
+
+func (b builder) logExporter(cfg LogRecordExporter) (sdklog.Exporter, error) {
+	switch {
+	case cfg.OTLP != nil && cfg.Console == nil:
+		return b.otlpLogExporter(cfg.OTLP)
+	case cfg.Console != nil && cfg.OTLP == nil:
+		return stdoutlog.New(stdoutlog.WithWriter(b.console()))
+	}
+	return nil, errInvalidExporter
+}
+
+func (b builder) otlpLogExporter(cfg *OTLP) (sdklog.Exporter, error) {
+	o, err := newOTLPOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	switch cfg.Protocol {
+	case protocolGRPC:
+		var opts []otlploggrpc.Option
+		if o.endpoint != "" {
+			opts = append(opts, otlploggrpc.WithEndpointURL(o.endpoint))
+		}
+		if o.insecure {
+			opts = append(opts, otlploggrpc.WithInsecure())
+		}
+		if o.gzip {
+			opts = append(opts, otlploggrpc.WithCompressor(compressionGzip))
+		}
+		if o.timeout > 0 {
+			opts = append(opts, otlploggrpc.WithTimeout(o.timeout))
+		}
+		if len(o.headers) > 0 {
+			opts = append(opts, otlploggrpc.WithHeaders(o.headers))
+		}
+		return otlploggrpc.New(b.ctx, opts...)
+	case protocolHTTP:
+		var opts []otlploghttp.Option
+		if o.endpoint != "" {
+			opts = append(opts, otlploghttp.WithEndpointURL(o.endpoint))
+		}
+		if o.gzip {
+			opts = append(opts, otlploghttp.WithCompression(otlploghttp.GzipCompression))
+		}
+		if o.timeout > 0 {
+			opts = append(opts, otlploghttp.WithTimeout(o.timeout))
+		}
+		if len(o.headers) > 0 {
+			opts = append(opts, otlploghttp.WithHeaders(o.headers))
+		}
+		return otlploghttp.New(b.ctx, opts...)
+	}
+	return nil, fmt.Errorf("%w: %q", errUnsupportedProtocol, cfg.Protocol)
+}
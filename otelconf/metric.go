@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelconf // import "go.opentelemetry.io/otel/otelconf"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+var (
+	errInvalidReader          = errors.New("otelconf: reader requires exactly one supported reader")
+	errUnsupportedTemporality = errors.New("otelconf: unsupported temporality preference")
+	errUnsupportedFilter      = errors.New("otelconf: unsupported exemplar filter")
+)
+
+func (b builder) meterProvider(cfg *MeterProvider) (*sdkmetric.MeterProvider, []*sdkmetric.PeriodicReader, error) {
+	opts := []sdkmetric.Option{sdkmetric.WithResource(b.res)}
+	if cfg.ExemplarFilter != nil {
+		var f exemplar.Filter
+		switch *cfg.ExemplarFilter {
+		case "trace_based":
+			f = exemplar.TraceBasedFilter
+		case "always_on":
+			f = exemplar.AlwaysOnFilter
+		case "always_off":
+			f = exemplar.AlwaysOffFilter
+		default:
+			return nil, nil, fmt.Errorf("%w: %q", errUnsupportedFilter, *cfg.ExemplarFilter)
+		}
+		opts = append(opts, sdkmetric.WithExemplarFilter(f))
+	}
+
+	var readers []*sdkmetric.PeriodicReader
+	for _, r := range cfg.Readers {
+		reader, err := b.metricReader(r)
+		if err != nil {
+			for _, created := range readers {
+				_ = created.Shutdown(b.ctx)
+			}
+			return nil, nil, err
+		}
+		readers = append(readers, reader)
+		opts = append(opts, sdkmetric.WithReader(reader))
+	}
+	return sdkmetric.NewMeterProvider(opts...), readers, nil
+}
+
+func (b builder) metricReader(cfg MetricReader) (*sdkmetric.PeriodicReader, error) {
+	if cfg.Periodic == nil {
+		return nil, errInvalidReader
+	}
+
+	exp, err := b.metricExporter(cfg.Periodic.Exporter)
+	if err != nil {
+		return nil, err
+	}
+	var opts []sdkmetric.PeriodicReaderOption
+	if d := cfg.Periodic.Interval; d != nil {
+		opts = append(opts, sdkmetric.WithInterval(millis(*d)))
+	}
+	if d := cfg.Periodic.Timeout; d != nil {
+		opts = append(opts, sdkmetric.WithTimeout(millis(*d)))
+	}
+	return sdkmetric.NewPeriodicReader(exp, opts...), nil
+}
+
+func (b builder) metricExporter(cfg MetricExporter) (sdkmetric.Exporter, error) {
+	switch {
+	case cfg.OTLP != nil && cfg.Console == nil:
+		return b.otlpMetricExporter(cfg.OTLP)
+	case cfg.Console != nil && cfg.OTLP == nil:
+		return stdoutmetric.New(stdoutmetric.WithWriter(b.console()))
+	}
+	return nil, errInvalidExporter
+}
+
+func (b builder) otlpMetricExporter(cfg *OTLPMetric) (sdkmetric.Exporter, error) {
+	o, err := newOTLPOptions(&cfg.OTLP)
+	if err != nil {
+		return nil, err
+	}
+	temporality := sdkmetric.DefaultTemporalitySelector
+	if cfg.TemporalityPreference != nil {
+		if temporality, err = temporalitySelector(*cfg.TemporalityPreference); err != nil {
+			return nil, err
+		}
+	}
+
+	switch cfg.Protocol {
+	case protocolGRPC:
+		opts := []otlpmetricgrpc.Option{otlpmetricgrpc.WithTemporalitySelector(temporality)}
+		if o.endpoint != "" {
+			opts = append(opts, otlpmetricgrpc.WithEndpointURL(o.endpoint))
+		}
+		if o.insecure {
+			opts = append(opts, otlpmetricgrpc.WithInsecure())
+		}
+		if o.gzip {
+			opts = append(opts, otlpmetricgrpc.WithCompressor(compressionGzip))
+		}
+		if o.timeout > 0 {
+			opts = append(opts, otlpmetricgrpc.WithTimeout(o.timeout))
+		}
+		if len(o.headers) > 0 {
+			opts = append(opts, otlpmetricgrpc.WithHeaders(o.headers))
+		}
+		return otlpmetricgrpc.New(b.ctx, opts...)
+	case protocolHTTP:
+		opts := []otlpmetrichttp.Option{otlpmetrichttp.WithTemporalitySelector(temporality)}
+		if o.endpoint != "" {
+			opts = append(opts, otlpmetrichttp.WithEndpointURL(o.endpoint))
+		}
+		if o.gzip {
+			opts = append(opts, otlpmetrichttp.WithCompression(otlpmetrichttp.GzipCompression))
+		}
+		if o.timeout > 0 {
+			opts = append(opts, otlpmetrichttp.WithTimeout(o.timeout))
+		}
+		if len(o.headers) > 0 {
+			opts = append(opts, otlpmetrichttp.WithHeaders(o.headers))
+		}
+		return otlpmetrichttp.New(b.ctx, opts...)
+	}
+	return nil, fmt.Errorf("%w: %q", errUnsupportedProtocol, cfg.Protocol)
+}
+
+// temporalitySelector returns the TemporalitySelector of the OTLP
+// temporality preference pref.
+func temporalitySelector(pref string) (sdkmetric.TemporalitySelector, error) {
+	switch pref {
+	case "cumulative":
+		return sdkmetric.DefaultTemporalitySelector, nil
+	case "delta":
+		return func(k sdkmetric.InstrumentKind) metricdata.Temporality {
+			switch k {
+			case sdkmetric.InstrumentKindUpDownCounter, sdkmetric.InstrumentKindObservableUpDownCounter:
+				return metricdata.CumulativeTemporality
+			}
+			return metricdata.DeltaTemporality
+		}, nil
+	case "low_memory":
+		return func(k sdkmetric.InstrumentKind) metricdata.Temporality {
+			switch k {
+			case sdkmetric.InstrumentKindCounter, sdkmetric.InstrumentKindHistogram:
+				return metricdata.DeltaTemporality
+			}
+			return metricdata.CumulativeTemporality
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: %q", errUnsupportedTemporality, pref)
+}
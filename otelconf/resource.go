@@ -0,0 +1,204 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelconf // import "go.opentelemetry.io/otel/otelconf"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+var (
+	errInvalidAttribute    = errors.New("otelconf: invalid attribute")
+	errUnsupportedProp     = errors.New("otelconf: unsupported propagator")
+	errInvalidKeyValueList = errors.New("otelconf: invalid key=value list")
+)
+
+// newResource returns the default resource merged with the attributes of
+// cfg.
+func newResource(cfg *Resource) (*resource.Resource, error) {
+	if cfg == nil {
+		return resource.Default(), nil
+	}
+
+	var attrs []attribute.KeyValue
+	if cfg.AttributesList != nil {
+		list, err := parseKeyValueList(*cfg.AttributesList)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range list {
+			attrs = append(attrs, attribute.String(k, v))
+		}
+	}
+	for _, a := range cfg.Attributes {
+		kv, err := a.keyValue()
+		if err != nil {
+			return nil, err
+		}
+		// Appended last so they have precedence over the attributes list.
+		attrs = append(attrs, kv)
+	}
+
+	var schemaURL string
+	if cfg.SchemaURL != nil {
+		schemaURL = *cfg.SchemaURL
+	}
+	return resource.Merge(resource.Default(), resource.NewWithAttributes(schemaURL, attrs...))
+}
+
+func (a AttributeNameValue) keyValue() (attribute.KeyValue, error) {
+	typ := ""
+	if a.Type != nil {
+		typ = *a.Type
+	}
+	invalid := func() (attribute.KeyValue, error) {
+		return attribute.KeyValue{}, fmt.Errorf("%w %q: %v is not a valid %s", errInvalidAttribute, a.Name, a.Value, typ)
+	}
+
+	switch v := a.Value.(type) {
+	case string:
+		if typ == "" || typ == "string" {
+			return attribute.String(a.Name, v), nil
+		}
+	case bool:
+		if typ == "" || typ == "bool" {
+			return attribute.Bool(a.Name, v), nil
+		}
+	case int:
+		switch typ {
+		case "", "int":
+			return attribute.Int(a.Name, v), nil
+		case "double":
+			return attribute.Float64(a.Name, float64(v)), nil
+		}
+	case float64:
+		if typ == "" || typ == "double" {
+			return attribute.Float64(a.Name, v), nil
+		}
+	case []any:
+		return sliceKeyValue(a.Name, typ, v)
+	}
+	return invalid()
+}
+
+func sliceKeyValue(name, typ string, values []any) (attribute.KeyValue, error) {
+	if typ == "" && len(values) > 0 {
+		switch values[0].(type) {
+		case string:
+			typ = "string_array"
+		case bool:
+			typ = "bool_array"
+		case int:
+			typ = "int_array"
+		case float64:
+			typ = "double_array"
+		}
+	}
+
+	var err error
+	switch typ {
+	case "string_array":
+		s, e := convertSlice(values, func(v any) (string, bool) { s, ok := v.(string); return s, ok })
+		err = e
+		if err == nil {
+			return attribute.StringSlice(name, s), nil
+		}
+	case "bool_array":
+		s, e := convertSlice(values, func(v any) (bool, bool) { b, ok := v.(bool); return b, ok })
+		err = e
+		if err == nil {
+			return attribute.BoolSlice(name, s), nil
+		}
+	case "int_array":
+		s, e := convertSlice(values, func(v any) (int, bool) { i, ok := v.(int); return i, ok })
+		err = e
+		if err == nil {
+			return attribute.IntSlice(name, s), nil
+		}
+	case "double_array":
+		s, e := convertSlice(values, func(v any) (float64, bool) {
+			switch n := v.(type) {
+			case float64:
+				return n, true
+			case int:
+				return float64(n), true
+			}
+			return 0, false
+		})
+		err = e
+		if err == nil {
+			return attribute.Float64Slice(name, s), nil
+		}
+	default:
+		err = errors.New("not a valid array type " + typ)
+	}
+	return attribute.KeyValue{}, fmt.Errorf("%w %q: %w", errInvalidAttribute, name, err)
+}
+
+func convertSlice[T any](values []any, conv func(any) (T, bool)) ([]T, error) {
+	out := make([]T, len(values))
+	for i, v := range values {
+		var ok bool
+		if out[i], ok = conv(v); !ok {
+			return nil, fmt.Errorf("invalid element %v", v)
+		}
+	}
+	return out, nil
+}
+
+// parseKeyValueList parses a comma-separated list of percent-encoded
+// key=value pairs.
+func parseKeyValueList(s string) (map[string]string, error) {
+	out := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", errInvalidKeyValueList, pair)
+		}
+		key, err := url.PathUnescape(strings.TrimSpace(k))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidKeyValueList, err)
+		}
+		val, err := url.PathUnescape(strings.TrimSpace(v))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidKeyValueList, err)
+		}
+		out[key] = val
+	}
+	return out, nil
+}
+
+// newPropagator returns the propagator configured by cfg. The W3C trace
+// context and baggage propagators are used if cfg is nil.
+func newPropagator(cfg *Propagator) (propagation.TextMapPropagator, error) {
+	names := []string{"tracecontext", "baggage"}
+	if cfg != nil {
+		names = cfg.Composite
+	}
+
+	var props []propagation.TextMapPropagator
+	for _, name := range names {
+		switch name {
+		case "tracecontext":
+			props = append(props, propagation.TraceContext{})
+		case "baggage":
+			props = append(props, propagation.Baggage{})
+		case "xray":
+			props = append(props, propagation.AWSXRay{})
+		case "none":
+		default:
+			return nil, fmt.Errorf("%w: %q", errUnsupportedProp, name)
+		}
+	}
+	return propagation.NewCompositeTextMapPropagator(props...), nil
+}
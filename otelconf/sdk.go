@@ -0,0 +1,204 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelconf // import "go.opentelemetry.io/otel/otelconf"
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+	nooplog "go.opentelemetry.io/otel/log/noop"
+	"go.opentelemetry.io/otel/metric"
+	noopmetric "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/propagation"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	nooptrace "go.opentelemetry.io/otel/trace/noop"
+)
+
+var errNoConfiguration = errors.New("otelconf: no configuration provided")
+
+// Controller is the control surface of a running SDK. A remote
+// configuration client, such as an OpAMP client, can use it to change the
+// sampling, the log verbosity, and the metric collection interval of the
+// SDK without tearing down its processors, readers, and exporters.
+type Controller interface {
+	// SetSampler replaces the Sampler of the TracerProvider.
+	SetSampler(sdktrace.Sampler)
+	// SetMinSeverity sets the minimum severity of the records emitted with
+	// the LoggerProvider.
+	SetMinSeverity(log.Severity)
+	// SetInterval sets the interval between the collections of the periodic
+	// metric readers.
+	SetInterval(time.Duration)
+}
+
+var _ Controller = SDK{}
+
+// SDK holds the providers and the propagator built from a configuration.
+type SDK struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	loggerProvider log.LoggerProvider
+	propagator     propagation.TextMapPropagator
+	shutdown       []func(context.Context) error
+
+	// The configured SDK components controlled by the Controller methods.
+	sdkTracerProvider *sdktrace.TracerProvider
+	sdkLoggerProvider *sdklog.LoggerProvider
+	periodicReaders   []*sdkmetric.PeriodicReader
+}
+
+// TracerProvider returns the configured TracerProvider.
+func (s SDK) TracerProvider() trace.TracerProvider {
+	return s.tracerProvider
+}
+
+// MeterProvider returns the configured MeterProvider.
+func (s SDK) MeterProvider() metric.MeterProvider {
+	return s.meterProvider
+}
+
+// LoggerProvider returns the configured LoggerProvider.
+func (s SDK) LoggerProvider() log.LoggerProvider {
+	return s.loggerProvider
+}
+
+// Propagator returns the configured TextMapPropagator.
+func (s SDK) Propagator() propagation.TextMapPropagator {
+	return s.propagator
+}
+
+// SetSampler replaces the Sampler of the configured TracerProvider. It does
+// nothing if no TracerProvider is configured.
+func (s SDK) SetSampler(sampler sdktrace.Sampler) {
+	if s.sdkTracerProvider != nil {
+		s.sdkTracerProvider.SetSampler(sampler)
+	}
+}
+
+// SetMinSeverity sets the minimum severity of the records emitted with the
+// configured LoggerProvider. It does nothing if no LoggerProvider is
+// configured.
+func (s SDK) SetMinSeverity(sev log.Severity) {
+	if s.sdkLoggerProvider != nil {
+		s.sdkLoggerProvider.SetMinSeverity(sev)
+	}
+}
+
+// SetInterval sets the interval between the collections of all the
+// configured periodic metric readers.
+func (s SDK) SetInterval(d time.Duration) {
+	for _, r := range s.periodicReaders {
+		r.SetInterval(d)
+	}
+}
+
+// Shutdown shuts down the configured providers, flushing all the telemetry
+// they hold.
+func (s SDK) Shutdown(ctx context.Context) error {
+	var err error
+	for _, f := range s.shutdown {
+		err = errors.Join(err, f(ctx))
+	}
+	return err
+}
+
+type configOptions struct {
+	ctx                        context.Context
+	opentelemetryConfiguration OpenTelemetryConfiguration
+}
+
+// ConfigurationOption configures options for NewSDK.
+type ConfigurationOption interface {
+	apply(configOptions) configOptions
+}
+
+type configurationOptionFunc func(configOptions) configOptions
+
+func (fn configurationOptionFunc) apply(cfg configOptions) configOptions {
+	return fn(cfg)
+}
+
+// WithContext sets the context used to build the exporters. It defaults to
+// context.Background.
+func WithContext(ctx context.Context) ConfigurationOption {
+	return configurationOptionFunc(func(c configOptions) configOptions {
+		c.ctx = ctx
+		return c
+	})
+}
+
+// WithOpenTelemetryConfiguration sets the configuration to build the SDK
+// from. It is required.
+func WithOpenTelemetryConfiguration(cfg OpenTelemetryConfiguration) ConfigurationOption {
+	return configurationOptionFunc(func(c configOptions) configOptions {
+		c.opentelemetryConfiguration = cfg
+		return c
+	})
+}
+
+// NewSDK returns an SDK built from the configuration set with
+// WithOpenTelemetryConfiguration.
+//
+// The returned providers are not registered globally. If an error is
+// returned, all the built components are shut down.
+func NewSDK(opts ...ConfigurationOption) (SDK, error) {
+	o := configOptions{ctx: context.Background()}
+	for _, opt := range opts {
+		o = opt.apply(o)
+	}
+	cfg := o.opentelemetryConfiguration
+	if cfg.FileFormat == "" {
+		return SDK{}, errNoConfiguration
+	}
+
+	s := SDK{
+		tracerProvider: nooptrace.NewTracerProvider(),
+		meterProvider:  noopmetric.NewMeterProvider(),
+		loggerProvider: nooplog.NewLoggerProvider(),
+		propagator:     propagation.NewCompositeTextMapPropagator(),
+	}
+	if cfg.Disabled != nil && *cfg.Disabled {
+		return s, nil
+	}
+
+	b := builder{ctx: o.ctx, cfg: cfg}
+	var err error
+	if s.propagator, err = newPropagator(cfg.Propagator); err != nil {
+		return SDK{}, err
+	}
+	if b.res, err = newResource(cfg.Resource); err != nil {
+		return SDK{}, err
+	}
+
+	if cfg.TracerProvider != nil {
+		tp, err := b.tracerProvider(cfg.TracerProvider)
+		if err != nil {
+			return SDK{}, errors.Join(err, s.Shutdown(o.ctx))
+		}
+		s.tracerProvider, s.sdkTracerProvider = tp, tp
+		s.shutdown = append(s.shutdown, tp.Shutdown)
+	}
+	if cfg.MeterProvider != nil {
+		mp, readers, err := b.meterProvider(cfg.MeterProvider)
+		if err != nil {
+			return SDK{}, errors.Join(err, s.Shutdown(o.ctx))
+		}
+		s.meterProvider, s.periodicReaders = mp, readers
+		s.shutdown = append(s.shutdown, mp.Shutdown)
+	}
+	if cfg.LoggerProvider != nil {
+		lp, err := b.loggerProvider(cfg.LoggerProvider)
+		if err != nil {
+			return SDK{}, errors.Join(err, s.Shutdown(o.ctx))
+		}
+		s.loggerProvider, s.sdkLoggerProvider = lp, lp
+		s.shutdown = append(s.shutdown, lp.Shutdown)
+	}
+	return s, nil
+}
@@ -0,0 +1,267 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelconf
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	nooplog "go.opentelemetry.io/otel/log/noop"
+	noopmetric "go.opentelemetry.io/otel/metric/noop"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	nooptrace "go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestNewSDK(t *testing.T) {
+	b, err := os.ReadFile(filepath.Join("testdata", "v0.3.yaml"))
+	require.NoError(t, err)
+	cfg, err := ParseYAML(b)
+	require.NoError(t, err)
+
+	sdk, err := NewSDK(WithContext(context.Background()), WithOpenTelemetryConfiguration(*cfg))
+	require.NoError(t, err)
+	assert.IsType(t, &sdktrace.TracerProvider{}, sdk.TracerProvider())
+	assert.IsType(t, &sdkmetric.MeterProvider{}, sdk.MeterProvider())
+	assert.IsType(t, &sdklog.LoggerProvider{}, sdk.LoggerProvider())
+	assert.ElementsMatch(t, []string{"traceparent", "tracestate", "baggage", "X-Amzn-Trace-Id"}, sdk.Propagator().Fields())
+
+	// There is no collector to export to, do not wait for the final exports.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_ = sdk.Shutdown(ctx)
+}
+
+func TestNewSDKNoop(t *testing.T) {
+	_, err := NewSDK()
+	assert.ErrorIs(t, err, errNoConfiguration)
+
+	for name, cfg := range map[string]OpenTelemetryConfiguration{
+		"Empty": {FileFormat: "0.3"},
+		"Disabled": {
+			FileFormat:     "0.3",
+			Disabled:       ptr(true),
+			TracerProvider: &TracerProvider{},
+			MeterProvider:  &MeterProvider{},
+			LoggerProvider: &LoggerProvider{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sdk, err := NewSDK(WithOpenTelemetryConfiguration(cfg))
+			require.NoError(t, err)
+			assert.Equal(t, nooptrace.NewTracerProvider(), sdk.TracerProvider())
+			assert.Equal(t, noopmetric.NewMeterProvider(), sdk.MeterProvider())
+			assert.Equal(t, nooplog.NewLoggerProvider(), sdk.LoggerProvider())
+			assert.NoError(t, sdk.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestSDKController(t *testing.T) {
+	sdk, err := NewSDK(WithOpenTelemetryConfiguration(OpenTelemetryConfiguration{
+		FileFormat:     "0.3",
+		TracerProvider: &TracerProvider{},
+		MeterProvider: &MeterProvider{Readers: []MetricReader{{
+			Periodic: &PeriodicMetricReader{Exporter: MetricExporter{Console: &Console{}}},
+		}}},
+		LoggerProvider: &LoggerProvider{},
+	}))
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, sdk.Shutdown(context.Background())) })
+
+	tr := sdk.TracerProvider().Tracer("TestSDKController")
+	_, span := tr.Start(context.Background(), "sampled")
+	assert.True(t, span.IsRecording())
+
+	sdk.SetSampler(sdktrace.NeverSample())
+	_, span = tr.Start(context.Background(), "dropped")
+	assert.False(t, span.IsRecording())
+
+	sdk.SetMinSeverity(log.SeverityWarn)
+	sdk.SetInterval(time.Hour)
+
+	// The methods of an SDK without providers do nothing.
+	noop, err := NewSDK(WithOpenTelemetryConfiguration(OpenTelemetryConfiguration{FileFormat: "0.3"}))
+	require.NoError(t, err)
+	noop.SetSampler(sdktrace.NeverSample())
+	noop.SetMinSeverity(log.SeverityWarn)
+	noop.SetInterval(time.Hour)
+}
+
+func TestNewSDKErrors(t *testing.T) {
+	otlp := func(protocol string) *OTLP { return &OTLP{Protocol: protocol} }
+
+	tests := []struct {
+		name string
+		cfg  OpenTelemetryConfiguration
+		want error
+	}{
+		{
+			name: "Propagator",
+			cfg:  OpenTelemetryConfiguration{Propagator: &Propagator{Composite: []string{"b3"}}},
+			want: errUnsupportedProp,
+		},
+		{
+			name: "Attribute",
+			cfg: OpenTelemetryConfiguration{Resource: &Resource{Attributes: []AttributeNameValue{
+				{Name: "key", Value: "value", Type: ptr("int")},
+			}}},
+			want: errInvalidAttribute,
+		},
+		{
+			name: "AttributesList",
+			cfg:  OpenTelemetryConfiguration{Resource: &Resource{AttributesList: ptr("invalid")}},
+			want: errInvalidKeyValueList,
+		},
+		{
+			name: "SpanProcessor",
+			cfg:  OpenTelemetryConfiguration{TracerProvider: &TracerProvider{Processors: []SpanProcessor{{}}}},
+			want: errInvalidProcessor,
+		},
+		{
+			name: "SpanExporter",
+			cfg: OpenTelemetryConfiguration{TracerProvider: &TracerProvider{Processors: []SpanProcessor{
+				{Simple: &SimpleSpanProcessor{}},
+			}}},
+			want: errInvalidExporter,
+		},
+		{
+			name: "Protocol",
+			cfg: OpenTelemetryConfiguration{TracerProvider: &TracerProvider{Processors: []SpanProcessor{
+				{Simple: &SimpleSpanProcessor{Exporter: SpanExporter{OTLP: otlp("http/json")}}},
+			}}},
+			want: errUnsupportedProtocol,
+		},
+		{
+			name: "Sampler",
+			cfg: OpenTelemetryConfiguration{TracerProvider: &TracerProvider{Sampler: &Sampler{
+				AlwaysOn:  &struct{}{},
+				AlwaysOff: &struct{}{},
+			}}},
+			want: errInvalidSampler,
+		},
+		{
+			name: "Reader",
+			cfg:  OpenTelemetryConfiguration{MeterProvider: &MeterProvider{Readers: []MetricReader{{}}}},
+			want: errInvalidReader,
+		},
+		{
+			name: "ExemplarFilter",
+			cfg:  OpenTelemetryConfiguration{MeterProvider: &MeterProvider{ExemplarFilter: ptr("invalid")}},
+			want: errUnsupportedFilter,
+		},
+		{
+			name: "Temporality",
+			cfg: OpenTelemetryConfiguration{MeterProvider: &MeterProvider{Readers: []MetricReader{{
+				Periodic: &PeriodicMetricReader{Exporter: MetricExporter{OTLP: &OTLPMetric{
+					OTLP:                  *otlp(protocolGRPC),
+					TemporalityPreference: ptr("invalid"),
+				}}},
+			}}}},
+			want: errUnsupportedTemporality,
+		},
+		{
+			name: "Compression",
+			cfg: OpenTelemetryConfiguration{LoggerProvider: &LoggerProvider{Processors: []LogRecordProcessor{
+				{Simple: &SimpleLogRecordProcessor{Exporter: LogRecordExporter{OTLP: &OTLP{
+					Protocol:    protocolHTTP,
+					Compression: ptr("zstd"),
+				}}}},
+			}}},
+			want: errUnsupportedCompression,
+		},
+		{
+			name: "Endpoint",
+			cfg: OpenTelemetryConfiguration{LoggerProvider: &LoggerProvider{Processors: []LogRecordProcessor{
+				{Simple: &SimpleLogRecordProcessor{Exporter: LogRecordExporter{OTLP: &OTLP{
+					Protocol: protocolGRPC,
+					Endpoint: ptr("localhost:4317"),
+				}}}},
+			}}},
+			want: errInvalidEndpoint,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.FileFormat = SupportedFileFormat
+			_, err := NewSDK(WithOpenTelemetryConfiguration(tt.cfg))
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestNewResource(t *testing.T) {
+	res, err := newResource(&Resource{
+		Attributes: []AttributeNameValue{
+			{Name: "service.name", Value: "service"},
+			{Name: "bool", Value: true},
+			{Name: "int", Value: 1},
+			{Name: "double", Value: 1, Type: ptr("double")},
+			{Name: "strings", Value: []any{"a", "b"}},
+			{Name: "doubles", Value: []any{1, 2.5}, Type: ptr("double_array")},
+		},
+		AttributesList: ptr("service.name=list,list=a%20value"),
+		SchemaURL:      ptr("https://opentelemetry.io/schemas/1.26.0"),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.26.0", res.SchemaURL())
+	set := res.Set()
+	for _, want := range []attribute.KeyValue{
+		attribute.String("service.name", "service"),
+		attribute.Bool("bool", true),
+		attribute.Int("int", 1),
+		attribute.Float64("double", 1),
+		attribute.StringSlice("strings", []string{"a", "b"}),
+		attribute.Float64Slice("doubles", []float64{1, 2.5}),
+		attribute.String("list", "a value"),
+	} {
+		got, ok := set.Value(want.Key)
+		if assert.True(t, ok, want.Key) {
+			assert.Equal(t, want.Value, got, want.Key)
+		}
+	}
+}
+
+func TestSampler(t *testing.T) {
+	s, err := sampler(&Sampler{ParentBased: &ParentBasedSampler{
+		Root:                &Sampler{TraceIDRatioBased: &TraceIDRatioBasedSampler{Ratio: ptr(0.25)}},
+		LocalParentSampled:  &Sampler{AlwaysOff: &struct{}{}},
+		RemoteParentSampled: &Sampler{AlwaysOn: &struct{}{}},
+	}})
+	require.NoError(t, err)
+
+	want := sdktrace.ParentBased(
+		sdktrace.TraceIDRatioBased(0.25),
+		sdktrace.WithLocalParentSampled(sdktrace.NeverSample()),
+		sdktrace.WithRemoteParentSampled(sdktrace.AlwaysSample()),
+	)
+	assert.Equal(t, want.Description(), s.Description())
+}
+
+func TestSpanLimits(t *testing.T) {
+	b := builder{cfg: OpenTelemetryConfiguration{AttributeLimits: &AttributeLimits{
+		AttributeValueLengthLimit: ptr(10),
+		AttributeCountLimit:       ptr(20),
+	}}}
+
+	want := sdktrace.NewSpanLimits()
+	want.AttributeValueLengthLimit = 10
+	want.AttributeCountLimit = 5
+	want.EventCountLimit = 1
+	assert.Equal(t, want, b.spanLimits(&SpanLimits{
+		AttributeLimits: AttributeLimits{AttributeCountLimit: ptr(5)},
+		EventCountLimit: ptr(1),
+	}))
+}
@@ -0,0 +1,225 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelconf // import "go.opentelemetry.io/otel/otelconf"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+var (
+	errInvalidProcessor = errors.New("otelconf: processor requires exactly one of batch or simple")
+	errInvalidExporter  = errors.New("otelconf: exporter requires exactly one supported exporter")
+	errInvalidSampler   = errors.New("otelconf: sampler requires exactly one supported sampler")
+)
+
+func (b builder) tracerProvider(cfg *TracerProvider) (*sdktrace.TracerProvider, error) {
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(b.res),
+		sdktrace.WithRawSpanLimits(b.spanLimits(cfg.Limits)),
+	}
+	if cfg.Sampler != nil {
+		s, err := sampler(cfg.Sampler)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithSampler(s))
+	}
+
+	var processors []sdktrace.SpanProcessor
+	for _, p := range cfg.Processors {
+		sp, err := b.spanProcessor(p)
+		if err != nil {
+			for _, created := range processors {
+				_ = created.Shutdown(b.ctx)
+			}
+			return nil, err
+		}
+		processors = append(processors, sp)
+		opts = append(opts, sdktrace.WithSpanProcessor(sp))
+	}
+	return sdktrace.NewTracerProvider(opts...), nil
+}
+
+func (b builder) spanLimits(cfg *SpanLimits) sdktrace.SpanLimits {
+	l := sdktrace.NewSpanLimits()
+	if lim := b.cfg.AttributeLimits; lim != nil {
+		setInt(&l.AttributeValueLengthLimit, lim.AttributeValueLengthLimit)
+		setInt(&l.AttributeCountLimit, lim.AttributeCountLimit)
+	}
+	if cfg == nil {
+		return l
+	}
+	setInt(&l.AttributeValueLengthLimit, cfg.AttributeValueLengthLimit)
+	setInt(&l.AttributeCountLimit, cfg.AttributeCountLimit)
+	setInt(&l.EventCountLimit, cfg.EventCountLimit)
+	setInt(&l.LinkCountLimit, cfg.LinkCountLimit)
+	setInt(&l.AttributePerEventCountLimit, cfg.EventAttributeCountLimit)
+	setInt(&l.AttributePerLinkCountLimit, cfg.LinkAttributeCountLimit)
+	return l
+}
+
+func (b builder) spanProcessor(cfg SpanProcessor) (sdktrace.SpanProcessor, error) {
+	switch {
+	case cfg.Batch != nil && cfg.Simple == nil:
+		exp, err := b.spanExporter(cfg.Batch.Exporter)
+		if err != nil {
+			return nil, err
+		}
+		var opts []sdktrace.BatchSpanProcessorOption
+		if d := cfg.Batch.ScheduleDelay; d != nil {
+			opts = append(opts, sdktrace.WithBatchTimeout(millis(*d)))
+		}
+		if d := cfg.Batch.ExportTimeout; d != nil {
+			opts = append(opts, sdktrace.WithExportTimeout(millis(*d)))
+		}
+		if n := cfg.Batch.MaxQueueSize; n != nil {
+			opts = append(opts, sdktrace.WithMaxQueueSize(*n))
+		}
+		if n := cfg.Batch.MaxExportBatchSize; n != nil {
+			opts = append(opts, sdktrace.WithMaxExportBatchSize(*n))
+		}
+		return sdktrace.NewBatchSpanProcessor(exp, opts...), nil
+	case cfg.Simple != nil && cfg.Batch == nil:
+		exp, err := b.spanExporter(cfg.Simple.Exporter)
+		if err != nil {
+			return nil, err
+		}
+		return sdktrace.NewSimpleSpanProcessor(exp), nil
+	}
+	return nil, errInvalidProcessor
+}
+
+func (b builder) spanExporter(cfg SpanExporter) (sdktrace.SpanExporter, error) {
+	switch {
+	case cfg.OTLP != nil && cfg.Console == nil:
+		return b.otlpSpanExporter(cfg.OTLP)
+	case cfg.Console != nil && cfg.OTLP == nil:
+		return stdouttrace.New(stdouttrace.WithWriter(b.console()))
+	}
+	return nil, errInvalidExporter
+}
+
+func (b builder) otlpSpanExporter(cfg *OTLP) (sdktrace.SpanExporter, error) {
+	o, err := newOTLPOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	switch cfg.Protocol {
+	case protocolGRPC:
+		var opts []otlptracegrpc.Option
+		if o.endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpointURL(o.endpoint))
+		}
+		if o.insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		if o.gzip {
+			opts = append(opts, otlptracegrpc.WithCompressor(compressionGzip))
+		}
+		if o.timeout > 0 {
+			opts = append(opts, otlptracegrpc.WithTimeout(o.timeout))
+		}
+		if len(o.headers) > 0 {
+			opts = append(opts, otlptracegrpc.WithHeaders(o.headers))
+		}
+		return otlptracegrpc.New(b.ctx, opts...)
+	case protocolHTTP:
+		var opts []otlptracehttp.Option
+		if o.endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpointURL(o.endpoint))
+		}
+		if o.gzip {
+			opts = append(opts, otlptracehttp.WithCompression(otlptracehttp.GzipCompression))
+		}
+		if o.timeout > 0 {
+			opts = append(opts, otlptracehttp.WithTimeout(o.timeout))
+		}
+		if len(o.headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(o.headers))
+		}
+		return otlptracehttp.New(b.ctx, opts...)
+	}
+	return nil, fmt.Errorf("%w: %q", errUnsupportedProtocol, cfg.Protocol)
+}
+
+func sampler(cfg *Sampler) (sdktrace.Sampler, error) {
+	set := 0
+	var s sdktrace.Sampler
+	if cfg.AlwaysOn != nil {
+		set++
+		s = sdktrace.AlwaysSample()
+	}
+	if cfg.AlwaysOff != nil {
+		set++
+		s = sdktrace.NeverSample()
+	}
+	if cfg.TraceIDRatioBased != nil {
+		set++
+		ratio := 1.0
+		if cfg.TraceIDRatioBased.Ratio != nil {
+			ratio = *cfg.TraceIDRatioBased.Ratio
+		}
+		s = sdktrace.TraceIDRatioBased(ratio)
+	}
+	if pb := cfg.ParentBased; pb != nil {
+		set++
+		var err error
+		if s, err = parentBasedSampler(pb); err != nil {
+			return nil, err
+		}
+	}
+	if set != 1 {
+		return nil, errInvalidSampler
+	}
+	return s, nil
+}
+
+func parentBasedSampler(cfg *ParentBasedSampler) (sdktrace.Sampler, error) {
+	root := sdktrace.AlwaysSample()
+	if cfg.Root != nil {
+		var err error
+		if root, err = sampler(cfg.Root); err != nil {
+			return nil, err
+		}
+	}
+
+	var opts []sdktrace.ParentBasedSamplerOption
+	for _, delegate := range []struct {
+		cfg *Sampler
+		opt func(sdktrace.Sampler) sdktrace.ParentBasedSamplerOption
+	}{
+		{cfg.RemoteParentSampled, sdktrace.WithRemoteParentSampled},
+		{cfg.RemoteParentNotSampled, sdktrace.WithRemoteParentNotSampled},
+		{cfg.LocalParentSampled, sdktrace.WithLocalParentSampled},
+		{cfg.LocalParentNotSampled, sdktrace.WithLocalParentNotSampled},
+	} {
+		if delegate.cfg == nil {
+			continue
+		}
+		s, err := sampler(delegate.cfg)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, delegate.opt(s))
+	}
+	return sdktrace.ParentBased(root, opts...), nil
+}
+
+func millis(ms int) time.Duration {
+	return time.Duration(ms) * time.Millisecond
+}
+
+func setInt(dst *int, v *int) {
+	if v != nil {
+		*dst = *v
+	}
+}
@@ -4,17 +4,33 @@
 package otel // import "go.opentelemetry.io/otel"
 
 import (
+	"context"
+
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/propagation"
 )
 
 // GetTextMapPropagator returns the global TextMapPropagator. If none has been
 // set, a No-Op TextMapPropagator is returned.
+//
+// Use propagation.Components to list the propagators composing the returned
+// TextMapPropagator.
 func GetTextMapPropagator() propagation.TextMapPropagator {
 	return global.TextMapPropagator()
 }
 
 // SetTextMapPropagator sets propagator as the global TextMapPropagator.
+//
+// The fields of propagator are validated with propagation.ValidateFields. A
+// conflict is reported to the global ErrorHandler as a warning, and
+// propagator is still set.
 func SetTextMapPropagator(propagator propagation.TextMapPropagator) {
+	if err := propagation.ValidateFields(propagator); err != nil {
+		HandleDiagnostic(context.Background(), Diagnostic{
+			Component: "go.opentelemetry.io/otel",
+			Severity:  SeverityWarning,
+			Err:       err,
+		})
+	}
 	global.SetTextMapPropagator(propagator)
 }
@@ -5,6 +5,8 @@ package propagation // import "go.opentelemetry.io/otel/propagation"
 
 import (
 	"context"
+	"net/url"
+	"strings"
 
 	"go.opentelemetry.io/otel/baggage"
 )
@@ -15,15 +17,173 @@ const baggageHeader = "baggage"
 //
 // This propagates user-defined baggage associated with a trace. The complete
 // specification is defined at https://www.w3.org/TR/baggage/.
-type Baggage struct{}
+//
+// The zero value uses the standard baggage header name and injects all
+// baggage members. Use NewBaggage to configure an alternate header name or
+// to restrict which members are injected.
+type Baggage struct {
+	// cfg is nil for the zero value, which uses the default configuration.
+	cfg *baggageConfig
+}
+
+type baggageConfig struct {
+	header   string
+	filter   func(baggage.Member) bool
+	limits   *baggage.Limits
+	encoding BaggageEncoding
+	lenient  bool
+}
+
+// BaggageEncoding determines how baggage values are percent-encoded when
+// injected by a Baggage propagator.
+type BaggageEncoding int
+
+const (
+	// BaggageEncodingW3C percent-encodes only the characters not allowed
+	// in a value by the W3C Baggage specification. This is the default.
+	BaggageEncodingW3C BaggageEncoding = iota
+	// BaggageEncodingURL percent-encodes all characters other than the
+	// unreserved characters defined by RFC 3986 (ALPHA, DIGIT, "-", ".",
+	// "_", and "~"). This includes spaces, commas, and other characters
+	// that some older agents and non-Go SDKs fail to decode when left
+	// unencoded.
+	BaggageEncodingURL
+)
+
+// BaggageOption configures a Baggage propagator.
+type BaggageOption interface {
+	applyBaggage(baggageConfig) baggageConfig
+}
+
+type baggageOptionFunc func(baggageConfig) baggageConfig
+
+func (fn baggageOptionFunc) applyBaggage(c baggageConfig) baggageConfig {
+	return fn(c)
+}
+
+// WithBaggageHeader sets the header name used to inject and extract baggage.
+// An empty name is ignored.
+func WithBaggageHeader(name string) BaggageOption {
+	return baggageOptionFunc(func(c baggageConfig) baggageConfig {
+		if name != "" {
+			c.header = name
+		}
+		return c
+	})
+}
+
+// WithBaggageInjectFilter sets a filter that determines which baggage
+// members are injected into outbound carriers. Only members for which filter
+// returns true are injected. Extraction is not affected.
+//
+// By default, all members are injected.
+func WithBaggageInjectFilter(filter func(baggage.Member) bool) BaggageOption {
+	return baggageOptionFunc(func(c baggageConfig) baggageConfig {
+		c.filter = filter
+		return c
+	})
+}
+
+// WithBaggageLimits sets the limits applied to injected and extracted
+// baggage. Limit violations are handled according to the limits Policy. If
+// the Policy is baggage.LimitPolicyError, baggage that exceeds the limits is
+// neither injected nor extracted.
+//
+// When extracting from a carrier that contains multiple baggage values, the
+// values are combined, in order, before the limits are applied.
+//
+// By default, the limits defined by the W3C Baggage specification are
+// enforced when extracting and no limits are enforced when injecting.
+func WithBaggageLimits(limits baggage.Limits) BaggageOption {
+	return baggageOptionFunc(func(c baggageConfig) baggageConfig {
+		c.limits = &limits
+		return c
+	})
+}
+
+// WithBaggageEncoding sets the percent-encoding used for baggage values when
+// injecting. By default, BaggageEncodingW3C is used.
+//
+// When limits are also set with WithBaggageLimits, the limits are evaluated
+// against the W3C encoding of the baggage.
+func WithBaggageEncoding(encoding BaggageEncoding) BaggageOption {
+	return baggageOptionFunc(func(c baggageConfig) baggageConfig {
+		c.encoding = encoding
+		return c
+	})
+}
+
+// WithBaggageLenientDecoding configures the Baggage propagator to decode
+// extracted baggage leniently. List-members that are invalid are dropped
+// instead of causing the whole baggage to be discarded, values that contain
+// characters not allowed by the W3C Baggage specification are accepted, and
+// values with invalid percent-encoding are kept as-is. Invalid properties of
+// otherwise valid list-members are dropped.
+//
+// This is intended for interoperability with older agents and non-Go SDKs
+// that do not strictly follow the W3C Baggage specification.
+//
+// When limits are also set with WithBaggageLimits, the limits are evaluated
+// against the W3C encoding of the decoded baggage.
+func WithBaggageLenientDecoding() BaggageOption {
+	return baggageOptionFunc(func(c baggageConfig) baggageConfig {
+		c.lenient = true
+		return c
+	})
+}
+
+// NewBaggage returns a Baggage propagator configured with opts.
+func NewBaggage(opts ...BaggageOption) Baggage {
+	c := baggageConfig{header: baggageHeader}
+	for _, o := range opts {
+		c = o.applyBaggage(c)
+	}
+	return Baggage{cfg: &c}
+}
+
+func (b Baggage) header() string {
+	if b.cfg == nil {
+		return baggageHeader
+	}
+	return b.cfg.header
+}
 
 var _ TextMapPropagator = Baggage{}
 
 // Inject sets baggage key-values from ctx into the carrier.
 func (b Baggage) Inject(ctx context.Context, carrier TextMapCarrier) {
-	bStr := baggage.FromContext(ctx).String()
+	if IsInjectionSuppressed(ctx) {
+		return
+	}
+	bag := baggage.FromContext(ctx)
+	if b.cfg != nil && b.cfg.filter != nil {
+		for _, m := range bag.Members() {
+			if !b.cfg.filter(m) {
+				bag = bag.DeleteMember(m.Key())
+			}
+		}
+	}
+	var bStr string
+	switch {
+	case b.cfg != nil && b.cfg.limits != nil:
+		var err error
+		bStr, err = bag.StringWithLimits(*b.cfg.limits)
+		if err != nil {
+			return
+		}
+		if b.cfg.encoding == BaggageEncodingURL {
+			// Limits are evaluated against the W3C encoding. Re-encode
+			// only the members that fit.
+			limited, _ := baggage.Parse(bStr)
+			bStr = encodeURLBaggage(limited)
+		}
+	case b.cfg != nil && b.cfg.encoding == BaggageEncodingURL:
+		bStr = encodeURLBaggage(bag)
+	default:
+		bStr = bag.String()
+	}
 	if bStr != "" {
-		carrier.Set(baggageHeader, bStr)
+		carrier.Set(b.header(), bStr)
 	}
 }
 
@@ -31,19 +191,22 @@ func (b Baggage) Inject(ctx context.Context, carrier TextMapCarrier) {
 // If carrier implements [ValuesGetter] (e.g. [HeaderCarrier]), Values is invoked
 // for multiple values extraction. Otherwise, Get is called.
 func (b Baggage) Extract(parent context.Context, carrier TextMapCarrier) context.Context {
+	if b.cfg != nil && (b.cfg.limits != nil || b.cfg.lenient) {
+		return b.extractConfigured(parent, carrier)
+	}
 	if multiCarrier, ok := carrier.(ValuesGetter); ok {
-		return extractMultiBaggage(parent, multiCarrier)
+		return extractMultiBaggage(parent, multiCarrier, b.header())
 	}
-	return extractSingleBaggage(parent, carrier)
+	return extractSingleBaggage(parent, carrier, b.header())
 }
 
 // Fields returns the keys who's values are set with Inject.
 func (b Baggage) Fields() []string {
-	return []string{baggageHeader}
+	return []string{b.header()}
 }
 
-func extractSingleBaggage(parent context.Context, carrier TextMapCarrier) context.Context {
-	bStr := carrier.Get(baggageHeader)
+func extractSingleBaggage(parent context.Context, carrier TextMapCarrier, header string) context.Context {
+	bStr := carrier.Get(header)
 	if bStr == "" {
 		return parent
 	}
@@ -55,8 +218,8 @@ func extractSingleBaggage(parent context.Context, carrier TextMapCarrier) contex
 	return baggage.ContextWithBaggage(parent, bag)
 }
 
-func extractMultiBaggage(parent context.Context, carrier ValuesGetter) context.Context {
-	bVals := carrier.Values(baggageHeader)
+func extractMultiBaggage(parent context.Context, carrier ValuesGetter, header string) context.Context {
+	bVals := carrier.Values(header)
 	if len(bVals) == 0 {
 		return parent
 	}
@@ -75,3 +238,162 @@ func extractMultiBaggage(parent context.Context, carrier ValuesGetter) context.C
 	}
 	return baggage.ContextWithBaggage(parent, b)
 }
+
+// extractConfigured extracts baggage from carrier applying the configured
+// limits and decoding leniency. Multiple baggage values are combined, in
+// order, before being decoded.
+func (b Baggage) extractConfigured(parent context.Context, carrier TextMapCarrier) context.Context {
+	var bStr string
+	if multiCarrier, ok := carrier.(ValuesGetter); ok {
+		bStr = strings.Join(multiCarrier.Values(b.header()), listDelimiter)
+	} else {
+		bStr = carrier.Get(b.header())
+	}
+	if bStr == "" {
+		return parent
+	}
+
+	var (
+		bag baggage.Baggage
+		err error
+	)
+	if b.cfg.lenient {
+		bag, err = baggage.New(parseLenientBaggage(bStr)...)
+		if err == nil && b.cfg.limits != nil {
+			bag, err = baggage.ParseWithLimits(bag.String(), *b.cfg.limits)
+		}
+	} else {
+		bag, err = baggage.ParseWithLimits(bStr, *b.cfg.limits)
+	}
+	if err != nil || bag.Len() == 0 {
+		return parent
+	}
+	return baggage.ContextWithBaggage(parent, bag)
+}
+
+const (
+	listDelimiter     = ","
+	keyValueDelimiter = "="
+	propertyDelimiter = ";"
+)
+
+// parseLenientBaggage decodes the list-members of bStr, dropping those that
+// are invalid.
+func parseLenientBaggage(bStr string) []baggage.Member {
+	var members []baggage.Member
+	for _, memberStr := range strings.Split(bStr, listDelimiter) {
+		keyValue, propsStr, _ := strings.Cut(memberStr, propertyDelimiter)
+		k, v, ok := strings.Cut(keyValue, keyValueDelimiter)
+		if !ok {
+			continue
+		}
+
+		var props []baggage.Property
+		for _, pStr := range strings.Split(propsStr, propertyDelimiter) {
+			pk, pv, hasValue := strings.Cut(pStr, keyValueDelimiter)
+			pk = strings.TrimSpace(pk)
+			if pk == "" {
+				continue
+			}
+
+			var (
+				p   baggage.Property
+				err error
+			)
+			if hasValue {
+				p, err = baggage.NewKeyValuePropertyRaw(pk, lenientUnescape(strings.TrimSpace(pv)))
+			} else {
+				p, err = baggage.NewKeyProperty(pk)
+			}
+			if err == nil {
+				props = append(props, p)
+			}
+		}
+
+		m, err := baggage.NewMemberRaw(strings.TrimSpace(k), lenientUnescape(strings.TrimSpace(v)), props...)
+		if err != nil {
+			continue
+		}
+		members = append(members, m)
+	}
+	return members
+}
+
+// lenientUnescape returns the percent-decoded s, or s unchanged if it is not
+// validly percent-encoded.
+func lenientUnescape(s string) string {
+	if u, err := url.PathUnescape(s); err == nil {
+		return u
+	}
+	return s
+}
+
+// encodeURLBaggage encodes bag into a baggage-string with all values encoded
+// using urlEscape. List-members and properties with keys that are not valid
+// according to the W3C Baggage specification are ignored.
+func encodeURLBaggage(bag baggage.Baggage) string {
+	members := bag.Members()
+	encoded := make([]string, 0, len(members))
+	for _, m := range members {
+		// Member.String is empty if the key is invalid.
+		if m.String() == "" {
+			continue
+		}
+
+		var sb strings.Builder
+		_, _ = sb.WriteString(m.Key())
+		_, _ = sb.WriteString(keyValueDelimiter)
+		_, _ = sb.WriteString(urlEscape(m.Value()))
+		for _, p := range m.Properties() {
+			// Property.String is empty if the key is invalid.
+			if p.String() == "" {
+				continue
+			}
+			_, _ = sb.WriteString(propertyDelimiter)
+			_, _ = sb.WriteString(p.Key())
+			if v, ok := p.Value(); ok {
+				_, _ = sb.WriteString(keyValueDelimiter)
+				_, _ = sb.WriteString(urlEscape(v))
+			}
+		}
+		encoded = append(encoded, sb.String())
+	}
+	return strings.Join(encoded, listDelimiter)
+}
+
+// urlEscape percent-encodes all bytes of s other than the unreserved
+// characters defined by RFC 3986.
+func urlEscape(s string) string {
+	const upperhex = "0123456789ABCDEF"
+
+	var n int
+	for i := 0; i < len(s); i++ {
+		if !isUnreserved(s[i]) {
+			n++
+		}
+	}
+	if n == 0 {
+		return s
+	}
+
+	buf := make([]byte, 0, len(s)+2*n)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isUnreserved(c) {
+			buf = append(buf, c)
+			continue
+		}
+		buf = append(buf, '%', upperhex[c>>4], upperhex[c&15])
+	}
+	return string(buf)
+}
+
+func isUnreserved(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	case c == '-', c == '.', c == '_', c == '~':
+		return true
+	}
+	return false
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/baggage"
 	"go.opentelemetry.io/otel/propagation"
@@ -359,3 +360,196 @@ func TestBaggagePropagatorGetAllKeys(t *testing.T) {
 		t.Errorf("GetAllKeys: -got +want %s", diff)
 	}
 }
+
+func TestBaggageCustomHeader(t *testing.T) {
+	propagator := propagation.NewBaggage(propagation.WithBaggageHeader("x-baggage"))
+	assert.Equal(t, []string{"x-baggage"}, propagator.Fields())
+
+	b := members{{Key: "key1", Value: "val1"}}.Baggage(t)
+	header := http.Header{}
+	propagator.Inject(baggage.ContextWithBaggage(context.Background(), b), propagation.HeaderCarrier(header))
+	assert.Equal(t, "key1=val1", header.Get("x-baggage"))
+	assert.Empty(t, header.Get("baggage"))
+
+	ctx := propagator.Extract(context.Background(), propagation.HeaderCarrier(header))
+	assert.Equal(t, b, baggage.FromContext(ctx))
+
+	ctx = propagator.Extract(context.Background(), propagation.MapCarrier{"x-baggage": "key2=val2"})
+	assert.Equal(t, "val2", baggage.FromContext(ctx).Member("key2").Value())
+}
+
+func TestBaggageInjectFilter(t *testing.T) {
+	propagator := propagation.NewBaggage(propagation.WithBaggageInjectFilter(func(m baggage.Member) bool {
+		return strings.HasPrefix(m.Key(), "public.")
+	}))
+
+	b := members{
+		{Key: "public.tenant", Value: "acme"},
+		{Key: "secret", Value: "s3cr3t"},
+	}.Baggage(t)
+	ctx := baggage.ContextWithBaggage(context.Background(), b)
+
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	assert.Equal(t, propagation.MapCarrier{"baggage": "public.tenant=acme"}, carrier)
+
+	// Baggage in the context is not modified.
+	assert.Equal(t, 2, baggage.FromContext(ctx).Len())
+
+	carrier = propagation.MapCarrier{}
+	propagator.Inject(baggage.ContextWithBaggage(context.Background(), members{
+		{Key: "secret", Value: "s3cr3t"},
+	}.Baggage(t)), carrier)
+	assert.Empty(t, carrier, "nothing injected when all members are filtered")
+}
+
+func TestBaggageLimits(t *testing.T) {
+	propagator := propagation.NewBaggage(propagation.WithBaggageLimits(baggage.Limits{
+		MaxMembers: 2,
+		Policy:     baggage.LimitPolicyTruncate,
+	}))
+
+	b := members{
+		{Key: "a", Value: "1"},
+		{Key: "b", Value: "2"},
+		{Key: "c", Value: "3"},
+	}.Baggage(t)
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(baggage.ContextWithBaggage(context.Background(), b), carrier)
+	assert.Equal(t, propagation.MapCarrier{"baggage": "a=1,b=2"}, carrier)
+
+	header := http.Header{}
+	header.Add("baggage", "a=1")
+	header.Add("baggage", "b=2,c=3")
+	ctx := propagator.Extract(context.Background(), propagation.HeaderCarrier(header))
+	assert.Equal(t, members{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}}.Baggage(t), baggage.FromContext(ctx))
+}
+
+func TestBaggageLimitsError(t *testing.T) {
+	propagator := propagation.NewBaggage(propagation.WithBaggageLimits(baggage.Limits{MaxMembers: 1}))
+
+	b := members{
+		{Key: "a", Value: "1"},
+		{Key: "b", Value: "2"},
+	}.Baggage(t)
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(baggage.ContextWithBaggage(context.Background(), b), carrier)
+	assert.Empty(t, carrier, "baggage exceeding limits injected")
+
+	ctx := propagator.Extract(context.Background(), propagation.MapCarrier{"baggage": "a=1,b=2"})
+	assert.Equal(t, 0, baggage.FromContext(ctx).Len(), "baggage exceeding limits extracted")
+}
+
+func TestBaggageEncodingURL(t *testing.T) {
+	p, err := baggage.NewKeyValuePropertyRaw("prop", "a b")
+	require.NoError(t, err)
+	m, err := baggage.NewMemberRaw("key", "val ue,1=2;3", p)
+	require.NoError(t, err)
+	b, err := baggage.New(m)
+	require.NoError(t, err)
+	ctx := baggage.ContextWithBaggage(context.Background(), b)
+
+	carrier := propagation.MapCarrier{}
+	propagation.Baggage{}.Inject(ctx, carrier)
+	assert.Equal(t, "key=val%20ue%2C1=2%3B3;prop=a%20b", carrier["baggage"], "W3C encoding")
+
+	prop := propagation.NewBaggage(propagation.WithBaggageEncoding(propagation.BaggageEncodingURL))
+	carrier = propagation.MapCarrier{}
+	prop.Inject(ctx, carrier)
+	assert.Equal(t, "key=val%20ue%2C1%3D2%3B3;prop=a%20b", carrier["baggage"], "URL encoding")
+
+	// Both encodings are decoded to the same baggage.
+	got := baggage.FromContext(propagation.Baggage{}.Extract(context.Background(), carrier))
+	assert.Equal(t, b, got)
+}
+
+func TestBaggageEncodingURLWithLimits(t *testing.T) {
+	prop := propagation.NewBaggage(
+		propagation.WithBaggageEncoding(propagation.BaggageEncodingURL),
+		propagation.WithBaggageLimits(baggage.Limits{MaxMembers: 1, Policy: baggage.LimitPolicyTruncate}),
+	)
+	b := members{
+		{Key: "a", Value: "1=1"},
+		{Key: "b", Value: "2"},
+	}.Baggage(t)
+
+	carrier := propagation.MapCarrier{}
+	prop.Inject(baggage.ContextWithBaggage(context.Background(), b), carrier)
+	assert.Equal(t, "a=1%3D1", carrier["baggage"])
+}
+
+func TestBaggageLenientDecoding(t *testing.T) {
+	prop := propagation.NewBaggage(propagation.WithBaggageLenientDecoding())
+
+	tests := []struct {
+		name   string
+		header string
+		want   members
+	}{
+		{
+			name:   "valid",
+			header: "key1=val1,key2=val%202",
+			want: members{
+				{Key: "key1", Value: "val1"},
+				{Key: "key2", Value: "val 2"},
+			},
+		},
+		{
+			name:   "invalid member dropped",
+			header: "key1=val1,invalid,key2=val2",
+			want: members{
+				{Key: "key1", Value: "val1"},
+				{Key: "key2", Value: "val2"},
+			},
+		},
+		{
+			name:   "unencoded characters",
+			header: `key1=val "1",key2=val\2`,
+			want: members{
+				{Key: "key1", Value: `val "1"`},
+				{Key: "key2", Value: `val\2`},
+			},
+		},
+		{
+			name:   "invalid percent-encoding",
+			header: "key1=100%,key2=%zz",
+			want: members{
+				{Key: "key1", Value: "100%"},
+				{Key: "key2", Value: "%zz"},
+			},
+		},
+		{
+			name:   "properties",
+			header: "key1=val1;p1=a%20b;=bad",
+			want: members{
+				{
+					Key:   "key1",
+					Value: "val1",
+					Properties: []property{
+						{Key: "p1", Value: "a b"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := prop.Extract(context.Background(), propagation.MapCarrier{"baggage": tt.header})
+			assert.Equal(t, tt.want.Baggage(t), baggage.FromContext(ctx))
+		})
+	}
+}
+
+func TestBaggageLenientDecodingFlagProperty(t *testing.T) {
+	prop := propagation.NewBaggage(propagation.WithBaggageLenientDecoding())
+	ctx := prop.Extract(context.Background(), propagation.MapCarrier{"baggage": "key1=val1;flag"})
+	assert.True(t, baggage.FromContext(ctx).Member("key1").Metadata().HasFlag("flag"))
+}
+
+func TestBaggageLenientDecodingStrictDefault(t *testing.T) {
+	ctx := propagation.Baggage{}.Extract(context.Background(), propagation.MapCarrier{
+		"baggage": "key1=val1,invalid",
+	})
+	assert.Equal(t, 0, baggage.FromContext(ctx).Len())
+}
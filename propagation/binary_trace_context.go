@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation // import "go.opentelemetry.io/otel/propagation"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// GRPCTraceBinHeader is the gRPC metadata key conventionally used to
+	// carry the binary encoding produced by BinaryTraceContext.
+	GRPCTraceBinHeader = "grpc-trace-bin"
+
+	binaryVersion       = 0
+	binaryTraceIDField  = 0
+	binarySpanIDField   = 1
+	binaryTraceOptField = 2
+
+	binaryTraceIDLen = 16
+	binarySpanIDLen  = 8
+	binaryLen        = 1 + (1 + binaryTraceIDLen) + (1 + binarySpanIDLen) + (1 + 1)
+)
+
+// BinaryPropagator propagates cross-cutting concerns as a byte slice that
+// travels in-band across process boundaries. It is intended for transports
+// that do not support text headers.
+type BinaryPropagator interface {
+	// Inject returns the binary encoding of the cross-cutting concerns held
+	// in ctx. It returns nil if there is nothing to propagate.
+	Inject(ctx context.Context) []byte
+
+	// Extract decodes the cross-cutting concerns from data and returns a
+	// copy of ctx containing them. If data cannot be decoded, ctx is
+	// returned unchanged.
+	Extract(ctx context.Context, data []byte) context.Context
+}
+
+// BinaryTraceContext is a BinaryPropagator that supports the binary trace
+// context format used by gRPC (the grpc-trace-bin metadata value) and the
+// W3C binary trace context draft
+// (https://w3c.github.io/trace-context-binary/).
+//
+// The encoding is a version byte followed by a sequence of fields, each
+// prefixed by a field ID:
+//
+//	version (0) | 0 | trace-id (16 bytes) | 1 | span-id (8 bytes) | 2 | trace-flags (1 byte)
+//
+// All the trace-flags, including the random flag, are carried. The binary
+// format does not carry the tracestate.
+type BinaryTraceContext struct{}
+
+var _ BinaryPropagator = BinaryTraceContext{}
+
+// Inject returns the binary encoding of the SpanContext in ctx. It returns
+// nil if ctx does not contain a valid SpanContext or if injection is
+// suppressed for ctx (see WithSuppressedInjection).
+func (BinaryTraceContext) Inject(ctx context.Context) []byte {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() || IsInjectionSuppressed(ctx) {
+		return nil
+	}
+
+	traceID := sc.TraceID()
+	spanID := sc.SpanID()
+
+	b := make([]byte, 0, binaryLen)
+	b = append(b, binaryVersion)
+	b = append(b, binaryTraceIDField)
+	b = append(b, traceID[:]...)
+	b = append(b, binarySpanIDField)
+	b = append(b, spanID[:]...)
+	// The whole trace-flags byte is carried, including the random flag and
+	// flags not yet defined.
+	b = append(b, binaryTraceOptField, byte(sc.TraceFlags()))
+	return b
+}
+
+// Extract decodes the binary trace context in data and returns a copy of
+// ctx containing it as the remote SpanContext. If data is not a valid
+// binary trace context, ctx is returned unchanged.
+func (btc BinaryTraceContext) Extract(ctx context.Context, data []byte) context.Context {
+	sc := btc.extract(data)
+	if !sc.IsValid() {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+func (BinaryTraceContext) extract(b []byte) trace.SpanContext {
+	if len(b) == 0 || b[0] != binaryVersion {
+		return trace.SpanContext{}
+	}
+	b = b[1:]
+
+	// The trace-id is required.
+	if len(b) < 1+binaryTraceIDLen || b[0] != binaryTraceIDField {
+		return trace.SpanContext{}
+	}
+	var scc trace.SpanContextConfig
+	copy(scc.TraceID[:], b[1:1+binaryTraceIDLen])
+	b = b[1+binaryTraceIDLen:]
+
+	if len(b) >= 1+binarySpanIDLen && b[0] == binarySpanIDField {
+		copy(scc.SpanID[:], b[1:1+binarySpanIDLen])
+		b = b[1+binarySpanIDLen:]
+	}
+
+	if len(b) >= 2 && b[0] == binaryTraceOptField {
+		scc.TraceFlags = trace.TraceFlags(b[1])
+	}
+	// Any remaining unknown fields are ignored for forward compatibility.
+
+	scc.Remote = true
+	sc := trace.NewSpanContext(scc)
+	if !sc.IsValid() {
+		return trace.SpanContext{}
+	}
+	return sc
+}
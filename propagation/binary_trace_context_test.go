@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var binaryTraceContext = []byte{
+	0,
+	0, 0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36,
+	1, 0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7,
+	2, 1,
+}
+
+func TestBinaryTraceContextInject(t *testing.T) {
+	var prop propagation.BinaryTraceContext
+
+	assert.Nil(t, prop.Inject(context.Background()), "invalid span context")
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+	assert.Equal(t, binaryTraceContext, prop.Inject(ctx))
+
+	// All the trace-flags are injected.
+	sc = sc.WithTraceFlags(trace.FlagsSampled | trace.FlagsRandom | 0xf0)
+	ctx = trace.ContextWithSpanContext(context.Background(), sc)
+	got := prop.Inject(ctx)
+	assert.Equal(t, byte(0xf3), got[len(got)-1])
+}
+
+func TestBinaryTraceContextExtract(t *testing.T) {
+	want := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+
+	withExtra := append(append([]byte{}, binaryTraceContext...), 3, 0xff)
+	notSampled := append([]byte{}, binaryTraceContext...)
+	notSampled[len(notSampled)-1] = 0
+	random := append([]byte{}, binaryTraceContext...)
+	random[len(random)-1] = 0x03
+
+	tests := []struct {
+		name string
+		data []byte
+		want trace.SpanContext
+	}{
+		{
+			name: "valid",
+			data: binaryTraceContext,
+			want: want,
+		},
+		{
+			name: "not sampled",
+			data: notSampled,
+			want: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: traceID,
+				SpanID:  spanID,
+				Remote:  true,
+			}),
+		},
+		{
+			name: "random",
+			data: random,
+			want: want.WithTraceFlags(trace.FlagsSampled | trace.FlagsRandom),
+		},
+		{
+			name: "unknown trailing field",
+			data: withExtra,
+			want: want,
+		},
+		{
+			name: "empty",
+			data: nil,
+		},
+		{
+			name: "unsupported version",
+			data: append([]byte{1}, binaryTraceContext[1:]...),
+		},
+		{
+			name: "missing trace-id",
+			data: append([]byte{0}, binaryTraceContext[17:]...),
+		},
+		{
+			name: "truncated trace-id",
+			data: binaryTraceContext[:10],
+		},
+		{
+			name: "missing span-id",
+			data: binaryTraceContext[:17],
+		},
+	}
+
+	var prop propagation.BinaryTraceContext
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := prop.Extract(context.Background(), tt.data)
+			assert.Equal(t, tt.want, trace.SpanContextFromContext(ctx))
+		})
+	}
+}
+
+func TestBinaryTraceContextRoundTrip(t *testing.T) {
+	var prop propagation.BinaryTraceContext
+	for _, flags := range []trace.TraceFlags{0, trace.FlagsSampled, trace.FlagsRandom, trace.FlagsSampled | trace.FlagsRandom} {
+		sc := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: flags,
+			Remote:     true,
+		})
+		ctx := trace.ContextWithRemoteSpanContext(context.Background(), sc)
+		got := prop.Extract(context.Background(), prop.Inject(ctx))
+		assert.Equal(t, sc, trace.SpanContextFromContext(got), "flags %#x", flags)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation // import "go.opentelemetry.io/otel/propagation"
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrFieldConflict is returned by ValidateFields when a field is injected by
+// more than one of the propagators composing a TextMapPropagator.
+var ErrFieldConflict = errors.New("propagation: field injected by multiple propagators")
+
+// Components returns the TextMapPropagators composing p, in the order they
+// inject their fields.
+//
+// Composite TextMapPropagators created with NewCompositeTextMapPropagator or
+// NewCompositeTextMapPropagatorWithOptions are flattened recursively. A
+// TextMapPropagator wrapping another one can be inspected by implementing an
+// Unwrap method returning the wrapped TextMapPropagator. Any other
+// TextMapPropagator is returned as is.
+//
+// This is intended to debug the configuration of layered propagators. For
+// example, to list the propagators, and their fields, installed globally:
+//
+//	for _, p := range propagation.Components(otel.GetTextMapPropagator()) {
+//		fmt.Printf("%T: %v\n", p, p.Fields())
+//	}
+func Components(p TextMapPropagator) []TextMapPropagator {
+	return appendComponents(nil, p)
+}
+
+func appendComponents(dst []TextMapPropagator, p TextMapPropagator) []TextMapPropagator {
+	switch c := p.(type) {
+	case nil:
+		return dst
+	case compositeTextMapPropagator:
+		for _, p := range c {
+			dst = appendComponents(dst, p)
+		}
+		return dst
+	case configuredCompositeTextMapPropagator:
+		return appendComponents(dst, c.compositeTextMapPropagator)
+	case interface{ Unwrap() TextMapPropagator }:
+		return appendComponents(dst, c.Unwrap())
+	}
+	return append(dst, p)
+}
+
+// ValidateFields returns an error wrapping ErrFieldConflict for each field
+// injected by more than one of the Components of p. Fields are compared
+// without regard to case, as carriers like HTTP headers do. Nil is returned
+// if there is no conflict.
+//
+// Conflicting fields are usually the result of the same propagator being
+// installed twice, or of propagators overwriting the values injected by
+// each other.
+func ValidateFields(p TextMapPropagator) error {
+	components := Components(p)
+	owners := make(map[string][]int)
+	var order []string
+	for i, c := range components {
+		for _, f := range c.Fields() {
+			k := strings.ToLower(f)
+			idx := owners[k]
+			if slices.Contains(idx, i) {
+				continue
+			}
+			if len(idx) == 0 {
+				order = append(order, k)
+			}
+			owners[k] = append(idx, i)
+		}
+	}
+
+	var errs []error
+	for _, f := range order {
+		idx := owners[f]
+		if len(idx) < 2 {
+			continue
+		}
+		types := make([]string, len(idx))
+		for i, j := range idx {
+			types[i] = fmt.Sprintf("%T", components[j])
+		}
+		errs = append(errs, fmt.Errorf("%w: %q (%s)", ErrFieldConflict, f, strings.Join(types, ", ")))
+	}
+	return errors.Join(errs...)
+}
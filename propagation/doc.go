@@ -8,6 +8,11 @@ OpenTelemetry propagators are used to extract and inject context data from and
 into messages exchanged by applications. The propagator supported by this
 package is the W3C Trace Context encoding
 (https://www.w3.org/TR/trace-context/), and W3C Baggage
-(https://www.w3.org/TR/baggage/).
+(https://www.w3.org/TR/baggage/). The AWS X-Ray trace header format is also
+supported to continue traces originating from AWS services.
+
+Transports without text headers can use a BinaryPropagator, such as
+BinaryTraceContext which supports the binary trace context format used by
+the gRPC grpc-trace-bin metadata value.
 */
 package propagation // import "go.opentelemetry.io/otel/propagation"
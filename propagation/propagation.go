@@ -6,6 +6,10 @@ package propagation // import "go.opentelemetry.io/otel/propagation"
 import (
 	"context"
 	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // TextMapCarrier is the storage medium used by a TextMapPropagator.
@@ -101,6 +105,160 @@ func (hc HeaderCarrier) Keys() []string {
 	return keys
 }
 
+// ValuesMapCarrier is a TextMapCarrier that uses a map of keys to multiple
+// values held in memory as a storage medium for propagated key-value pairs.
+// Keys are case-sensitive.
+type ValuesMapCarrier map[string][]string
+
+// Compile time check that ValuesMapCarrier implements TextMapCarrier.
+var _ TextMapCarrier = ValuesMapCarrier{}
+
+// Compile time check that ValuesMapCarrier implements ValuesGetter.
+var _ ValuesGetter = ValuesMapCarrier{}
+
+// Get returns the first value associated with the passed key.
+func (c ValuesMapCarrier) Get(key string) string {
+	if v := c[key]; len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// Values returns all values associated with the passed key.
+func (c ValuesMapCarrier) Values(key string) []string {
+	return c[key]
+}
+
+// Set stores the key-value pair, replacing any existing values for key.
+func (c ValuesMapCarrier) Set(key, value string) {
+	c[key] = []string{value}
+}
+
+// Keys lists the keys stored in this carrier.
+func (c ValuesMapCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// GRPCMetadataCarrier adapts gRPC metadata (google.golang.org/grpc/metadata.MD)
+// to satisfy the TextMapCarrier and ValuesGetter interfaces.
+//
+// The metadata.MD type is defined as a map[string][]string, so it can be
+// converted directly:
+//
+//	carrier := propagation.GRPCMetadataCarrier(md)
+//
+// Keys are lower-cased when stored and looked up, matching the behavior of
+// the metadata.MD methods.
+type GRPCMetadataCarrier map[string][]string
+
+// Compile time check that GRPCMetadataCarrier implements TextMapCarrier.
+var _ TextMapCarrier = GRPCMetadataCarrier{}
+
+// Compile time check that GRPCMetadataCarrier implements ValuesGetter.
+var _ ValuesGetter = GRPCMetadataCarrier{}
+
+// Get returns the first value associated with the passed key.
+func (c GRPCMetadataCarrier) Get(key string) string {
+	if v := c[strings.ToLower(key)]; len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// Values returns all values associated with the passed key.
+func (c GRPCMetadataCarrier) Values(key string) []string {
+	return c[strings.ToLower(key)]
+}
+
+// Set stores the key-value pair, replacing any existing values for key.
+func (c GRPCMetadataCarrier) Set(key, value string) {
+	c[strings.ToLower(key)] = []string{value}
+}
+
+// Keys lists the keys stored in this carrier.
+func (c GRPCMetadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// CaseInsensitiveCarrier wraps a TextMapCarrier so that keys are matched
+// without regard to case. This is useful for carriers, such as message
+// headers of some messaging systems, that preserve the case of keys set by
+// other, non-OpenTelemetry, producers.
+//
+// Lookups are performed by comparing against all keys of the wrapped
+// carrier, so they are linear in the number of keys it contains.
+type CaseInsensitiveCarrier struct {
+	carrier TextMapCarrier
+}
+
+// Compile time check that CaseInsensitiveCarrier implements TextMapCarrier.
+var _ TextMapCarrier = CaseInsensitiveCarrier{}
+
+// Compile time check that CaseInsensitiveCarrier implements ValuesGetter.
+var _ ValuesGetter = CaseInsensitiveCarrier{}
+
+// NewCaseInsensitiveCarrier returns a CaseInsensitiveCarrier that wraps c.
+func NewCaseInsensitiveCarrier(c TextMapCarrier) CaseInsensitiveCarrier {
+	return CaseInsensitiveCarrier{carrier: c}
+}
+
+// lookup returns the key stored in the wrapped carrier that matches key
+// without regard to case.
+func (c CaseInsensitiveCarrier) lookup(key string) (string, bool) {
+	for _, k := range c.carrier.Keys() {
+		if strings.EqualFold(k, key) {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// Get returns the value associated with the passed key.
+func (c CaseInsensitiveCarrier) Get(key string) string {
+	k, ok := c.lookup(key)
+	if !ok {
+		return ""
+	}
+	return c.carrier.Get(k)
+}
+
+// Values returns all values associated with the passed key. If the wrapped
+// carrier does not implement ValuesGetter, the single value returned by its
+// Get method is returned.
+func (c CaseInsensitiveCarrier) Values(key string) []string {
+	k, ok := c.lookup(key)
+	if !ok {
+		return nil
+	}
+	if vg, ok := c.carrier.(ValuesGetter); ok {
+		return vg.Values(k)
+	}
+	return []string{c.carrier.Get(k)}
+}
+
+// Set stores the key-value pair. If the wrapped carrier already contains a
+// key matching key without regard to case, its value is replaced using the
+// existing key.
+func (c CaseInsensitiveCarrier) Set(key, value string) {
+	if k, ok := c.lookup(key); ok {
+		key = k
+	}
+	c.carrier.Set(key, value)
+}
+
+// Keys lists the keys stored in the wrapped carrier.
+func (c CaseInsensitiveCarrier) Keys() []string {
+	return c.carrier.Keys()
+}
+
 // TextMapPropagator propagates cross-cutting concerns as key-value text
 // pairs within a carrier that travels in-band across process boundaries.
 type TextMapPropagator interface {
@@ -128,6 +286,9 @@ type TextMapPropagator interface {
 type compositeTextMapPropagator []TextMapPropagator
 
 func (p compositeTextMapPropagator) Inject(ctx context.Context, carrier TextMapCarrier) {
+	if IsInjectionSuppressed(ctx) {
+		return
+	}
 	for _, i := range p {
 		i.Inject(ctx, carrier)
 	}
@@ -166,3 +327,116 @@ func (p compositeTextMapPropagator) Fields() []string {
 func NewCompositeTextMapPropagator(p ...TextMapPropagator) TextMapPropagator {
 	return compositeTextMapPropagator(p)
 }
+
+// ExtractMode determines how a composite TextMapPropagator resolves
+// cross-cutting concerns that are extracted by more than one of its
+// propagators.
+type ExtractMode int
+
+const (
+	// ExtractLastMatchWins means the result of a later propagator overwrites
+	// the result of an earlier one. This is the default.
+	ExtractLastMatchWins ExtractMode = iota
+	// ExtractFirstMatchWins means the first propagator to extract a remote
+	// SpanContext or Baggage determines that value, and later propagators
+	// cannot overwrite it.
+	ExtractFirstMatchWins
+)
+
+// CompositeOption configures a composite TextMapPropagator created with
+// NewCompositeTextMapPropagatorWithOptions.
+type CompositeOption interface {
+	applyComposite(compositeConfig) compositeConfig
+}
+
+type compositeOptionFunc func(compositeConfig) compositeConfig
+
+func (fn compositeOptionFunc) applyComposite(c compositeConfig) compositeConfig {
+	return fn(c)
+}
+
+type compositeConfig struct {
+	mode    ExtractMode
+	extract []TextMapPropagator
+}
+
+// WithExtractMode sets the ExtractMode used to resolve values extracted by
+// more than one propagator. The default is ExtractLastMatchWins.
+func WithExtractMode(mode ExtractMode) CompositeOption {
+	return compositeOptionFunc(func(c compositeConfig) compositeConfig {
+		c.mode = mode
+		return c
+	})
+}
+
+// WithExtractOrder sets the propagators, in order, used by Extract. This
+// allows the precedence of extracted values to differ from the order used to
+// inject them. For example, with ExtractFirstMatchWins the first propagator
+// passed here takes precedence when multiple header formats are present.
+//
+// By default, the propagators passed to
+// NewCompositeTextMapPropagatorWithOptions are used in the order provided.
+func WithExtractOrder(p ...TextMapPropagator) CompositeOption {
+	return compositeOptionFunc(func(c compositeConfig) compositeConfig {
+		c.extract = p
+		return c
+	})
+}
+
+type configuredCompositeTextMapPropagator struct {
+	compositeTextMapPropagator
+
+	mode    ExtractMode
+	extract compositeTextMapPropagator
+}
+
+func (p configuredCompositeTextMapPropagator) Extract(ctx context.Context, carrier TextMapCarrier) context.Context {
+	if p.mode != ExtractFirstMatchWins {
+		return p.extract.Extract(ctx, carrier)
+	}
+
+	var (
+		sc  trace.SpanContext
+		bag baggage.Baggage
+	)
+	origSC := trace.SpanContextFromContext(ctx)
+	origBag := baggage.FromContext(ctx)
+	for _, i := range p.extract {
+		ctx = i.Extract(ctx, carrier)
+
+		if sc.IsValid() {
+			ctx = trace.ContextWithRemoteSpanContext(ctx, sc)
+		} else if cur := trace.SpanContextFromContext(ctx); cur.IsValid() && !cur.Equal(origSC) {
+			sc = cur
+		}
+
+		if bag.Len() > 0 {
+			ctx = baggage.ContextWithBaggage(ctx, bag)
+		} else if cur := baggage.FromContext(ctx); cur.Len() > 0 && cur.String() != origBag.String() {
+			bag = cur
+		}
+	}
+	return ctx
+}
+
+// NewCompositeTextMapPropagatorWithOptions returns a unified
+// TextMapPropagator from the group of passed TextMapPropagator in the same
+// way as NewCompositeTextMapPropagator, but with extraction configured by
+// opts.
+//
+// The returned TextMapPropagator will inject cross-cutting concerns in the
+// order the TextMapPropagators were provided. Extraction is done in the
+// order set by WithExtractOrder, or the provided order if that option is not
+// used, and values extracted by multiple propagators are resolved according
+// to WithExtractMode.
+func NewCompositeTextMapPropagatorWithOptions(p []TextMapPropagator, opts ...CompositeOption) TextMapPropagator {
+	c := compositeConfig{extract: p}
+	for _, o := range opts {
+		c = o.applyComposite(c)
+	}
+	return configuredCompositeTextMapPropagator{
+		compositeTextMapPropagator: compositeTextMapPropagator(p),
+		mode:                       c.mode,
+		extract:                    compositeTextMapPropagator(c.extract),
+	}
+}
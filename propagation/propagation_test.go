@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"go.opentelemetry.io/otel/baggage"
 	"go.opentelemetry.io/otel/propagation"
 )
 
@@ -93,6 +94,126 @@ func TestCompositeTextMapPropagatorExtract(t *testing.T) {
 	}
 }
 
+// prefixedTraceContext extracts a W3C traceparent from a header with a
+// custom prefix, simulating an alternate trace header format.
+type prefixedTraceContext struct {
+	prefix string
+}
+
+func (p prefixedTraceContext) Inject(context.Context, propagation.TextMapCarrier) {}
+
+func (p prefixedTraceContext) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	v := carrier.Get(p.prefix + "traceparent")
+	if v == "" {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{"traceparent": v})
+}
+
+func (p prefixedTraceContext) Fields() []string { return []string{p.prefix + "traceparent"} }
+
+func TestCompositeTextMapPropagatorWithOptionsExtract(t *testing.T) {
+	const (
+		tp1 = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+		tp2 = "00-11111111111111111111111111111111-2222222222222222-01"
+	)
+	first, second := propagation.TraceContext{}, prefixedTraceContext{"x-"}
+	carrier := propagation.MapCarrier{
+		"traceparent":   tp1,
+		"x-traceparent": tp2,
+		"baggage":       "key=one",
+	}
+
+	traceparent := func(ctx context.Context) string {
+		c := propagation.MapCarrier{}
+		propagation.TraceContext{}.Inject(ctx, c)
+		return c["traceparent"]
+	}
+
+	tests := []struct {
+		name string
+		opts []propagation.CompositeOption
+		want string
+	}{
+		{
+			name: "default",
+			want: tp2,
+		},
+		{
+			name: "last match wins",
+			opts: []propagation.CompositeOption{
+				propagation.WithExtractMode(propagation.ExtractLastMatchWins),
+			},
+			want: tp2,
+		},
+		{
+			name: "first match wins",
+			opts: []propagation.CompositeOption{
+				propagation.WithExtractMode(propagation.ExtractFirstMatchWins),
+			},
+			want: tp1,
+		},
+		{
+			name: "first match wins with extract order",
+			opts: []propagation.CompositeOption{
+				propagation.WithExtractMode(propagation.ExtractFirstMatchWins),
+				propagation.WithExtractOrder(second, first, propagation.Baggage{}),
+			},
+			want: tp2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := propagation.NewCompositeTextMapPropagatorWithOptions(
+				[]propagation.TextMapPropagator{first, second, propagation.Baggage{}},
+				tt.opts...,
+			)
+			ctx := p.Extract(context.Background(), carrier)
+			assert.Equal(t, tt.want, traceparent(ctx))
+			assert.Equal(t, "one", baggage.FromContext(ctx).Member("key").Value())
+		})
+	}
+}
+
+func TestCompositeTextMapPropagatorWithOptionsFirstMatchBaggage(t *testing.T) {
+	p := propagation.NewCompositeTextMapPropagatorWithOptions(
+		[]propagation.TextMapPropagator{propagation.Baggage{}, prefixedBaggage{}},
+		propagation.WithExtractMode(propagation.ExtractFirstMatchWins),
+	)
+	ctx := p.Extract(context.Background(), propagation.MapCarrier{
+		"baggage":   "key=one",
+		"x-baggage": "key=two",
+	})
+	assert.Equal(t, "one", baggage.FromContext(ctx).Member("key").Value())
+}
+
+type prefixedBaggage struct{}
+
+func (prefixedBaggage) Inject(context.Context, propagation.TextMapCarrier) {}
+
+func (prefixedBaggage) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	return propagation.Baggage{}.Extract(ctx, propagation.MapCarrier{"baggage": carrier.Get("x-baggage")})
+}
+
+func (prefixedBaggage) Fields() []string { return []string{"x-baggage"} }
+
+func TestCompositeTextMapPropagatorWithOptionsInject(t *testing.T) {
+	a, b := propagator{"a"}, propagator{"b"}
+
+	c := make(carrier, 0, 2)
+	p := propagation.NewCompositeTextMapPropagatorWithOptions(
+		[]propagation.TextMapPropagator{a, b},
+		propagation.WithExtractOrder(b, a),
+	)
+	p.Inject(context.Background(), &c)
+	assert.Equal(t, "a,b", strings.Join([]string(c), ","), "inject order")
+	assert.ElementsMatch(t, []string{"a", "b"}, p.Fields())
+
+	v := p.Extract(context.Background(), nil).Value(ctxKey)
+	assert.Equal(t, []string{"b", "a"}, v, "extract order")
+}
+
 func TestMapCarrierGet(t *testing.T) {
 	carrier := propagation.MapCarrier{
 		"foo": "bar",
@@ -122,3 +243,111 @@ func TestMapCarrierKeys(t *testing.T) {
 	slices.Sort(keys)
 	assert.Equal(t, []string{"baz", "foo"}, keys)
 }
+
+func TestValuesMapCarrier(t *testing.T) {
+	carrier := propagation.ValuesMapCarrier{
+		"foo": {"bar", "baz"},
+	}
+
+	assert.Equal(t, "bar", carrier.Get("foo"))
+	assert.Equal(t, []string{"bar", "baz"}, carrier.Values("foo"))
+	assert.Empty(t, carrier.Get("Foo"), "keys are case-sensitive")
+	assert.Empty(t, carrier.Get("missing"))
+
+	carrier.Set("foo", "qux")
+	carrier.Set("key", "val")
+	assert.Equal(t, []string{"qux"}, carrier.Values("foo"))
+
+	keys := carrier.Keys()
+	slices.Sort(keys)
+	assert.Equal(t, []string{"foo", "key"}, keys)
+}
+
+func TestGRPCMetadataCarrier(t *testing.T) {
+	// Simulate metadata.MD, which stores lower-case keys.
+	md := map[string][]string{
+		"traceparent": {"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+		"baggage":     {"a=1", "b=2"},
+	}
+	carrier := propagation.GRPCMetadataCarrier(md)
+
+	assert.Equal(t, md["traceparent"][0], carrier.Get("Traceparent"))
+	assert.Equal(t, []string{"a=1", "b=2"}, carrier.Values("Baggage"))
+	assert.Empty(t, carrier.Get("missing"))
+
+	carrier.Set("X-Custom", "val")
+	assert.Equal(t, []string{"val"}, md["x-custom"])
+
+	keys := carrier.Keys()
+	slices.Sort(keys)
+	assert.Equal(t, []string{"baggage", "traceparent", "x-custom"}, keys)
+
+	ctx := propagation.Baggage{}.Extract(context.Background(), carrier)
+	assert.Equal(t, 2, baggage.FromContext(ctx).Len())
+}
+
+func TestCaseInsensitiveCarrier(t *testing.T) {
+	m := propagation.MapCarrier{"TraceParent": "value"}
+	carrier := propagation.NewCaseInsensitiveCarrier(m)
+
+	assert.Equal(t, "value", carrier.Get("traceparent"))
+	assert.Equal(t, []string{"value"}, carrier.Values("TRACEPARENT"))
+	assert.Empty(t, carrier.Get("missing"))
+	assert.Nil(t, carrier.Values("missing"))
+
+	carrier.Set("traceparent", "new")
+	carrier.Set("tracestate", "state")
+	assert.Equal(t, propagation.MapCarrier{
+		"TraceParent": "new",
+		"tracestate":  "state",
+	}, m)
+
+	keys := carrier.Keys()
+	slices.Sort(keys)
+	assert.Equal(t, []string{"TraceParent", "tracestate"}, keys)
+}
+
+func TestCaseInsensitiveCarrierValuesGetter(t *testing.T) {
+	carrier := propagation.NewCaseInsensitiveCarrier(propagation.ValuesMapCarrier{
+		"Baggage": {"a=1", "b=2"},
+	})
+	assert.Equal(t, []string{"a=1", "b=2"}, carrier.Values("baggage"))
+}
+
+type wrapper struct {
+	propagation.TextMapPropagator
+}
+
+func (w wrapper) Unwrap() propagation.TextMapPropagator { return w.TextMapPropagator }
+
+func TestComponents(t *testing.T) {
+	a, b, c := propagator{"a"}, propagator{"b"}, propagator{"c"}
+
+	assert.Empty(t, propagation.Components(nil))
+	assert.Empty(t, propagation.Components(propagation.NewCompositeTextMapPropagator()))
+	assert.Equal(t, []propagation.TextMapPropagator{a}, propagation.Components(a))
+
+	p := propagation.NewCompositeTextMapPropagator(
+		a,
+		wrapper{propagation.NewCompositeTextMapPropagatorWithOptions(
+			[]propagation.TextMapPropagator{b, c},
+			propagation.WithExtractOrder(c, b),
+		)},
+	)
+	assert.Equal(t, []propagation.TextMapPropagator{a, b, c}, propagation.Components(p))
+}
+
+func TestValidateFields(t *testing.T) {
+	a, b := propagator{"a"}, propagator{"b"}
+
+	assert.NoError(t, propagation.ValidateFields(nil))
+	assert.NoError(t, propagation.ValidateFields(propagation.NewCompositeTextMapPropagator(a, b)))
+	assert.NoError(t, propagation.ValidateFields(propagator{"a"}))
+
+	err := propagation.ValidateFields(propagation.NewCompositeTextMapPropagator(
+		a, b, propagator{"A"},
+	))
+	assert.ErrorIs(t, err, propagation.ErrFieldConflict)
+	assert.ErrorContains(t, err, `"a"`)
+	assert.NotContains(t, err.Error(), `"b"`)
+}
@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation // import "go.opentelemetry.io/otel/propagation"
+
+import "context"
+
+type suppressInjectionKeyType int
+
+const suppressInjectionKey suppressInjectionKeyType = 0
+
+// WithSuppressedInjection returns a copy of parent in which injection of
+// cross-cutting concerns is suppressed. Propagators that honor suppression
+// do not inject anything into a carrier when passed the returned context or
+// any context derived from it.
+//
+// This is intended for requests made by telemetry exporters and other
+// internal clients, so that outer instrumentation (e.g. an instrumented
+// http.RoundTripper) does not leak trace context to telemetry backends.
+//
+// All propagators provided by this package, including composite
+// propagators, honor suppression. Other propagators can check for it with
+// IsInjectionSuppressed.
+func WithSuppressedInjection(parent context.Context) context.Context {
+	return context.WithValue(parent, suppressInjectionKey, true)
+}
+
+// IsInjectionSuppressed returns whether injection of cross-cutting concerns
+// is suppressed for ctx by WithSuppressedInjection.
+func IsInjectionSuppressed(ctx context.Context) bool {
+	suppressed, _ := ctx.Value(suppressInjectionKey).(bool)
+	return suppressed
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestIsInjectionSuppressed(t *testing.T) {
+	ctx := context.Background()
+	assert.False(t, propagation.IsInjectionSuppressed(ctx))
+
+	ctx = propagation.WithSuppressedInjection(ctx)
+	assert.True(t, propagation.IsInjectionSuppressed(ctx))
+
+	type key struct{}
+	derived := context.WithValue(ctx, key{}, "value")
+	assert.True(t, propagation.IsInjectionSuppressed(derived), "derived context")
+}
+
+func TestSuppressedInjection(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	m, err := baggage.NewMemberRaw("key", "value")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bag, err := baggage.New(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+	ctx = baggage.ContextWithBaggage(ctx, bag)
+	suppressed := propagation.WithSuppressedInjection(ctx)
+
+	props := map[string]propagation.TextMapPropagator{
+		"TraceContext": propagation.TraceContext{},
+		"Baggage":      propagation.Baggage{},
+		"AWSXRay":      propagation.AWSXRay{},
+		"Composite": propagation.NewCompositeTextMapPropagator(
+			propagation.TraceContext{},
+			propagation.Baggage{},
+		),
+		"CompositeWithOptions": propagation.NewCompositeTextMapPropagatorWithOptions(
+			[]propagation.TextMapPropagator{propagation.TraceContext{}, propagation.Baggage{}},
+		),
+	}
+	for name, p := range props {
+		t.Run(name, func(t *testing.T) {
+			carrier := propagation.MapCarrier{}
+			p.Inject(ctx, carrier)
+			assert.NotEmpty(t, carrier, "not suppressed")
+
+			carrier = propagation.MapCarrier{}
+			p.Inject(suppressed, carrier)
+			assert.Empty(t, carrier, "suppressed")
+		})
+	}
+
+	t.Run("BinaryTraceContext", func(t *testing.T) {
+		var p propagation.BinaryTraceContext
+		assert.NotNil(t, p.Inject(ctx), "not suppressed")
+		assert.Nil(t, p.Inject(suppressed), "suppressed")
+	})
+}
@@ -6,6 +6,7 @@ package propagation // import "go.opentelemetry.io/otel/propagation"
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -18,6 +19,14 @@ const (
 	traceparentHeader = "traceparent"
 	tracestateHeader  = "tracestate"
 	delimiter         = "-"
+
+	// supportedFlags are the trace-flags defined by W3C Trace Context Level
+	// 2. All other flags are cleared.
+	supportedFlags = trace.FlagsSampled | trace.FlagsRandom
+
+	// maxTraceStateMembers is the maximum number of tracestate list-members
+	// defined by W3C Trace Context.
+	maxTraceStateMembers = 32
 )
 
 // TraceContext is a propagator that supports the W3C Trace Context format
@@ -28,7 +37,113 @@ const (
 // to choose if they want to participate in a trace by modifying the
 // traceparent header and relevant parts of the tracestate header containing
 // their proprietary information.
-type TraceContext struct{}
+//
+// The zero value uses the standard traceparent and tracestate header names.
+// Use NewTraceContext to configure alternate header names.
+type TraceContext struct {
+	// cfg is nil for the zero value, which uses the default configuration.
+	cfg *traceContextConfig
+}
+
+type traceContextConfig struct {
+	traceparent string
+	tracestate  string
+	diagnostics func(error)
+}
+
+var (
+	// ErrMalformedTraceParent is reported by a TraceContext propagator when
+	// the traceparent is not formatted according to the W3C Trace Context
+	// specification.
+	ErrMalformedTraceParent = errors.New("malformed traceparent")
+	// ErrUnsupportedTraceParentVersion is reported by a TraceContext
+	// propagator when the traceparent version is not supported.
+	ErrUnsupportedTraceParentVersion = errors.New("unsupported traceparent version")
+	// ErrInvalidTraceParentID is reported by a TraceContext propagator when
+	// the traceparent trace-id or parent-id is all zeros.
+	ErrInvalidTraceParentID = errors.New("invalid traceparent trace-id or parent-id")
+	// ErrMalformedTraceState is reported by a TraceContext propagator when
+	// the tracestate cannot be parsed. This does not prevent the traceparent
+	// from being extracted.
+	ErrMalformedTraceState = errors.New("malformed tracestate")
+)
+
+// TraceContextOption configures a TraceContext propagator.
+type TraceContextOption interface {
+	applyTraceContext(traceContextConfig) traceContextConfig
+}
+
+type traceContextOptionFunc func(traceContextConfig) traceContextConfig
+
+func (fn traceContextOptionFunc) applyTraceContext(c traceContextConfig) traceContextConfig {
+	return fn(c)
+}
+
+// WithTraceParentHeader sets the header name used to inject and extract the
+// traceparent. This is useful when a legacy gateway or proxy requires an
+// alternate header name (e.g. "x-custom-traceparent"). An empty name is
+// ignored.
+func WithTraceParentHeader(name string) TraceContextOption {
+	return traceContextOptionFunc(func(c traceContextConfig) traceContextConfig {
+		if name != "" {
+			c.traceparent = name
+		}
+		return c
+	})
+}
+
+// WithTraceStateHeader sets the header name used to inject and extract the
+// tracestate. An empty name is ignored.
+func WithTraceStateHeader(name string) TraceContextOption {
+	return traceContextOptionFunc(func(c traceContextConfig) traceContextConfig {
+		if name != "" {
+			c.tracestate = name
+		}
+		return c
+	})
+}
+
+// WithTraceContextDiagnostics sets a function that is called with the reason
+// a traceparent or tracestate present in a carrier could not be extracted.
+// The reported error wraps one of ErrMalformedTraceParent,
+// ErrUnsupportedTraceParentVersion, ErrInvalidTraceParentID, or
+// ErrMalformedTraceState.
+//
+// This is intended to help debug upstream services or proxies that send
+// broken trace context. The function is not called if the carrier does not
+// contain a traceparent. It is called synchronously and should not block.
+func WithTraceContextDiagnostics(f func(error)) TraceContextOption {
+	return traceContextOptionFunc(func(c traceContextConfig) traceContextConfig {
+		c.diagnostics = f
+		return c
+	})
+}
+
+// NewTraceContext returns a TraceContext propagator configured with opts.
+func NewTraceContext(opts ...TraceContextOption) TraceContext {
+	c := traceContextConfig{
+		traceparent: traceparentHeader,
+		tracestate:  tracestateHeader,
+	}
+	for _, o := range opts {
+		c = o.applyTraceContext(c)
+	}
+	return TraceContext{cfg: &c}
+}
+
+func (tc TraceContext) traceparentHeader() string {
+	if tc.cfg == nil {
+		return traceparentHeader
+	}
+	return tc.cfg.traceparent
+}
+
+func (tc TraceContext) tracestateHeader() string {
+	if tc.cfg == nil {
+		return tracestateHeader
+	}
+	return tc.cfg.tracestate
+}
 
 var (
 	_           TextMapPropagator = TraceContext{}
@@ -38,16 +153,17 @@ var (
 // Inject injects the trace context from ctx into carrier.
 func (tc TraceContext) Inject(ctx context.Context, carrier TextMapCarrier) {
 	sc := trace.SpanContextFromContext(ctx)
-	if !sc.IsValid() {
+	if !sc.IsValid() || IsInjectionSuppressed(ctx) {
 		return
 	}
 
 	if ts := sc.TraceState().String(); ts != "" {
-		carrier.Set(tracestateHeader, ts)
+		carrier.Set(tc.tracestateHeader(), ts)
 	}
 
-	// Clear all flags other than the trace-context supported sampling bit.
-	flags := sc.TraceFlags() & trace.FlagsSampled
+	// Clear all flags other than the trace-context supported sampling and
+	// random bits.
+	flags := sc.TraceFlags() & supportedFlags
 
 	var sb strings.Builder
 	sb.Grow(2 + 32 + 16 + 2 + 3)
@@ -61,7 +177,7 @@ func (tc TraceContext) Inject(ctx context.Context, carrier TextMapCarrier) {
 		n := hex.Encode(buf[:], src)
 		_, _ = sb.Write(buf[:n])
 	}
-	carrier.Set(traceparentHeader, sb.String())
+	carrier.Set(tc.traceparentHeader(), sb.String())
 }
 
 // Extract reads tracecontext from the carrier into a returned Context.
@@ -70,61 +186,103 @@ func (tc TraceContext) Inject(ctx context.Context, carrier TextMapCarrier) {
 // tracecontext as the remote SpanContext. If the extracted tracecontext is
 // invalid, the passed ctx will be returned directly instead.
 func (tc TraceContext) Extract(ctx context.Context, carrier TextMapCarrier) context.Context {
-	sc := tc.extract(carrier)
+	sc, err := tc.extract(carrier)
+	if err != nil && tc.cfg != nil && tc.cfg.diagnostics != nil {
+		tc.cfg.diagnostics(err)
+	}
 	if !sc.IsValid() {
 		return ctx
 	}
 	return trace.ContextWithRemoteSpanContext(ctx, sc)
 }
 
-func (tc TraceContext) extract(carrier TextMapCarrier) trace.SpanContext {
-	h := carrier.Get(traceparentHeader)
+// extract returns the SpanContext extracted from carrier. If the SpanContext
+// could not be extracted, or the tracestate could not be parsed, the reason
+// is returned as an error.
+func (tc TraceContext) extract(carrier TextMapCarrier) (trace.SpanContext, error) {
+	h := carrier.Get(tc.traceparentHeader())
 	if h == "" {
-		return trace.SpanContext{}
+		return trace.SpanContext{}, nil
+	}
+	malformed := func(field string) error {
+		return fmt.Errorf("%w: invalid %s: %q", ErrMalformedTraceParent, field, carrier.Get(tc.traceparentHeader()))
 	}
 
 	var ver [1]byte
 	if !extractPart(ver[:], &h, 2) {
-		return trace.SpanContext{}
+		return trace.SpanContext{}, malformed("version")
 	}
 	version := int(ver[0])
 	if version > maxVersion {
-		return trace.SpanContext{}
+		return trace.SpanContext{}, fmt.Errorf("%w: %d", ErrUnsupportedTraceParentVersion, version)
 	}
 
 	var scc trace.SpanContextConfig
 	if !extractPart(scc.TraceID[:], &h, 32) {
-		return trace.SpanContext{}
+		return trace.SpanContext{}, malformed("trace-id")
 	}
 	if !extractPart(scc.SpanID[:], &h, 16) {
-		return trace.SpanContext{}
+		return trace.SpanContext{}, malformed("parent-id")
 	}
 
 	var opts [1]byte
 	if !extractPart(opts[:], &h, 2) {
-		return trace.SpanContext{}
+		return trace.SpanContext{}, malformed("trace-flags")
 	}
-	if version == 0 && (h != "" || opts[0] > 2) {
+	if version == 0 && (h != "" || opts[0]&^byte(supportedFlags) != 0) {
 		// version 0 not allow extra
 		// version 0 not allow other flag
-		return trace.SpanContext{}
+		return trace.SpanContext{}, malformed("version 0 format")
 	}
 
-	// Clear all flags other than the trace-context supported sampling bit.
-	scc.TraceFlags = trace.TraceFlags(opts[0]) & trace.FlagsSampled
+	// Clear all flags other than the trace-context supported sampling and
+	// random bits.
+	scc.TraceFlags = trace.TraceFlags(opts[0]) & supportedFlags
 
-	// Ignore the error returned here. Failure to parse tracestate MUST NOT
-	// affect the parsing of traceparent according to the W3C tracecontext
-	// specification.
-	scc.TraceState, _ = trace.ParseTraceState(carrier.Get(tracestateHeader))
+	// Failure to parse tracestate MUST NOT affect the parsing of traceparent
+	// according to the W3C tracecontext specification. The error is only
+	// reported.
+	var err error
+	ts := carrier.Get(tc.tracestateHeader())
+	scc.TraceState, err = trace.ParseTraceState(ts)
+	if errors.Is(err, trace.ErrTraceStateMemberLimit) {
+		// The specification allows truncating the tracestate instead of
+		// discarding it. Keep the left-most list-members, the most
+		// recently updated ones.
+		scc.TraceState, err = trace.ParseTraceState(truncateTraceState(ts, maxTraceStateMembers))
+	}
+	if err != nil {
+		err = fmt.Errorf("%w: %w", ErrMalformedTraceState, err)
+	}
 	scc.Remote = true
 
 	sc := trace.NewSpanContext(scc)
 	if !sc.IsValid() {
-		return trace.SpanContext{}
+		return trace.SpanContext{}, ErrInvalidTraceParentID
 	}
 
-	return sc
+	return sc, err
+}
+
+// truncateTraceState returns ts with only its first n non-empty
+// list-members.
+func truncateTraceState(ts string, n int) string {
+	var end int
+	for i := 0; i < len(ts); {
+		next := strings.IndexByte(ts[i:], ',')
+		if next < 0 {
+			next = len(ts) - i
+		}
+		if strings.TrimSpace(ts[i:i+next]) != "" {
+			if n == 0 {
+				break
+			}
+			n--
+		}
+		i += next + 1
+		end = min(i, len(ts))
+	}
+	return ts[:end]
 }
 
 // upperHex detect hex is upper case Unicode characters.
@@ -152,5 +310,5 @@ func extractPart(dst []byte, h *string, n int) bool {
 
 // Fields returns the keys who's values are set with Inject.
 func (tc TraceContext) Fields() []string {
-	return []string{traceparentHeader, tracestateHeader}
+	return []string{tc.traceparentHeader(), tc.tracestateHeader()}
 }
@@ -5,7 +5,9 @@ package propagation_test
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -57,6 +59,18 @@ func TestExtractValidTraceContext(t *testing.T) {
 				Remote:     true,
 			}),
 		},
+		{
+			name: "random",
+			header: http.Header{
+				traceparent: []string{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-03"},
+			},
+			sc: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    traceID,
+				SpanID:     spanID,
+				TraceFlags: trace.FlagsSampled | trace.FlagsRandom,
+				Remote:     true,
+			}),
+		},
 		{
 			name: "valid tracestate",
 			header: http.Header{
@@ -287,10 +301,22 @@ func TestInjectValidTraceContext(t *testing.T) {
 				Remote:     true,
 			}),
 		},
+		{
+			name: "random",
+			header: http.Header{
+				traceparent: []string{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-02"},
+			},
+			sc: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    traceID,
+				SpanID:     spanID,
+				TraceFlags: trace.FlagsRandom,
+				Remote:     true,
+			}),
+		},
 		{
 			name: "unsupported trace flag bits dropped",
 			header: http.Header{
-				traceparent: []string{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+				traceparent: []string{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-03"},
 			},
 			sc: trace.NewSpanContext(trace.SpanContextConfig{
 				TraceID:    traceID,
@@ -340,3 +366,169 @@ func TestTraceContextFields(t *testing.T) {
 	expected := []string{"traceparent", "tracestate"}
 	assert.Equal(t, expected, propagation.TraceContext{}.Fields())
 }
+
+func TestTraceContextCustomHeaders(t *testing.T) {
+	prop := propagation.NewTraceContext(
+		propagation.WithTraceParentHeader("x-custom-traceparent"),
+		propagation.WithTraceStateHeader("x-custom-tracestate"),
+	)
+	assert.Equal(t, []string{"x-custom-traceparent", "x-custom-tracestate"}, prop.Fields())
+
+	state, err := trace.ParseTraceState("key1=value1")
+	require.NoError(t, err)
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		TraceState: state,
+		Remote:     true,
+	})
+
+	carrier := propagation.MapCarrier{}
+	prop.Inject(trace.ContextWithRemoteSpanContext(context.Background(), sc), carrier)
+	assert.Equal(t, propagation.MapCarrier{
+		"x-custom-traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"x-custom-tracestate":  "key1=value1",
+	}, carrier)
+
+	ctx := prop.Extract(context.Background(), carrier)
+	assert.Equal(t, sc, trace.SpanContextFromContext(ctx))
+
+	// The default header names are not used.
+	ctx = prop.Extract(context.Background(), propagation.MapCarrier{
+		"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	})
+	assert.False(t, trace.SpanContextFromContext(ctx).IsValid())
+}
+
+func TestNewTraceContextDefaults(t *testing.T) {
+	prop := propagation.NewTraceContext(propagation.WithTraceParentHeader(""))
+	assert.Equal(t, propagation.TraceContext{}.Fields(), prop.Fields())
+}
+
+func TestTraceContextDiagnostics(t *testing.T) {
+	tests := []struct {
+		name        string
+		traceparent string
+		tracestate  string
+		valid       bool
+		want        error
+	}{
+		{
+			name:        "valid",
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			valid:       true,
+		},
+		{
+			name: "missing",
+		},
+		{
+			name:        "bad version",
+			traceparent: "0x-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			want:        propagation.ErrMalformedTraceParent,
+		},
+		{
+			name:        "unsupported version",
+			traceparent: "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			want:        propagation.ErrUnsupportedTraceParentVersion,
+		},
+		{
+			name:        "malformed trace-id",
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e473-00f067aa0ba902b7-01",
+			want:        propagation.ErrMalformedTraceParent,
+		},
+		{
+			name:        "malformed parent-id",
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00F067AA0BA902B7-01",
+			want:        propagation.ErrMalformedTraceParent,
+		},
+		{
+			name:        "malformed trace-flags",
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-0",
+			want:        propagation.ErrMalformedTraceParent,
+		},
+		{
+			name:        "extra fields in version 0",
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+			want:        propagation.ErrMalformedTraceParent,
+		},
+		{
+			name:        "zero trace-id",
+			traceparent: "00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+			want:        propagation.ErrInvalidTraceParentID,
+		},
+		{
+			name:        "zero parent-id",
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+			want:        propagation.ErrInvalidTraceParentID,
+		},
+		{
+			name:        "malformed tracestate",
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			tracestate:  "foo=1,foo=2",
+			valid:       true,
+			want:        propagation.ErrMalformedTraceState,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []error
+			prop := propagation.NewTraceContext(propagation.WithTraceContextDiagnostics(func(err error) {
+				got = append(got, err)
+			}))
+
+			carrier := propagation.MapCarrier{}
+			if tt.traceparent != "" {
+				carrier["traceparent"] = tt.traceparent
+			}
+			if tt.tracestate != "" {
+				carrier["tracestate"] = tt.tracestate
+			}
+			ctx := prop.Extract(context.Background(), carrier)
+			assert.Equal(t, tt.valid, trace.SpanContextFromContext(ctx).IsValid())
+
+			if tt.want == nil {
+				assert.Empty(t, got)
+				return
+			}
+			require.Len(t, got, 1)
+			assert.ErrorIs(t, got[0], tt.want)
+		})
+	}
+}
+
+func TestTraceContextExtractTruncatesTraceState(t *testing.T) {
+	members := make([]string, 40)
+	for i := range members {
+		members[i] = fmt.Sprintf("k%d=v%d", i, i)
+	}
+	var diags []error
+	prop := propagation.NewTraceContext(propagation.WithTraceContextDiagnostics(func(err error) {
+		diags = append(diags, err)
+	}))
+	carrier := propagation.MapCarrier{
+		"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		// Empty list-members are not counted.
+		"tracestate": ",," + strings.Join(members, ","),
+	}
+	ctx := prop.Extract(context.Background(), carrier)
+
+	want, err := trace.ParseTraceState(strings.Join(members[:32], ","))
+	require.NoError(t, err)
+	assert.Equal(t, want, trace.SpanContextFromContext(ctx).TraceState())
+	assert.Empty(t, diags)
+
+	// List-members after the first 32 are ignored, even if malformed.
+	carrier["tracestate"] = strings.Join(members, ",") + ",k0=dup"
+	ctx = prop.Extract(context.Background(), carrier)
+	assert.Equal(t, want, trace.SpanContextFromContext(ctx).TraceState())
+	assert.Empty(t, diags)
+
+	// The truncated tracestate is still dropped if it is malformed.
+	carrier["tracestate"] = "k0=dup," + strings.Join(members, ",")
+	ctx = prop.Extract(context.Background(), carrier)
+	assert.Equal(t, 0, trace.SpanContextFromContext(ctx).TraceState().Len())
+	require.Len(t, diags, 1)
+	assert.ErrorIs(t, diags[0], propagation.ErrMalformedTraceState)
+}
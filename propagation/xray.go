@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation // import "go.opentelemetry.io/otel/propagation"
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	xrayHeader = "X-Amzn-Trace-Id"
+
+	xrayRootKey    = "Root"
+	xrayParentKey  = "Parent"
+	xraySampledKey = "Sampled"
+
+	xrayPartDelimiter     = ";"
+	xrayKeyValueDelimiter = "="
+	xrayTraceIDVersion    = "1"
+	xrayTraceIDDelimiter  = "-"
+
+	xraySampled    = "1"
+	xrayNotSampled = "0"
+	xrayRequested  = "?"
+	xrayDebug      = "d"
+
+	// xrayTraceIDLen is the length of an X-Ray trace ID:
+	// version (1) + "-" + epoch (8) + "-" + unique ID (24).
+	xrayTraceIDLen = 35
+)
+
+// AWSXRay is a propagator that supports the AWS X-Ray trace header format
+// (https://docs.aws.amazon.com/xray/latest/devguide/xray-concepts.html#xray-concepts-tracingheader).
+//
+// This allows traces originating from AWS services, such as Lambda or an
+// Application Load Balancer, to be continued. The X-Ray trace ID is mapped to
+// the OpenTelemetry trace ID by joining its epoch and unique ID parts, and
+// the X-Ray sampling decision is mapped to the sampled trace flag. A
+// sampling decision of "?" (requested) is treated as not sampled, and "d"
+// (debug) as sampled.
+//
+// The X-Ray header does not carry a tracestate.
+type AWSXRay struct{}
+
+var _ TextMapPropagator = AWSXRay{}
+
+// Inject injects the trace context from ctx into carrier using the X-Ray
+// trace header format.
+func (AWSXRay) Inject(ctx context.Context, carrier TextMapCarrier) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() || IsInjectionSuppressed(ctx) {
+		return
+	}
+
+	traceID := sc.TraceID().String()
+	sampled := xrayNotSampled
+	if sc.IsSampled() {
+		sampled = xraySampled
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(xrayRootKey) + 1 + xrayTraceIDLen + 1 + len(xrayParentKey) + 1 + 16 + 1 + len(xraySampledKey) + 2)
+	_, _ = sb.WriteString(xrayRootKey)
+	_, _ = sb.WriteString(xrayKeyValueDelimiter)
+	_, _ = sb.WriteString(xrayTraceIDVersion)
+	_, _ = sb.WriteString(xrayTraceIDDelimiter)
+	_, _ = sb.WriteString(traceID[:8])
+	_, _ = sb.WriteString(xrayTraceIDDelimiter)
+	_, _ = sb.WriteString(traceID[8:])
+	_, _ = sb.WriteString(xrayPartDelimiter)
+	_, _ = sb.WriteString(xrayParentKey)
+	_, _ = sb.WriteString(xrayKeyValueDelimiter)
+	_, _ = sb.WriteString(sc.SpanID().String())
+	_, _ = sb.WriteString(xrayPartDelimiter)
+	_, _ = sb.WriteString(xraySampledKey)
+	_, _ = sb.WriteString(xrayKeyValueDelimiter)
+	_, _ = sb.WriteString(sampled)
+	carrier.Set(xrayHeader, sb.String())
+}
+
+// Extract reads the X-Ray trace header from carrier into a returned Context.
+//
+// The returned Context will be a copy of ctx and contain the extracted trace
+// context as the remote SpanContext. If the extracted trace context is
+// invalid, the passed ctx will be returned directly instead.
+func (x AWSXRay) Extract(ctx context.Context, carrier TextMapCarrier) context.Context {
+	sc := x.extract(carrier.Get(xrayHeader))
+	if !sc.IsValid() {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+func (AWSXRay) extract(h string) trace.SpanContext {
+	if h == "" {
+		return trace.SpanContext{}
+	}
+
+	var scc trace.SpanContextConfig
+	for h != "" {
+		var part string
+		part, h, _ = strings.Cut(h, xrayPartDelimiter)
+		key, value, ok := strings.Cut(strings.TrimSpace(part), xrayKeyValueDelimiter)
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case xrayRootKey:
+			if !parseXRayTraceID(&scc.TraceID, value) {
+				return trace.SpanContext{}
+			}
+		case xrayParentKey:
+			var err error
+			if scc.SpanID, err = trace.SpanIDFromHex(value); err != nil {
+				return trace.SpanContext{}
+			}
+		case xraySampledKey:
+			switch value {
+			case xraySampled, xrayDebug:
+				scc.TraceFlags = trace.FlagsSampled
+			case xrayNotSampled, xrayRequested:
+				scc.TraceFlags = 0
+			default:
+				return trace.SpanContext{}
+			}
+		}
+	}
+
+	scc.Remote = true
+	sc := trace.NewSpanContext(scc)
+	if !sc.IsValid() {
+		return trace.SpanContext{}
+	}
+	return sc
+}
+
+// parseXRayTraceID parses an X-Ray trace ID (e.g.
+// "1-5759e988-bd862e3fe1be46a994272793") into dst. It returns false if v is
+// not a valid X-Ray trace ID.
+func parseXRayTraceID(dst *trace.TraceID, v string) bool {
+	if len(v) != xrayTraceIDLen ||
+		v[:2] != xrayTraceIDVersion+xrayTraceIDDelimiter ||
+		v[10:11] != xrayTraceIDDelimiter {
+		return false
+	}
+
+	epoch, unique := v[2:10], v[11:]
+	// hex.Decode decodes unsupported upper-case characters, so exclude explicitly.
+	if upperHex(epoch) || upperHex(unique) {
+		return false
+	}
+	if _, err := hex.Decode(dst[:4], []byte(epoch)); err != nil {
+		return false
+	}
+	_, err := hex.Decode(dst[4:], []byte(unique))
+	return err == nil
+}
+
+// Fields returns the keys who's values are set with Inject.
+func (AWSXRay) Fields() []string {
+	return []string{xrayHeader}
+}
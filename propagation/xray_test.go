@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const xrayHeader = "X-Amzn-Trace-Id"
+
+var (
+	xrayTraceID = trace.TraceID{0x57, 0x59, 0xe9, 0x88, 0xbd, 0x86, 0x2e, 0x3f, 0xe1, 0xbe, 0x46, 0xa9, 0x94, 0x27, 0x27, 0x93}
+	xraySpanID  = trace.SpanID{0x53, 0x99, 0x5c, 0x3f, 0x42, 0xcd, 0x8a, 0xd8}
+)
+
+func TestAWSXRayExtract(t *testing.T) {
+	sampled := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    xrayTraceID,
+		SpanID:     xraySpanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+	notSampled := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: xrayTraceID,
+		SpanID:  xraySpanID,
+		Remote:  true,
+	})
+
+	tests := []struct {
+		name   string
+		header string
+		want   trace.SpanContext
+	}{
+		{
+			name:   "sampled",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=1",
+			want:   sampled,
+		},
+		{
+			name:   "not sampled",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=0",
+			want:   notSampled,
+		},
+		{
+			name:   "sampling requested",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=?",
+			want:   notSampled,
+		},
+		{
+			name:   "debug",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=d",
+			want:   sampled,
+		},
+		{
+			name:   "missing sampled",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8",
+			want:   notSampled,
+		},
+		{
+			name:   "reordered with whitespace and unknown keys",
+			header: "Sampled=1; Lineage=a87bd80c:1|68fd508a:5 ;Parent=53995c3f42cd8ad8; Root=1-5759e988-bd862e3fe1be46a994272793",
+			want:   sampled,
+		},
+		{
+			name:   "missing parent",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Sampled=1",
+		},
+		{
+			name:   "missing root",
+			header: "Parent=53995c3f42cd8ad8;Sampled=1",
+		},
+		{
+			name:   "invalid sampled",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=2",
+		},
+		{
+			name:   "invalid root version",
+			header: "Root=2-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=1",
+		},
+		{
+			name:   "invalid root delimiter",
+			header: "Root=1-5759e988bbd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=1",
+		},
+		{
+			name:   "upper case root",
+			header: "Root=1-5759E988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=1",
+		},
+		{
+			name:   "invalid parent",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad;Sampled=1",
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := http.Header{}
+			if tt.header != "" {
+				h.Set(xrayHeader, tt.header)
+			}
+			ctx := propagation.AWSXRay{}.Extract(context.Background(), propagation.HeaderCarrier(h))
+			assert.Equal(t, tt.want, trace.SpanContextFromContext(ctx))
+		})
+	}
+}
+
+func TestAWSXRayInject(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags trace.TraceFlags
+		want  string
+	}{
+		{
+			name:  "sampled",
+			flags: trace.FlagsSampled,
+			want:  "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=1",
+		},
+		{
+			name: "not sampled",
+			want: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    xrayTraceID,
+				SpanID:     xraySpanID,
+				TraceFlags: tt.flags,
+			})
+			h := http.Header{}
+			ctx := trace.ContextWithSpanContext(context.Background(), sc)
+			propagation.AWSXRay{}.Inject(ctx, propagation.HeaderCarrier(h))
+			assert.Equal(t, tt.want, h.Get(xrayHeader))
+		})
+	}
+}
+
+func TestAWSXRayInjectInvalid(t *testing.T) {
+	h := http.Header{}
+	propagation.AWSXRay{}.Inject(context.Background(), propagation.HeaderCarrier(h))
+	assert.Empty(t, h)
+}
+
+func TestAWSXRayFields(t *testing.T) {
+	assert.Equal(t, []string{xrayHeader}, propagation.AWSXRay{}.Fields())
+}
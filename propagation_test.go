@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestSetTextMapPropagatorValidatesFields(t *testing.T) {
+	orig := GetTextMapPropagator()
+	t.Cleanup(func() { SetTextMapPropagator(orig) })
+
+	var got []Diagnostic
+	SetErrorHandler(DiagnosticHandlerFunc(func(_ context.Context, d Diagnostic) {
+		got = append(got, d)
+	}))
+
+	tc := propagation.TraceContext{}
+	SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(tc, propagation.Baggage{}))
+	assert.Empty(t, got, "valid propagator reported")
+
+	p := propagation.NewCompositeTextMapPropagator(tc, propagation.Baggage{}, tc)
+	SetTextMapPropagator(p)
+	require.Len(t, got, 1)
+	assert.Equal(t, SeverityWarning, got[0].Severity)
+	assert.ErrorIs(t, got[0].Err, propagation.ErrFieldConflict)
+	assert.Equal(t, []propagation.TextMapPropagator{tc, propagation.Baggage{}, tc}, propagation.Components(GetTextMapPropagator()), "propagator not set")
+}
@@ -0,0 +1,172 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package transform applies the translations of a schema file to telemetry.
+//
+// A [Transformer] is created from a parsed schema file. Its [Translation]
+// method returns the translations to convert telemetry from one version of
+// the schema to a later one, which can then be applied to the names and
+// attributes of resources, spans, span events, logs, and metrics.
+//
+// Only upgrades are supported: telemetry cannot be converted to an older
+// version of a schema.
+package transform // import "go.opentelemetry.io/otel/schema/v1.1/transform"
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/Masterminds/semver/v3"
+
+	ast10 "go.opentelemetry.io/otel/schema/v1.0/ast"
+	"go.opentelemetry.io/otel/schema/v1.1/ast"
+)
+
+var (
+	// ErrInvalidSchemaURL is returned when a schema URL does not end with a
+	// semantic version.
+	ErrInvalidSchemaURL = errors.New("invalid schema URL")
+
+	// ErrUnsupportedTranslation is returned when telemetry cannot be
+	// converted between two schema URLs.
+	ErrUnsupportedTranslation = errors.New("unsupported schema translation")
+)
+
+// Transformer holds the translations of a schema file.
+//
+// Use [New] to create a Transformer.
+type Transformer struct {
+	schemaURL string
+	family    string
+	version   *semver.Version
+	// versions are the versions of the schema, in semver order.
+	versions []version
+
+	mu           sync.Mutex
+	translations map[[2]string]*Translation
+}
+
+type version struct {
+	v   *semver.Version
+	def ast.VersionDef
+}
+
+// New returns a Transformer applying the translations of schema.
+func New(schema *ast.Schema) (*Transformer, error) {
+	if schema == nil {
+		return nil, errors.New("nil schema")
+	}
+	family, v, err := splitSchemaURL(schema.SchemaURL)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]version, 0, len(schema.Versions))
+	for k, def := range schema.Versions {
+		ver, err := semver.StrictNewVersion(string(k))
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema version %q: %w", k, err)
+		}
+		versions = append(versions, version{v: ver, def: def})
+	}
+	// Translations are applied in the semver order of the versions, not in
+	// the order they are listed in the file.
+	slices.SortFunc(versions, func(a, b version) int { return a.v.Compare(b.v) })
+
+	return &Transformer{
+		schemaURL:    schema.SchemaURL,
+		family:       family,
+		version:      v,
+		versions:     versions,
+		translations: make(map[[2]string]*Translation),
+	}, nil
+}
+
+// SchemaURL returns the schema URL of the schema file of t.
+func (t *Transformer) SchemaURL() string {
+	return t.schemaURL
+}
+
+// Translation returns the Translation converting telemetry from the from
+// schema URL to the to schema URL.
+//
+// Both schema URLs need to be of the same schema family as the schema file of
+// t, only differing by their trailing version (e.g.
+// https://opentelemetry.io/schemas/1.21.0 and
+// https://opentelemetry.io/schemas/1.26.0). The version of to cannot be older
+// than the one of from, nor newer than the one of the schema file of t. An
+// error wrapping [ErrInvalidSchemaURL] or [ErrUnsupportedTranslation] is
+// returned otherwise.
+//
+// The returned Translation is cached and can be used concurrently.
+func (t *Transformer) Translation(from, to string) (*Translation, error) {
+	key := [2]string{from, to}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if tr, ok := t.translations[key]; ok {
+		return tr, nil
+	}
+	tr, err := t.newTranslation(from, to)
+	if err != nil {
+		return nil, err
+	}
+	t.translations[key] = tr
+	return tr, nil
+}
+
+func (t *Transformer) newTranslation(from, to string) (*Translation, error) {
+	fromFamily, fromVer, err := splitSchemaURL(from)
+	if err != nil {
+		return nil, err
+	}
+	toFamily, toVer, err := splitSchemaURL(to)
+	if err != nil {
+		return nil, err
+	}
+	if fromFamily != t.family || toFamily != t.family {
+		return nil, fmt.Errorf("%w: %s to %s with schema %s: different schema families", ErrUnsupportedTranslation, from, to, t.schemaURL)
+	}
+	if toVer.GreaterThan(t.version) {
+		return nil, fmt.Errorf("%w: schema %s does not cover version %s", ErrUnsupportedTranslation, t.schemaURL, toVer)
+	}
+	if toVer.LessThan(fromVer) {
+		return nil, fmt.Errorf("%w: %s to %s: downgrades are not supported", ErrUnsupportedTranslation, from, to)
+	}
+
+	tr := new(Translation)
+	for _, v := range t.versions {
+		if v.v.GreaterThan(fromVer) && !v.v.GreaterThan(toVer) {
+			tr.add(v.def)
+		}
+	}
+	return tr, nil
+}
+
+// splitSchemaURL splits a schema URL into its family (the URL without the
+// version) and version.
+func splitSchemaURL(schemaURL string) (string, *semver.Version, error) {
+	i := strings.LastIndexByte(schemaURL, '/')
+	if i < 0 {
+		return "", nil, fmt.Errorf("%w: %q", ErrInvalidSchemaURL, schemaURL)
+	}
+	v, err := semver.StrictNewVersion(schemaURL[i+1:])
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %q: %w", ErrInvalidSchemaURL, schemaURL, err)
+	}
+	return schemaURL[:i], v, nil
+}
+
+// renames returns the attribute maps of the rename changes of attrs.
+func renames(attrs ast10.Attributes) []ast10.AttributeMap {
+	var out []ast10.AttributeMap
+	for _, c := range attrs.Changes {
+		if c.RenameAttributes != nil && len(c.RenameAttributes.AttributeMap) > 0 {
+			out = append(out, c.RenameAttributes.AttributeMap)
+		}
+	}
+	return out
+}
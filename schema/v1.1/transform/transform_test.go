@@ -0,0 +1,218 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	schema "go.opentelemetry.io/otel/schema/v1.1"
+)
+
+const (
+	v100 = "https://opentelemetry.io/schemas/1.0.0"
+	v110 = "https://opentelemetry.io/schemas/1.1.0"
+)
+
+func newTestTranslation(t *testing.T) *Translation {
+	t.Helper()
+	s, err := schema.ParseFile("../testdata/valid-example.yaml")
+	require.NoError(t, err)
+	tf, err := New(s)
+	require.NoError(t, err)
+	assert.Equal(t, v110, tf.SchemaURL())
+
+	tr, err := tf.Translation(v100, v110)
+	require.NoError(t, err)
+	return tr
+}
+
+func TestTranslationErrors(t *testing.T) {
+	s, err := schema.ParseFile("../testdata/valid-example.yaml")
+	require.NoError(t, err)
+	tf, err := New(s)
+	require.NoError(t, err)
+
+	_, err = tf.Translation(v100, "https://opentelemetry.io/schemas/latest")
+	assert.ErrorIs(t, err, ErrInvalidSchemaURL)
+	_, err = tf.Translation("1.0.0", v110)
+	assert.ErrorIs(t, err, ErrInvalidSchemaURL)
+	_, err = tf.Translation(v100, "https://example.com/schemas/1.1.0")
+	assert.ErrorIs(t, err, ErrUnsupportedTranslation, "different family")
+	_, err = tf.Translation(v100, "https://opentelemetry.io/schemas/1.2.0")
+	assert.ErrorIs(t, err, ErrUnsupportedTranslation, "version not covered")
+	_, err = tf.Translation(v110, v100)
+	assert.ErrorIs(t, err, ErrUnsupportedTranslation, "downgrade")
+
+	_, err = New(nil)
+	assert.Error(t, err)
+}
+
+func TestTranslationCached(t *testing.T) {
+	s, err := schema.ParseFile("../testdata/valid-example.yaml")
+	require.NoError(t, err)
+	tf, err := New(s)
+	require.NoError(t, err)
+
+	tr0, err := tf.Translation(v100, v110)
+	require.NoError(t, err)
+	tr1, err := tf.Translation(v100, v110)
+	require.NoError(t, err)
+	assert.Same(t, tr0, tr1)
+	assert.False(t, tr0.IsIdentity())
+
+	tr, err := tf.Translation(v110, v110)
+	require.NoError(t, err)
+	assert.True(t, tr.IsIdentity())
+	attrs := []attribute.KeyValue{attribute.String("k8s.pod.name", "pod")}
+	assert.Equal(t, attrs, tr.Resource(attrs))
+}
+
+func TestResource(t *testing.T) {
+	tr := newTestTranslation(t)
+
+	attrs := []attribute.KeyValue{
+		attribute.String("k8s.pod.name", "pod"),
+		attribute.String("telemetry.auto.version", "1.0"),
+		attribute.String("peer.service", "svc"),
+	}
+	got := tr.Resource(attrs)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("kubernetes.pod.name", "pod"),
+		attribute.String("telemetry.auto_instr.version", "1.0"),
+		attribute.String("peer.service", "svc"),
+	}, got)
+	assert.Equal(t, attribute.Key("k8s.pod.name"), attrs[0].Key, "input modified")
+
+	assert.Equal(t, attribute.Key("kubernetes.node.uid"), tr.ResourceKey("k8s.node.uid"))
+	assert.Equal(t, attribute.Key("other"), tr.ResourceKey("other"))
+}
+
+func TestRenameExistingKey(t *testing.T) {
+	tr := newTestTranslation(t)
+
+	// Both the old and new key are set: the value of the new key is kept
+	// regardless of the order of the attributes, and no key is duplicated.
+	for _, attrs := range [][]attribute.KeyValue{
+		{
+			attribute.String("k8s.pod.name", "old"),
+			attribute.String("kubernetes.pod.name", "new"),
+		},
+		{
+			attribute.String("kubernetes.pod.name", "new"),
+			attribute.String("k8s.pod.name", "old"),
+		},
+	} {
+		assert.Equal(t, []attribute.KeyValue{
+			attribute.String("kubernetes.pod.name", "new"),
+		}, tr.Resource(attrs))
+		assert.Len(t, attrs, 2, "input modified")
+	}
+}
+
+func TestLog(t *testing.T) {
+	tr := newTestTranslation(t)
+
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("kubernetes.pod.name", "pod"),
+		attribute.String("process.executable.name", "app"),
+		attribute.String("telemetry.auto.version", "1.0"),
+	}, tr.Log([]attribute.KeyValue{
+		attribute.String("k8s.pod.name", "pod"),
+		attribute.String("process.executable_name", "app"),
+		attribute.String("telemetry.auto.version", "1.0"),
+	}))
+}
+
+func TestSpan(t *testing.T) {
+	tr := newTestTranslation(t)
+
+	attrs := []attribute.KeyValue{
+		attribute.String("k8s.pod.name", "pod"),
+		attribute.String("peer.service", "svc"),
+	}
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("kubernetes.pod.name", "pod"),
+		attribute.String("peer.service.name", "svc"),
+	}, tr.Span("HTTP GET", attrs))
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("kubernetes.pod.name", "pod"),
+		attribute.String("peer.service", "svc"),
+	}, tr.Span("HTTP POST", attrs), "apply_to_spans")
+
+	unchanged := []attribute.KeyValue{attribute.String("other", "v")}
+	got := tr.Span("HTTP GET", unchanged)
+	assert.Equal(t, unchanged, got)
+}
+
+func TestSpanEvent(t *testing.T) {
+	tr := newTestTranslation(t)
+
+	attrs := []attribute.KeyValue{attribute.String("peer.service", "svc")}
+	name, got := tr.SpanEvent("span", "exception.stacktrace", attrs)
+	assert.Equal(t, "exception.stack_trace", name)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("peer.service.name", "svc")}, got)
+
+	name, got = tr.SpanEvent("span", "other", attrs)
+	assert.Equal(t, "other", name)
+	assert.Equal(t, attrs, got, "apply_to_events")
+}
+
+func TestMetric(t *testing.T) {
+	tr := newTestTranslation(t)
+
+	testCases := []struct {
+		name      string
+		attrs     []attribute.KeyValue
+		wantName  string
+		wantAttrs []attribute.KeyValue
+	}{
+		{
+			name:      "container.cpu.usage.total",
+			attrs:     []attribute.KeyValue{attribute.Int("http.status_code", 200)},
+			wantName:  "cpu.usage.total",
+			wantAttrs: []attribute.KeyValue{attribute.Int("http.response_status_code", 200)},
+		},
+		{
+			name:      "system.cpu.utilization",
+			attrs:     []attribute.KeyValue{attribute.String("status", "idle"), attribute.String("k8s.pod.name", "pod")},
+			wantName:  "system.cpu.utilization",
+			wantAttrs: []attribute.KeyValue{attribute.String("state", "idle"), attribute.String("kubernetes.pod.name", "pod")},
+		},
+		{
+			name:      "other",
+			attrs:     []attribute.KeyValue{attribute.String("status", "idle")},
+			wantName:  "other",
+			wantAttrs: []attribute.KeyValue{attribute.String("status", "idle")},
+		},
+		{
+			name:      "system.paging.operations",
+			attrs:     []attribute.KeyValue{attribute.String("direction", "in"), attribute.String("type", "major")},
+			wantName:  "system.paging.operations.in",
+			wantAttrs: []attribute.KeyValue{attribute.String("type", "major")},
+		},
+		{
+			name:      "system.paging.operations",
+			attrs:     []attribute.KeyValue{attribute.String("direction", "sideways")},
+			wantName:  "system.paging.operations",
+			wantAttrs: []attribute.KeyValue{attribute.String("direction", "sideways")},
+		},
+		{
+			name:      "system.paging.operations",
+			wantName:  "system.paging.operations",
+			wantAttrs: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			name, attrs := tr.Metric(tc.name, tc.attrs)
+			assert.Equal(t, tc.wantName, name)
+			assert.Equal(t, tc.wantAttrs, attrs)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformsdk // import "go.opentelemetry.io/otel/schema/v1.1/transform/transformsdk"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/schema/v1.1/transform"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// metricExporter is an Exporter that upgrades the exported metrics to a
+// schema before passing them to the wrapped Exporter.
+type metricExporter struct {
+	sdkmetric.Exporter
+	upgrader
+}
+
+// NewMetricExporter returns an Exporter that upgrades the metrics exported
+// from the schema URL of their instrumentation scope to the schema of t,
+// and exports them with exporter. The names and the data point attributes of
+// the metrics are translated, and the schema URL of their instrumentation
+// scope is set to the one of t. A metric split by the schema is exported as
+// one metric per resulting name. The Resource is not modified: use
+// [MergeResources] to upgrade it.
+//
+// Metrics without a schema URL, or with one that cannot be upgraded to the
+// schema of t, are exported unchanged. Each schema URL that cannot be
+// upgraded is reported once to the global ErrorHandler.
+//
+// The ResourceMetrics passed to Export are not modified.
+func NewMetricExporter(t *transform.Transformer, exporter sdkmetric.Exporter) sdkmetric.Exporter {
+	return &metricExporter{Exporter: exporter, upgrader: upgrader{t: t}}
+}
+
+// Export exports rm upgraded to the schema of the Transformer.
+func (e *metricExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	return e.Exporter.Export(ctx, e.upgrade(rm))
+}
+
+// upgrade returns a copy of rm upgraded to the schema of the Transformer, or
+// rm if none of its metrics need to be upgraded.
+func (e *metricExporter) upgrade(rm *metricdata.ResourceMetrics) *metricdata.ResourceMetrics {
+	var out *metricdata.ResourceMetrics
+	for i, sm := range rm.ScopeMetrics {
+		tr := e.translation(sm.Scope.SchemaURL)
+		if tr == nil {
+			continue
+		}
+		if out == nil {
+			out = &metricdata.ResourceMetrics{
+				Resource:     rm.Resource,
+				ScopeMetrics: make([]metricdata.ScopeMetrics, len(rm.ScopeMetrics)),
+			}
+			copy(out.ScopeMetrics, rm.ScopeMetrics)
+		}
+
+		upgraded := metricdata.ScopeMetrics{
+			Scope:   sm.Scope,
+			Metrics: make([]metricdata.Metrics, 0, len(sm.Metrics)),
+		}
+		upgraded.Scope.SchemaURL = e.t.SchemaURL()
+		for _, m := range sm.Metrics {
+			upgraded.Metrics = append(upgraded.Metrics, upgradeMetric(tr, m)...)
+		}
+		out.ScopeMetrics[i] = upgraded
+	}
+	if out == nil {
+		return rm
+	}
+	return out
+}
+
+// upgradeMetric returns m upgraded by tr. More than one metric is returned if
+// tr splits m.
+func upgradeMetric(tr *transform.Translation, m metricdata.Metrics) []metricdata.Metrics {
+	switch d := m.Data.(type) {
+	case metricdata.Gauge[int64]:
+		return upgradePoints(tr, m, d.DataPoints, pointAttrs, func(dps []metricdata.DataPoint[int64]) metricdata.Aggregation {
+			d.DataPoints = dps
+			return d
+		})
+	case metricdata.Gauge[float64]:
+		return upgradePoints(tr, m, d.DataPoints, pointAttrs, func(dps []metricdata.DataPoint[float64]) metricdata.Aggregation {
+			d.DataPoints = dps
+			return d
+		})
+	case metricdata.Sum[int64]:
+		return upgradePoints(tr, m, d.DataPoints, pointAttrs, func(dps []metricdata.DataPoint[int64]) metricdata.Aggregation {
+			d.DataPoints = dps
+			return d
+		})
+	case metricdata.Sum[float64]:
+		return upgradePoints(tr, m, d.DataPoints, pointAttrs, func(dps []metricdata.DataPoint[float64]) metricdata.Aggregation {
+			d.DataPoints = dps
+			return d
+		})
+	case metricdata.Histogram[int64]:
+		return upgradePoints(tr, m, d.DataPoints, histogramAttrs, func(dps []metricdata.HistogramDataPoint[int64]) metricdata.Aggregation {
+			d.DataPoints = dps
+			return d
+		})
+	case metricdata.Histogram[float64]:
+		return upgradePoints(tr, m, d.DataPoints, histogramAttrs, func(dps []metricdata.HistogramDataPoint[float64]) metricdata.Aggregation {
+			d.DataPoints = dps
+			return d
+		})
+	case metricdata.ExponentialHistogram[int64]:
+		return upgradePoints(tr, m, d.DataPoints, expHistogramAttrs, func(dps []metricdata.ExponentialHistogramDataPoint[int64]) metricdata.Aggregation {
+			d.DataPoints = dps
+			return d
+		})
+	case metricdata.ExponentialHistogram[float64]:
+		return upgradePoints(tr, m, d.DataPoints, expHistogramAttrs, func(dps []metricdata.ExponentialHistogramDataPoint[float64]) metricdata.Aggregation {
+			d.DataPoints = dps
+			return d
+		})
+	case metricdata.Summary:
+		return upgradePoints(tr, m, d.DataPoints, summaryAttrs, func(dps []metricdata.SummaryDataPoint) metricdata.Aggregation {
+			d.DataPoints = dps
+			return d
+		})
+	}
+	m.Name, _ = tr.Metric(m.Name, nil)
+	return []metricdata.Metrics{m}
+}
+
+func pointAttrs[N int64 | float64](dp *metricdata.DataPoint[N]) *attribute.Set { return &dp.Attributes }
+
+func histogramAttrs[N int64 | float64](dp *metricdata.HistogramDataPoint[N]) *attribute.Set {
+	return &dp.Attributes
+}
+
+func expHistogramAttrs[N int64 | float64](dp *metricdata.ExponentialHistogramDataPoint[N]) *attribute.Set {
+	return &dp.Attributes
+}
+
+func summaryAttrs(dp *metricdata.SummaryDataPoint) *attribute.Set { return &dp.Attributes }
+
+// upgradePoints returns the metrics resulting from upgrading the data points
+// dps of m with tr, grouped by metric name in the order the names are first
+// seen. The attributes of a data point are accessed with attrs, and the
+// aggregation of each group of data points is created with data.
+func upgradePoints[DP any](
+	tr *transform.Translation,
+	m metricdata.Metrics,
+	dps []DP,
+	attrs func(*DP) *attribute.Set,
+	data func([]DP) metricdata.Aggregation,
+) []metricdata.Metrics {
+	if len(dps) == 0 {
+		m.Name, _ = tr.Metric(m.Name, nil)
+		return []metricdata.Metrics{m}
+	}
+
+	var names []string
+	byName := make(map[string][]DP)
+	for _, dp := range dps {
+		set := attrs(&dp)
+		name, kvs := tr.Metric(m.Name, set.ToSlice())
+		*set = attribute.NewSet(kvs...)
+		if _, ok := byName[name]; !ok {
+			names = append(names, name)
+		}
+		byName[name] = append(byName[name], dp)
+	}
+
+	out := make([]metricdata.Metrics, 0, len(names))
+	for _, name := range names {
+		upgraded := m
+		upgraded.Name = name
+		upgraded.Data = data(byName[name])
+		out = append(out, upgraded)
+	}
+	return out
+}
@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformsdk
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	schema "go.opentelemetry.io/otel/schema/v1.1"
+	"go.opentelemetry.io/otel/schema/v1.1/transform"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+const metricTestSchema = `
+file_format: 1.1.0
+schema_url: https://example.com/schemas/1.1.0
+versions:
+  1.1.0:
+    metrics:
+      changes:
+        - rename_metrics:
+            requests: http.server.requests
+        - rename_attributes:
+            attribute_map:
+              http.method: http.request.method
+        - split:
+            apply_to_metric: paging
+            by_attribute: direction
+            metrics_from_attributes:
+              paging.in: in
+              paging.out: out
+  1.0.0:
+`
+
+func TestMetricExporter(t *testing.T) {
+	s, err := schema.Parse(strings.NewReader(metricTestSchema))
+	require.NoError(t, err)
+	tf, err := transform.New(s)
+	require.NoError(t, err)
+
+	defer func(orig otel.ErrorHandler) {
+		otel.SetErrorHandler(orig)
+	}(otel.GetErrorHandler())
+	var errs []error
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { errs = append(errs, err) }))
+
+	var got *metricdata.ResourceMetrics
+	exp := NewMetricExporter(tf, &fnExporter{
+		exportFunc: func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+			got = rm
+			return nil
+		},
+	})
+	assert.Equal(t, metricdata.CumulativeTemporality, exp.Temporality(sdkmetric.InstrumentKindCounter))
+
+	oldScope := instrumentation.Scope{Name: "old", SchemaURL: "https://example.com/schemas/1.0.0"}
+	otherScope := instrumentation.Scope{Name: "other", SchemaURL: "https://example.org/schemas/1.0.0"}
+	get := attribute.String("http.method", "GET")
+	requests := metricdata.Metrics{
+		Name: "requests",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints:  []metricdata.DataPoint[int64]{{Attributes: attribute.NewSet(get), Value: 1}},
+		},
+	}
+	rm := &metricdata.ResourceMetrics{
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			{
+				Scope: oldScope,
+				Metrics: []metricdata.Metrics{
+					requests,
+					{
+						Name: "paging",
+						Data: metricdata.Gauge[float64]{
+							DataPoints: []metricdata.DataPoint[float64]{
+								{Attributes: attribute.NewSet(attribute.String("direction", "in")), Value: 1},
+								{Attributes: attribute.NewSet(attribute.String("direction", "out")), Value: 2},
+								{Attributes: attribute.NewSet(attribute.String("direction", "in"), get), Value: 3},
+							},
+						},
+					},
+				},
+			},
+			{Scope: otherScope, Metrics: []metricdata.Metrics{requests}},
+		},
+	}
+
+	require.NoError(t, exp.Export(context.Background(), rm))
+
+	want := metricdata.ResourceMetrics{
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			{
+				Scope: instrumentation.Scope{Name: "old", SchemaURL: "https://example.com/schemas/1.1.0"},
+				Metrics: []metricdata.Metrics{
+					{
+						Name: "http.server.requests",
+						Data: metricdata.Sum[int64]{
+							Temporality: metricdata.CumulativeTemporality,
+							IsMonotonic: true,
+							DataPoints: []metricdata.DataPoint[int64]{{
+								Attributes: attribute.NewSet(attribute.String("http.request.method", "GET")),
+								Value:      1,
+							}},
+						},
+					},
+					{
+						Name: "paging.in",
+						Data: metricdata.Gauge[float64]{
+							DataPoints: []metricdata.DataPoint[float64]{
+								{Attributes: attribute.NewSet(), Value: 1},
+								{Attributes: attribute.NewSet(attribute.String("http.request.method", "GET")), Value: 3},
+							},
+						},
+					},
+					{
+						Name: "paging.out",
+						Data: metricdata.Gauge[float64]{
+							DataPoints: []metricdata.DataPoint[float64]{{Attributes: attribute.NewSet(), Value: 2}},
+						},
+					},
+				},
+			},
+			{Scope: otherScope, Metrics: []metricdata.Metrics{requests}},
+		},
+	}
+	metricdatatest.AssertEqual(t, want, *got)
+	require.Len(t, errs, 1, "unsupported schema URL reported")
+	assert.ErrorIs(t, errs[0], transform.ErrUnsupportedTranslation)
+
+	// The exported ResourceMetrics are not modified.
+	assert.Equal(t, "requests", rm.ScopeMetrics[0].Metrics[0].Name)
+	assert.Equal(t, oldScope, rm.ScopeMetrics[0].Scope)
+	assert.Len(t, rm.ScopeMetrics[0].Metrics, 2)
+
+	// ResourceMetrics without anything to upgrade are exported as is.
+	current := &metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{Scope: otherScope}}}
+	require.NoError(t, exp.Export(context.Background(), current))
+	assert.Same(t, current, got)
+	assert.Len(t, errs, 1, "unsupported schema URL reported once")
+}
+
+// fnExporter is an Exporter exporting with exportFunc.
+type fnExporter struct {
+	exportFunc func(context.Context, *metricdata.ResourceMetrics) error
+}
+
+var _ sdkmetric.Exporter = (*fnExporter)(nil)
+
+func (*fnExporter) Temporality(k sdkmetric.InstrumentKind) metricdata.Temporality {
+	return sdkmetric.DefaultTemporalitySelector(k)
+}
+
+func (*fnExporter) Aggregation(k sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+	return sdkmetric.DefaultAggregationSelector(k)
+}
+
+func (e *fnExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	return e.exportFunc(ctx, rm)
+}
+
+func (*fnExporter) ForceFlush(context.Context) error { return nil }
+
+func (*fnExporter) Shutdown(context.Context) error { return nil }
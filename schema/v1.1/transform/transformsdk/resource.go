@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformsdk // import "go.opentelemetry.io/otel/schema/v1.1/transform/transformsdk"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/schema/v1.1/transform"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// MergeResources creates a new Resource by merging a and b with
+// [resource.MergeWithSchemaUpgrade]: when a and b have different, non-empty,
+// schema URLs the attributes of the Resource with the older schema URL are
+// first upgraded to the newer one using the resource translations of t.
+//
+// The schema URLs must only differ by the trailing schema version (e.g.
+// https://opentelemetry.io/schemas/1.21.0 and
+// https://opentelemetry.io/schemas/1.26.0), and the schema of t must be the
+// one of the newer schema URL or a later version.
+//
+// If the Resource cannot be upgraded, the result of [resource.Merge] is
+// returned along with an error containing both
+// [resource.ErrSchemaURLConflict] and the reason the upgrade failed.
+func MergeResources(t *transform.Transformer, a, b *resource.Resource) (*resource.Resource, error) {
+	if a == nil || b == nil || a.SchemaURL() == "" || b.SchemaURL() == "" || a.SchemaURL() == b.SchemaURL() {
+		return resource.Merge(a, b)
+	}
+
+	older := a
+	tr, err := t.Translation(a.SchemaURL(), b.SchemaURL())
+	if err != nil {
+		var rErr error
+		if tr, rErr = t.Translation(b.SchemaURL(), a.SchemaURL()); rErr != nil {
+			res, mErr := resource.Merge(a, b)
+			return res, errors.Join(mErr, err)
+		}
+		older = b
+	}
+	return resource.MergeWithSchemaUpgrade(a, b, older.SchemaURL(), resourceRenames(tr, older))
+}
+
+// resourceRenames returns the renames tr applies to the attribute keys of r
+// and of its entities.
+func resourceRenames(tr *transform.Translation, r *resource.Resource) map[attribute.Key]attribute.Key {
+	renames := make(map[attribute.Key]attribute.Key)
+	add := func(attrs []attribute.KeyValue) {
+		for _, kv := range attrs {
+			if n := tr.ResourceKey(kv.Key); n != kv.Key {
+				renames[kv.Key] = n
+			}
+		}
+	}
+	add(r.Attributes())
+	for _, e := range r.Entities() {
+		add(e.ID)
+		add(e.Description)
+	}
+	return renames
+}
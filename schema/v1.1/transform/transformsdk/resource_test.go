@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformsdk
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	schema "go.opentelemetry.io/otel/schema/v1.1"
+	"go.opentelemetry.io/otel/schema/v1.1/transform"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+const resourceTestSchema = `
+file_format: 1.1.0
+schema_url: https://example.com/schemas/1.3.0
+versions:
+  1.3.0:
+    resources:
+      changes:
+        - rename_attributes:
+            attribute_map:
+              deployment.environment: deployment.environment.name
+  1.2.0:
+    all:
+      changes:
+        - rename_attributes:
+            attribute_map:
+              old.name: mid.name
+  1.1.0:
+    all:
+      changes:
+        - rename_attributes:
+            attribute_map:
+              oldest.name: old.name
+  1.0.0:
+`
+
+func newResourceTestTransformer(t *testing.T) *transform.Transformer {
+	t.Helper()
+	s, err := schema.Parse(strings.NewReader(resourceTestSchema))
+	require.NoError(t, err)
+	tf, err := transform.New(s)
+	require.NoError(t, err)
+	return tf
+}
+
+func TestMergeResources(t *testing.T) {
+	tf := newResourceTestTransformer(t)
+
+	older := resource.NewWithAttributes("https://example.com/schemas/1.1.0",
+		attribute.String("old.name", "a"),
+		attribute.String("deployment.environment", "prod"),
+		attribute.String("shared", "older"),
+	)
+	newer := resource.NewWithAttributes("https://example.com/schemas/1.3.0",
+		attribute.String("shared", "newer"),
+	)
+	want := resource.NewWithAttributes("https://example.com/schemas/1.3.0",
+		attribute.String("mid.name", "a"),
+		attribute.String("deployment.environment.name", "prod"),
+		attribute.String("shared", "newer"),
+	)
+
+	res, err := MergeResources(tf, older, newer)
+	require.NoError(t, err)
+	assert.Equal(t, want, res)
+
+	// The newer Resource is upgraded regardless of merge order, and the
+	// precedence of b is maintained.
+	res, err = MergeResources(tf, newer, older)
+	require.NoError(t, err)
+	assert.Equal(t, resource.NewWithAttributes("https://example.com/schemas/1.3.0",
+		attribute.String("mid.name", "a"),
+		attribute.String("deployment.environment.name", "prod"),
+		attribute.String("shared", "older"),
+	), res)
+}
+
+func TestMergeResourcesChainedRenames(t *testing.T) {
+	older := resource.NewWithAttributes("https://example.com/schemas/1.0.0",
+		attribute.String("oldest.name", "a"),
+	)
+	newer := resource.NewWithAttributes("https://example.com/schemas/1.2.0")
+
+	res, err := MergeResources(newResourceTestTransformer(t), older, newer)
+	require.NoError(t, err)
+	assert.Equal(t, resource.NewWithAttributes("https://example.com/schemas/1.2.0",
+		attribute.String("mid.name", "a"),
+	), res)
+}
+
+func TestMergeResourcesEntities(t *testing.T) {
+	older := resource.NewWithEntities("https://example.com/schemas/1.1.0", resource.Entity{
+		Type:        "deployment",
+		SchemaURL:   "https://example.com/schemas/1.1.0",
+		ID:          []attribute.KeyValue{attribute.String("deployment.environment", "prod")},
+		Description: []attribute.KeyValue{attribute.String("old.name", "a")},
+	})
+	newer := resource.NewWithAttributes("https://example.com/schemas/1.3.0")
+
+	res, err := MergeResources(newResourceTestTransformer(t), older, newer)
+	require.NoError(t, err)
+	assert.Equal(t, []resource.Entity{{
+		Type:        "deployment",
+		SchemaURL:   "https://example.com/schemas/1.3.0",
+		ID:          []attribute.KeyValue{attribute.String("deployment.environment.name", "prod")},
+		Description: []attribute.KeyValue{attribute.String("mid.name", "a")},
+	}}, res.Entities())
+}
+
+func TestMergeResourcesSameOrEmptySchema(t *testing.T) {
+	a := resource.NewWithAttributes("https://example.com/schemas/1.1.0", attribute.String("old.name", "a"))
+	b := resource.NewSchemaless(attribute.String("b", "1"))
+
+	res, err := MergeResources(newResourceTestTransformer(t), a, b)
+	require.NoError(t, err)
+	want, _ := resource.Merge(a, b)
+	assert.Equal(t, want, res)
+
+	res, err = MergeResources(newResourceTestTransformer(t), a, a)
+	require.NoError(t, err)
+	assert.Equal(t, a, res)
+}
+
+func TestMergeResourcesErrors(t *testing.T) {
+	tf := newResourceTestTransformer(t)
+	a := resource.NewWithAttributes("https://example.com/schemas/1.1.0", attribute.String("old.name", "a"))
+
+	testCases := []struct {
+		name string
+		b    *resource.Resource
+		err  error
+	}{
+		{
+			name: "schema does not cover version",
+			b:    resource.NewWithAttributes("https://example.com/schemas/1.4.0"),
+			err:  transform.ErrUnsupportedTranslation,
+		},
+		{
+			name: "different schema family",
+			b:    resource.NewWithAttributes("https://example.org/schemas/1.3.0"),
+			err:  transform.ErrUnsupportedTranslation,
+		},
+		{
+			name: "invalid version",
+			b:    resource.NewWithAttributes("https://example.com/schemas/latest"),
+			err:  transform.ErrInvalidSchemaURL,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := MergeResources(tf, a, tc.b)
+			assert.ErrorIs(t, err, resource.ErrSchemaURLConflict)
+			assert.ErrorIs(t, err, tc.err)
+
+			want, _ := resource.Merge(a, tc.b)
+			assert.Equal(t, want, res)
+		})
+	}
+}
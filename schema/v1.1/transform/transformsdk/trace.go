@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformsdk // import "go.opentelemetry.io/otel/schema/v1.1/transform/transformsdk"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/schema/v1.1/transform"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// spanProcessor is a SpanProcessor that upgrades ended spans to a schema
+// before passing them to the next SpanProcessor.
+type spanProcessor struct {
+	upgrader
+	next sdktrace.SpanProcessor
+}
+
+var _ sdktrace.SpanProcessor = (*spanProcessor)(nil)
+
+// NewSpanProcessor returns a new SpanProcessor that upgrades ended spans from
+// the schema URL of their instrumentation scope to the schema of t, and
+// passes them to next. The attributes of the spans and the names and
+// attributes of their events are translated, and the schema URL of their
+// instrumentation scope is set to the one of t. The Resource of the spans is
+// not modified: use [MergeResources] to upgrade it.
+//
+// Spans without a schema URL, or with one that cannot be upgraded to the
+// schema of t, are passed unchanged. Each schema URL that cannot be upgraded
+// is reported once to the global ErrorHandler.
+//
+// Spans started are passed to next unchanged.
+func NewSpanProcessor(t *transform.Transformer, next sdktrace.SpanProcessor) sdktrace.SpanProcessor {
+	return &spanProcessor{upgrader: upgrader{t: t}, next: next}
+}
+
+// OnStart passes s to the next SpanProcessor.
+func (p *spanProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	p.next.OnStart(parent, s)
+}
+
+// OnEnd passes s upgraded to the schema of the Transformer to the next
+// SpanProcessor.
+func (p *spanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	p.next.OnEnd(p.upgrade(s))
+}
+
+// Shutdown shuts down the next SpanProcessor.
+func (p *spanProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the next SpanProcessor.
+func (p *spanProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
+
+// upgrade returns s upgraded to the schema of the Transformer, or s if it
+// does not need to be upgraded.
+func (p *spanProcessor) upgrade(s sdktrace.ReadOnlySpan) sdktrace.ReadOnlySpan {
+	scope := s.InstrumentationScope()
+	tr := p.translation(scope.SchemaURL)
+	if tr == nil {
+		return s
+	}
+
+	name := s.Name()
+	events := s.Events()
+	upgraded := make([]sdktrace.Event, len(events))
+	for i, e := range events {
+		e.Name, e.Attributes = tr.SpanEvent(name, e.Name, e.Attributes)
+		upgraded[i] = e
+	}
+	scope.SchemaURL = p.t.SchemaURL()
+
+	return upgradedSpan{
+		ReadOnlySpan: s,
+		attributes:   tr.Span(name, s.Attributes()),
+		events:       upgraded,
+		scope:        scope,
+	}
+}
+
+// upgradedSpan is a ReadOnlySpan with its attributes, events, and
+// instrumentation scope replaced by their upgraded values.
+type upgradedSpan struct {
+	sdktrace.ReadOnlySpan
+
+	attributes []attribute.KeyValue
+	events     []sdktrace.Event
+	scope      instrumentation.Scope
+}
+
+// Attributes returns the upgraded attributes of the span.
+func (s upgradedSpan) Attributes() []attribute.KeyValue { return s.attributes }
+
+// Events returns the upgraded events of the span.
+func (s upgradedSpan) Events() []sdktrace.Event { return s.events }
+
+// InstrumentationScope returns the instrumentation scope of the span, with
+// the schema URL it is upgraded to.
+func (s upgradedSpan) InstrumentationScope() instrumentation.Scope { return s.scope }
+
+// InstrumentationLibrary returns the instrumentation scope of the span, with
+// the schema URL it is upgraded to.
+//
+//nolint:staticcheck // This method needs to be define for backwards compatibility
+func (s upgradedSpan) InstrumentationLibrary() instrumentation.Library { return s.scope }
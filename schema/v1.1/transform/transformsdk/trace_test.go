@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformsdk
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	schema "go.opentelemetry.io/otel/schema/v1.1"
+	"go.opentelemetry.io/otel/schema/v1.1/transform"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const spanTestSchema = `
+file_format: 1.1.0
+schema_url: https://example.com/schemas/1.1.0
+versions:
+  1.1.0:
+    spans:
+      changes:
+        - rename_attributes:
+            attribute_map:
+              http.method: http.request.method
+    span_events:
+      changes:
+        - rename_events:
+            name_map:
+              exception.stacktrace: exception.stack_trace
+        - rename_attributes:
+            attribute_map:
+              message.id: message.uid
+  1.0.0:
+`
+
+func newTestTransformer(t *testing.T) *transform.Transformer {
+	t.Helper()
+	s, err := schema.Parse(strings.NewReader(spanTestSchema))
+	require.NoError(t, err)
+	tf, err := transform.New(s)
+	require.NoError(t, err)
+	return tf
+}
+
+func TestSpanProcessor(t *testing.T) {
+	defer func(orig otel.ErrorHandler) {
+		otel.SetErrorHandler(orig)
+	}(otel.GetErrorHandler())
+	var errs []error
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { errs = append(errs, err) }))
+
+	rec := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(NewSpanProcessor(newTestTransformer(t), rec)))
+
+	tests := []struct {
+		name       string
+		schemaURL  string
+		wantSchema string
+		wantAttr   attribute.Key
+		wantEvent  string
+		wantEvAttr attribute.Key
+	}{
+		{
+			name:       "upgraded",
+			schemaURL:  "https://example.com/schemas/1.0.0",
+			wantSchema: "https://example.com/schemas/1.1.0",
+			wantAttr:   "http.request.method",
+			wantEvent:  "exception.stack_trace",
+			wantEvAttr: "message.uid",
+		},
+		{
+			name:       "no schema URL",
+			wantAttr:   "http.method",
+			wantEvent:  "exception.stacktrace",
+			wantEvAttr: "message.id",
+		},
+		{
+			name:       "current schema URL",
+			schemaURL:  "https://example.com/schemas/1.1.0",
+			wantSchema: "https://example.com/schemas/1.1.0",
+			wantAttr:   "http.method",
+			wantEvent:  "exception.stacktrace",
+			wantEvAttr: "message.id",
+		},
+		{
+			name:       "unsupported schema URL",
+			schemaURL:  "https://example.org/schemas/1.0.0",
+			wantSchema: "https://example.org/schemas/1.0.0",
+			wantAttr:   "http.method",
+			wantEvent:  "exception.stacktrace",
+			wantEvAttr: "message.id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec.Reset()
+			tracer := tp.Tracer(t.Name(), trace.WithSchemaURL(tt.schemaURL))
+			_, span := tracer.Start(context.Background(), "span", trace.WithAttributes(attribute.String("http.method", "GET")))
+			span.AddEvent("exception.stacktrace", trace.WithAttributes(attribute.String("message.id", "1")))
+			span.End()
+
+			ended := rec.Ended()
+			require.Len(t, ended, 1)
+			got := ended[0]
+			assert.Equal(t, "span", got.Name())
+			assert.Equal(t, span.SpanContext(), got.SpanContext())
+			assert.Equal(t, tt.wantSchema, got.InstrumentationScope().SchemaURL)
+			assert.Equal(t, tt.wantSchema, got.InstrumentationLibrary().SchemaURL) //nolint:staticcheck // Deprecated method tested.
+			assert.Equal(t, []attribute.KeyValue{attribute.String(string(tt.wantAttr), "GET")}, got.Attributes())
+			require.Len(t, got.Events(), 1)
+			assert.Equal(t, tt.wantEvent, got.Events()[0].Name)
+			assert.Equal(t, []attribute.KeyValue{attribute.String(string(tt.wantEvAttr), "1")}, got.Events()[0].Attributes)
+		})
+	}
+
+	require.Len(t, errs, 1, "unsupported schema URL reported")
+	assert.ErrorIs(t, errs[0], transform.ErrUnsupportedTranslation)
+
+	assert.NoError(t, tp.ForceFlush(context.Background()))
+	assert.NoError(t, tp.Shutdown(context.Background()))
+}
@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package transformsdk upgrades the telemetry of the OpenTelemetry SDKs to
+// the schema of a [transform.Transformer].
+//
+// A SpanProcessor, created with [NewSpanProcessor], upgrades the spans of the
+// trace SDK and a metric Exporter, created with [NewMetricExporter], upgrades
+// the metrics of the metric SDK. [MergeResources] upgrades the Resource they
+// are associated with.
+package transformsdk // import "go.opentelemetry.io/otel/schema/v1.1/transform/transformsdk"
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/schema/v1.1/transform"
+)
+
+// component is the name of the component reported in the Diagnostics sent to
+// the global ErrorHandler.
+const component = "go.opentelemetry.io/otel/schema/v1.1/transform/transformsdk"
+
+// upgrader provides the Translations from the schema URLs of instrumentation
+// scopes to the schema of a Transformer.
+type upgrader struct {
+	t *transform.Transformer
+
+	// failed holds the schema URLs that cannot be upgraded, so each is
+	// reported once.
+	failed sync.Map
+}
+
+// translation returns the Translation from schemaURL to the schema of the
+// Transformer, or nil if the telemetry of schemaURL is not upgraded. The
+// first time schemaURL cannot be upgraded, the reason is reported to the
+// global ErrorHandler.
+func (u *upgrader) translation(schemaURL string) *transform.Translation {
+	to := u.t.SchemaURL()
+	if schemaURL == "" || schemaURL == to {
+		return nil
+	}
+	if _, failed := u.failed.Load(schemaURL); failed {
+		return nil
+	}
+	tr, err := u.t.Translation(schemaURL, to)
+	if err != nil {
+		if _, loaded := u.failed.LoadOrStore(schemaURL, struct{}{}); !loaded {
+			otel.HandleDiagnostic(context.Background(), otel.Diagnostic{
+				Component:  component,
+				Severity:   otel.SeverityWarning,
+				Err:        err,
+				Attributes: []attribute.KeyValue{attribute.String("schema.url", schemaURL)},
+			})
+		}
+		return nil
+	}
+	return tr
+}
@@ -0,0 +1,271 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transform // import "go.opentelemetry.io/otel/schema/v1.1/transform"
+
+import (
+	"fmt"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	ast10 "go.opentelemetry.io/otel/schema/v1.0/ast"
+	types10 "go.opentelemetry.io/otel/schema/v1.0/types"
+	"go.opentelemetry.io/otel/schema/v1.1/ast"
+)
+
+// Translation converts telemetry between two versions of a schema.
+//
+// The translations of each version are applied in semver order. For each
+// version, the changes of the "all" section are applied first, followed by
+// the ones of the section of the telemetry signal, in the order they are
+// listed.
+//
+// The attributes passed to the methods of a Translation are never modified: a
+// copy is returned if any of them is renamed.
+//
+// Use the [Transformer.Translation] method to create a Translation.
+type Translation struct {
+	resource []ast10.AttributeMap
+	logs     []ast10.AttributeMap
+	spans    []spanChange
+	events   []eventChange
+	metrics  []metricChange
+}
+
+type spanChange struct {
+	spans nameSet
+	attrs ast10.AttributeMap
+}
+
+type eventChange struct {
+	names  map[string]string
+	spans  nameSet
+	events nameSet
+	attrs  ast10.AttributeMap
+}
+
+type metricChange struct {
+	metrics nameSet
+	attrs   ast10.AttributeMap
+	names   map[types10.MetricName]types10.MetricName
+	split   *ast.SplitMetric
+}
+
+// nameSet is a set of span, event, or metric names a change applies to. A nil
+// nameSet applies to all names.
+type nameSet map[string]struct{}
+
+func newNameSet[T ~string](names []T) nameSet {
+	if len(names) == 0 {
+		return nil
+	}
+	s := make(nameSet, len(names))
+	for _, n := range names {
+		s[string(n)] = struct{}{}
+	}
+	return s
+}
+
+func (s nameSet) has(name string) bool {
+	if s == nil {
+		return true
+	}
+	_, ok := s[name]
+	return ok
+}
+
+// add appends the changes of def to tr.
+func (tr *Translation) add(def ast.VersionDef) {
+	all := renames(def.All)
+
+	tr.resource = append(tr.resource, all...)
+	tr.resource = append(tr.resource, renames(def.Resources)...)
+
+	tr.logs = append(tr.logs, all...)
+	for _, c := range def.Logs.Changes {
+		if c.RenameAttributes != nil && len(c.RenameAttributes.AttributeMap) > 0 {
+			tr.logs = append(tr.logs, c.RenameAttributes.AttributeMap)
+		}
+	}
+
+	for _, m := range all {
+		tr.spans = append(tr.spans, spanChange{attrs: m})
+		tr.events = append(tr.events, eventChange{attrs: m})
+		tr.metrics = append(tr.metrics, metricChange{attrs: m})
+	}
+
+	for _, c := range def.Spans.Changes {
+		if c.RenameAttributes == nil {
+			continue
+		}
+		tr.spans = append(tr.spans, spanChange{
+			spans: newNameSet(c.RenameAttributes.ApplyToSpans),
+			attrs: c.RenameAttributes.AttributeMap,
+		})
+	}
+
+	for _, c := range def.SpanEvents.Changes {
+		var ec eventChange
+		if c.RenameEvents != nil {
+			ec.names = c.RenameEvents.EventNameMap
+		}
+		if c.RenameAttributes != nil {
+			ec.spans = newNameSet(c.RenameAttributes.ApplyToSpans)
+			ec.events = newNameSet(c.RenameAttributes.ApplyToEvents)
+			ec.attrs = c.RenameAttributes.AttributeMap
+		}
+		tr.events = append(tr.events, ec)
+	}
+
+	for _, c := range def.Metrics.Changes {
+		mc := metricChange{names: c.RenameMetrics, split: c.Split}
+		if c.RenameAttributes != nil {
+			mc.metrics = newNameSet(c.RenameAttributes.ApplyToMetrics)
+			mc.attrs = c.RenameAttributes.AttributeMap
+		}
+		tr.metrics = append(tr.metrics, mc)
+	}
+}
+
+// IsIdentity reports whether tr has no changes to apply, as is the case when
+// converting telemetry to its own version.
+func (tr *Translation) IsIdentity() bool {
+	return len(tr.resource) == 0 && len(tr.logs) == 0 && len(tr.spans) == 0 &&
+		len(tr.events) == 0 && len(tr.metrics) == 0
+}
+
+// ResourceKey returns the key of a resource attribute after the translation.
+func (tr *Translation) ResourceKey(key attribute.Key) attribute.Key {
+	for _, m := range tr.resource {
+		if n, ok := m[string(key)]; ok {
+			key = attribute.Key(n)
+		}
+	}
+	return key
+}
+
+// Resource returns the attributes of a resource after the translation.
+func (tr *Translation) Resource(attrs []attribute.KeyValue) []attribute.KeyValue {
+	var copied bool
+	for _, m := range tr.resource {
+		attrs, copied = rename(attrs, m, copied)
+	}
+	return attrs
+}
+
+// Log returns the attributes of a log record after the translation.
+func (tr *Translation) Log(attrs []attribute.KeyValue) []attribute.KeyValue {
+	var copied bool
+	for _, m := range tr.logs {
+		attrs, copied = rename(attrs, m, copied)
+	}
+	return attrs
+}
+
+// Span returns the attributes of the span named name after the translation.
+func (tr *Translation) Span(name string, attrs []attribute.KeyValue) []attribute.KeyValue {
+	var copied bool
+	for _, c := range tr.spans {
+		if c.spans.has(name) {
+			attrs, copied = rename(attrs, c.attrs, copied)
+		}
+	}
+	return attrs
+}
+
+// SpanEvent returns the name and attributes of the event named name of the
+// span named spanName after the translation.
+func (tr *Translation) SpanEvent(spanName, name string, attrs []attribute.KeyValue) (string, []attribute.KeyValue) {
+	var copied bool
+	for _, c := range tr.events {
+		if n, ok := c.names[name]; ok {
+			name = n
+		}
+		if len(c.attrs) > 0 && c.spans.has(spanName) && c.events.has(name) {
+			attrs, copied = rename(attrs, c.attrs, copied)
+		}
+	}
+	return name, attrs
+}
+
+// Metric returns the name and attributes of a data point of the metric named
+// name after the translation.
+//
+// A metric split can result in different names for the data points of a
+// metric, based on the value of their split attribute, and that attribute is
+// removed.
+func (tr *Translation) Metric(name string, attrs []attribute.KeyValue) (string, []attribute.KeyValue) {
+	var copied bool
+	for _, c := range tr.metrics {
+		if len(c.attrs) > 0 && c.metrics.has(name) {
+			attrs, copied = rename(attrs, c.attrs, copied)
+		}
+		if n, ok := c.names[types10.MetricName(name)]; ok {
+			name = string(n)
+		}
+		if c.split != nil && string(c.split.ApplyToMetric) == name {
+			name, attrs, copied = split(c.split, name, attrs, copied)
+		}
+	}
+	return name, attrs
+}
+
+// split returns the name and attributes of a data point of a metric split by
+// s. If attrs does not contain the split attribute, or its value is not one
+// of the values split on, name and attrs are returned unchanged.
+func split(s *ast.SplitMetric, name string, attrs []attribute.KeyValue, copied bool) (string, []attribute.KeyValue, bool) {
+	i := slices.IndexFunc(attrs, func(kv attribute.KeyValue) bool {
+		return string(kv.Key) == string(s.ByAttribute)
+	})
+	if i < 0 {
+		return name, attrs, copied
+	}
+	v := attrs[i].Value.Emit()
+	for n, sv := range s.MetricsFromAttributes {
+		if fmt.Sprint(sv) != v {
+			continue
+		}
+		if !copied {
+			attrs = slices.Clone(attrs)
+		}
+		return string(n), slices.Delete(attrs, i, i+1), true
+	}
+	return name, attrs, copied
+}
+
+// rename returns attrs with their keys renamed by m. If copied is false,
+// attrs is copied before its first change. The returned bool reports whether
+// the returned attributes are a copy.
+//
+// An attribute is removed instead of renamed if attrs already has an
+// attribute with the new key that is not itself renamed by m: the value set
+// with the new key is kept, as it is the one of the later version.
+func rename(attrs []attribute.KeyValue, m ast10.AttributeMap, copied bool) ([]attribute.KeyValue, bool) {
+	for i := 0; i < len(attrs); i++ {
+		n, ok := m[string(attrs[i].Key)]
+		if !ok {
+			continue
+		}
+		if !copied {
+			attrs, copied = slices.Clone(attrs), true
+		}
+		if has(attrs, n, m) {
+			attrs = slices.Delete(attrs, i, i+1)
+			i--
+			continue
+		}
+		attrs[i].Key = attribute.Key(n)
+	}
+	return attrs, copied
+}
+
+// has reports whether attrs has an attribute with key that is not renamed by
+// m.
+func has(attrs []attribute.KeyValue, key string, m ast10.AttributeMap) bool {
+	if _, renamed := m[key]; renamed {
+		return false
+	}
+	return slices.ContainsFunc(attrs, func(kv attribute.KeyValue) bool {
+		return string(kv.Key) == key
+	})
+}
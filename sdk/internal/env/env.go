@@ -8,6 +8,7 @@ package env // import "go.opentelemetry.io/otel/sdk/internal/env"
 import (
 	"os"
 	"strconv"
+	"strings"
 
 	"go.opentelemetry.io/otel/internal/global"
 )
@@ -54,6 +55,9 @@ const (
 	// SpanLinkAttributeCountKey is the maximum allowed attribute per span
 	// link count.
 	SpanLinkAttributeCountKey = "OTEL_LINK_ATTRIBUTE_COUNT_LIMIT"
+	// SDKDisabledKey is the environment variable disabling the SDK when set
+	// to true (i.e. "true").
+	SDKDisabledKey = "OTEL_SDK_DISABLED"
 )
 
 // firstInt returns the value of the first matching environment variable from
@@ -95,6 +99,12 @@ func IntEnvOr(key string, defaultValue int) int {
 	return intValue
 }
 
+// SDKDisabled returns true if the OTEL_SDK_DISABLED environment variable
+// value is true, regardless of its case.
+func SDKDisabled() bool {
+	return strings.EqualFold(strings.TrimSpace(os.Getenv(SDKDisabledKey)), "true")
+}
+
 // BatchSpanProcessorScheduleDelay returns the environment variable value for
 // the OTEL_BSP_SCHEDULE_DELAY key if it exists, otherwise defaultValue is
 // returned.
@@ -110,3 +110,16 @@ func TestEnvParse(t *testing.T) {
 		})
 	}
 }
+
+func TestSDKDisabled(t *testing.T) {
+	for val, want := range map[string]bool{
+		"":        false,
+		"false":   false,
+		"invalid": false,
+		"true":    true,
+		" TRUE ":  true,
+	} {
+		t.Setenv(SDKDisabledKey, val)
+		assert.Equal(t, want, SDKDisabled(), "OTEL_SDK_DISABLED=%q", val)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package exportstatus records the results of the exports of the processors
+// and readers of the SDK providers for their status.
+package exportstatus // import "go.opentelemetry.io/otel/sdk/internal/exportstatus"
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/sdkstatus"
+)
+
+// Recorder records the results of the exports of a component. The zero
+// value is ready to use.
+type Recorder struct {
+	mu     sync.Mutex
+	export sdkstatus.Export
+}
+
+// Record records the result of an export.
+func (r *Recorder) Record(err error) {
+	now := time.Now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.export.Count++
+	r.export.LastTime = now
+	if err != nil {
+		r.export.Failed++
+		r.export.LastError = err.Error()
+		r.export.LastErrorTime = now
+	}
+}
+
+// Status returns the status of the exports to exporter.
+func (r *Recorder) Status(exporter any) *sdkstatus.Export {
+	r.mu.Lock()
+	e := r.export
+	r.mu.Unlock()
+	e.Exporter = fmt.Sprintf("%T", exporter)
+	return &e
+}
@@ -0,0 +1,30 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exportstatus
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type exporter struct{}
+
+func TestRecorder(t *testing.T) {
+	var r Recorder
+	assert.Equal(t, "*exportstatus.exporter", r.Status(&exporter{}).Exporter)
+	assert.Zero(t, r.Status(nil).Count)
+
+	r.Record(nil)
+	r.Record(errors.New("failed"))
+	r.Record(nil)
+
+	got := r.Status(nil)
+	assert.Equal(t, uint64(3), got.Count)
+	assert.Equal(t, uint64(1), got.Failed)
+	assert.Equal(t, "failed", got.LastError)
+	assert.False(t, got.LastErrorTime.IsZero())
+	assert.False(t, got.LastErrorTime.After(got.LastTime))
+}
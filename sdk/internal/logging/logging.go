@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package logging provides the logger of the internal diagnostics of the
+// OpenTelemetry SDK providers and of the processors and readers registered
+// with them.
+package logging // import "go.opentelemetry.io/otel/sdk/internal/logging"
+
+import (
+	"sync/atomic"
+
+	"github.com/go-logr/logr"
+
+	"go.opentelemetry.io/otel/internal/global"
+)
+
+// Level is the verbosity of a message, as passed to the V method of a
+// logr.Logger.
+type Level int
+
+// Levels of the messages, the same as the ones of the global logger of
+// go.opentelemetry.io/otel.
+const (
+	LevelError Level = 0
+	LevelWarn  Level = 1
+	LevelInfo  Level = 4
+	LevelDebug Level = 8
+)
+
+// Logger logs the internal diagnostics of the SDK. The zero value logs every
+// message with the global logger.
+type Logger struct {
+	logger *logr.Logger
+	// maxLevel is the most verbose level of the messages logged, if
+	// limited.
+	maxLevel *Level
+}
+
+// New returns a Logger logging with l.
+func New(l logr.Logger) Logger {
+	return Logger{logger: &l}
+}
+
+// WithLogger returns a copy of l logging with logger.
+func (l Logger) WithLogger(logger logr.Logger) Logger {
+	l.logger = &logger
+	return l
+}
+
+// WithLevel returns a copy of l that drops the messages more verbose than
+// level. The verbosity of the messages that are logged is still checked by
+// the underlying logr.Logger.
+func (l Logger) WithLevel(level Level) Logger {
+	l.maxLevel = &level
+	return l
+}
+
+func (l Logger) get() logr.Logger {
+	if l.logger == nil {
+		return global.GetLogger()
+	}
+	return *l.logger
+}
+
+func (l Logger) log(level Level, msg string, keysAndValues []interface{}) {
+	if l.maxLevel != nil && level > *l.maxLevel {
+		return
+	}
+	l.get().V(int(level)).Info(msg, keysAndValues...)
+}
+
+// Info logs messages about the general state of the SDK.
+func (l Logger) Info(msg string, keysAndValues ...interface{}) {
+	l.log(LevelInfo, msg, keysAndValues)
+}
+
+// Debug logs messages about all internal changes in the SDK.
+func (l Logger) Debug(msg string, keysAndValues ...interface{}) {
+	l.log(LevelDebug, msg, keysAndValues)
+}
+
+// Warn logs messages about warnings in the SDK.
+func (l Logger) Warn(msg string, keysAndValues ...interface{}) {
+	l.log(LevelWarn, msg, keysAndValues)
+}
+
+// Error logs error messages in the SDK. Errors are always logged.
+func (l Logger) Error(err error, msg string, keysAndValues ...interface{}) {
+	l.get().Error(err, msg, keysAndValues...)
+}
+
+// Pointer holds the Logger of a component that is set when the component is
+// registered with a provider, concurrently with its use. The zero value holds
+// the zero Logger.
+type Pointer struct {
+	p atomic.Pointer[Logger]
+}
+
+// Store sets the held Logger to l.
+func (p *Pointer) Store(l Logger) {
+	p.p.Store(&l)
+}
+
+// Load returns the held Logger.
+func (p *Pointer) Load() Logger {
+	if l := p.p.Load(); l != nil {
+		return *l
+	}
+	return Logger{}
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/internal/global"
+)
+
+func newTestLogger(msgs *[]string) logr.Logger {
+	return funcr.New(func(_, args string) {
+		*msgs = append(*msgs, args)
+	}, funcr.Options{Verbosity: 8})
+}
+
+func logAll(l Logger) {
+	l.Error(errors.New("err"), "error")
+	l.Warn("warn")
+	l.Info("info")
+	l.Debug("debug")
+}
+
+func TestLogger(t *testing.T) {
+	var msgs []string
+	logAll(New(newTestLogger(&msgs)))
+	assert.Equal(t, []string{
+		`"msg"="error" "error"="err"`,
+		`"level"=1 "msg"="warn"`,
+		`"level"=4 "msg"="info"`,
+		`"level"=8 "msg"="debug"`,
+	}, msgs)
+}
+
+func TestLoggerWithLevel(t *testing.T) {
+	var msgs []string
+	logAll(New(newTestLogger(&msgs)).WithLevel(LevelWarn))
+	assert.Equal(t, []string{
+		`"msg"="error" "error"="err"`,
+		`"level"=1 "msg"="warn"`,
+	}, msgs)
+
+	// The level is kept when the logr.Logger is replaced.
+	msgs = nil
+	logAll(Logger{}.WithLevel(LevelError).WithLogger(newTestLogger(&msgs)))
+	assert.Equal(t, []string{`"msg"="error" "error"="err"`}, msgs)
+}
+
+func TestLoggerGlobal(t *testing.T) {
+	t.Cleanup(func(l logr.Logger) func() {
+		return func() { global.SetLogger(l) }
+	}(global.GetLogger()))
+
+	var msgs []string
+	global.SetLogger(newTestLogger(&msgs))
+
+	var p Pointer
+	p.Load().Warn("global")
+	p.Store(Logger{}.WithLevel(LevelError))
+	p.Load().Warn("dropped")
+	assert.Equal(t, []string{`"level"=1 "msg"="global"`}, msgs)
+}
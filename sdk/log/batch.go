@@ -11,7 +11,7 @@ import (
 	"sync/atomic"
 	"time"
 
-	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/internal/logging"
 )
 
 const (
@@ -73,6 +73,8 @@ type BatchProcessor struct {
 
 	// exporter is the bufferedExporter all batches are exported with.
 	exporter *bufferExporter
+	// exports records the results of the exports of the decorated exporter.
+	exports *statusExporter
 
 	// q is the active queue of records that have not yet been exported.
 	q *queue
@@ -98,19 +100,35 @@ type BatchProcessor struct {
 	// stopped holds the stopped state of the BatchProcessor.
 	stopped atomic.Bool
 
+	// log is the internal logger of the LoggerProvider the BatchProcessor is
+	// registered with.
+	log logging.Pointer
+
 	noCmp [0]func() //nolint: unused  // This is indeed used.
 }
 
+// setInternalLogger sets the logger of the internal diagnostics of b.
+func (b *BatchProcessor) setInternalLogger(l logging.Logger) {
+	b.log.Store(l)
+}
+
 // NewBatchProcessor decorates the provided exporter
 // so that the log records are batched before exporting.
 //
 // All of the exporter's methods are called synchronously.
+//
+// The settings not configured by options are read from the OTEL_BLRP_*
+// environment variables. Options always have precedence over environment
+// variables. Use WithoutBatchEnvironment to ignore these environment
+// variables.
 func NewBatchProcessor(exporter Exporter, opts ...BatchProcessorOption) *BatchProcessor {
 	cfg := newBatchConfig(opts)
 	if exporter == nil {
 		// Do not panic on nil export.
 		exporter = defaultNoopExporter
 	}
+	exports := &statusExporter{Exporter: exporter}
+	exporter = exports
 	// Order is important here. Wrap the timeoutExporter with the chunkExporter
 	// to ensure each export completes in timeout (instead of all chunked
 	// exports).
@@ -121,6 +139,7 @@ func NewBatchProcessor(exporter Exporter, opts ...BatchProcessorOption) *BatchPr
 
 	b := &BatchProcessor{
 		exporter: newBufferExporter(exporter, cfg.expBufferSize.Value),
+		exports:  exports,
 
 		q:           newQueue(cfg.maxQSize.Value),
 		batchSize:   cfg.expMaxBatchSize.Value,
@@ -153,7 +172,7 @@ func (b *BatchProcessor) poll(interval time.Duration) (done chan struct{}) {
 			}
 
 			if d := b.q.Dropped(); d > 0 {
-				global.Warn("dropped log records", "dropped", d)
+				b.log.Load().Warn("dropped log records", "dropped", d)
 			}
 
 			var qLen int
@@ -265,9 +284,10 @@ func (b *BatchProcessor) ForceFlush(ctx context.Context) error {
 type queue struct {
 	sync.Mutex
 
-	dropped     atomic.Uint64
-	cap, len    int
-	read, write *ring
+	dropped      atomic.Uint64
+	totalDropped atomic.Uint64
+	cap, len     int
+	read, write  *ring
 }
 
 func newQueue(size int) *queue {
@@ -310,6 +330,7 @@ func (q *queue) Enqueue(r Record) int {
 		q.len = q.cap
 		q.read = q.read.Next()
 		q.dropped.Add(1)
+		q.totalDropped.Add(1)
 	}
 	return q.len
 }
@@ -364,6 +385,7 @@ type batchConfig struct {
 	expTimeout      setting[time.Duration]
 	expMaxBatchSize setting[int]
 	expBufferSize   setting[int]
+	ignoreEnv       bool
 }
 
 func newBatchConfig(options []BatchProcessorOption) batchConfig {
@@ -372,27 +394,28 @@ func newBatchConfig(options []BatchProcessorOption) batchConfig {
 		c = o.apply(c)
 	}
 
+	useEnv := !c.ignoreEnv
 	c.maxQSize = c.maxQSize.Resolve(
 		clearLessThanOne[int](),
-		getenv[int](envarMaxQSize),
+		getenvIf[int](useEnv, envarMaxQSize),
 		clearLessThanOne[int](),
 		fallback[int](dfltMaxQSize),
 	)
 	c.expInterval = c.expInterval.Resolve(
 		clearLessThanOne[time.Duration](),
-		getenv[time.Duration](envarExpInterval),
+		getenvIf[time.Duration](useEnv, envarExpInterval),
 		clearLessThanOne[time.Duration](),
 		fallback[time.Duration](dfltExpInterval),
 	)
 	c.expTimeout = c.expTimeout.Resolve(
 		clearLessThanOne[time.Duration](),
-		getenv[time.Duration](envarExpTimeout),
+		getenvIf[time.Duration](useEnv, envarExpTimeout),
 		clearLessThanOne[time.Duration](),
 		fallback[time.Duration](dfltExpTimeout),
 	)
 	c.expMaxBatchSize = c.expMaxBatchSize.Resolve(
 		clearLessThanOne[int](),
-		getenv[int](envarExpMaxBatchSize),
+		getenvIf[int](useEnv, envarExpMaxBatchSize),
 		clearLessThanOne[int](),
 		clampMax[int](c.maxQSize.Value),
 		fallback[int](dfltExpMaxBatchSize),
@@ -489,3 +512,13 @@ func WithExportBufferSize(size int) BatchProcessorOption {
 		return cfg
 	})
 }
+
+// WithoutBatchEnvironment configures the BatchProcessor to ignore the
+// OTEL_BLRP_* environment variables. The defaults are used for the settings
+// not configured by options.
+func WithoutBatchEnvironment() BatchProcessorOption {
+	return batchOptionFunc(func(cfg batchConfig) batchConfig {
+		cfg.ignoreEnv = true
+		return cfg
+	})
+}
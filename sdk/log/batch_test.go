@@ -163,6 +163,27 @@ func TestNewBatchConfig(t *testing.T) {
 				expBufferSize:   newSetting(2),
 			},
 		},
+		{
+			name: "WithoutEnvironment",
+			envars: map[string]string{
+				envarMaxQSize:        strconv.Itoa(10),
+				envarExpInterval:     strconv.Itoa(100),
+				envarExpTimeout:      strconv.Itoa(1000),
+				envarExpMaxBatchSize: strconv.Itoa(1),
+			},
+			options: []BatchProcessorOption{
+				WithoutBatchEnvironment(),
+				WithExportTimeout(time.Hour),
+			},
+			want: batchConfig{
+				maxQSize:        newSetting(dfltMaxQSize),
+				expInterval:     newSetting(dfltExpInterval),
+				expTimeout:      newSetting(time.Hour),
+				expMaxBatchSize: newSetting(dfltExpMaxBatchSize),
+				expBufferSize:   newSetting(dfltExpBufferSize),
+				ignoreEnv:       true,
+			},
+		},
 		{
 			name: "BatchLessThanOrEqualToQSize",
 			options: []BatchProcessorOption{
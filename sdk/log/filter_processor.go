@@ -20,6 +20,8 @@ import (
 // The SDK's Logger.Enabled returns false
 // if all the registered Processors implement FilterProcessor
 // and they all return false.
+// In that case, the SDK's Logger.Emit also drops the records, without
+// resolving their lazily computed body and attributes.
 //
 // Processor implementations that choose to support this by satisfying this
 // interface are expected to re-evaluate the [Record] passed to [Processor.OnEmit],
@@ -57,4 +59,5 @@ type FilterProcessor interface {
 type EnabledParameters struct {
 	InstrumentationScope instrumentation.Scope
 	Severity             log.Severity
+	EventName            string
 }
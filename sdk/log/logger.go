@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/baggage"
 	"go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/log/embedded"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
@@ -24,6 +25,12 @@ type logger struct {
 
 	provider             *LoggerProvider
 	instrumentationScope instrumentation.Scope
+
+	// baggage is whether the context baggage is added to the records.
+	baggage bool
+	// baggageKeys are the keys of the baggage members added. All members are
+	// added if empty.
+	baggageKeys []string
 }
 
 func newLogger(p *LoggerProvider, scope instrumentation.Scope) *logger {
@@ -33,7 +40,26 @@ func newLogger(p *LoggerProvider, scope instrumentation.Scope) *logger {
 	}
 }
 
+// withConfig returns l, or a copy of l sharing its instrumentation scope if
+// cfg configures how the records are emitted.
+func (l *logger) withConfig(cfg log.LoggerConfig) *logger {
+	keys, ok := cfg.ContextBaggage()
+	if !ok {
+		return l
+	}
+	c := *l
+	c.baggage, c.baggageKeys = true, keys
+	return &c
+}
+
 func (l *logger) Emit(ctx context.Context, r log.Record) {
+	param := log.EnabledParameters{Severity: r.Severity(), EventName: r.EventName()}
+	if !l.Enabled(ctx, param) {
+		// No Processor will process the record. Do not build it, which would
+		// resolve its lazily computed body and attributes.
+		return
+	}
+
 	newRecord := l.newRecord(ctx, r)
 	for _, p := range l.provider.processors {
 		if err := p.OnEmit(ctx, &newRecord); err != nil {
@@ -47,11 +73,18 @@ func (l *logger) Emit(ctx context.Context, r log.Record) {
 //
 // If it is not possible to definitively determine the param will be
 // processed, true will be returned by default. A value of false will only be
-// returned if it can be positively verified that no Processor will process.
+// returned if it can be positively verified that no Processor will process,
+// or if the severity of param is lower than the minimum severity set with
+// LoggerProvider.SetMinSeverity.
 func (l *logger) Enabled(ctx context.Context, param log.EnabledParameters) bool {
+	if int64(param.Severity) < l.provider.minSeverity.Load() {
+		return false
+	}
+
 	p := EnabledParameters{
 		InstrumentationScope: l.instrumentationScope,
 		Severity:             param.Severity,
+		EventName:            param.EventName,
 	}
 
 	// If there are more Processors than FilterProcessors,
@@ -89,7 +122,7 @@ func (l *logger) newRecord(ctx context.Context, r log.Record) Record {
 		spanID:     sc.SpanID(),
 		traceFlags: sc.TraceFlags(),
 
-		resource:                  l.provider.resource,
+		resource:                  l.provider.getResource(),
 		scope:                     &l.instrumentationScope,
 		attributeValueLengthLimit: l.provider.attributeValueLengthLimit,
 		attributeCountLimit:       l.provider.attributeCountLimit,
@@ -100,6 +133,11 @@ func (l *logger) newRecord(ctx context.Context, r log.Record) Record {
 		newRecord.observedTimestamp = now()
 	}
 
+	if l.baggage {
+		// Added first so the attributes of r take precedence.
+		newRecord.AddAttributes(l.baggageAttrs(ctx)...)
+	}
+
 	r.WalkAttributes(func(kv log.KeyValue) bool {
 		newRecord.AddAttributes(kv)
 		return true
@@ -107,3 +145,25 @@ func (l *logger) newRecord(ctx context.Context, r log.Record) Record {
 
 	return newRecord
 }
+
+// baggageAttrs returns the members of the baggage in ctx that l adds to the
+// records it emits as attributes.
+func (l *logger) baggageAttrs(ctx context.Context) []log.KeyValue {
+	b := baggage.FromContext(ctx)
+	if len(l.baggageKeys) == 0 {
+		members := b.Members()
+		attrs := make([]log.KeyValue, 0, len(members))
+		for _, m := range members {
+			attrs = append(attrs, log.String(m.Key(), m.Value()))
+		}
+		return attrs
+	}
+
+	attrs := make([]log.KeyValue, 0, len(l.baggageKeys))
+	for _, k := range l.baggageKeys {
+		if m := b.Member(k); m.Key() != "" {
+			attrs = append(attrs, log.String(k, m.Value()))
+		}
+	}
+	return attrs
+}
@@ -10,8 +10,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
 	"go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/resource"
@@ -222,6 +224,85 @@ func TestLoggerEmit(t *testing.T) {
 	}
 }
 
+func TestLoggerEmitLazy(t *testing.T) {
+	var calls int
+	newRecord := func() log.Record {
+		var r log.Record
+		r.SetSeverity(log.SeverityDebug)
+		r.SetBodyFunc(func() log.Value {
+			calls++
+			return log.StringValue("body")
+		})
+		r.AddAttributesFunc(func() []log.KeyValue {
+			calls++
+			return []log.KeyValue{log.String("key", "value")}
+		})
+		return r
+	}
+
+	disabled := newFltrProcessor("disabled", false)
+	l := newLogger(NewLoggerProvider(WithProcessor(disabled)), instrumentation.Scope{})
+	l.Emit(context.Background(), newRecord())
+	assert.Equal(t, 0, calls, "lazy record resolved")
+	assert.Empty(t, disabled.records, "record emitted")
+	assert.Equal(t, []EnabledParameters{{Severity: log.SeverityDebug}}, disabled.params)
+
+	enabled := newFltrProcessor("enabled", true)
+	l = newLogger(NewLoggerProvider(WithProcessor(enabled)), instrumentation.Scope{})
+	l.Emit(context.Background(), newRecord())
+	assert.Equal(t, 2, calls, "lazy record not resolved")
+	require.Len(t, enabled.records, 1)
+	got := enabled.records[0]
+	assert.Equal(t, log.StringValue("body"), got.Body())
+	assert.Equal(t, 1, got.AttributesLen())
+}
+
+func TestLoggerContextBaggage(t *testing.T) {
+	user, err := baggage.NewMember("user", "alice")
+	require.NoError(t, err)
+	tenant, err := baggage.NewMember("tenant", "acme")
+	require.NoError(t, err)
+	b, err := baggage.New(user, tenant)
+	require.NoError(t, err)
+	ctx := baggage.ContextWithBaggage(context.Background(), b)
+
+	attrs := func(r Record) map[string]log.Value {
+		got := make(map[string]log.Value)
+		r.WalkAttributes(func(kv log.KeyValue) bool {
+			got[kv.Key] = kv.Value
+			return true
+		})
+		return got
+	}
+
+	proc := newProcessor("baggage")
+	p := NewLoggerProvider(WithProcessor(proc))
+
+	var r log.Record
+	r.AddAttributes(log.String("tenant", "override"))
+
+	p.Logger("scope").Emit(ctx, r)
+	p.Logger("scope", log.WithContextBaggage()).Emit(ctx, r)
+	p.Logger("scope", log.WithContextBaggage("user", "missing")).Emit(ctx, r)
+
+	require.Len(t, proc.records, 3)
+	assert.Equal(t, map[string]log.Value{
+		"tenant": log.StringValue("override"),
+	}, attrs(proc.records[0]), "no baggage")
+	assert.Equal(t, map[string]log.Value{
+		"user":   log.StringValue("alice"),
+		"tenant": log.StringValue("override"),
+	}, attrs(proc.records[1]), "all baggage")
+	assert.Equal(t, map[string]log.Value{
+		"user":   log.StringValue("alice"),
+		"tenant": log.StringValue("override"),
+	}, attrs(proc.records[2]), "selected baggage")
+
+	for _, rec := range proc.records {
+		assert.Equal(t, instrumentation.Scope{Name: "scope"}, rec.InstrumentationScope())
+	}
+}
+
 func TestLoggerEnabled(t *testing.T) {
 	p0 := newFltrProcessor("0", true)
 	p1 := newFltrProcessor("1", true)
@@ -231,6 +312,7 @@ func TestLoggerEnabled(t *testing.T) {
 		name             string
 		logger           *logger
 		ctx              context.Context
+		param            log.EnabledParameters
 		expected         bool
 		expectedP0Params []EnabledParameters
 		expectedP1Params []EnabledParameters
@@ -255,6 +337,20 @@ func TestLoggerEnabled(t *testing.T) {
 			}},
 			expectedP1Params: nil,
 		},
+		{
+			name: "WithParameters",
+			logger: newLogger(NewLoggerProvider(
+				WithProcessor(p0),
+			), instrumentation.Scope{Name: "scope"}),
+			ctx:      context.Background(),
+			param:    log.EnabledParameters{Severity: log.SeverityWarn, EventName: "event"},
+			expected: true,
+			expectedP0Params: []EnabledParameters{{
+				InstrumentationScope: instrumentation.Scope{Name: "scope"},
+				Severity:             log.SeverityWarn,
+				EventName:            "event",
+			}},
+		},
 		{
 			name: "WithDisabledProcessors",
 			logger: newLogger(NewLoggerProvider(
@@ -295,10 +391,41 @@ func TestLoggerEnabled(t *testing.T) {
 			p1.params = nil
 			p2WithDisabled.params = nil
 
-			assert.Equal(t, tc.expected, tc.logger.Enabled(tc.ctx, log.EnabledParameters{}))
+			assert.Equal(t, tc.expected, tc.logger.Enabled(tc.ctx, tc.param))
 			assert.Equal(t, tc.expectedP0Params, p0.params)
 			assert.Equal(t, tc.expectedP1Params, p1.params)
 			assert.Equal(t, tc.expectedP2Params, p2WithDisabled.params)
 		})
 	}
 }
+
+func TestLoggerProviderSetMinSeverity(t *testing.T) {
+	proc := newProcessor("min-severity")
+	p := NewLoggerProvider(WithProcessor(proc))
+	l := p.Logger("scope")
+	ctx := context.Background()
+
+	emit := func(sev log.Severity) {
+		var r log.Record
+		r.SetSeverity(sev)
+		l.Emit(ctx, r)
+	}
+
+	emit(log.SeverityDebug)
+	require.Len(t, proc.records, 1, "default minimum severity")
+
+	p.SetMinSeverity(log.SeverityWarn)
+	emit(log.SeverityDebug)
+	emit(log.SeverityUndefined)
+	emit(log.SeverityWarn)
+	emit(log.SeverityError)
+	require.Len(t, proc.records, 3)
+	assert.Equal(t, log.SeverityWarn, proc.records[1].Severity())
+	assert.Equal(t, log.SeverityError, proc.records[2].Severity())
+	assert.False(t, l.Enabled(ctx, log.EnabledParameters{Severity: log.SeverityInfo}))
+	assert.True(t, l.Enabled(ctx, log.EnabledParameters{Severity: log.SeverityWarn}))
+
+	p.SetMinSeverity(log.SeverityUndefined)
+	emit(log.SeverityUndefined)
+	assert.Len(t, proc.records, 4, "minimum severity reset")
+}
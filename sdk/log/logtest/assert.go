@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest // import "go.opentelemetry.io/otel/sdk/log/logtest"
+
+import (
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TestingT is an interface that implements [testing.T], but without the
+// private method of [testing.TB], so other testing packages can rely on it as
+// well.
+// The methods in this interface must match the [testing.TB] interface.
+type TestingT interface {
+	Helper()
+	// DO NOT CHANGE: any modification will not be backwards compatible and
+	// must never be done outside of a new major release.
+
+	Error(...any)
+	// DO NOT CHANGE: any modification will not be backwards compatible and
+	// must never be done outside of a new major release.
+}
+
+// AssertSeverity asserts that the severity of r is severity.
+func AssertSeverity(t TestingT, r sdklog.Record, severity log.Severity) bool {
+	t.Helper()
+
+	if got := r.Severity(); got != severity {
+		t.Error(notEqualStr("Severity", severity, got))
+		return false
+	}
+	return true
+}
+
+// AssertBody asserts that the body of r is equal to body.
+func AssertBody(t TestingT, r sdklog.Record, body log.Value) bool {
+	t.Helper()
+
+	if got := r.Body(); !got.Equal(body) {
+		t.Error(notEqualStr("Body", body, got))
+		return false
+	}
+	return true
+}
+
+// AssertHasAttributes asserts that r holds all the attributes attrs. The
+// record can hold other attributes.
+func AssertHasAttributes(t TestingT, r sdklog.Record, attrs ...log.KeyValue) bool {
+	t.Helper()
+
+	got := make(map[string]log.Value, r.AttributesLen())
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		got[kv.Key] = kv.Value
+		return true
+	})
+
+	var reasons []string
+	for _, want := range attrs {
+		v, ok := got[want.Key]
+		if !ok {
+			reasons = append(reasons, "missing attribute "+want.Key)
+			continue
+		}
+		if !v.Equal(want.Value) {
+			reasons = append(reasons, notEqualStr(want.Key, want.Value, v))
+		}
+	}
+	if len(reasons) > 0 {
+		t.Error(strings.Join(reasons, "\n"))
+		return false
+	}
+	return true
+}
+
+// AssertSpanContext asserts that r is correlated with the span of sc: the
+// trace ID, span ID, and trace flags of r are the ones of sc.
+func AssertSpanContext(t TestingT, r sdklog.Record, sc trace.SpanContext) bool {
+	t.Helper()
+
+	var reasons []string
+	if got := r.TraceID(); got != sc.TraceID() {
+		reasons = append(reasons, notEqualStr("TraceID", sc.TraceID(), got))
+	}
+	if got := r.SpanID(); got != sc.SpanID() {
+		reasons = append(reasons, notEqualStr("SpanID", sc.SpanID(), got))
+	}
+	if got := r.TraceFlags(); got != sc.TraceFlags() {
+		reasons = append(reasons, notEqualStr("TraceFlags", sc.TraceFlags(), got))
+	}
+	if len(reasons) > 0 {
+		t.Error(strings.Join(reasons, "\n"))
+		return false
+	}
+	return true
+}
+
+func notEqualStr(prefix string, expected, actual interface{}) string {
+	return fmt.Sprintf("%s not equal:\nexpected: %v\nactual: %v", prefix, expected, actual)
+}
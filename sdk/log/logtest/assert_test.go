@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type mockTestingT struct {
+	errors []string
+}
+
+func (m *mockTestingT) Helper() {}
+
+func (m *mockTestingT) Error(args ...any) {
+	m.errors = append(m.errors, fmt.Sprint(args...))
+}
+
+func TestAssertions(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x02},
+		TraceFlags: trace.FlagsSampled,
+	})
+	r := RecordFactory{
+		Severity: log.SeverityError,
+		Body:     log.MapValue(log.String("msg", "hello")),
+		Attributes: []log.KeyValue{
+			log.String("k0", "v0"),
+			log.Int("k1", 1),
+		},
+		TraceID:    sc.TraceID(),
+		SpanID:     sc.SpanID(),
+		TraceFlags: sc.TraceFlags(),
+	}.NewRecord()
+
+	assert.True(t, AssertSeverity(t, r, log.SeverityError))
+	assert.True(t, AssertBody(t, r, log.MapValue(log.String("msg", "hello"))))
+	assert.True(t, AssertHasAttributes(t, r, log.Int("k1", 1)))
+	assert.True(t, AssertHasAttributes(t, r))
+	assert.True(t, AssertSpanContext(t, r, sc))
+
+	mt := &mockTestingT{}
+	assert.False(t, AssertSeverity(mt, r, log.SeverityInfo))
+	assert.False(t, AssertBody(mt, r, log.StringValue("hello")))
+	assert.False(t, AssertHasAttributes(mt, r, log.String("k0", "other"), log.Bool("k2", true)))
+	assert.False(t, AssertSpanContext(mt, r, trace.SpanContext{}))
+	if assert.Len(t, mt.errors, 4) {
+		assert.Contains(t, mt.errors[0], "Severity not equal:\nexpected: INFO\nactual: ERROR")
+		assert.Contains(t, mt.errors[1], "Body not equal:")
+		assert.Contains(t, mt.errors[2], "k0 not equal:\nexpected: other\nactual: v0")
+		assert.Contains(t, mt.errors[2], "missing attribute k2")
+		assert.Contains(t, mt.errors[3], "TraceID not equal:")
+		assert.Contains(t, mt.errors[3], "SpanID not equal:")
+		assert.Contains(t, mt.errors[3], "TraceFlags not equal:")
+	}
+}
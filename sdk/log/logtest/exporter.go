@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest // import "go.opentelemetry.io/otel/sdk/log/logtest"
+
+import (
+	"context"
+	"sync"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+// Compile-time check InMemoryExporter implements sdklog.Exporter.
+var _ sdklog.Exporter = (*InMemoryExporter)(nil)
+
+// NewInMemoryExporter returns a new InMemoryExporter.
+func NewInMemoryExporter() *InMemoryExporter {
+	return new(InMemoryExporter)
+}
+
+// InMemoryExporter is an exporter that stores all received records
+// in-memory.
+type InMemoryExporter struct {
+	mu      sync.Mutex
+	records []sdklog.Record
+}
+
+// Export stores a copy of the records.
+func (e *InMemoryExporter) Export(_ context.Context, records []sdklog.Record) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for i := range records {
+		e.records = append(e.records, records[i].Clone())
+	}
+	return nil
+}
+
+// Shutdown stops the exporter by clearing the records held in memory.
+func (e *InMemoryExporter) Shutdown(context.Context) error {
+	e.Reset()
+	return nil
+}
+
+// ForceFlush does nothing. The records are stored when they are exported.
+func (e *InMemoryExporter) ForceFlush(context.Context) error {
+	return nil
+}
+
+// Reset the current in-memory storage.
+func (e *InMemoryExporter) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.records = nil
+}
+
+// GetRecords returns a copy of the current in-memory stored records, in the
+// order they were exported.
+func (e *InMemoryExporter) GetRecords() []sdklog.Record {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	out := make([]sdklog.Record, len(e.records))
+	for i := range e.records {
+		out[i] = e.records[i].Clone()
+	}
+	return out
+}
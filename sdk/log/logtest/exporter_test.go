@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+func TestInMemoryExporter(t *testing.T) {
+	ctx := context.Background()
+	exp := NewInMemoryExporter()
+	provider := sdklog.NewLoggerProvider(sdklog.WithProcessor(sdklog.NewSimpleProcessor(exp)))
+	logger := provider.Logger("test")
+
+	var r log.Record
+	r.SetBody(log.StringValue("first"))
+	logger.Emit(ctx, r)
+	r.SetBody(log.StringValue("second"))
+	logger.Emit(ctx, r)
+
+	got := exp.GetRecords()
+	require.Len(t, got, 2)
+	assert.Equal(t, "first", got[0].Body().AsString())
+	assert.Equal(t, "second", got[1].Body().AsString())
+
+	// Returned records do not share state with the exporter.
+	got[0].SetBody(log.StringValue("changed"))
+	assert.Equal(t, "first", exp.GetRecords()[0].Body().AsString())
+
+	assert.NoError(t, exp.ForceFlush(ctx))
+	exp.Reset()
+	assert.Empty(t, exp.GetRecords())
+
+	logger.Emit(ctx, r)
+	assert.NoError(t, exp.Shutdown(ctx))
+	assert.Empty(t, exp.GetRecords())
+}
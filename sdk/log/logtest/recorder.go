@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest // import "go.opentelemetry.io/otel/sdk/log/logtest"
+
+import (
+	"context"
+	"sync"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+// Compile-time check Recorder implements sdklog.Processor.
+var _ sdklog.Processor = (*Recorder)(nil)
+
+// Recorder is a Processor that records the emitted records synchronously.
+// Unlike an InMemoryExporter used with a batching Processor, the records are
+// available as soon as they are emitted.
+type Recorder struct {
+	mu      sync.Mutex
+	records []sdklog.Record
+}
+
+// NewRecorder returns a new Recorder.
+func NewRecorder() *Recorder {
+	return new(Recorder)
+}
+
+// OnEmit records a copy of record.
+func (r *Recorder) OnEmit(_ context.Context, record *sdklog.Record) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records = append(r.records, record.Clone())
+	return nil
+}
+
+// Shutdown does nothing.
+func (r *Recorder) Shutdown(context.Context) error {
+	return nil
+}
+
+// ForceFlush does nothing.
+func (r *Recorder) ForceFlush(context.Context) error {
+	return nil
+}
+
+// Reset clears the recorded records.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records = nil
+}
+
+// Records returns a copy of the records emitted, in the order they were
+// emitted.
+func (r *Recorder) Records() []sdklog.Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]sdklog.Record, len(r.records))
+	for i := range r.records {
+		out[i] = r.records[i].Clone()
+	}
+	return out
+}
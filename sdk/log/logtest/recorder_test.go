@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+func TestRecorder(t *testing.T) {
+	ctx := context.Background()
+	rec := NewRecorder()
+	provider := sdklog.NewLoggerProvider(sdklog.WithProcessor(rec))
+	logger := provider.Logger("test")
+
+	var r log.Record
+	r.SetSeverity(log.SeverityInfo)
+	logger.Emit(ctx, r)
+	r.SetSeverity(log.SeverityWarn)
+	logger.Emit(ctx, r)
+
+	got := rec.Records()
+	require.Len(t, got, 2)
+	assert.Equal(t, log.SeverityInfo, got[0].Severity())
+	assert.Equal(t, log.SeverityWarn, got[1].Severity())
+
+	assert.NoError(t, rec.ForceFlush(ctx))
+	assert.NoError(t, rec.Shutdown(ctx))
+
+	rec.Reset()
+	assert.Empty(t, rec.Records())
+}
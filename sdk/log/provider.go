@@ -9,12 +9,15 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/go-logr/logr"
+
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/log/embedded"
 	"go.opentelemetry.io/otel/log/noop"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/internal/env"
+	"go.opentelemetry.io/otel/sdk/internal/logging"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
 
@@ -24,14 +27,21 @@ const (
 
 	envarAttrCntLim    = "OTEL_LOGRECORD_ATTRIBUTE_COUNT_LIMIT"
 	envarAttrValLenLim = "OTEL_LOGRECORD_ATTRIBUTE_VALUE_LENGTH_LIMIT"
+
+	envarGeneralAttrCntLim    = "OTEL_ATTRIBUTE_COUNT_LIMIT"
+	envarGeneralAttrValLenLim = "OTEL_ATTRIBUTE_VALUE_LENGTH_LIMIT"
 )
 
 type providerConfig struct {
 	resource       *resource.Resource
+	asyncResource  *resource.Async
 	processors     []Processor
 	fltrProcessors []FilterProcessor
 	attrCntLim     setting[int]
 	attrValLenLim  setting[int]
+	log            logging.Logger
+	ignoreEnv      bool
+	disabled       bool
 }
 
 func newProviderConfig(opts []LoggerProviderOption) providerConfig {
@@ -44,16 +54,23 @@ func newProviderConfig(opts []LoggerProviderOption) providerConfig {
 		c.resource = resource.Default()
 	}
 
+	useEnv := !c.ignoreEnv
 	c.attrCntLim = c.attrCntLim.Resolve(
-		getenv[int](envarAttrCntLim),
+		getenvIf[int](useEnv, envarAttrCntLim),
+		getenvIf[int](useEnv, envarGeneralAttrCntLim),
 		fallback[int](defaultAttrCntLim),
 	)
 
 	c.attrValLenLim = c.attrValLenLim.Resolve(
-		getenv[int](envarAttrValLenLim),
+		getenvIf[int](useEnv, envarAttrValLenLim),
+		getenvIf[int](useEnv, envarGeneralAttrValLenLim),
 		fallback[int](defaultAttrValLenLim),
 	)
 
+	if useEnv {
+		c.disabled = env.SDKDisabled()
+	}
+
 	return c
 }
 
@@ -63,10 +80,18 @@ type LoggerProvider struct {
 	embedded.LoggerProvider
 
 	resource                  *resource.Resource
+	asyncResource             *resource.Async
 	processors                []Processor
 	fltrProcessors            []FilterProcessor
 	attributeCountLimit       int
 	attributeValueLengthLimit int
+	disabled                  bool
+
+	// minSeverity is the minimum severity of the emitted records. It can be
+	// changed at runtime with SetMinSeverity.
+	minSeverity atomic.Int64
+
+	log logging.Logger
 
 	loggersMu sync.Mutex
 	loggers   map[instrumentation.Scope]*logger
@@ -85,28 +110,69 @@ var _ log.LoggerProvider = (*LoggerProvider)(nil)
 // Resource and no Processors. Processors cannot be added after a LoggerProvider is
 // created. This means the returned LoggerProvider, one created with no
 // Processors, will perform no operations.
+//
+// The attribute limits not configured by options are read from the
+// OTEL_LOGRECORD_ATTRIBUTE_COUNT_LIMIT and
+// OTEL_LOGRECORD_ATTRIBUTE_VALUE_LENGTH_LIMIT environment variables, or from
+// the OTEL_ATTRIBUTE_COUNT_LIMIT and OTEL_ATTRIBUTE_VALUE_LENGTH_LIMIT
+// environment variables if they are not set. Options always have precedence
+// over environment variables. If the OTEL_SDK_DISABLED environment variable is
+// true, the returned LoggerProvider provides no-op Loggers and does not flush
+// its Processors, they are only shut down with it. Use WithoutEnvironment to
+// ignore these environment variables.
 func NewLoggerProvider(opts ...LoggerProviderOption) *LoggerProvider {
 	cfg := newProviderConfig(opts)
+	for _, p := range cfg.processors {
+		setInternalLogger(p, cfg.log)
+	}
 	return &LoggerProvider{
 		resource:                  cfg.resource,
+		asyncResource:             cfg.asyncResource,
 		processors:                cfg.processors,
 		fltrProcessors:            cfg.fltrProcessors,
 		attributeCountLimit:       cfg.attrCntLim.Value,
 		attributeValueLengthLimit: cfg.attrValLenLim.Value,
+		disabled:                  cfg.disabled,
+		log:                       cfg.log,
 	}
 }
 
+// SetMinSeverity sets the minimum severity of the records emitted by the
+// Loggers of p, including the ones already returned. Records with a lower
+// severity, including the ones with an undefined severity, are dropped before
+// reaching any Processor, and the Enabled method of the Loggers returns false
+// for them. This allows changing the verbosity of an application at runtime,
+// e.g. from a remote configuration, without recreating the telemetry
+// pipeline.
+//
+// By default, or if sev is [log.SeverityUndefined], no record is dropped.
+//
+// This method is safe to call concurrently.
+func (p *LoggerProvider) SetMinSeverity(sev log.Severity) {
+	p.minSeverity.Store(int64(sev))
+	p.log.Info("LoggerProvider minimum severity changed", "severity", sev)
+}
+
+// getResource returns the Resource telemetry is currently associated with.
+func (p *LoggerProvider) getResource() *resource.Resource {
+	if p.asyncResource != nil {
+		return p.asyncResource.Resource()
+	}
+	return p.resource
+}
+
 // Logger returns a new [log.Logger] with the provided name and configuration.
 //
-// If p is shut down, a [noop.Logger] instance is returned.
+// If p is shut down or disabled by the OTEL_SDK_DISABLED environment variable,
+// a [noop.Logger] instance is returned.
 //
 // This method can be called concurrently.
 func (p *LoggerProvider) Logger(name string, opts ...log.LoggerOption) log.Logger {
 	if name == "" {
-		global.Warn("Invalid Logger name.", "name", name)
+		p.log.Warn("Invalid Logger name.", "name", name)
 	}
 
-	if p.stopped.Load() {
+	if p.disabled || p.stopped.Load() {
 		return noop.NewLoggerProvider().Logger(name, opts...)
 	}
 
@@ -124,7 +190,7 @@ func (p *LoggerProvider) Logger(name string, opts ...log.LoggerOption) log.Logge
 	if p.loggers == nil {
 		l := newLogger(p, scope)
 		p.loggers = map[instrumentation.Scope]*logger{scope: l}
-		return l
+		return l.withConfig(cfg)
 	}
 
 	l, ok := p.loggers[scope]
@@ -133,7 +199,7 @@ func (p *LoggerProvider) Logger(name string, opts ...log.LoggerOption) log.Logge
 		p.loggers[scope] = l
 	}
 
-	return l
+	return l.withConfig(cfg)
 }
 
 // Shutdown shuts down the provider and all processors.
@@ -156,7 +222,7 @@ func (p *LoggerProvider) Shutdown(ctx context.Context) error {
 //
 // This method can be called concurrently.
 func (p *LoggerProvider) ForceFlush(ctx context.Context) error {
-	if p.stopped.Load() {
+	if p.disabled || p.stopped.Load() {
 		return nil
 	}
 
@@ -191,6 +257,22 @@ func WithResource(res *resource.Resource) LoggerProviderOption {
 		if err != nil {
 			otel.Handle(err)
 		}
+		cfg.asyncResource = nil
+		return cfg
+	})
+}
+
+// WithAsyncResource associates the asynchronously detected Resource a with a
+// LoggerProvider. Log records are associated with the Resource a has detected
+// at the time they are emitted, so they are produced with a partial Resource
+// until detection is complete and reflect any subsequent refresh of a.
+//
+// Unlike WithResource, the Resource is not merged with the
+// resource.Environment() Resource. Use resource.WithFromEnv when creating a
+// to include it.
+func WithAsyncResource(a *resource.Async) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		cfg.asyncResource = a
 		return cfg
 	})
 }
@@ -224,8 +306,9 @@ func WithProcessor(processor Processor) LoggerProviderOption {
 //
 // Setting this to a negative value means no limit is applied.
 //
-// If the OTEL_LOGRECORD_ATTRIBUTE_COUNT_LIMIT environment variable is set,
-// and this option is not passed, that variable value will be used.
+// If the OTEL_LOGRECORD_ATTRIBUTE_COUNT_LIMIT environment variable, or
+// otherwise the OTEL_ATTRIBUTE_COUNT_LIMIT environment variable, is set, and
+// this option is not passed, that variable value will be used.
 //
 // By default, if an environment variable is not set, and this option is not
 // passed, 128 will be used.
@@ -243,8 +326,9 @@ func WithAttributeCountLimit(limit int) LoggerProviderOption {
 //
 // Setting this to a negative value means no limit is applied.
 //
-// If the OTEL_LOGRECORD_ATTRIBUTE_VALUE_LENGTH_LIMIT environment variable is set,
-// and this option is not passed, that variable value will be used.
+// If the OTEL_LOGRECORD_ATTRIBUTE_VALUE_LENGTH_LIMIT environment variable, or
+// otherwise the OTEL_ATTRIBUTE_VALUE_LENGTH_LIMIT environment variable, is
+// set, and this option is not passed, that variable value will be used.
 //
 // By default, if an environment variable is not set, and this option is not
 // passed, no limit (-1) will be used.
@@ -254,3 +338,65 @@ func WithAttributeValueLengthLimit(limit int) LoggerProviderOption {
 		return cfg
 	})
 }
+
+// WithoutEnvironment configures the LoggerProvider to ignore the
+// OTEL_SDK_DISABLED and attribute limits environment variables. The defaults
+// are used for the settings not configured by options.
+//
+// This does not apply to the processors registered with the LoggerProvider.
+// Use WithoutBatchEnvironment for a BatchProcessor.
+func WithoutEnvironment() LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		cfg.ignoreEnv = true
+		return cfg
+	})
+}
+
+// WithInternalLogger configures the logger used by the LoggerProvider to log
+// internal diagnostics. This allows those diagnostics to be routed to the
+// logger of the application instead of the global logger set with
+// go.opentelemetry.io/otel.SetLogger.
+//
+// Messages are logged with the same verbosity levels as the global logger:
+// warnings with V(1), informational messages with V(4), and debug messages
+// with V(8).
+//
+// The BatchProcessors of the LoggerProvider also log with l. The warning about
+// attributes dropped from a Record continues to use the global logger.
+//
+// By default, if this option is not used, the global logger is used.
+func WithInternalLogger(l logr.Logger) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		cfg.log = cfg.log.WithLogger(l)
+		return cfg
+	})
+}
+
+// WithInternalLogLevel configures the most verbose level of the internal
+// diagnostics logged by the LoggerProvider and its BatchProcessors. Messages
+// logged with a greater verbosity are dropped: use 0 to only log errors, 1 to
+// also log warnings, 4 informational messages, and 8 debug messages.
+//
+// By default, if this option is not used, all messages are passed to the
+// logger, which decides the verbosity levels it logs.
+func WithInternalLogLevel(level int) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		cfg.log = cfg.log.WithLevel(logging.Level(level))
+		return cfg
+	})
+}
+
+// internalLoggerSetter is implemented by the Processors logging their
+// internal diagnostics with the logger of the LoggerProvider they are
+// registered with.
+type internalLoggerSetter interface {
+	setInternalLogger(logging.Logger)
+}
+
+// setInternalLogger sets the internal logger of p to l if p logs its
+// internal diagnostics.
+func setInternalLogger(p Processor, l logging.Logger) {
+	if s, ok := p.(internalLoggerSetter); ok {
+		s.setInternalLogger(l)
+	}
+}
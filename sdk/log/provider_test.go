@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
@@ -21,6 +22,7 @@ import (
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/log/noop"
+	"go.opentelemetry.io/otel/sdk/internal/env"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
 
@@ -147,6 +149,46 @@ func TestNewLoggerProviderConfiguration(t *testing.T) {
 				attributeValueLengthLimit: defaultAttrValLenLim,
 			},
 		},
+		{
+			name: "GeneralEnvironment",
+			envars: map[string]string{
+				envarGeneralAttrCntLim:    strconv.Itoa(attrCntLim),
+				envarGeneralAttrValLenLim: strconv.Itoa(attrValLenLim),
+			},
+			want: &LoggerProvider{
+				resource:                  resource.Default(),
+				attributeCountLimit:       attrCntLim,
+				attributeValueLengthLimit: attrValLenLim,
+			},
+		},
+		{
+			name: "LogRecordEnvironmentPrecedence",
+			envars: map[string]string{
+				envarAttrCntLim:           strconv.Itoa(attrCntLim),
+				envarAttrValLenLim:        strconv.Itoa(attrValLenLim),
+				envarGeneralAttrCntLim:    strconv.Itoa(100),
+				envarGeneralAttrValLenLim: strconv.Itoa(101),
+			},
+			want: &LoggerProvider{
+				resource:                  resource.Default(),
+				attributeCountLimit:       attrCntLim,
+				attributeValueLengthLimit: attrValLenLim,
+			},
+		},
+		{
+			name: "WithoutEnvironment",
+			envars: map[string]string{
+				envarAttrCntLim:    strconv.Itoa(attrCntLim),
+				envarAttrValLenLim: strconv.Itoa(attrValLenLim),
+				env.SDKDisabledKey: "true",
+			},
+			options: []LoggerProviderOption{WithoutEnvironment()},
+			want: &LoggerProvider{
+				resource:                  resource.Default(),
+				attributeCountLimit:       defaultAttrCntLim,
+				attributeValueLengthLimit: defaultAttrValLenLim,
+			},
+		},
 		{
 			name: "Precedence",
 			envars: map[string]string{
@@ -247,6 +289,36 @@ func TestWithResource(t *testing.T) {
 	}
 }
 
+func TestWithAsyncResource(t *testing.T) {
+	release := make(chan struct{})
+	a := resource.NewAsync(context.Background(), time.Millisecond,
+		resource.WithAttributes(attribute.String("fast", "1")),
+		resource.WithDetectors(resource.StringDetector("", "slow", func() (string, error) {
+			<-release
+			return "1", nil
+		})),
+	)
+
+	p := newProcessor("async")
+	l := NewLoggerProvider(
+		WithResource(resource.Empty()),
+		WithAsyncResource(a),
+		WithProcessor(p),
+	).Logger("TestWithAsyncResource")
+
+	l.Emit(context.Background(), log.Record{})
+	close(release)
+	<-a.Done()
+	l.Emit(context.Background(), log.Record{})
+
+	require.Len(t, p.records, 2)
+	assert.Equal(t, *resource.NewSchemaless(attribute.String("fast", "1")), p.records[0].Resource())
+	assert.Equal(t, *resource.NewSchemaless(
+		attribute.String("fast", "1"),
+		attribute.String("slow", "1"),
+	), p.records[1].Resource())
+}
+
 func TestLoggerProviderConcurrentSafe(t *testing.T) {
 	const goRoutineN = 10
 
@@ -299,6 +371,36 @@ func TestLoggerProviderLogger(t *testing.T) {
 		assert.Empty(t, l.keysAndValues[1], "logged name")
 	})
 
+	t.Run("InternalLogger", func(t *testing.T) {
+		g := &logSink{LogSink: testr.New(t).GetSink()}
+		t.Cleanup(func(orig logr.Logger) func() {
+			global.SetLogger(logr.New(g))
+			return func() { global.SetLogger(orig) }
+		}(global.GetLogger()))
+
+		l := &logSink{LogSink: testr.New(t).GetSink()}
+		_ = NewLoggerProvider(WithInternalLogger(logr.New(l))).Logger("")
+		assert.Empty(t, g.msg, "logged to the global logger")
+		assert.Equal(t, 1, l.level, "logged level")
+		assert.Equal(t, "Invalid Logger name.", l.msg, "logged message")
+	})
+
+	t.Run("InternalLogLevel", func(t *testing.T) {
+		l := &logSink{LogSink: testr.New(t).GetSink()}
+		_ = NewLoggerProvider(WithInternalLogger(logr.New(l)), WithInternalLogLevel(0)).Logger("")
+		assert.Empty(t, l.msg, "logged warning")
+	})
+
+	t.Run("BatchProcessorInternalLogger", func(t *testing.T) {
+		b := NewBatchProcessor(newTestExporter(nil))
+		t.Cleanup(func() { _ = b.Shutdown(context.Background()) })
+
+		l := &logSink{LogSink: testr.New(t).GetSink()}
+		_ = NewLoggerProvider(WithProcessor(b), WithInternalLogger(logr.New(l)))
+		b.log.Load().Warn("warning")
+		assert.Equal(t, "warning", l.msg, "logged message")
+	})
+
 	t.Run("Stopped", func(t *testing.T) {
 		ctx := context.Background()
 		p := NewLoggerProvider()
@@ -309,6 +411,25 @@ func TestLoggerProviderLogger(t *testing.T) {
 		assert.IsType(t, noop.Logger{}, l)
 	})
 
+	t.Run("Disabled", func(t *testing.T) {
+		t.Setenv(env.SDKDisabledKey, "true")
+		proc := newProcessor("disabled")
+		p := NewLoggerProvider(WithProcessor(proc))
+		l := p.Logger("testing")
+
+		assert.NotNil(t, l)
+		assert.IsType(t, noop.Logger{}, l)
+
+		// The processors are not flushed, but they are shut down.
+		assert.NoError(t, p.ForceFlush(context.Background()))
+		assert.Equal(t, 0, proc.forceFlushCalls)
+		assert.NoError(t, p.Shutdown(context.Background()))
+		assert.Equal(t, 1, proc.shutdownCalls)
+
+		l = NewLoggerProvider(WithoutEnvironment()).Logger("testing")
+		assert.IsType(t, &logger{}, l)
+	})
+
 	t.Run("SameLoggers", func(t *testing.T) {
 		p := NewLoggerProvider()
 
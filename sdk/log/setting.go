@@ -103,6 +103,15 @@ func getenv[T ~int | ~int64](key string) resolver[T] {
 	}
 }
 
+// getenvIf returns the [getenv] resolver for key if enabled is true.
+// Otherwise, it returns a resolver that does not update a setting.
+func getenvIf[T ~int | ~int64](enabled bool, key string) resolver[T] {
+	if !enabled {
+		return func(s setting[T]) setting[T] { return s }
+	}
+	return getenv[T](key)
+}
+
 // fallback returns a resolve that will set a setting value to val if it is not
 // already set.
 //
@@ -6,6 +6,8 @@ package log // import "go.opentelemetry.io/otel/sdk/log"
 import (
 	"context"
 	"sync"
+
+	"go.opentelemetry.io/otel/sdk/internal/exportstatus"
 )
 
 // Compile-time check SimpleProcessor implements Processor.
@@ -17,6 +19,7 @@ var _ Processor = (*SimpleProcessor)(nil)
 type SimpleProcessor struct {
 	mu       sync.Mutex
 	exporter Exporter
+	exports  exportstatus.Recorder
 
 	noCmp [0]func() //nolint: unused  // This is indeed used.
 }
@@ -55,7 +58,9 @@ func (s *SimpleProcessor) OnEmit(ctx context.Context, r *Record) error {
 		simpleProcRecordsPool.Put(records)
 	}()
 
-	return s.exporter.Export(ctx, *records)
+	err := s.exporter.Export(ctx, *records)
+	s.exports.Record(err)
+	return err
 }
 
 // Shutdown shuts down the exporter.
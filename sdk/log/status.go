@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/internal/exportstatus"
+	"go.opentelemetry.io/otel/sdk/sdkstatus"
+)
+
+var (
+	_ sdkstatus.Reporter = (*LoggerProvider)(nil)
+	_ sdkstatus.Reporter = (*BatchProcessor)(nil)
+	_ sdkstatus.Reporter = (*SimpleProcessor)(nil)
+)
+
+// statusExporter wraps an Exporter and records the results of its exports.
+type statusExporter struct {
+	Exporter
+	exportstatus.Recorder
+}
+
+// Export calls the Exporter e wraps and records the result.
+func (e *statusExporter) Export(ctx context.Context, records []Record) error {
+	err := e.Exporter.Export(ctx, records)
+	e.Record(err)
+	return err
+}
+
+// Status returns the status of p and of its registered Processors.
+//
+// This method can be called concurrently.
+func (p *LoggerProvider) Status() sdkstatus.Status {
+	s := sdkstatus.Status{
+		Kind:     "LoggerProvider",
+		Shutdown: p.stopped.Load(),
+		Settings: map[string]string{
+			"disabled":                  strconv.FormatBool(p.disabled),
+			"minSeverity":               log.Severity(p.minSeverity.Load()).String(),
+			"attributeCountLimit":       strconv.Itoa(p.attributeCountLimit),
+			"attributeValueLengthLimit": strconv.Itoa(p.attributeValueLengthLimit),
+		},
+	}
+	for _, proc := range p.processors {
+		if r, ok := proc.(sdkstatus.Reporter); ok {
+			s.Components = append(s.Components, r.Status())
+			continue
+		}
+		s.Components = append(s.Components, sdkstatus.Status{Kind: fmt.Sprintf("%T", proc)})
+	}
+	return s
+}
+
+// Status returns the status of b.
+//
+// This method can be called concurrently.
+func (b *BatchProcessor) Status() sdkstatus.Status {
+	s := sdkstatus.Status{
+		Kind:     "BatchProcessor",
+		Shutdown: b.stopped.Load(),
+	}
+	if b.q != nil {
+		s.Queue = &sdkstatus.Queue{
+			Len:      b.q.Len(),
+			Capacity: b.q.cap,
+			Dropped:  b.q.totalDropped.Load(),
+		}
+	}
+	if b.exports != nil {
+		s.Export = b.exports.Status(b.exports.Exporter)
+	}
+	return s
+}
+
+// Status returns the status of s.
+//
+// This method can be called concurrently.
+func (s *SimpleProcessor) Status() sdkstatus.Status {
+	return sdkstatus.Status{
+		Kind:   "SimpleProcessor",
+		Export: s.exports.Status(s.exporter),
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/sdkstatus"
+)
+
+func TestLoggerProviderStatus(t *testing.T) {
+	bExp := newTestExporter(assert.AnError)
+	b := NewBatchProcessor(bExp, WithMaxQueueSize(2), WithExportMaxBatchSize(2))
+	sExp := newTestExporter(nil)
+	s := NewSimpleProcessor(sExp)
+	proc := newProcessor("other")
+	p := NewLoggerProvider(WithProcessor(b), WithProcessor(s), WithProcessor(proc))
+	p.SetMinSeverity(log.SeverityInfo)
+
+	ctx := context.Background()
+	var r log.Record
+	r.SetSeverity(log.SeverityInfo)
+	p.Logger("TestLoggerProviderStatus").Emit(ctx, r)
+	assert.ErrorIs(t, b.ForceFlush(ctx), assert.AnError)
+
+	got := p.Status()
+	assert.Equal(t, "LoggerProvider", got.Kind)
+	assert.False(t, got.Shutdown)
+	assert.Equal(t, "INFO", got.Settings["minSeverity"])
+	require.Len(t, got.Components, 3)
+
+	batch := got.Components[0]
+	assert.Equal(t, "BatchProcessor", batch.Kind)
+	assert.Equal(t, &sdkstatus.Queue{Len: 0, Capacity: 2}, batch.Queue)
+	require.NotNil(t, batch.Export)
+	assert.Equal(t, "*log.testExporter", batch.Export.Exporter)
+	assert.Equal(t, uint64(1), batch.Export.Count)
+	assert.Equal(t, uint64(1), batch.Export.Failed)
+	assert.Equal(t, assert.AnError.Error(), batch.Export.LastError)
+
+	simple := got.Components[1]
+	assert.Equal(t, "SimpleProcessor", simple.Kind)
+	require.NotNil(t, simple.Export)
+	assert.Equal(t, uint64(1), simple.Export.Count)
+	assert.Empty(t, simple.Export.LastError)
+
+	assert.Equal(t, sdkstatus.Status{Kind: "*log.processor"}, got.Components[2])
+
+	_ = p.Shutdown(ctx)
+	got = p.Status()
+	assert.True(t, got.Shutdown)
+	assert.True(t, got.Components[0].Shutdown)
+}
+
+func TestBatchProcessorStatusDropped(t *testing.T) {
+	q := newQueue(1)
+	q.Enqueue(Record{})
+	q.Enqueue(Record{})
+	q.Enqueue(Record{})
+	assert.Equal(t, uint64(2), q.Dropped())
+	assert.Equal(t, uint64(0), q.Dropped())
+	assert.Equal(t, uint64(2), q.totalDropped.Load(), "total dropped reset")
+
+	b := &BatchProcessor{q: q}
+	assert.Equal(t, &sdkstatus.Queue{Len: 1, Capacity: 1, Dropped: 2}, b.Status().Queue)
+}
@@ -10,7 +10,12 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/go-logr/logr"
+
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/internal/env"
+	"go.opentelemetry.io/otel/sdk/internal/logging"
 	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
@@ -18,9 +23,18 @@ import (
 // config contains configuration options for a MeterProvider.
 type config struct {
 	res            *resource.Resource
+	asyncRes       *resource.Async
 	readers        []Reader
 	views          []View
 	exemplarFilter exemplar.Filter
+	ctxAttrs       *contextAttributes
+	strictUnits    bool
+	log            logging.Logger
+
+	// ignoreEnv disables the configuration from environment variables.
+	ignoreEnv bool
+	// disabled makes the MeterProvider provide no-op Meters.
+	disabled bool
 }
 
 // readerSignals returns a force-flush and shutdown function for a
@@ -68,16 +82,22 @@ func unifyShutdown(funcs []func(context.Context) error) func(context.Context) er
 
 // newConfig returns a config configured with options.
 func newConfig(options []Option) config {
-	conf := config{
-		res:            resource.Default(),
-		exemplarFilter: exemplar.TraceBasedFilter,
-	}
-	for _, o := range meterProviderOptionsFromEnv() {
-		conf = o.apply(conf)
-	}
+	conf := config{res: resource.Default()}
 	for _, o := range options {
 		conf = o.apply(conf)
 	}
+	if !conf.ignoreEnv {
+		conf.disabled = env.SDKDisabled()
+		if conf.exemplarFilter == nil {
+			// Options have precedence.
+			for _, o := range meterProviderOptionsFromEnv() {
+				conf = o.apply(conf)
+			}
+		}
+	}
+	if conf.exemplarFilter == nil {
+		conf.exemplarFilter = exemplar.TraceBasedFilter
+	}
 	return conf
 }
 
@@ -105,8 +125,24 @@ func WithResource(res *resource.Resource) Option {
 		var err error
 		conf.res, err = resource.Merge(resource.Environment(), res)
 		if err != nil {
-			otel.Handle(err)
+			handle(context.Background(), otel.SeverityWarning, err)
 		}
+		conf.asyncRes = nil
+		return conf
+	})
+}
+
+// WithAsyncResource associates the asynchronously detected Resource a with a
+// MeterProvider. Metrics are associated with the Resource a has detected at
+// the time they are collected, so they are produced with a partial Resource
+// until detection is complete and reflect any subsequent refresh of a.
+//
+// Unlike WithResource, the Resource is not merged with the
+// resource.Environment() Resource. Use resource.WithFromEnv when creating a
+// to include it.
+func WithAsyncResource(a *resource.Async) Option {
+	return optionFunc(func(conf config) config {
+		conf.asyncRes = a
 		return conf
 	})
 }
@@ -145,9 +181,16 @@ func WithView(views ...View) Option {
 // exemplar reservoir, but the exemplar reservoir makes the final decision of
 // whether to store an exemplar.
 //
-// By default, the [exemplar.SampledFilter]
-// is used. Exemplars can be entirely disabled by providing the
-// [exemplar.AlwaysOffFilter].
+// The filter can also be selected with the OTEL_METRICS_EXEMPLAR_FILTER
+// environment variable: "trace_based" for [exemplar.TraceBasedFilter],
+// "always_on" for [exemplar.AlwaysOnFilter], and "always_off" for
+// [exemplar.AlwaysOffFilter]. This option takes precedence over the
+// environment variable.
+//
+// By default, the [exemplar.TraceBasedFilter] is used. Exemplars can be
+// entirely disabled by providing the [exemplar.AlwaysOffFilter], or only be
+// recorded for the measurements made in the context of targeted requests by
+// providing an [exemplar.BaggageFilter].
 func WithExemplarFilter(filter exemplar.Filter) Option {
 	return optionFunc(func(cfg config) config {
 		cfg.exemplarFilter = filter
@@ -155,6 +198,92 @@ func WithExemplarFilter(filter exemplar.Filter) Option {
 	})
 }
 
+// WithContextAttributes configures the MeterProvider to add the attributes
+// stored in the context of a measurement, with
+// [go.opentelemetry.io/otel/metric.ContextWithAttributes], to the measurements
+// of synchronous instruments. This allows attributes to be set once, e.g. by a
+// middleware, instead of being passed to every measurement.
+//
+// If keys are provided, only the context attributes with one of these keys are
+// added. Otherwise, all of them are added.
+//
+// Attributes of a measurement take precedence over the context attributes
+// with the same key.
+//
+// By default, if this option is not used, the context attributes are ignored.
+func WithContextAttributes(keys ...attribute.Key) Option {
+	return optionFunc(func(cfg config) config {
+		c := &contextAttributes{}
+		if len(keys) > 0 {
+			c.filter = attribute.NewAllowKeysFilter(keys...)
+		}
+		cfg.ctxAttrs = c
+		return cfg
+	})
+}
+
+// WithStrictUnits configures the MeterProvider to validate that the units of
+// the instruments are [UCUM] case-sensitive codes. An error wrapping
+// ErrInstrumentUnit is returned when an instrument with an invalid unit is
+// created. The instrument is still created and usable.
+//
+// By default, if this option is not used, units are not validated.
+//
+// [UCUM]: https://ucum.org
+func WithStrictUnits() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.strictUnits = true
+		return cfg
+	})
+}
+
+// WithInternalLogger configures the logger used by the MeterProvider, and the
+// Meters it creates, to log internal diagnostics. This allows those
+// diagnostics to be routed to the logger of the application instead of the
+// global logger set with go.opentelemetry.io/otel.SetLogger.
+//
+// Messages are logged with the same verbosity levels as the global logger:
+// warnings with V(1), informational messages with V(4), and debug messages
+// with V(8). Errors are logged with the Error method of l.
+//
+// The ManualReaders and PeriodicReaders of the MeterProvider also log with l.
+//
+// By default, if this option is not used, the global logger is used.
+func WithInternalLogger(l logr.Logger) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.log = cfg.log.WithLogger(l)
+		return cfg
+	})
+}
+
+// WithInternalLogLevel configures the most verbose level of the internal
+// diagnostics logged by the MeterProvider, its Meters, and its Readers.
+// Messages logged with a greater verbosity are dropped: use 0 to only log
+// errors, 1 to also log warnings, 4 informational messages, and 8 debug
+// messages.
+//
+// By default, if this option is not used, all messages are passed to the
+// logger, which decides the verbosity levels it logs.
+func WithInternalLogLevel(level int) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.log = cfg.log.WithLevel(logging.Level(level))
+		return cfg
+	})
+}
+
+// WithoutEnvironment configures the MeterProvider to ignore the
+// OTEL_SDK_DISABLED and OTEL_METRICS_EXEMPLAR_FILTER environment variables.
+// The defaults are used for the settings not configured by options.
+//
+// This does not apply to the Readers registered with the MeterProvider. Use
+// WithoutPeriodicReaderEnvironment for a PeriodicReader.
+func WithoutEnvironment() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.ignoreEnv = true
+		return cfg
+	})
+}
+
 func meterProviderOptionsFromEnv() []Option {
 	var opts []Option
 	// https://github.com/open-telemetry/opentelemetry-specification/blob/d4b241f451674e8f611bb589477680341006ad2b/specification/configuration/sdk-environment-variables.md#exemplar
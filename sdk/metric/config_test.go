@@ -13,6 +13,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/sdk/internal/env"
 	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/resource"
@@ -293,6 +295,13 @@ func TestWithExemplarFilterOff(t *testing.T) {
 			expectFilterSampled:    true,
 			expectFilterNotSampled: true,
 		},
+		{
+			desc:                   "without environment",
+			env:                    "always_off",
+			opts:                   []Option{WithoutEnvironment()},
+			expectFilterSampled:    true,
+			expectFilterNotSampled: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			if tc.env != "" {
@@ -314,3 +323,21 @@ func sample(parent context.Context) context.Context {
 	})
 	return trace.ContextWithSpanContext(parent, sc)
 }
+
+func TestSDKDisabled(t *testing.T) {
+	t.Setenv(env.SDKDisabledKey, "true")
+	assert.True(t, newConfig(nil).disabled)
+	assert.False(t, newConfig([]Option{WithoutEnvironment()}).disabled)
+
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr))
+	assert.IsType(t, noop.Meter{}, mp.Meter("testing"))
+
+	// The readers are not registered, but they are shut down.
+	err := rdr.Collect(context.Background(), &metricdata.ResourceMetrics{})
+	assert.ErrorIs(t, err, ErrReaderNotRegistered)
+	assert.NoError(t, mp.ForceFlush(context.Background()))
+	assert.NoError(t, mp.Shutdown(context.Background()))
+	err = rdr.Collect(context.Background(), &metricdata.ResourceMetrics{})
+	assert.ErrorIs(t, err, ErrReaderShutdown)
+}
@@ -201,6 +201,29 @@ func ExampleNewView_drop() {
 	)
 }
 
+func ExampleNewView_unitConversion() {
+	// Create a view that converts the instruments of the "http"
+	// instrumentation library recording durations in milliseconds to record
+	// them in seconds, as the semantic conventions require.
+	conv, err := metric.NewUnitConversion("ms", "s")
+	if err != nil {
+		log.Fatalln(err)
+	}
+	view := metric.NewView(
+		metric.Instrument{
+			Name:  "*",
+			Scope: instrumentation.Scope{Name: "http"},
+		},
+		metric.Stream{UnitConversion: conv},
+	)
+
+	// The created view can then be registered with the OpenTelemetry metric
+	// SDK using the WithView option.
+	_ = metric.NewMeterProvider(
+		metric.WithView(view),
+	)
+}
+
 func ExampleNewView_attributeFilter() {
 	// Create a view that removes the "http.request.method" attribute recorded
 	// by the "latency" instrument from the "http" instrumentation library.
@@ -274,6 +297,15 @@ func ExampleWithExemplarFilter_disabled() {
 	)
 }
 
+func ExampleWithExemplarFilter_baggage() {
+	// Use exemplar.BaggageFilter to only record exemplars for the
+	// measurements made in the context of requests marked with the
+	// "synthetic" baggage member, e.g. by a synthetic test client.
+	_ = metric.NewMeterProvider(
+		metric.WithExemplarFilter(exemplar.BaggageFilter("synthetic", "true")),
+	)
+}
+
 func ExampleWithExemplarFilter_custom() {
 	// Create a custom filter function that only offers measurements if the
 	// context has an error.
@@ -5,7 +5,9 @@ package exemplar // import "go.opentelemetry.io/otel/sdk/metric/exemplar"
 
 import (
 	"context"
+	"slices"
 
+	"go.opentelemetry.io/otel/baggage"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -32,3 +34,22 @@ func AlwaysOnFilter(ctx context.Context) bool {
 func AlwaysOffFilter(ctx context.Context) bool {
 	return false
 }
+
+// BaggageFilter returns a [Filter] that only offers measurements if the
+// [go.opentelemetry.io/otel/baggage.Baggage] of the context associated with
+// the measurement contains a member with key. If values are provided, the
+// value of the member also needs to be one of them.
+//
+// This allows exemplars to be only recorded for targeted requests, e.g.
+// synthetic test traffic marked with a baggage member by its client, or
+// requests marked in-process with
+// [go.opentelemetry.io/otel/baggage.ContextWithBaggage].
+func BaggageFilter(key string, values ...string) Filter {
+	return func(ctx context.Context) bool {
+		m := baggage.FromContext(ctx).Member(key)
+		if m.Key() == "" {
+			return false
+		}
+		return len(values) == 0 || slices.Contains(values, m.Value())
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel/baggage"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -44,3 +46,29 @@ func testAlwaysOnFiltered[N int64 | float64](t *testing.T) {
 	assert.True(t, AlwaysOnFilter(ctx), "non-sampled context should not be offered")
 	assert.True(t, AlwaysOnFilter(sample(ctx)), "sampled context should be offered")
 }
+
+func TestBaggageFilter(t *testing.T) {
+	ctxWith := func(t *testing.T, key, value string) context.Context {
+		m, err := baggage.NewMember(key, value)
+		require.NoError(t, err)
+		b, err := baggage.New(m)
+		require.NoError(t, err)
+		return baggage.ContextWithBaggage(context.Background(), b)
+	}
+
+	t.Run("Key", func(t *testing.T) {
+		f := BaggageFilter("synthetic")
+		assert.False(t, f(context.Background()), "context without baggage should not be offered")
+		assert.False(t, f(ctxWith(t, "other", "true")), "context without member should not be offered")
+		assert.True(t, f(ctxWith(t, "synthetic", "true")), "context with member should be offered")
+		assert.True(t, f(ctxWith(t, "synthetic", "")), "context with empty member should be offered")
+	})
+
+	t.Run("Values", func(t *testing.T) {
+		f := BaggageFilter("synthetic", "true", "1")
+		assert.False(t, f(context.Background()), "context without baggage should not be offered")
+		assert.False(t, f(ctxWith(t, "synthetic", "false")), "context with other value should not be offered")
+		assert.True(t, f(ctxWith(t, "synthetic", "true")), "context with value should be offered")
+		assert.True(t, f(ctxWith(t, "synthetic", "1")), "context with value should be offered")
+	})
+}
@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// component is the name of the component reported in the Diagnostics sent to
+// the global ErrorHandler.
+const component = "go.opentelemetry.io/otel/sdk/metric"
+
+// handle sends err to the global ErrorHandler as a Diagnostic with the
+// severity and attributes passed.
+func handle(ctx context.Context, severity otel.Severity, err error, attrs ...attribute.KeyValue) {
+	otel.HandleDiagnostic(ctx, otel.Diagnostic{
+		Component:  component,
+		Severity:   severity,
+		Err:        err,
+		Attributes: attrs,
+	})
+}
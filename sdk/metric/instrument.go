@@ -9,9 +9,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
@@ -71,6 +74,11 @@ type Instrument struct {
 	// Scope identifies the instrumentation that created the instrument.
 	Scope instrumentation.Scope
 
+	// attributeKeys are the advisory attribute keys the instrument was
+	// created with. They are used as the attribute filter of streams that do
+	// not define one.
+	attributeKeys []attribute.Key
+
 	// Ensure forward compatibility if non-comparable fields need to be added.
 	nonComparable // nolint: unused
 }
@@ -127,6 +135,17 @@ func (i Instrument) matchesScope(other Instrument) bool {
 		(i.Scope.SchemaURL == "" || i.Scope.SchemaURL == other.Scope.SchemaURL)
 }
 
+// adviseFilter returns stream with an AttributeFilter that only allows the
+// advisory attribute keys of i if stream does not already have a filter and i
+// was created with advisory attribute keys. Otherwise, stream is returned
+// unchanged.
+func (i Instrument) adviseFilter(stream Stream) Stream {
+	if stream.AttributeFilter == nil && i.attributeKeys != nil {
+		stream.AttributeFilter = attribute.NewAllowKeysFilter(i.attributeKeys...)
+	}
+	return stream
+}
+
 // Stream describes the stream of data an instrument produces.
 type Stream struct {
 	// Name is the human-readable identifier of the stream.
@@ -144,6 +163,10 @@ type Stream struct {
 	//
 	// Use NewAllowKeysFilter from "go.opentelemetry.io/otel/attribute" to
 	// provide an allow-list of attribute keys here.
+	//
+	// If unset, and the instrument was created with advisory attribute keys
+	// (see WithAttributeKeys from "go.opentelemetry.io/otel/metric"), only
+	// attributes with those keys are recorded.
 	AttributeFilter attribute.Filter
 	// ExemplarReservoirProvider selects the
 	// [go.opentelemetry.io/otel/sdk/metric/exemplar.ReservoirProvider] based
@@ -151,6 +174,39 @@ type Stream struct {
 	//
 	// If unspecified, [DefaultExemplarReservoirProviderSelector] is used.
 	ExemplarReservoirProviderSelector ExemplarReservoirProviderSelector
+	// ExtremaStreams, if true, adds two Gauge streams for an asynchronous
+	// UpDownCounter or Gauge instrument. They are named with the ".min" and
+	// ".max" suffixes and contain the minimum and maximum values observed
+	// for each attribute set. This captures the spikes of observations that
+	// the aggregation of the stream does not report.
+	//
+	// With cumulative temporality, the extrema are of all the observations
+	// made since the stream was created. With delta temporality, they are of
+	// the observations made since the previous collection, which are usually
+	// the single observation made for each attribute set by the callbacks
+	// of the collection.
+	//
+	// This is ignored for other instrument kinds.
+	ExtremaStreams bool
+	// UnitConversion, if not the zero value, converts the measurements of
+	// the instrument before they are aggregated, and the Unit of the stream
+	// is replaced by the unit converted to. This allows instrumentation
+	// recording in non-standard units (e.g. ms instead of s) to be corrected
+	// in the SDK.
+	//
+	// Bucket boundaries of a histogram aggregation are in the unit converted
+	// to. The default boundaries are not rescaled: they suit measurements
+	// in milliseconds, so after a conversion from ms to s most measurements
+	// fall in the first bucket. Use an AggregationExplicitBucketHistogram
+	// with boundaries in the unit converted to instead. The bounds, sum,
+	// minimum, and maximum of the buckets recorded with RecordBuckets are
+	// converted like measurements.
+	//
+	// Conversions to a larger unit (e.g. ms to s), or between units whose
+	// scales are not multiples of each other (e.g. KiBy to kBy), are not
+	// applied to int64 instruments, as they would lose precision: an error
+	// is logged and the measurements are not converted.
+	UnitConversion UnitConversion
 }
 
 // instID are the identifying properties of a instrument.
@@ -178,7 +234,21 @@ func (i instID) normalize() instID {
 }
 
 type int64Inst struct {
+	// meter is the meter that created the instrument.
+	meter    *meter
 	measures []aggregate.Measure[int64]
+	// bucketMeasures are the inputs of buckets of the aggregations of a
+	// histogram instrument.
+	bucketMeasures []aggregate.MeasureBuckets
+	// attrs are the constant attributes of the meter added to every
+	// measurement.
+	attrs attribute.Set
+	// ctxAttrs, if not nil, adds the attributes of the context to every
+	// measurement.
+	ctxAttrs *contextAttributes
+	// recordedKeys, if not nil, are the only attribute keys measurements are
+	// recorded with.
+	recordedKeys []attribute.Key
 
 	embedded.Int64Counter
 	embedded.Int64UpDownCounter
@@ -187,39 +257,91 @@ type int64Inst struct {
 }
 
 var (
-	_ metric.Int64Counter       = (*int64Inst)(nil)
-	_ metric.Int64UpDownCounter = (*int64Inst)(nil)
-	_ metric.Int64Histogram     = (*int64Inst)(nil)
-	_ metric.Int64Gauge         = (*int64Inst)(nil)
-	_ x.EnabledInstrument       = (*int64Inst)(nil)
+	_ metric.Int64Counter         = (*int64Inst)(nil)
+	_ metric.Int64UpDownCounter   = (*int64Inst)(nil)
+	_ metric.Int64Histogram       = (*int64Inst)(nil)
+	_ metric.Int64Gauge           = (*int64Inst)(nil)
+	_ x.EnabledInstrument         = (*int64Inst)(nil)
+	_ metric.AttributeKeyRecorder = (*int64Inst)(nil)
 )
 
 func (i *int64Inst) Add(ctx context.Context, val int64, opts ...metric.AddOption) {
 	c := metric.NewAddConfig(opts)
-	i.aggregate(ctx, val, c.Attributes())
+	i.aggregate(ctx, val, c.Attributes(), time.Time{})
 }
 
 func (i *int64Inst) Record(ctx context.Context, val int64, opts ...metric.RecordOption) {
 	c := metric.NewRecordConfig(opts)
-	i.aggregate(ctx, val, c.Attributes())
+	i.aggregate(ctx, val, c.Attributes(), c.Timestamp())
 }
 
 func (i *int64Inst) Enabled(_ context.Context) bool {
 	return len(i.measures) != 0
 }
 
+func (i *int64Inst) RecordsAttributeKey(key attribute.Key) bool {
+	return i.recordedKeys == nil || slices.Contains(i.recordedKeys, key)
+}
+
+func (i *int64Inst) Measurement(val int64) metric.Measurement {
+	return metric.NewInt64Measurement(i, val)
+}
+
+func (i *int64Inst) RecordBuckets(ctx context.Context, b metric.HistogramBuckets, opts ...metric.RecordOption) {
+	hb, ok := toBuckets(b)
+	if !ok {
+		return
+	}
+	c := metric.NewRecordConfig(opts)
+	s := mergeAttrs(i.attrs, i.ctxAttrs.from(ctx), c.Attributes())
+	for _, in := range i.bucketMeasures {
+		in(ctx, hb, s)
+	}
+}
+
+// withAttrs returns a copy of i that adds attrs to its measurements. If attrs
+// is empty, i is returned.
+func (i *int64Inst) withAttrs(attrs attribute.Set) *int64Inst {
+	if attrs.Len() == 0 {
+		return i
+	}
+	cp := *i
+	cp.attrs = attrs
+	return &cp
+}
+
 func (i *int64Inst) aggregate(
 	ctx context.Context,
 	val int64,
 	s attribute.Set,
+	t time.Time,
 ) { // nolint:revive  // okay to shadow pkg with method.
+	i.measure(ctx, val, mergeAttrs(i.attrs, i.ctxAttrs.from(ctx), s), t)
+}
+
+// measure makes a measurement of val with the already merged attributes s.
+func (i *int64Inst) measure(ctx context.Context, val int64, s attribute.Set, t time.Time) {
 	for _, in := range i.measures {
-		in(ctx, val, s)
+		in(ctx, val, s, t)
 	}
 }
 
 type float64Inst struct {
+	// meter is the meter that created the instrument.
+	meter    *meter
 	measures []aggregate.Measure[float64]
+	// bucketMeasures are the inputs of buckets of the aggregations of a
+	// histogram instrument.
+	bucketMeasures []aggregate.MeasureBuckets
+	// attrs are the constant attributes of the meter added to every
+	// measurement.
+	attrs attribute.Set
+	// ctxAttrs, if not nil, adds the attributes of the context to every
+	// measurement.
+	ctxAttrs *contextAttributes
+	// recordedKeys, if not nil, are the only attribute keys measurements are
+	// recorded with.
+	recordedKeys []attribute.Key
 
 	embedded.Float64Counter
 	embedded.Float64UpDownCounter
@@ -233,26 +355,135 @@ var (
 	_ metric.Float64Histogram     = (*float64Inst)(nil)
 	_ metric.Float64Gauge         = (*float64Inst)(nil)
 	_ x.EnabledInstrument         = (*float64Inst)(nil)
+	_ metric.AttributeKeyRecorder = (*float64Inst)(nil)
 )
 
 func (i *float64Inst) Add(ctx context.Context, val float64, opts ...metric.AddOption) {
 	c := metric.NewAddConfig(opts)
-	i.aggregate(ctx, val, c.Attributes())
+	i.aggregate(ctx, val, c.Attributes(), time.Time{})
 }
 
 func (i *float64Inst) Record(ctx context.Context, val float64, opts ...metric.RecordOption) {
 	c := metric.NewRecordConfig(opts)
-	i.aggregate(ctx, val, c.Attributes())
+	i.aggregate(ctx, val, c.Attributes(), c.Timestamp())
 }
 
 func (i *float64Inst) Enabled(_ context.Context) bool {
 	return len(i.measures) != 0
 }
 
-func (i *float64Inst) aggregate(ctx context.Context, val float64, s attribute.Set) {
+func (i *float64Inst) RecordsAttributeKey(key attribute.Key) bool {
+	return i.recordedKeys == nil || slices.Contains(i.recordedKeys, key)
+}
+
+func (i *float64Inst) Measurement(val float64) metric.Measurement {
+	return metric.NewFloat64Measurement(i, val)
+}
+
+func (i *float64Inst) RecordBuckets(ctx context.Context, b metric.HistogramBuckets, opts ...metric.RecordOption) {
+	hb, ok := toBuckets(b)
+	if !ok {
+		return
+	}
+	c := metric.NewRecordConfig(opts)
+	s := mergeAttrs(i.attrs, i.ctxAttrs.from(ctx), c.Attributes())
+	for _, in := range i.bucketMeasures {
+		in(ctx, hb, s)
+	}
+}
+
+// withAttrs returns a copy of i that adds attrs to its measurements. If attrs
+// is empty, i is returned.
+func (i *float64Inst) withAttrs(attrs attribute.Set) *float64Inst {
+	if attrs.Len() == 0 {
+		return i
+	}
+	cp := *i
+	cp.attrs = attrs
+	return &cp
+}
+
+func (i *float64Inst) aggregate(ctx context.Context, val float64, s attribute.Set, t time.Time) {
+	i.measure(ctx, val, mergeAttrs(i.attrs, i.ctxAttrs.from(ctx), s), t)
+}
+
+// measure makes a measurement of val with the already merged attributes s.
+func (i *float64Inst) measure(ctx context.Context, val float64, s attribute.Set, t time.Time) {
 	for _, in := range i.measures {
-		in(ctx, val, s)
+		in(ctx, val, s, t)
+	}
+}
+
+var errInvalidBuckets = errors.New("invalid histogram buckets")
+
+// toBuckets returns b as the buckets measured by the aggregations. It
+// returns false if b is empty, or invalid in which case the error is logged.
+func toBuckets(b metric.HistogramBuckets) (aggregate.Buckets, bool) {
+	if len(b.Counts) != len(b.Bounds)+1 {
+		global.Error(errInvalidBuckets, "dropping histogram buckets",
+			"bounds", len(b.Bounds), "counts", len(b.Counts))
+		return aggregate.Buckets{}, false
+	}
+	for i := 1; i < len(b.Bounds); i++ {
+		if b.Bounds[i] <= b.Bounds[i-1] {
+			global.Error(errInvalidBuckets, "dropping histogram buckets",
+				"bounds", b.Bounds)
+			return aggregate.Buckets{}, false
+		}
+	}
+	if b.Count() == 0 {
+		return aggregate.Buckets{}, false
+	}
+	return aggregate.Buckets{
+		Bounds: b.Bounds,
+		Counts: b.Counts,
+		Sum:    b.Sum,
+		Min:    b.Min,
+		Max:    b.Max,
+	}, true
+}
+
+// contextAttributes selects the attributes stored in the context of a
+// measurement, with metric.ContextWithAttributes, that are added to it.
+type contextAttributes struct {
+	// filter, if not nil, selects the context attributes added.
+	filter attribute.Filter
+}
+
+// from returns the attributes of ctx that c adds to a measurement. An empty set
+// is returned if c is nil.
+func (c *contextAttributes) from(ctx context.Context) attribute.Set {
+	if c == nil {
+		return *attribute.EmptySet()
+	}
+	s := metric.AttributesFromContext(ctx)
+	if c.filter != nil && s.Len() > 0 {
+		s, _ = s.Filter(c.filter)
+	}
+	return s
+}
+
+// mergeAttrs returns the union of sets. For keys in several of them, the value
+// of the last set is used: the constant attributes of a meter are overridden
+// by the attributes of the context, which are overridden by the attributes of
+// the measurement.
+func mergeAttrs(sets ...attribute.Set) attribute.Set {
+	var n, last int
+	for i, s := range sets {
+		if s.Len() > 0 {
+			n += s.Len()
+			last = i
+		}
+	}
+	if n == sets[last].Len() {
+		// At most one set is not empty.
+		return sets[last]
+	}
+	kvs := make([]attribute.KeyValue, 0, n)
+	for _, s := range sets {
+		kvs = append(kvs, s.ToSlice()...)
 	}
+	return attribute.NewSet(kvs...)
 }
 
 // observableID is a comparable unique identifier of an observable.
@@ -267,6 +498,9 @@ type observableID[N int64 | float64] struct {
 type float64Observable struct {
 	metric.Float64Observable
 	*observable[float64]
+	// attrs are the constant attributes of the meter that returned the
+	// observable. They are added to the observations made with it.
+	attrs attribute.Set
 
 	embedded.Float64ObservableCounter
 	embedded.Float64ObservableUpDownCounter
@@ -288,6 +522,9 @@ func newFloat64Observable(m *meter, kind InstrumentKind, name, desc, u string) f
 type int64Observable struct {
 	metric.Int64Observable
 	*observable[int64]
+	// attrs are the constant attributes of the meter that returned the
+	// observable. They are added to the observations made with it.
+	attrs attribute.Set
 
 	embedded.Int64ObservableCounter
 	embedded.Int64ObservableUpDownCounter
@@ -342,7 +579,7 @@ type measures[N int64 | float64] []aggregate.Measure[N]
 // observe records the val for the set of attrs.
 func (m measures[N]) observe(val N, s attribute.Set) {
 	for _, in := range m {
-		in(context.Background(), val, s)
+		in(context.Background(), val, s, time.Time{})
 	}
 }
 
@@ -356,7 +593,7 @@ func (o *observable[N]) registerable(m *meter) error {
 	if len(o.measures) == 0 {
 		return errEmptyAgg
 	}
-	if m != o.meter {
+	if !m.sameScope(o.meter) {
 		return fmt.Errorf(
 			"invalid registration: observable %q from Meter %q, registered with Meter %q",
 			o.name,
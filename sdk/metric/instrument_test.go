@@ -6,6 +6,7 @@ package metric
 import (
 	"context"
 	"testing"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
@@ -47,7 +48,7 @@ func BenchmarkInstrument(b *testing.B) {
 		b.ReportAllocs()
 		b.ResetTimer()
 		for i := 0; i < b.N; i++ {
-			inst.aggregate(ctx, int64(i), attr(i))
+			inst.aggregate(ctx, int64(i), attr(i), time.Time{})
 		}
 	})
 
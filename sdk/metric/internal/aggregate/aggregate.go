@@ -16,7 +16,15 @@ import (
 var now = time.Now
 
 // Measure receives measurements to be aggregated.
-type Measure[N int64 | float64] func(context.Context, N, attribute.Set)
+//
+// The time.Time argument is the time the measurement was made at. It is the
+// zero time if the measurement was made when it was received. Only the
+// last-value aggregate functions use this time.
+type Measure[N int64 | float64] func(context.Context, N, attribute.Set, time.Time)
+
+// MeasureBuckets receives measurements already aggregated into buckets to be
+// aggregated.
+type MeasureBuckets func(context.Context, Buckets, attribute.Set)
 
 // ComputeAggregation stores the aggregate of measurements into dest and
 // returns the number of aggregate data-points output.
@@ -57,19 +65,30 @@ func (b Builder[N]) resFunc() func(attribute.Set) FilteredExemplarReservoir[N] {
 	return dropReservoir
 }
 
-type fltrMeasure[N int64 | float64] func(ctx context.Context, value N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue)
+type fltrMeasure[N int64 | float64] func(ctx context.Context, value N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue, t time.Time)
 
 func (b Builder[N]) filter(f fltrMeasure[N]) Measure[N] {
 	if b.Filter != nil {
 		fltr := b.Filter // Copy to make it immutable after assignment.
-		return func(ctx context.Context, n N, a attribute.Set) {
+		return func(ctx context.Context, n N, a attribute.Set, t time.Time) {
 			fAttr, dropped := a.Filter(fltr)
-			f(ctx, n, fAttr, dropped)
+			f(ctx, n, fAttr, dropped, t)
 		}
 	}
-	return func(ctx context.Context, n N, a attribute.Set) {
-		f(ctx, n, a, nil)
+	return func(ctx context.Context, n N, a attribute.Set, t time.Time) {
+		f(ctx, n, a, nil, t)
+	}
+}
+
+func (b Builder[N]) filterBuckets(f MeasureBuckets) MeasureBuckets {
+	if b.Filter != nil {
+		fltr := b.Filter // Copy to make it immutable after assignment.
+		return func(ctx context.Context, hb Buckets, a attribute.Set) {
+			fAttr, _ := a.Filter(fltr)
+			f(ctx, hb, fAttr)
+		}
 	}
+	return f
 }
 
 // LastValue returns a last-value aggregate function input and output.
@@ -119,33 +138,52 @@ func (b Builder[N]) Sum(monotonic bool) (Measure[N], ComputeAggregation) {
 	}
 }
 
-// ExplicitBucketHistogram returns a histogram aggregate function input and
-// output.
+// ExplicitBucketHistogram returns a histogram aggregate function input, the
+// input of measurements already aggregated into buckets, and output.
 func (b Builder[N]) ExplicitBucketHistogram(
 	boundaries []float64,
 	noMinMax, noSum bool,
-) (Measure[N], ComputeAggregation) {
+) (Measure[N], MeasureBuckets, ComputeAggregation) {
 	h := newHistogram[N](boundaries, noMinMax, noSum, b.AggregationLimit, b.resFunc())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(h.measure), h.delta
+		return b.filter(h.measure), b.filterBuckets(h.measureBuckets), h.delta
 	default:
-		return b.filter(h.measure), h.cumulative
+		return b.filter(h.measure), b.filterBuckets(h.measureBuckets), h.cumulative
 	}
 }
 
-// ExponentialBucketHistogram returns a histogram aggregate function input and
-// output.
+// ExponentialBucketHistogram returns a histogram aggregate function input, the
+// input of measurements already aggregated into buckets, and output.
 func (b Builder[N]) ExponentialBucketHistogram(
 	maxSize, maxScale int32,
 	noMinMax, noSum bool,
-) (Measure[N], ComputeAggregation) {
+) (Measure[N], MeasureBuckets, ComputeAggregation) {
 	h := newExponentialHistogram[N](maxSize, maxScale, noMinMax, noSum, b.AggregationLimit, b.resFunc())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(h.measure), h.delta
+		return b.filter(h.measure), b.filterBuckets(h.measureBuckets), h.delta
+	default:
+		return b.filter(h.measure), b.filterBuckets(h.measureBuckets), h.cumulative
+	}
+}
+
+// Extrema returns an aggregate function input and the outputs of the minimum
+// and maximum of the measurements made for each attribute set. With delta
+// temporality, the extrema are of the measurements made since the last
+// collection. Otherwise, they are of all measurements made.
+func (b Builder[N]) Extrema() (meas Measure[N], minComp, maxComp ComputeAggregation) {
+	minAgg := newExtremum[N](false, b.AggregationLimit)
+	maxAgg := newExtremum[N](true, b.AggregationLimit)
+	meas = b.filter(func(ctx context.Context, v N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue, t time.Time) {
+		minAgg.measure(ctx, v, fltrAttr, droppedAttr, t)
+		maxAgg.measure(ctx, v, fltrAttr, droppedAttr, t)
+	})
+	switch b.Temporality {
+	case metricdata.DeltaTemporality:
+		return meas, minAgg.delta, maxAgg.delta
 	default:
-		return b.filter(h.measure), h.cumulative
+		return meas, minAgg.cumulative, maxAgg.cumulative
 	}
 }
 
@@ -90,12 +90,12 @@ func testBuilderFilter[N int64 | float64]() func(t *testing.T) {
 			return func(t *testing.T) {
 				t.Helper()
 
-				meas := b.filter(func(_ context.Context, v N, f attribute.Set, d []attribute.KeyValue) {
+				meas := b.filter(func(_ context.Context, v N, f attribute.Set, d []attribute.KeyValue, _ time.Time) {
 					assert.Equal(t, value, v, "measured incorrect value")
 					assert.Equal(t, wantF, f, "measured incorrect filtered attributes")
 					assert.ElementsMatch(t, wantD, d, "measured incorrect dropped attributes")
 				})
-				meas(context.Background(), value, attr)
+				meas(context.Background(), value, attr, time.Time{})
 			}
 		}
 
@@ -128,7 +128,7 @@ func test[N int64 | float64](meas Measure[N], comp ComputeAggregation, steps []t
 		got := new(metricdata.Aggregation)
 		for i, step := range steps {
 			for _, args := range step.input {
-				meas(args.ctx, args.value, args.attr)
+				meas(args.ctx, args.value, args.attr, time.Time{})
 			}
 
 			t.Logf("step: %d", i)
@@ -166,7 +166,7 @@ func benchmarkAggregateN[N int64 | float64](b *testing.B, factory func() (Measur
 
 		for n := 0; n < b.N; n++ {
 			for _, attr := range attrs {
-				meas(ctx, 1, attr)
+				meas(ctx, 1, attr, time.Time{})
 			}
 		}
 
@@ -178,7 +178,7 @@ func benchmarkAggregateN[N int64 | float64](b *testing.B, factory func() (Measur
 		for n := range comps {
 			meas, comp := factory()
 			for _, attr := range attrs {
-				meas(ctx, 1, attr)
+				meas(ctx, 1, attr, time.Time{})
 			}
 			comps[n] = comp
 		}
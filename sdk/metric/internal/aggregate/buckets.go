@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregate // import "go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+
+import "math"
+
+// Buckets are measurements already aggregated into explicit buckets.
+type Buckets struct {
+	// Bounds are the inclusive upper bounds of the buckets in increasing
+	// order.
+	Bounds []float64
+	// Counts are the number of measurements in each bucket. It contains
+	// len(Bounds)+1 elements.
+	Counts []uint64
+
+	Sum, Min, Max float64
+}
+
+// representative returns the value the measurements of the bucket at idx are
+// assumed to have when they are re-bucketed: the upper bound of the bucket.
+// For the overflow bucket, it is the maximum measurement if it is greater than
+// the last bound, otherwise the smallest value greater than that bound.
+func (b Buckets) representative(idx int) float64 {
+	if idx < len(b.Bounds) {
+		return b.Bounds[idx]
+	}
+	if len(b.Bounds) == 0 {
+		return b.Max
+	}
+	return max(b.Max, math.Nextafter(b.Bounds[len(b.Bounds)-1], math.Inf(1)))
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregate // import "go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBucketsRepresentative(t *testing.T) {
+	b := Buckets{Bounds: []float64{1, 5}, Max: 3}
+	assert.Equal(t, 1.0, b.representative(0))
+	assert.Equal(t, 5.0, b.representative(1))
+	assert.Equal(t, math.Nextafter(5, math.Inf(1)), b.representative(2))
+
+	b.Max = 20
+	assert.Equal(t, 20.0, b.representative(2))
+
+	assert.Equal(t, 7.0, Buckets{Max: 7}.representative(0))
+}
+
+func TestHistogramMeasureBuckets(t *testing.T) {
+	h := newHistogram[float64]([]float64{1, 5, 10}, false, false, 0, dropExemplars[float64])
+
+	ctx := context.Background()
+	h.measureBuckets(ctx, Buckets{
+		Bounds: []float64{1, 5},
+		Counts: []uint64{2, 3, 4},
+		Sum:    50,
+		Min:    0.5,
+		Max:    20,
+	}, alice)
+	h.measure(ctx, 7, alice, nil, time.Time{})
+
+	b := h.values[alice.Equivalent()]
+	require.NotNil(t, b)
+	assert.Equal(t, []uint64{2, 3, 1, 4}, b.counts)
+	assert.Equal(t, uint64(10), b.count)
+	assert.Equal(t, 57.0, b.total)
+	assert.Equal(t, 0.5, b.min)
+	assert.Equal(t, 20.0, b.max)
+}
+
+func TestExpoHistogramRecordBuckets(t *testing.T) {
+	got := newExpoHistogramDataPoint[float64](alice, 4, 20, false, false)
+	got.recordBuckets(Buckets{
+		Bounds: []float64{2, 4},
+		Counts: []uint64{1, 3, 0},
+		Sum:    11,
+		Min:    1.5,
+		Max:    4,
+	})
+
+	// The measurements are binned as the upper bound of their bucket.
+	want := newExpoHistogramDataPoint[float64](alice, 4, 20, false, false)
+	for _, v := range []float64{2, 4, 4, 4} {
+		want.record(v)
+	}
+
+	assert.Equal(t, want.scale, got.scale, "scale")
+	assert.Equal(t, want.posBuckets, got.posBuckets, "buckets")
+	assert.Equal(t, uint64(4), got.count, "count")
+	assert.Equal(t, 11.0, got.sum, "sum")
+	assert.Equal(t, 1.5, got.min, "min")
+	assert.Equal(t, 4.0, got.max, "max")
+}
@@ -88,10 +88,41 @@ func (p *expoHistogramDataPoint[N]) record(v N) {
 		p.sum += v
 	}
 
-	absV := math.Abs(float64(v))
+	p.bin(float64(v), 1)
+}
+
+// recordBuckets adds the pre-aggregated measurements of hb to the histogram.
+// The measurements of each bucket of hb are binned as the representative
+// value of that bucket.
+func (p *expoHistogramDataPoint[N]) recordBuckets(hb Buckets) {
+	for i, n := range hb.Counts {
+		if n == 0 {
+			continue
+		}
+		p.count += n
+		p.bin(hb.representative(i), n)
+	}
+
+	if !p.noMinMax {
+		if N(hb.Min) < p.min {
+			p.min = N(hb.Min)
+		}
+		if N(hb.Max) > p.max {
+			p.max = N(hb.Max)
+		}
+	}
+	if !p.noSum {
+		p.sum += N(hb.Sum)
+	}
+}
 
-	if float64(absV) == 0.0 {
-		p.zeroCount++
+// bin adds n measurements of v to the buckets. It will rescale the buckets if
+// needed.
+func (p *expoHistogramDataPoint[N]) bin(v float64, n uint64) {
+	absV := math.Abs(v)
+
+	if absV == 0.0 {
+		p.zeroCount += n
 		return
 	}
 
@@ -120,6 +151,7 @@ func (p *expoHistogramDataPoint[N]) record(v N) {
 	}
 
 	bucket.record(bin)
+	bucket.counts[bin-bucket.startBin] += n - 1
 }
 
 // getBin returns the bin v should be recorded into.
@@ -329,6 +361,7 @@ func (e *expoHistogram[N]) measure(
 	value N,
 	fltrAttr attribute.Set,
 	droppedAttr []attribute.KeyValue,
+	_ time.Time,
 ) {
 	// Ignore NaN and infinity.
 	if math.IsInf(float64(value), 0) || math.IsNaN(float64(value)) {
@@ -338,6 +371,23 @@ func (e *expoHistogram[N]) measure(
 	e.valuesMu.Lock()
 	defer e.valuesMu.Unlock()
 
+	v := e.dataPoint(fltrAttr)
+	v.record(value)
+	v.res.Offer(ctx, value, droppedAttr)
+}
+
+// measureBuckets adds the pre-aggregated measurements of hb to the histogram
+// of fltrAttr.
+func (e *expoHistogram[N]) measureBuckets(_ context.Context, hb Buckets, fltrAttr attribute.Set) {
+	e.valuesMu.Lock()
+	defer e.valuesMu.Unlock()
+
+	e.dataPoint(fltrAttr).recordBuckets(hb)
+}
+
+// dataPoint returns the data point of fltrAttr, creating it if needed. The
+// valuesMu lock must be held.
+func (e *expoHistogram[N]) dataPoint(fltrAttr attribute.Set) *expoHistogramDataPoint[N] {
 	attr := e.limit.Attributes(fltrAttr, e.values)
 	v, ok := e.values[attr.Equivalent()]
 	if !ok {
@@ -346,8 +396,7 @@ func (e *expoHistogram[N]) measure(
 
 		e.values[attr.Equivalent()] = v
 	}
-	v.record(value)
-	v.res.Offer(ctx, value, droppedAttr)
+	return v
 }
 
 func (e *expoHistogram[N]) delta(dest *metricdata.Aggregation) int {
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregate // import "go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// extremumValue is the extremum of the measurements made for attrs.
+type extremumValue[N int64 | float64] struct {
+	attrs attribute.Set
+	value N
+}
+
+func newExtremum[N int64 | float64](isMax bool, limit int) *extremum[N] {
+	return &extremum[N]{
+		isMax:  isMax,
+		limit:  newLimiter[extremumValue[N]](limit),
+		values: make(map[attribute.Distinct]extremumValue[N]),
+		start:  now(),
+	}
+}
+
+// extremum summarizes the set of measurements made since the last collection
+// as the minimum, or maximum if isMax is true, of them.
+type extremum[N int64 | float64] struct {
+	sync.Mutex
+
+	isMax  bool
+	limit  limiter[extremumValue[N]]
+	values map[attribute.Distinct]extremumValue[N]
+	start  time.Time
+}
+
+func (s *extremum[N]) measure(_ context.Context, value N, fltrAttr attribute.Set, _ []attribute.KeyValue, _ time.Time) {
+	s.Lock()
+	defer s.Unlock()
+
+	attr := s.limit.Attributes(fltrAttr, s.values)
+	v, ok := s.values[attr.Equivalent()]
+	if ok && (s.isMax && value <= v.value || !s.isMax && value >= v.value) {
+		return
+	}
+	s.values[attr.Equivalent()] = extremumValue[N]{attrs: attr, value: value}
+}
+
+// delta stores the extrema into dest as a metricdata.Gauge and forgets them,
+// so the next collection only reports measurements made after this one.
+func (s *extremum[N]) delta(dest *metricdata.Aggregation) int {
+	t := now()
+	// Ignore if dest is not a metricdata.Gauge. The chance for memory reuse of
+	// the DataPoints is missed (better luck next time).
+	gData, _ := (*dest).(metricdata.Gauge[N])
+
+	s.Lock()
+	defer s.Unlock()
+
+	n := s.copyDpts(&gData.DataPoints, t)
+	clear(s.values)
+	s.start = t
+
+	*dest = gData
+
+	return n
+}
+
+// cumulative stores the extrema into dest as a metricdata.Gauge. The extrema
+// are kept, so the next collection reports the extrema of all measurements
+// made since s was created. This lets the extrema of asynchronous
+// instruments, observed once per collection, span multiple collections.
+func (s *extremum[N]) cumulative(dest *metricdata.Aggregation) int {
+	t := now()
+	// Ignore if dest is not a metricdata.Gauge. The chance for memory reuse of
+	// the DataPoints is missed (better luck next time).
+	gData, _ := (*dest).(metricdata.Gauge[N])
+
+	s.Lock()
+	defer s.Unlock()
+
+	n := s.copyDpts(&gData.DataPoints, t)
+	// TODO (#3006): This will use an unbounded amount of memory if there
+	// are unbounded number of attribute sets being aggregated. Attribute
+	// sets that become "stale" need to be forgotten so this will not
+	// overload the system.
+
+	*dest = gData
+
+	return n
+}
+
+// copyDpts copies the extrema held by s into dest. The number of datapoints
+// copied is returned.
+func (s *extremum[N]) copyDpts(dest *[]metricdata.DataPoint[N], t time.Time) int {
+	n := len(s.values)
+	*dest = reset(*dest, n, n)
+
+	var i int
+	for _, v := range s.values {
+		(*dest)[i].Attributes = v.attrs
+		(*dest)[i].StartTime = s.start
+		(*dest)[i].Time = t
+		(*dest)[i].Value = v.value
+		(*dest)[i].Exemplars = (*dest)[i].Exemplars[:0]
+		i++
+	}
+	return n
+}
@@ -0,0 +1,220 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregate // import "go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestExtrema(t *testing.T) {
+	c := new(clock)
+	t.Cleanup(c.Register())
+
+	t.Run("Int64/DeltaMin", testDeltaExtremum[int64](false))
+	c.Reset()
+	t.Run("Float64/DeltaMin", testDeltaExtremum[float64](false))
+	c.Reset()
+
+	t.Run("Int64/DeltaMax", testDeltaExtremum[int64](true))
+	c.Reset()
+	t.Run("Float64/DeltaMax", testDeltaExtremum[float64](true))
+	c.Reset()
+
+	t.Run("Int64/CumulativeMin", testCumulativeExtremum[int64](false))
+	c.Reset()
+	t.Run("Float64/CumulativeMin", testCumulativeExtremum[float64](false))
+	c.Reset()
+
+	t.Run("Int64/CumulativeMax", testCumulativeExtremum[int64](true))
+	c.Reset()
+	t.Run("Float64/CumulativeMax", testCumulativeExtremum[float64](true))
+}
+
+func testDeltaExtremum[N int64 | float64](isMax bool) func(*testing.T) {
+	in, minOut, maxOut := Builder[N]{
+		Temporality:      metricdata.DeltaTemporality,
+		Filter:           attrFltr,
+		AggregationLimit: 3,
+	}.Extrema()
+	out, aliceWant, bobWant := minOut, N(-2), N(-10)
+	if isMax {
+		out, aliceWant, bobWant = maxOut, 5, 3
+	}
+
+	ctx := context.Background()
+	return test[N](in, out, []teststep[N]{
+		{
+			// Empty output if nothing is measured.
+			input:  []arg[N]{},
+			expect: output{n: 0, agg: metricdata.Gauge[N]{}},
+		}, {
+			input: []arg[N]{
+				{ctx, 1, alice},
+				{ctx, 3, bob},
+				{ctx, -2, fltrAlice},
+				{ctx, 5, alice},
+				{ctx, -10, bob},
+			},
+			expect: output{
+				n: 2,
+				agg: metricdata.Gauge[N]{
+					DataPoints: []metricdata.DataPoint[N]{
+						{
+							Attributes: fltrAlice,
+							StartTime:  y2kPlus(2),
+							Time:       y2kPlus(3),
+							Value:      aliceWant,
+						},
+						{
+							Attributes: fltrBob,
+							StartTime:  y2kPlus(2),
+							Time:       y2kPlus(3),
+							Value:      bobWant,
+						},
+					},
+				},
+			},
+		}, {
+			// Extrema are reset every collection.
+			input:  []arg[N]{},
+			expect: output{n: 0, agg: metricdata.Gauge[N]{}},
+		}, {
+			input: []arg[N]{
+				{ctx, 1, alice},
+				{ctx, 2, bob},
+				{ctx, 3, carol},
+				{ctx, 4, dave},
+			},
+			expect: output{
+				n: 3,
+				agg: metricdata.Gauge[N]{
+					DataPoints: []metricdata.DataPoint[N]{
+						{
+							Attributes: fltrAlice,
+							StartTime:  y2kPlus(4),
+							Time:       y2kPlus(5),
+							Value:      1,
+						},
+						{
+							Attributes: fltrBob,
+							StartTime:  y2kPlus(4),
+							Time:       y2kPlus(5),
+							Value:      2,
+						},
+						{
+							Attributes: overflowSet,
+							StartTime:  y2kPlus(4),
+							Time:       y2kPlus(5),
+							Value:      map[bool]N{false: 3, true: 4}[isMax],
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func testCumulativeExtremum[N int64 | float64](isMax bool) func(*testing.T) {
+	in, minOut, maxOut := Builder[N]{
+		Temporality:      metricdata.CumulativeTemporality,
+		Filter:           attrFltr,
+		AggregationLimit: 3,
+	}.Extrema()
+	// The maximum is created after the minimum.
+	out, start := minOut, y2kPlus(0)
+	if isMax {
+		out, start = maxOut, y2kPlus(1)
+	}
+	// want returns the minimum, or maximum if isMax, of the values.
+	want := func(values ...N) N {
+		if isMax {
+			return slices.Max(values)
+		}
+		return slices.Min(values)
+	}
+
+	ctx := context.Background()
+	// The measurements of an asynchronous instrument, a single one per
+	// attribute set and collection.
+	return test[N](in, out, []teststep[N]{
+		{
+			input:  []arg[N]{},
+			expect: output{n: 0, agg: metricdata.Gauge[N]{}},
+		}, {
+			input: []arg[N]{
+				{ctx, 1, alice},
+				{ctx, 3, bob},
+			},
+			expect: output{
+				n: 2,
+				agg: metricdata.Gauge[N]{
+					DataPoints: []metricdata.DataPoint[N]{
+						{
+							Attributes: fltrAlice,
+							StartTime:  start,
+							Time:       y2kPlus(3),
+							Value:      1,
+						},
+						{
+							Attributes: fltrBob,
+							StartTime:  start,
+							Time:       y2kPlus(3),
+							Value:      3,
+						},
+					},
+				},
+			},
+		}, {
+			input: []arg[N]{
+				{ctx, 5, alice},
+				{ctx, -10, bob},
+			},
+			expect: output{
+				n: 2,
+				agg: metricdata.Gauge[N]{
+					DataPoints: []metricdata.DataPoint[N]{
+						{
+							Attributes: fltrAlice,
+							StartTime:  start,
+							Time:       y2kPlus(4),
+							Value:      want(1, 5),
+						},
+						{
+							Attributes: fltrBob,
+							StartTime:  start,
+							Time:       y2kPlus(4),
+							Value:      want(3, -10),
+						},
+					},
+				},
+			},
+		}, {
+			// Extrema are kept across collections.
+			input: []arg[N]{},
+			expect: output{
+				n: 2,
+				agg: metricdata.Gauge[N]{
+					DataPoints: []metricdata.DataPoint[N]{
+						{
+							Attributes: fltrAlice,
+							StartTime:  start,
+							Time:       y2kPlus(5),
+							Value:      want(1, 5),
+						},
+						{
+							Attributes: fltrBob,
+							StartTime:  start,
+							Time:       y2kPlus(5),
+							Value:      want(3, -10),
+						},
+					},
+				},
+			},
+		},
+	})
+}
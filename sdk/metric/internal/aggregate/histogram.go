@@ -81,6 +81,7 @@ func (s *histValues[N]) measure(
 	value N,
 	fltrAttr attribute.Set,
 	droppedAttr []attribute.KeyValue,
+	_ time.Time,
 ) {
 	// This search will return an index in the range [0, len(s.bounds)], where
 	// it will return len(s.bounds) if value is greater than the last element
@@ -116,6 +117,37 @@ func (s *histValues[N]) measure(
 	b.res.Offer(ctx, value, droppedAttr)
 }
 
+// measureBuckets merges the pre-aggregated measurements of hb into the
+// histogram of fltrAttr. The measurements of each bucket of hb are counted in
+// the bucket of s containing the representative value of that bucket.
+func (s *histValues[N]) measureBuckets(_ context.Context, hb Buckets, fltrAttr attribute.Set) {
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+
+	attr := s.limit.Attributes(fltrAttr, s.values)
+	b, ok := s.values[attr.Equivalent()]
+	if !ok {
+		b = newBuckets[N](attr, len(s.bounds)+1)
+		b.res = s.newRes(attr)
+		b.min, b.max = N(hb.Min), N(hb.Max)
+		s.values[attr.Equivalent()] = b
+	}
+
+	for i, n := range hb.Counts {
+		if n == 0 {
+			continue
+		}
+		idx := sort.SearchFloat64s(s.bounds, hb.representative(i))
+		b.counts[idx] += n
+		b.count += n
+	}
+	b.min = min(b.min, N(hb.Min))
+	b.max = max(b.max, N(hb.Max))
+	if !s.noSum {
+		b.sum(N(hb.Sum))
+	}
+}
+
 // newHistogram returns an Aggregator that summarizes a set of measurements as
 // an histogram.
 func newHistogram[N int64 | float64](
@@ -17,6 +17,9 @@ type datapoint[N int64 | float64] struct {
 	attrs attribute.Set
 	value N
 	res   FilteredExemplarReservoir[N]
+	// time is the explicit time the measurement was made at. It is zero if
+	// the measurement is timestamped when collected.
+	time time.Time
 }
 
 func newLastValue[N int64 | float64](limit int, r func(attribute.Set) FilteredExemplarReservoir[N]) *lastValue[N] {
@@ -38,7 +41,13 @@ type lastValue[N int64 | float64] struct {
 	start  time.Time
 }
 
-func (s *lastValue[N]) measure(ctx context.Context, value N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) {
+func (s *lastValue[N]) measure(
+	ctx context.Context,
+	value N,
+	fltrAttr attribute.Set,
+	droppedAttr []attribute.KeyValue,
+	t time.Time,
+) {
 	s.Lock()
 	defer s.Unlock()
 
@@ -48,8 +57,15 @@ func (s *lastValue[N]) measure(ctx context.Context, value N, fltrAttr attribute.
 		d.res = s.newRes(attr)
 	}
 
+	if ok && !t.IsZero() && d.time.After(t) {
+		// A backfilled measurement older than the last one is not the
+		// last value.
+		return
+	}
+
 	d.attrs = attr
 	d.value = value
+	d.time = t
 	d.res.Offer(ctx, value, droppedAttr)
 
 	s.values[attr.Equivalent()] = d
@@ -105,6 +121,15 @@ func (s *lastValue[N]) copyDpts(dest *[]metricdata.DataPoint[N], t time.Time) in
 		(*dest)[i].Attributes = v.attrs
 		(*dest)[i].StartTime = s.start
 		(*dest)[i].Time = t
+		if !v.time.IsZero() {
+			// A backfilled measurement made before the start of the
+			// collection interval is reported at its start so the data
+			// point does not end before it starts.
+			(*dest)[i].Time = v.time
+			if v.time.Before(s.start) {
+				(*dest)[i].Time = s.start
+			}
+		}
 		(*dest)[i].Value = v.value
 		collectExemplars(&(*dest)[i].Exemplars, v.res.Collect)
 		i++
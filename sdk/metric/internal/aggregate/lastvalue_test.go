@@ -6,8 +6,13 @@ package aggregate // import "go.opentelemetry.io/otel/sdk/metric/internal/aggreg
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 )
 
 func TestLastValue(t *testing.T) {
@@ -32,6 +37,11 @@ func TestLastValue(t *testing.T) {
 	t.Run("Int64/CumulativePrecomputedLastValue", testCumulativePrecomputedLastValue[int64]())
 	c.Reset()
 	t.Run("Float64/CumulativePrecomputedLastValue", testCumulativePrecomputedLastValue[float64]())
+	c.Reset()
+
+	t.Run("Int64/MeasurementTime", testLastValueMeasurementTime[int64]())
+	c.Reset()
+	t.Run("Float64/MeasurementTime", testLastValueMeasurementTime[float64]())
 }
 
 func testDeltaLastValue[N int64 | float64]() func(*testing.T) {
@@ -472,3 +482,48 @@ func BenchmarkLastValue(b *testing.B) {
 	b.Run("Int64", benchmarkAggregate(Builder[int64]{}.PrecomputedLastValue))
 	b.Run("Float64", benchmarkAggregate(Builder[float64]{}.PrecomputedLastValue))
 }
+
+func testLastValueMeasurementTime[N int64 | float64]() func(*testing.T) {
+	return func(t *testing.T) {
+		in, out := Builder[N]{
+			Temporality: metricdata.DeltaTemporality,
+			Filter:      attrFltr,
+		}.LastValue()
+
+		ctx := context.Background()
+		in(ctx, 1, alice, y2kPlus(10))
+		in(ctx, 2, alice, y2kPlus(30))
+		// Older backfilled measurements are not the last value.
+		in(ctx, 3, alice, y2kPlus(20))
+		in(ctx, 4, bob, y2kPlus(10))
+		// Measurements without time are the last value.
+		in(ctx, 5, bob, time.Time{})
+		// Measurements made before the start are reported at the start.
+		in(ctx, 6, carol, y2k.Add(-time.Hour))
+
+		var got metricdata.Aggregation
+		assert.Equal(t, 3, out(&got), "incorrect data size")
+		metricdatatest.AssertAggregationsEqual(t, metricdata.Gauge[N]{
+			DataPoints: []metricdata.DataPoint[N]{
+				{
+					Attributes: fltrAlice,
+					StartTime:  y2kPlus(0),
+					Time:       y2kPlus(30),
+					Value:      2,
+				},
+				{
+					Attributes: fltrBob,
+					StartTime:  y2kPlus(0),
+					Time:       y2kPlus(1),
+					Value:      5,
+				},
+				{
+					Attributes: attribute.NewSet(userCarol),
+					StartTime:  y2kPlus(0),
+					Time:       y2kPlus(0),
+					Value:      6,
+				},
+			},
+		}, got)
+	}
+}
@@ -34,7 +34,13 @@ func newValueMap[N int64 | float64](limit int, r func(attribute.Set) FilteredExe
 	}
 }
 
-func (s *valueMap[N]) measure(ctx context.Context, value N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) {
+func (s *valueMap[N]) measure(
+	ctx context.Context,
+	value N,
+	fltrAttr attribute.Set,
+	droppedAttr []attribute.KeyValue,
+	_ time.Time,
+) {
 	s.Lock()
 	defer s.Unlock()
 
@@ -10,7 +10,7 @@ import (
 	"sync"
 	"sync/atomic"
 
-	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/internal/logging"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
@@ -26,6 +26,9 @@ type ManualReader struct {
 
 	temporalitySelector TemporalitySelector
 	aggregationSelector AggregationSelector
+
+	// log is the internal logger of the MeterProvider mr is registered with.
+	log logging.Pointer
 }
 
 // Compile time check the manualReader implements Reader and is comparable.
@@ -48,10 +51,20 @@ func (mr *ManualReader) register(p sdkProducer) {
 	// Only register once. If producer is already set, do nothing.
 	if !mr.sdkProducer.CompareAndSwap(nil, produceHolder{produce: p.produce}) {
 		msg := "did not register manual reader"
-		global.Error(errDuplicateRegister, msg)
+		mr.log.Load().Error(errDuplicateRegister, msg)
 	}
 }
 
+// setInternalLogger sets the logger of the internal diagnostics of mr.
+func (mr *ManualReader) setInternalLogger(l logging.Logger) {
+	mr.log.Store(l)
+}
+
+// addProducer registers p as an external Producer of mr.
+func (mr *ManualReader) addProducer(p Producer) (func(), error) {
+	return addProducer(&mr.mu, &mr.isShutdown, &mr.externalProducers, p)
+}
+
 // temporality reports the Temporality for the instrument kind provided.
 func (mr *ManualReader) temporality(kind InstrumentKind) metricdata.Temporality {
 	return mr.temporalitySelector(kind)
@@ -123,7 +136,7 @@ func (mr *ManualReader) Collect(ctx context.Context, rm *metricdata.ResourceMetr
 		rm.ScopeMetrics = append(rm.ScopeMetrics, externalMetrics...)
 	}
 
-	global.Debug("ManualReader collection", "Data", rm)
+	mr.log.Load().Debug("ManualReader collection", "Data", rm)
 
 	return err
 }
@@ -4,15 +4,20 @@
 package metric // import "go.opentelemetry.io/otel/sdk/metric"
 
 import (
+	"container/list"
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
-	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 
+	"go.opentelemetry.io/otel/sdk/internal/logging"
 	"go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
 )
 
@@ -27,8 +32,24 @@ var ErrInstrumentName = errors.New("invalid instrument name")
 type meter struct {
 	embedded.Meter
 
-	scope instrumentation.Scope
-	pipes pipelines
+	// provider is the MeterProvider that created the meter.
+	provider *MeterProvider
+	scope    instrumentation.Scope
+	pipes    pipelines
+	// attrs are the constant attributes added to the measurements of the
+	// instruments of the meter.
+	attrs attribute.Set
+	// ctxAttrs, if not nil, adds the attributes of the context to the
+	// measurements of the synchronous instruments of the meter.
+	ctxAttrs *contextAttributes
+	// strictUnits is true if the units of the instruments are validated.
+	strictUnits bool
+	// log logs the internal diagnostics of the meter.
+	log logging.Logger
+
+	// registrations are shared by the copies of the meter made to add
+	// constant attributes.
+	registrations *registrations
 
 	int64Insts             *cacheWithErr[instID, *int64Inst]
 	float64Insts           *cacheWithErr[instID, *float64Inst]
@@ -52,6 +73,7 @@ func newMeter(s instrumentation.Scope, p pipelines) *meter {
 	return &meter{
 		scope:                  s,
 		pipes:                  p,
+		registrations:          &registrations{},
 		int64Insts:             &int64Insts,
 		float64Insts:           &float64Insts,
 		int64ObservableInsts:   &int64ObservableInsts,
@@ -61,6 +83,13 @@ func newMeter(s instrumentation.Scope, p pipelines) *meter {
 	}
 }
 
+// sameScope reports whether m and other were created by the same
+// MeterProvider for the same instrumentation scope. Meters that only differ
+// by their constant attributes have the same scope.
+func (m *meter) sameScope(other *meter) bool {
+	return m.provider == other.provider && m.scope == other.scope
+}
+
 // Compile-time check meter implements metric.Meter.
 var _ metric.Meter = (*meter)(nil)
 
@@ -71,12 +100,12 @@ func (m *meter) Int64Counter(name string, options ...metric.Int64CounterOption)
 	cfg := metric.NewInt64CounterConfig(options...)
 	const kind = InstrumentKindCounter
 	p := int64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, m.validateInstrument(name, cfg.Unit())
 }
 
 // Int64UpDownCounter returns a new instrument identified by name and
@@ -89,12 +118,12 @@ func (m *meter) Int64UpDownCounter(
 	cfg := metric.NewInt64UpDownCounterConfig(options...)
 	const kind = InstrumentKindUpDownCounter
 	p := int64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, m.validateInstrument(name, cfg.Unit())
 }
 
 // Int64Histogram returns a new instrument identified by name and configured
@@ -108,7 +137,7 @@ func (m *meter) Int64Histogram(name string, options ...metric.Int64HistogramOpti
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, m.validateInstrument(name, cfg.Unit())
 }
 
 // Int64Gauge returns a new instrument identified by name and configured
@@ -118,12 +147,12 @@ func (m *meter) Int64Gauge(name string, options ...metric.Int64GaugeOption) (met
 	cfg := metric.NewInt64GaugeConfig(options...)
 	const kind = InstrumentKindGauge
 	p := int64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, m.validateInstrument(name, cfg.Unit())
 }
 
 // int64ObservableInstrument returns a new observable identified by the Instrument.
@@ -136,14 +165,14 @@ func (m *meter) int64ObservableInstrument(id Instrument, callbacks []metric.Int6
 		Kind:        id.Kind,
 	}
 	if m.int64ObservableInsts.HasKey(key) && len(callbacks) > 0 {
-		warnRepeatedObservableCallbacks(id)
+		warnRepeatedObservableCallbacks(m.log, id)
 	}
-	return m.int64ObservableInsts.Lookup(key, func() (int64Observable, error) {
+	o, err := m.int64ObservableInsts.Lookup(key, func() (int64Observable, error) {
 		inst := newInt64Observable(m, id.Kind, id.Name, id.Description, id.Unit)
 		for _, insert := range m.int64Resolver.inserters {
 			// Connect the measure functions for instruments in this pipeline with the
 			// callbacks for this pipeline.
-			in, err := insert.Instrument(id, insert.readerDefaultAggregation(id.Kind))
+			in, _, err := insert.Instrument(id, insert.readerDefaultAggregation(id.Kind))
 			if err != nil {
 				return inst, err
 			}
@@ -159,13 +188,15 @@ func (m *meter) int64ObservableInstrument(id Instrument, callbacks []metric.Int6
 			// is not part of the pipeline.
 			insert.pipeline.addInt64Measure(inst.observableID, in)
 			for _, cback := range callbacks {
-				inst := int64Observer{measures: in}
+				inst := int64Observer{measures: in, attrs: m.attrs}
 				fn := cback
 				insert.addCallback(func(ctx context.Context) error { return fn(ctx, inst) })
 			}
 		}
-		return inst, validateInstrumentName(id.Name)
+		return inst, m.validateInstrument(id.Name, id.Unit)
 	})
+	o.attrs = m.attrs
+	return o, err
 }
 
 // Int64ObservableCounter returns a new instrument identified by name and
@@ -183,11 +214,12 @@ func (m *meter) Int64ObservableCounter(
 ) (metric.Int64ObservableCounter, error) {
 	cfg := metric.NewInt64ObservableCounterConfig(options...)
 	id := Instrument{
-		Name:        name,
-		Description: cfg.Description(),
-		Unit:        cfg.Unit(),
-		Kind:        InstrumentKindObservableCounter,
-		Scope:       m.scope,
+		Name:          name,
+		Description:   cfg.Description(),
+		Unit:          cfg.Unit(),
+		Kind:          InstrumentKindObservableCounter,
+		Scope:         m.scope,
+		attributeKeys: cfg.AttributeKeys(),
 	}
 	return m.int64ObservableInstrument(id, cfg.Callbacks())
 }
@@ -207,11 +239,12 @@ func (m *meter) Int64ObservableUpDownCounter(
 ) (metric.Int64ObservableUpDownCounter, error) {
 	cfg := metric.NewInt64ObservableUpDownCounterConfig(options...)
 	id := Instrument{
-		Name:        name,
-		Description: cfg.Description(),
-		Unit:        cfg.Unit(),
-		Kind:        InstrumentKindObservableUpDownCounter,
-		Scope:       m.scope,
+		Name:          name,
+		Description:   cfg.Description(),
+		Unit:          cfg.Unit(),
+		Kind:          InstrumentKindObservableUpDownCounter,
+		Scope:         m.scope,
+		attributeKeys: cfg.AttributeKeys(),
 	}
 	return m.int64ObservableInstrument(id, cfg.Callbacks())
 }
@@ -231,11 +264,12 @@ func (m *meter) Int64ObservableGauge(
 ) (metric.Int64ObservableGauge, error) {
 	cfg := metric.NewInt64ObservableGaugeConfig(options...)
 	id := Instrument{
-		Name:        name,
-		Description: cfg.Description(),
-		Unit:        cfg.Unit(),
-		Kind:        InstrumentKindObservableGauge,
-		Scope:       m.scope,
+		Name:          name,
+		Description:   cfg.Description(),
+		Unit:          cfg.Unit(),
+		Kind:          InstrumentKindObservableGauge,
+		Scope:         m.scope,
+		attributeKeys: cfg.AttributeKeys(),
 	}
 	return m.int64ObservableInstrument(id, cfg.Callbacks())
 }
@@ -247,12 +281,12 @@ func (m *meter) Float64Counter(name string, options ...metric.Float64CounterOpti
 	cfg := metric.NewFloat64CounterConfig(options...)
 	const kind = InstrumentKindCounter
 	p := float64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, m.validateInstrument(name, cfg.Unit())
 }
 
 // Float64UpDownCounter returns a new instrument identified by name and
@@ -265,12 +299,12 @@ func (m *meter) Float64UpDownCounter(
 	cfg := metric.NewFloat64UpDownCounterConfig(options...)
 	const kind = InstrumentKindUpDownCounter
 	p := float64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, m.validateInstrument(name, cfg.Unit())
 }
 
 // Float64Histogram returns a new instrument identified by name and configured
@@ -287,7 +321,7 @@ func (m *meter) Float64Histogram(
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, m.validateInstrument(name, cfg.Unit())
 }
 
 // Float64Gauge returns a new instrument identified by name and configured
@@ -297,12 +331,12 @@ func (m *meter) Float64Gauge(name string, options ...metric.Float64GaugeOption)
 	cfg := metric.NewFloat64GaugeConfig(options...)
 	const kind = InstrumentKindGauge
 	p := float64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, m.validateInstrument(name, cfg.Unit())
 }
 
 // float64ObservableInstrument returns a new observable identified by the Instrument.
@@ -318,14 +352,14 @@ func (m *meter) float64ObservableInstrument(
 		Kind:        id.Kind,
 	}
 	if m.int64ObservableInsts.HasKey(key) && len(callbacks) > 0 {
-		warnRepeatedObservableCallbacks(id)
+		warnRepeatedObservableCallbacks(m.log, id)
 	}
-	return m.float64ObservableInsts.Lookup(key, func() (float64Observable, error) {
+	o, err := m.float64ObservableInsts.Lookup(key, func() (float64Observable, error) {
 		inst := newFloat64Observable(m, id.Kind, id.Name, id.Description, id.Unit)
 		for _, insert := range m.float64Resolver.inserters {
 			// Connect the measure functions for instruments in this pipeline with the
 			// callbacks for this pipeline.
-			in, err := insert.Instrument(id, insert.readerDefaultAggregation(id.Kind))
+			in, _, err := insert.Instrument(id, insert.readerDefaultAggregation(id.Kind))
 			if err != nil {
 				return inst, err
 			}
@@ -341,13 +375,15 @@ func (m *meter) float64ObservableInstrument(
 			// is not part of the pipeline.
 			insert.pipeline.addFloat64Measure(inst.observableID, in)
 			for _, cback := range callbacks {
-				inst := float64Observer{measures: in}
+				inst := float64Observer{measures: in, attrs: m.attrs}
 				fn := cback
 				insert.addCallback(func(ctx context.Context) error { return fn(ctx, inst) })
 			}
 		}
-		return inst, validateInstrumentName(id.Name)
+		return inst, m.validateInstrument(id.Name, id.Unit)
 	})
+	o.attrs = m.attrs
+	return o, err
 }
 
 // Float64ObservableCounter returns a new instrument identified by name and
@@ -365,11 +401,12 @@ func (m *meter) Float64ObservableCounter(
 ) (metric.Float64ObservableCounter, error) {
 	cfg := metric.NewFloat64ObservableCounterConfig(options...)
 	id := Instrument{
-		Name:        name,
-		Description: cfg.Description(),
-		Unit:        cfg.Unit(),
-		Kind:        InstrumentKindObservableCounter,
-		Scope:       m.scope,
+		Name:          name,
+		Description:   cfg.Description(),
+		Unit:          cfg.Unit(),
+		Kind:          InstrumentKindObservableCounter,
+		Scope:         m.scope,
+		attributeKeys: cfg.AttributeKeys(),
 	}
 	return m.float64ObservableInstrument(id, cfg.Callbacks())
 }
@@ -389,11 +426,12 @@ func (m *meter) Float64ObservableUpDownCounter(
 ) (metric.Float64ObservableUpDownCounter, error) {
 	cfg := metric.NewFloat64ObservableUpDownCounterConfig(options...)
 	id := Instrument{
-		Name:        name,
-		Description: cfg.Description(),
-		Unit:        cfg.Unit(),
-		Kind:        InstrumentKindObservableUpDownCounter,
-		Scope:       m.scope,
+		Name:          name,
+		Description:   cfg.Description(),
+		Unit:          cfg.Unit(),
+		Kind:          InstrumentKindObservableUpDownCounter,
+		Scope:         m.scope,
+		attributeKeys: cfg.AttributeKeys(),
 	}
 	return m.float64ObservableInstrument(id, cfg.Callbacks())
 }
@@ -413,15 +451,26 @@ func (m *meter) Float64ObservableGauge(
 ) (metric.Float64ObservableGauge, error) {
 	cfg := metric.NewFloat64ObservableGaugeConfig(options...)
 	id := Instrument{
-		Name:        name,
-		Description: cfg.Description(),
-		Unit:        cfg.Unit(),
-		Kind:        InstrumentKindObservableGauge,
-		Scope:       m.scope,
+		Name:          name,
+		Description:   cfg.Description(),
+		Unit:          cfg.Unit(),
+		Kind:          InstrumentKindObservableGauge,
+		Scope:         m.scope,
+		attributeKeys: cfg.AttributeKeys(),
 	}
 	return m.float64ObservableInstrument(id, cfg.Callbacks())
 }
 
+// validateInstrument returns an error if the name of an instrument is invalid,
+// or if its unit is invalid and m validates units.
+func (m *meter) validateInstrument(name, unit string) error {
+	err := validateInstrumentName(name)
+	if m.strictUnits {
+		err = errors.Join(err, validateUnit(unit))
+	}
+	return err
+}
+
 func validateInstrumentName(name string) error {
 	if len(name) == 0 {
 		return fmt.Errorf("%w: %s: is empty", ErrInstrumentName, name)
@@ -451,18 +500,61 @@ func isAlphanumeric(c rune) bool {
 	return isAlpha(c) || ('0' <= c && c <= '9')
 }
 
-func warnRepeatedObservableCallbacks(id Instrument) {
+func warnRepeatedObservableCallbacks(log logging.Logger, id Instrument) {
 	inst := fmt.Sprintf(
 		"Instrument{Name: %q, Description: %q, Kind: %q, Unit: %q}",
 		id.Name, id.Description, "InstrumentKind"+id.Kind.String(), id.Unit,
 	)
-	global.Warn(
+	log.Warn(
 		"Repeated observable instrument creation with callbacks. Ignoring new callbacks. Use meter.RegisterCallback and Registration.Unregister to manage callbacks.",
 		"instrument",
 		inst,
 	)
 }
 
+// RecordBatch records measurements with the attributes attrs. The attributes
+// of the meter and of the context are merged with attrs once for all the
+// measurements instead of for each of them. The attribute filters of the
+// views are still applied to each measurement, as they are specific to the
+// streams of an instrument.
+//
+// The measurements are not recorded atomically: a concurrent collection may
+// export some of them and not the others.
+//
+// Measurements made by instruments not created by m, including instruments
+// created by a meter of the same scope with other meter attributes, are
+// dropped and a warning is logged.
+func (m *meter) RecordBatch(ctx context.Context, attrs attribute.Set, measurements ...metric.Measurement) {
+	if len(measurements) == 0 {
+		return
+	}
+	s := mergeAttrs(m.attrs, m.ctxAttrs.from(ctx), attrs)
+	for _, meas := range measurements {
+		switch inst := meas.Instrument().(type) {
+		case *int64Inst:
+			if m.owns(inst.meter, inst.attrs) {
+				inst.measure(ctx, meas.Int64Value(), s, time.Time{})
+				continue
+			}
+		case *float64Inst:
+			if m.owns(inst.meter, inst.attrs) {
+				inst.measure(ctx, meas.Float64Value(), s, time.Time{})
+				continue
+			}
+		}
+		m.log.Warn(
+			"Dropping a measurement of an instrument not created by the meter recording the batch.",
+			"meter", m.scope.Name,
+		)
+	}
+}
+
+// owns reports whether m created the instruments created by the meter
+// creator with the constant attributes attrs.
+func (m *meter) owns(creator *meter, attrs attribute.Set) bool {
+	return creator != nil && m.sameScope(creator) && m.attrs.Equals(&attrs)
+}
+
 // RegisterCallback registers f to be called each collection cycle so it will
 // make observations for insts during those cycles.
 //
@@ -516,7 +608,8 @@ func (m *meter) RegisterCallback(f metric.Callback, insts ...metric.Observable)
 		return noopRegister{}, err
 	}
 
-	unregs := make([]func(), len(m.pipes))
+	r := &registration{unregs: make([]func(), len(m.pipes), len(m.pipes)+1)}
+	r.f.Store(&f)
 	for ix, pipe := range m.pipes {
 		reg := newObserver(pipe)
 		for _, inst := range validInstruments {
@@ -529,11 +622,18 @@ func (m *meter) RegisterCallback(f metric.Callback, insts ...metric.Observable)
 		}
 
 		// Some or all instruments were valid.
-		cBack := func(ctx context.Context) error { return f(ctx, reg) }
-		unregs[ix] = pipe.addMultiCallback(cBack)
+		cBack := func(ctx context.Context) error { return (*r.f.Load())(ctx, reg) }
+		r.unregs[ix] = pipe.addMultiCallback(cBack)
 	}
+	r.unregs = append(r.unregs, m.registrations.add(r))
 
-	return unregisterFuncs{f: unregs}, err
+	return r, err
+}
+
+// Registrations returns the registrations made with RegisterCallback that
+// have not been unregistered.
+func (m *meter) Registrations() []metric.Registration {
+	return m.registrations.all()
 }
 
 type observer struct {
@@ -571,13 +671,13 @@ func (r observer) ObserveFloat64(o metric.Float64Observable, v float64, opts ...
 	case float64Observable:
 		oImpl = conv
 	default:
-		global.Error(errUnknownObserver, "failed to record")
+		r.pipe.log.Error(errUnknownObserver, "failed to record")
 		return
 	}
 
 	if _, registered := r.float64[oImpl.observableID]; !registered {
 		if !oImpl.dropAggregation {
-			global.Error(errUnregObserver, "failed to record",
+			r.pipe.log.Error(errUnregObserver, "failed to record",
 				"name", oImpl.name,
 				"description", oImpl.description,
 				"unit", oImpl.unit,
@@ -589,9 +689,10 @@ func (r observer) ObserveFloat64(o metric.Float64Observable, v float64, opts ...
 	c := metric.NewObserveConfig(opts)
 	// Access to r.pipe.float64Measure is already guarded by a lock in pipeline.produce.
 	// TODO (#5946): Refactor pipeline and observable measures.
+	s := mergeAttrs(oImpl.attrs, c.Attributes())
 	measures := r.pipe.float64Measures[oImpl.observableID]
 	for _, m := range measures {
-		m(context.Background(), v, c.Attributes())
+		m(context.Background(), v, s, time.Time{})
 	}
 }
 
@@ -601,13 +702,13 @@ func (r observer) ObserveInt64(o metric.Int64Observable, v int64, opts ...metric
 	case int64Observable:
 		oImpl = conv
 	default:
-		global.Error(errUnknownObserver, "failed to record")
+		r.pipe.log.Error(errUnknownObserver, "failed to record")
 		return
 	}
 
 	if _, registered := r.int64[oImpl.observableID]; !registered {
 		if !oImpl.dropAggregation {
-			global.Error(errUnregObserver, "failed to record",
+			r.pipe.log.Error(errUnregObserver, "failed to record",
 				"name", oImpl.name,
 				"description", oImpl.description,
 				"unit", oImpl.unit,
@@ -619,9 +720,10 @@ func (r observer) ObserveInt64(o metric.Int64Observable, v int64, opts ...metric
 	c := metric.NewObserveConfig(opts)
 	// Access to r.pipe.int64Measures is already guarded b a lock in pipeline.produce.
 	// TODO (#5946): Refactor pipeline and observable measures.
+	s := mergeAttrs(oImpl.attrs, c.Attributes())
 	measures := r.pipe.int64Measures[oImpl.observableID]
 	for _, m := range measures {
-		m(context.Background(), v, c.Attributes())
+		m(context.Background(), v, s, time.Time{})
 	}
 }
 
@@ -631,24 +733,101 @@ func (noopRegister) Unregister() error {
 	return nil
 }
 
+func (noopRegister) Replace(metric.Callback) error {
+	return nil
+}
+
+var errUnregistered = errors.New("callback unregistered")
+
+// registration is the registration of a callback with the pipelines of a
+// meter.
+type registration struct {
+	embedded.Registration
+
+	// f is the registered callback. It is loaded at each call so it can be
+	// replaced atomically.
+	f atomic.Pointer[metric.Callback]
+
+	mu     sync.Mutex
+	unregs []func() // nil once unregistered.
+}
+
+func (r *registration) Unregister() error {
+	r.mu.Lock()
+	unregs := r.unregs
+	r.unregs = nil
+	r.mu.Unlock()
+
+	for _, f := range unregs {
+		f()
+	}
+	return nil
+}
+
+func (r *registration) Replace(f metric.Callback) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.unregs == nil {
+		return errUnregistered
+	}
+	r.f.Store(&f)
+	return nil
+}
+
+// registrations are the callback registrations of a meter that have not been
+// unregistered.
+type registrations struct {
+	sync.Mutex
+	list list.List
+}
+
+// add adds r to the registrations. The returned function removes it.
+func (regs *registrations) add(r *registration) (remove func()) {
+	regs.Lock()
+	defer regs.Unlock()
+	e := regs.list.PushBack(r)
+	return func() {
+		regs.Lock()
+		regs.list.Remove(e)
+		regs.Unlock()
+	}
+}
+
+// all returns the registrations in the order they were made.
+func (regs *registrations) all() []metric.Registration {
+	regs.Lock()
+	defer regs.Unlock()
+	out := make([]metric.Registration, 0, regs.list.Len())
+	for e := regs.list.Front(); e != nil; e = e.Next() {
+		out = append(out, e.Value.(*registration))
+	}
+	return out
+}
+
 // int64InstProvider provides int64 OpenTelemetry instruments.
 type int64InstProvider struct{ *meter }
 
-func (p int64InstProvider) aggs(kind InstrumentKind, name, desc, u string) ([]aggregate.Measure[int64], error) {
+func (p int64InstProvider) aggs(
+	kind InstrumentKind,
+	name, desc, u string,
+	keys []attribute.Key,
+) ([]aggregate.Measure[int64], []attribute.Key, error) {
 	inst := Instrument{
-		Name:        name,
-		Description: desc,
-		Unit:        u,
-		Kind:        kind,
-		Scope:       p.scope,
+		Name:          name,
+		Description:   desc,
+		Unit:          u,
+		Kind:          kind,
+		Scope:         p.scope,
+		attributeKeys: keys,
 	}
-	return p.int64Resolver.Aggregators(inst)
+	measures, err := p.int64Resolver.Aggregators(inst)
+	return measures, p.int64Resolver.AttributeKeys(inst), err
 }
 
 func (p int64InstProvider) histogramAggs(
 	name string,
 	cfg metric.Int64HistogramConfig,
-) ([]aggregate.Measure[int64], error) {
+) ([]aggregate.Measure[int64], []aggregate.MeasureBuckets, []attribute.Key, error) {
 	boundaries := cfg.ExplicitBucketBoundaries()
 	aggError := AggregationExplicitBucketHistogram{Boundaries: boundaries}.err()
 	if aggError != nil {
@@ -656,60 +835,79 @@ func (p int64InstProvider) histogramAggs(
 		boundaries = nil
 	}
 	inst := Instrument{
-		Name:        name,
-		Description: cfg.Description(),
-		Unit:        cfg.Unit(),
-		Kind:        InstrumentKindHistogram,
-		Scope:       p.scope,
+		Name:          name,
+		Description:   cfg.Description(),
+		Unit:          cfg.Unit(),
+		Kind:          InstrumentKindHistogram,
+		Scope:         p.scope,
+		attributeKeys: cfg.AttributeKeys(),
 	}
-	measures, err := p.int64Resolver.HistogramAggregators(inst, boundaries)
-	return measures, errors.Join(aggError, err)
+	measures, bucketMeasures, err := p.int64Resolver.HistogramAggregators(inst, boundaries)
+	return measures, bucketMeasures, p.int64Resolver.AttributeKeys(inst), errors.Join(aggError, err)
 }
 
 // lookup returns the resolved instrumentImpl.
-func (p int64InstProvider) lookup(kind InstrumentKind, name, desc, u string) (*int64Inst, error) {
-	return p.int64Insts.Lookup(instID{
+func (p int64InstProvider) lookup(
+	kind InstrumentKind,
+	name, desc, u string,
+	keys []attribute.Key,
+) (*int64Inst, error) {
+	i, err := p.int64Insts.Lookup(instID{
 		Name:        name,
 		Description: desc,
 		Unit:        u,
 		Kind:        kind,
 	}, func() (*int64Inst, error) {
-		aggs, err := p.aggs(kind, name, desc, u)
-		return &int64Inst{measures: aggs}, err
+		aggs, recorded, err := p.aggs(kind, name, desc, u, keys)
+		return &int64Inst{meter: p.meter, measures: aggs, ctxAttrs: p.ctxAttrs, recordedKeys: recorded}, err
 	})
+	return i.withAttrs(p.attrs), err
 }
 
 // lookupHistogram returns the resolved instrumentImpl.
 func (p int64InstProvider) lookupHistogram(name string, cfg metric.Int64HistogramConfig) (*int64Inst, error) {
-	return p.int64Insts.Lookup(instID{
+	i, err := p.int64Insts.Lookup(instID{
 		Name:        name,
 		Description: cfg.Description(),
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindHistogram,
 	}, func() (*int64Inst, error) {
-		aggs, err := p.histogramAggs(name, cfg)
-		return &int64Inst{measures: aggs}, err
+		aggs, bucketAggs, recorded, err := p.histogramAggs(name, cfg)
+		return &int64Inst{
+			meter:          p.meter,
+			measures:       aggs,
+			bucketMeasures: bucketAggs,
+			ctxAttrs:       p.ctxAttrs,
+			recordedKeys:   recorded,
+		}, err
 	})
+	return i.withAttrs(p.attrs), err
 }
 
 // float64InstProvider provides float64 OpenTelemetry instruments.
 type float64InstProvider struct{ *meter }
 
-func (p float64InstProvider) aggs(kind InstrumentKind, name, desc, u string) ([]aggregate.Measure[float64], error) {
+func (p float64InstProvider) aggs(
+	kind InstrumentKind,
+	name, desc, u string,
+	keys []attribute.Key,
+) ([]aggregate.Measure[float64], []attribute.Key, error) {
 	inst := Instrument{
-		Name:        name,
-		Description: desc,
-		Unit:        u,
-		Kind:        kind,
-		Scope:       p.scope,
+		Name:          name,
+		Description:   desc,
+		Unit:          u,
+		Kind:          kind,
+		Scope:         p.scope,
+		attributeKeys: keys,
 	}
-	return p.float64Resolver.Aggregators(inst)
+	measures, err := p.float64Resolver.Aggregators(inst)
+	return measures, p.float64Resolver.AttributeKeys(inst), err
 }
 
 func (p float64InstProvider) histogramAggs(
 	name string,
 	cfg metric.Float64HistogramConfig,
-) ([]aggregate.Measure[float64], error) {
+) ([]aggregate.Measure[float64], []aggregate.MeasureBuckets, []attribute.Key, error) {
 	boundaries := cfg.ExplicitBucketBoundaries()
 	aggError := AggregationExplicitBucketHistogram{Boundaries: boundaries}.err()
 	if aggError != nil {
@@ -717,58 +915,77 @@ func (p float64InstProvider) histogramAggs(
 		boundaries = nil
 	}
 	inst := Instrument{
-		Name:        name,
-		Description: cfg.Description(),
-		Unit:        cfg.Unit(),
-		Kind:        InstrumentKindHistogram,
-		Scope:       p.scope,
+		Name:          name,
+		Description:   cfg.Description(),
+		Unit:          cfg.Unit(),
+		Kind:          InstrumentKindHistogram,
+		Scope:         p.scope,
+		attributeKeys: cfg.AttributeKeys(),
 	}
-	measures, err := p.float64Resolver.HistogramAggregators(inst, boundaries)
-	return measures, errors.Join(aggError, err)
+	measures, bucketMeasures, err := p.float64Resolver.HistogramAggregators(inst, boundaries)
+	return measures, bucketMeasures, p.float64Resolver.AttributeKeys(inst), errors.Join(aggError, err)
 }
 
 // lookup returns the resolved instrumentImpl.
-func (p float64InstProvider) lookup(kind InstrumentKind, name, desc, u string) (*float64Inst, error) {
-	return p.float64Insts.Lookup(instID{
+func (p float64InstProvider) lookup(
+	kind InstrumentKind,
+	name, desc, u string,
+	keys []attribute.Key,
+) (*float64Inst, error) {
+	i, err := p.float64Insts.Lookup(instID{
 		Name:        name,
 		Description: desc,
 		Unit:        u,
 		Kind:        kind,
 	}, func() (*float64Inst, error) {
-		aggs, err := p.aggs(kind, name, desc, u)
-		return &float64Inst{measures: aggs}, err
+		aggs, recorded, err := p.aggs(kind, name, desc, u, keys)
+		return &float64Inst{meter: p.meter, measures: aggs, ctxAttrs: p.ctxAttrs, recordedKeys: recorded}, err
 	})
+	return i.withAttrs(p.attrs), err
 }
 
 // lookupHistogram returns the resolved instrumentImpl.
 func (p float64InstProvider) lookupHistogram(name string, cfg metric.Float64HistogramConfig) (*float64Inst, error) {
-	return p.float64Insts.Lookup(instID{
+	i, err := p.float64Insts.Lookup(instID{
 		Name:        name,
 		Description: cfg.Description(),
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindHistogram,
 	}, func() (*float64Inst, error) {
-		aggs, err := p.histogramAggs(name, cfg)
-		return &float64Inst{measures: aggs}, err
+		aggs, bucketAggs, recorded, err := p.histogramAggs(name, cfg)
+		return &float64Inst{
+			meter:          p.meter,
+			measures:       aggs,
+			bucketMeasures: bucketAggs,
+			ctxAttrs:       p.ctxAttrs,
+			recordedKeys:   recorded,
+		}, err
 	})
+	return i.withAttrs(p.attrs), err
 }
 
 type int64Observer struct {
 	embedded.Int64Observer
 	measures[int64]
+	// attrs are the constant attributes of the meter added to every
+	// observation.
+	attrs attribute.Set
 }
 
 func (o int64Observer) Observe(val int64, opts ...metric.ObserveOption) {
 	c := metric.NewObserveConfig(opts)
-	o.observe(val, c.Attributes())
+	o.observe(val, mergeAttrs(o.attrs, c.Attributes()))
 }
 
 type float64Observer struct {
 	embedded.Float64Observer
 	measures[float64]
+	// attrs are the constant attributes of the meter added to every
+	// observation.
+	attrs attribute.Set
 }
 
 func (o float64Observer) Observe(val float64, opts ...metric.ObserveOption) {
 	c := metric.NewObserveConfig(opts)
-	o.observe(val, c.Attributes())
+	o.observe(val, mergeAttrs(o.attrs, c.Attributes()))
 }
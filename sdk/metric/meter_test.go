@@ -11,6 +11,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/funcr"
@@ -20,8 +21,10 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/metric/internal/x"
@@ -957,6 +960,30 @@ func TestValidateInstrumentName(t *testing.T) {
 	}
 }
 
+func TestStrictUnits(t *testing.T) {
+	m := NewMeterProvider(WithStrictUnits()).Meter("TestStrictUnits")
+
+	_, err := m.Int64Counter("valid", metric.WithUnit(metric.UnitBytes))
+	assert.NoError(t, err)
+	_, err = m.Float64ObservableGauge("valid", metric.WithUnit("{request}/s"))
+	assert.NoError(t, err)
+
+	i, err := m.Int64Histogram("invalid", metric.WithUnit("seconds"))
+	assert.NotNil(t, i)
+	assert.ErrorIs(t, err, ErrInstrumentUnit)
+	assert.EqualError(t, err, `invalid instrument unit: seconds: unknown unit "seconds"`)
+
+	o, err := m.Float64ObservableCounter("_", metric.WithUnit("seconds"))
+	assert.NotNil(t, o)
+	assert.ErrorIs(t, err, ErrInstrumentName)
+	assert.ErrorIs(t, err, ErrInstrumentUnit)
+
+	// Units are not validated by default.
+	m = NewMeterProvider().Meter("TestStrictUnits")
+	_, err = m.Int64Histogram("invalid", metric.WithUnit("seconds"))
+	assert.NoError(t, err)
+}
+
 func TestRegisterNonSDKObserverErrors(t *testing.T) {
 	rdr := NewManualReader()
 	mp := NewMeterProvider(WithReader(rdr))
@@ -1300,6 +1327,53 @@ func TestUnregisterUnregisters(t *testing.T) {
 	assert.False(t, called, "callback called for unregistered callback")
 }
 
+func TestRegistrationReplace(t *testing.T) {
+	r := NewManualReader()
+	mp := NewMeterProvider(WithReader(r))
+	m := mp.Meter("TestRegistrationReplace")
+
+	gauge, err := m.Int64ObservableGauge("gauge")
+	require.NoError(t, err)
+
+	observe := func(v int64) metric.Callback {
+		return func(_ context.Context, o metric.Observer) error {
+			o.ObserveInt64(gauge, v)
+			return nil
+		}
+	}
+	collect := func() int64 {
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, r.Collect(context.Background(), &rm))
+		require.Len(t, rm.ScopeMetrics, 1)
+		require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+		dPts := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Gauge[int64]).DataPoints
+		require.Len(t, dPts, 1)
+		return dPts[0].Value
+	}
+
+	assert.Empty(t, m.Registrations())
+	reg0, err := m.RegisterCallback(observe(1), gauge)
+	require.NoError(t, err)
+	reg1, err := m.RegisterCallback(func(context.Context, metric.Observer) error { return nil }, gauge)
+	require.NoError(t, err)
+	assert.Equal(t, []metric.Registration{reg0, reg1}, m.Registrations())
+	assert.Equal(t, int64(1), collect())
+
+	require.NoError(t, reg0.Replace(observe(2)))
+	assert.Equal(t, int64(2), collect())
+
+	// Meters copied to add constant attributes share the registrations.
+	withAttrs := mp.Meter("TestRegistrationReplace", metric.WithMeterAttributes(attribute.String("a", "b")))
+	assert.Equal(t, []metric.Registration{reg0, reg1}, withAttrs.Registrations())
+
+	require.NoError(t, reg1.Unregister())
+	assert.Equal(t, []metric.Registration{reg0}, m.Registrations())
+
+	require.NoError(t, reg0.Unregister())
+	assert.Empty(t, m.Registrations())
+	assert.ErrorIs(t, reg0.Replace(observe(3)), errUnregistered)
+}
+
 func TestRegisterCallbackDropAggregations(t *testing.T) {
 	aggFn := func(InstrumentKind) Aggregation {
 		return AggregationDrop{}
@@ -2634,3 +2708,765 @@ func TestExemplarFilter(t *testing.T) {
 	assert.NoError(t, err)
 	metricdatatest.AssertEqual(t, want, got, metricdatatest.IgnoreTimestamp())
 }
+
+func TestExemplarFilterBaggage(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(
+		WithReader(rdr),
+		WithExemplarFilter(exemplar.BaggageFilter("synthetic")),
+	)
+	ctr, err := mp.Meter("scope").Float64Counter("ctr")
+	require.NoError(t, err)
+
+	m, err := baggage.NewMember("synthetic", "true")
+	require.NoError(t, err)
+	b, err := baggage.New(m)
+	require.NoError(t, err)
+
+	ctr.Add(context.Background(), 1.0)
+	ctr.Add(baggage.ContextWithBaggage(context.Background(), b), 2.0)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	sum, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[float64])
+	require.True(t, ok)
+	require.Len(t, sum.DataPoints, 1)
+
+	// Only the measurement made with the baggage member is an exemplar.
+	require.Len(t, sum.DataPoints[0].Exemplars, 1)
+	assert.Equal(t, 2.0, sum.DataPoints[0].Exemplars[0].Value)
+}
+
+func TestGaugeRecordWithTimestamp(t *testing.T) {
+	rdr := NewManualReader()
+	m := NewMeterProvider(WithReader(rdr)).Meter("TestGaugeRecordWithTimestamp")
+
+	fGauge, err := m.Float64Gauge("float64.gauge")
+	require.NoError(t, err)
+	iGauge, err := m.Int64Gauge("int64.gauge")
+	require.NoError(t, err)
+
+	ts := time.Now()
+	backfilled := attribute.NewSet(attribute.Bool("backfilled", true))
+	old := ts.Add(-time.Hour)
+	fGauge.Record(context.Background(), 1, metric.WithTimestamp(ts))
+	fGauge.Record(context.Background(), 1, metric.WithTimestamp(old), metric.WithAttributeSet(backfilled))
+	iGauge.Record(context.Background(), 1, metric.WithTimestamp(ts))
+	iGauge.Record(context.Background(), 1, metric.WithTimestamp(old), metric.WithAttributeSet(backfilled))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 2)
+
+	fData, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Gauge[float64])
+	require.True(t, ok)
+	require.Len(t, fData.DataPoints, 2)
+	for _, dp := range fData.DataPoints {
+		if dp.Attributes.Equals(&backfilled) {
+			// Measurements made before the start time are reported at it.
+			assert.Equal(t, dp.StartTime, dp.Time)
+			continue
+		}
+		assert.Equal(t, ts, dp.Time)
+	}
+
+	iData, ok := rm.ScopeMetrics[0].Metrics[1].Data.(metricdata.Gauge[int64])
+	require.True(t, ok)
+	require.Len(t, iData.DataPoints, 2)
+	for _, dp := range iData.DataPoints {
+		if dp.Attributes.Equals(&backfilled) {
+			assert.Equal(t, dp.StartTime, dp.Time)
+			continue
+		}
+		assert.Equal(t, ts, dp.Time)
+	}
+}
+
+func TestAttributeKeysAdvice(t *testing.T) {
+	attrs := attribute.NewSet(attribute.String("a", "x"), attribute.String("b", "y"))
+	keys := metric.WithAttributeKeys("a")
+
+	collect := func(t *testing.T, views ...View) []attribute.Set {
+		t.Helper()
+
+		rdr := NewManualReader()
+		m := NewMeterProvider(WithReader(rdr), WithView(views...)).Meter("TestAttributeKeysAdvice")
+
+		ctr, err := m.Int64Counter("int64.counter", keys)
+		require.NoError(t, err)
+		ctr.Add(context.Background(), 1, metric.WithAttributeSet(attrs))
+
+		hist, err := m.Float64Histogram("float64.histogram", keys)
+		require.NoError(t, err)
+		hist.Record(context.Background(), 1, metric.WithAttributeSet(attrs))
+
+		_, err = m.Int64ObservableGauge("int64.observable.gauge", keys, metric.WithInt64Callback(
+			func(_ context.Context, o metric.Int64Observer) error {
+				o.Observe(1, metric.WithAttributeSet(attrs))
+				return nil
+			},
+		))
+		require.NoError(t, err)
+
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, rdr.Collect(context.Background(), &rm))
+		require.Len(t, rm.ScopeMetrics, 1)
+		require.Len(t, rm.ScopeMetrics[0].Metrics, 3)
+
+		var got []attribute.Set
+		for _, m := range rm.ScopeMetrics[0].Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				require.Len(t, data.DataPoints, 1)
+				got = append(got, data.DataPoints[0].Attributes)
+			case metricdata.Histogram[float64]:
+				require.Len(t, data.DataPoints, 1)
+				got = append(got, data.DataPoints[0].Attributes)
+			case metricdata.Gauge[int64]:
+				require.Len(t, data.DataPoints, 1)
+				got = append(got, data.DataPoints[0].Attributes)
+			default:
+				t.Fatalf("unexpected data type %T", data)
+			}
+		}
+		return got
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		want := attribute.NewSet(attribute.String("a", "x"))
+		for _, got := range collect(t) {
+			assert.Equal(t, want, got)
+		}
+	})
+
+	t.Run("ViewWithoutFilter", func(t *testing.T) {
+		want := attribute.NewSet(attribute.String("a", "x"))
+		view := NewView(Instrument{Name: "*"}, Stream{Description: "view"})
+		for _, got := range collect(t, view) {
+			assert.Equal(t, want, got)
+		}
+	})
+
+	t.Run("ViewFilterOverrides", func(t *testing.T) {
+		view := NewView(Instrument{Name: "*"}, Stream{
+			AttributeFilter: func(attribute.KeyValue) bool { return true },
+		})
+		for _, got := range collect(t, view) {
+			assert.Equal(t, attrs, got)
+		}
+	})
+}
+
+func TestAttributeKeysRecorded(t *testing.T) {
+	keys := metric.WithAttributeKeys("a")
+
+	newCounter := func(t *testing.T, views ...View) metric.Int64Counter {
+		t.Helper()
+
+		mp := NewMeterProvider(WithReader(NewManualReader()), WithView(views...))
+		ctr, err := mp.Meter("TestAttributeKeysRecorded").Int64Counter("int64.counter", keys)
+		require.NoError(t, err)
+		return ctr
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		a := metric.NewKeyedAdder[int64](newCounter(t), "a", "b")
+		assert.True(t, a.Records(0))
+		assert.False(t, a.Records(1))
+	})
+
+	t.Run("ViewWithoutFilter", func(t *testing.T) {
+		view := NewView(Instrument{Name: "*"}, Stream{Description: "view"})
+		a := metric.NewKeyedAdder[int64](newCounter(t, view), "a", "b")
+		assert.True(t, a.Records(0))
+		assert.False(t, a.Records(1))
+	})
+
+	t.Run("ViewFilterOverrides", func(t *testing.T) {
+		view := NewView(Instrument{Name: "*"}, Stream{
+			AttributeFilter: func(attribute.KeyValue) bool { return true },
+		})
+		a := metric.NewKeyedAdder[int64](newCounter(t, view), "a", "b")
+		assert.True(t, a.Records(0))
+		assert.True(t, a.Records(1))
+	})
+
+	t.Run("NoAdvice", func(t *testing.T) {
+		mp := NewMeterProvider(WithReader(NewManualReader()))
+		hist, err := mp.Meter("TestAttributeKeysRecorded").Float64Histogram("float64.histogram")
+		require.NoError(t, err)
+		r := metric.NewKeyedRecorder[float64](hist, "a", "b")
+		assert.True(t, r.Records(0))
+		assert.True(t, r.Records(1))
+	})
+}
+
+func TestMeterRecordBatch(t *testing.T) {
+	rdr := NewManualReader()
+	m := NewMeterProvider(WithReader(rdr)).Meter("TestMeterRecordBatch")
+
+	ctr, err := m.Int64Counter("int64.counter")
+	require.NoError(t, err)
+	hist, err := m.Float64Histogram("float64.histogram", metric.WithExplicitBucketBoundaries(5))
+	require.NoError(t, err)
+
+	attrs := attribute.NewSet(attribute.String("a", "x"))
+	m.RecordBatch(
+		context.Background(),
+		attrs,
+		ctr.Measurement(3),
+		hist.Measurement(4),
+		noop.Int64Counter{}.Measurement(1), // Dropped.
+	)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	want := metricdata.ScopeMetrics{
+		Scope: instrumentation.Scope{Name: "TestMeterRecordBatch"},
+		Metrics: []metricdata.Metrics{
+			{
+				Name: "int64.counter",
+				Data: metricdata.Sum[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints: []metricdata.DataPoint[int64]{
+						{Attributes: attrs, Value: 3},
+					},
+				},
+			},
+			{
+				Name: "float64.histogram",
+				Data: metricdata.Histogram[float64]{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints: []metricdata.HistogramDataPoint[float64]{
+						{
+							Attributes:   attrs,
+							Count:        1,
+							Bounds:       []float64{5},
+							BucketCounts: []uint64{1, 0},
+							Min:          metricdata.NewExtrema(4.),
+							Max:          metricdata.NewExtrema(4.),
+							Sum:          4,
+						},
+					},
+				},
+			},
+		},
+	}
+	metricdatatest.AssertEqual(t, want, rm.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestMeterRecordBatchForeignInstruments(t *testing.T) {
+	var logged []string
+	l := funcr.New(func(_, args string) { logged = append(logged, args) }, funcr.Options{Verbosity: 1})
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr), WithInternalLogger(l))
+	m := mp.Meter("TestMeterRecordBatchForeignInstruments")
+	withAttrs := mp.Meter(
+		"TestMeterRecordBatchForeignInstruments",
+		metric.WithMeterAttributes(attribute.String("component", "cache")),
+	)
+	other := NewMeterProvider().Meter("TestMeterRecordBatchForeignInstruments")
+
+	ctr, err := m.Int64Counter("counter")
+	require.NoError(t, err)
+	attrsCtr, err := withAttrs.Int64Counter("counter")
+	require.NoError(t, err)
+	otherCtr, err := other.Int64Counter("counter")
+	require.NoError(t, err)
+	otherHist, err := other.Float64Histogram("histogram")
+	require.NoError(t, err)
+
+	m.RecordBatch(
+		context.Background(),
+		*attribute.EmptySet(),
+		ctr.Measurement(1),
+		attrsCtr.Measurement(2),  // Other meter attributes.
+		otherCtr.Measurement(4),  // Other MeterProvider.
+		otherHist.Measurement(8), // Other MeterProvider.
+	)
+	assert.Len(t, logged, 3, "dropped measurements not logged")
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints:  []metricdata.DataPoint[int64]{{Value: 1}},
+	}, rm.ScopeMetrics[0].Metrics[0].Data, metricdatatest.IgnoreTimestamp())
+}
+
+func TestExtremaStreams(t *testing.T) {
+	gauge := func(name string, v int64) metricdata.Metrics {
+		return metricdata.Metrics{
+			Name: name,
+			Data: metricdata.Gauge[int64]{
+				DataPoints: []metricdata.DataPoint[int64]{{Value: v}},
+			},
+		}
+	}
+
+	collect := func(t *testing.T, temporality metricdata.Temporality) []metricdata.ScopeMetrics {
+		t.Helper()
+
+		rdr := NewManualReader(WithTemporalitySelector(func(InstrumentKind) metricdata.Temporality {
+			return temporality
+		}))
+		view := NewView(Instrument{Name: "*"}, Stream{ExtremaStreams: true})
+		m := NewMeterProvider(WithReader(rdr), WithView(view)).Meter("TestExtremaStreams")
+
+		// A single observation is made per collection.
+		values := []int64{3, 7, 1}
+		_, err := m.Int64ObservableGauge("gauge", metric.WithInt64Callback(
+			func(_ context.Context, o metric.Int64Observer) error {
+				o.Observe(values[0])
+				values = values[1:]
+				return nil
+			},
+		))
+		require.NoError(t, err)
+		// Extrema are not added for other instrument kinds.
+		_, err = m.Int64ObservableCounter("counter", metric.WithInt64Callback(
+			func(_ context.Context, o metric.Int64Observer) error {
+				o.Observe(1)
+				return nil
+			},
+		))
+		require.NoError(t, err)
+
+		var got []metricdata.ScopeMetrics
+		for range values {
+			var rm metricdata.ResourceMetrics
+			require.NoError(t, rdr.Collect(context.Background(), &rm))
+			require.Len(t, rm.ScopeMetrics, 1)
+			got = append(got, rm.ScopeMetrics[0])
+		}
+		return got
+	}
+
+	want := func(temporality metricdata.Temporality, v, minV, maxV, ctr int64) metricdata.ScopeMetrics {
+		return metricdata.ScopeMetrics{
+			Scope: instrumentation.Scope{Name: "TestExtremaStreams"},
+			Metrics: []metricdata.Metrics{
+				gauge("gauge", v),
+				gauge("gauge.min", minV),
+				gauge("gauge.max", maxV),
+				{
+					Name: "counter",
+					Data: metricdata.Sum[int64]{
+						Temporality: temporality,
+						IsMonotonic: true,
+						DataPoints:  []metricdata.DataPoint[int64]{{Value: ctr}},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("Cumulative", func(t *testing.T) {
+		got := collect(t, metricdata.CumulativeTemporality)
+		require.Len(t, got, 3)
+
+		// The extrema span the collections.
+		c := metricdata.CumulativeTemporality
+		metricdatatest.AssertEqual(t, want(c, 3, 3, 3, 1), got[0], metricdatatest.IgnoreTimestamp())
+		metricdatatest.AssertEqual(t, want(c, 7, 3, 7, 1), got[1], metricdatatest.IgnoreTimestamp())
+		metricdatatest.AssertEqual(t, want(c, 1, 1, 7, 1), got[2], metricdatatest.IgnoreTimestamp())
+	})
+
+	t.Run("Delta", func(t *testing.T) {
+		got := collect(t, metricdata.DeltaTemporality)
+		require.Len(t, got, 3)
+
+		// The extrema are of the observations of each collection.
+		d := metricdata.DeltaTemporality
+		metricdatatest.AssertEqual(t, want(d, 3, 3, 3, 1), got[0], metricdatatest.IgnoreTimestamp())
+		metricdatatest.AssertEqual(t, want(d, 7, 7, 7, 0), got[1], metricdatatest.IgnoreTimestamp())
+		metricdatatest.AssertEqual(t, want(d, 1, 1, 1, 0), got[2], metricdatatest.IgnoreTimestamp())
+	})
+}
+
+func TestExtremaStreamsConflict(t *testing.T) {
+	var msg string
+	otel.SetLogger(funcr.New(func(_, args string) {
+		msg += args
+	}, funcr.Options{Verbosity: 20}))
+	defer otel.SetLogger(logr.Discard())
+
+	view := NewView(Instrument{Name: "*"}, Stream{ExtremaStreams: true})
+	m := NewMeterProvider(WithReader(NewManualReader()), WithView(view)).Meter("TestExtremaStreamsConflict")
+
+	_, err := m.Int64Counter("gauge.max")
+	require.NoError(t, err)
+	_, err = m.Int64ObservableGauge("gauge")
+	require.NoError(t, err)
+
+	assert.Contains(t, msg, "duplicate metric stream definitions")
+	assert.Contains(t, msg, "gauge.max")
+}
+func TestUnitConversion(t *testing.T) {
+	msToS, err := NewUnitConversion(metric.UnitMilliseconds, metric.UnitSeconds)
+	require.NoError(t, err)
+	sToMs, err := NewUnitConversion(metric.UnitSeconds, metric.UnitMilliseconds)
+	require.NoError(t, err)
+
+	rdr := NewManualReader()
+	m := NewMeterProvider(
+		WithReader(rdr),
+		WithView(
+			NewView(Instrument{Name: "float.*"}, Stream{UnitConversion: msToS}),
+			NewView(Instrument{Name: "int.ms"}, Stream{UnitConversion: msToS}),
+			NewView(Instrument{Name: "int.s"}, Stream{UnitConversion: sToMs}),
+		),
+	).Meter("TestUnitConversion")
+
+	ctx := context.Background()
+	hist, err := m.Float64Histogram("float.ms", metric.WithUnit(metric.UnitMilliseconds),
+		metric.WithExplicitBucketBoundaries(1))
+	require.NoError(t, err)
+	hist.Record(ctx, 500)
+	hist.Record(ctx, 1500)
+
+	// Not converted to s, as it would lose precision.
+	intMs, err := m.Int64Counter("int.ms", metric.WithUnit(metric.UnitMilliseconds))
+	require.NoError(t, err)
+	intMs.Add(ctx, 1500)
+
+	intS, err := m.Int64Counter("int.s", metric.WithUnit(metric.UnitSeconds))
+	require.NoError(t, err)
+	intS.Add(ctx, 2)
+
+	// Not matched by the unit of the conversion.
+	bytes, err := m.Float64Counter("float.bytes", metric.WithUnit(metric.UnitBytes))
+	require.NoError(t, err)
+	bytes.Add(ctx, 10)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+
+	sum := func(name, unit string, v int64) metricdata.Metrics {
+		return metricdata.Metrics{
+			Name: name,
+			Unit: unit,
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints:  []metricdata.DataPoint[int64]{{Value: v}},
+			},
+		}
+	}
+	want := metricdata.ScopeMetrics{
+		Scope: instrumentation.Scope{Name: "TestUnitConversion"},
+		Metrics: []metricdata.Metrics{
+			{
+				Name: "float.ms",
+				Unit: metric.UnitSeconds,
+				Data: metricdata.Histogram[float64]{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints: []metricdata.HistogramDataPoint[float64]{{
+						Count:        2,
+						Bounds:       []float64{1},
+						BucketCounts: []uint64{1, 1},
+						Min:          metricdata.NewExtrema(0.5),
+						Max:          metricdata.NewExtrema(1.5),
+						Sum:          2,
+					}},
+				},
+			},
+			sum("int.ms", metric.UnitMilliseconds, 1500),
+			sum("int.s", metric.UnitMilliseconds, 2000),
+			{
+				Name: "float.bytes",
+				Unit: metric.UnitBytes,
+				Data: metricdata.Sum[float64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints:  []metricdata.DataPoint[float64]{{Value: 10}},
+				},
+			},
+		},
+	}
+	metricdatatest.AssertEqual(t, want, rm.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestMeterAttributes(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr))
+	component := attribute.String("component", "cache")
+	m := mp.Meter("TestMeterAttributes", metric.WithMeterAttributes(component))
+	plain := mp.Meter("TestMeterAttributes")
+
+	ctx := context.Background()
+	ctr, err := m.Int64Counter("counter")
+	require.NoError(t, err)
+	ctr.Add(ctx, 1)
+	ctr.Add(ctx, 2, metric.WithAttributes(attribute.String("a", "x")))
+	ctr.Add(ctx, 4, metric.WithAttributes(attribute.String("component", "db")))
+	m.RecordBatch(ctx, *attribute.EmptySet(), ctr.Measurement(8))
+
+	// The meters of the scope share their instruments.
+	plainCtr, err := plain.Int64Counter("counter")
+	require.NoError(t, err)
+	plainCtr.Add(ctx, 16)
+
+	obs, err := m.Float64ObservableGauge("gauge")
+	require.NoError(t, err)
+	_, err = plain.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveFloat64(obs, 1)
+		return nil
+	}, obs)
+	require.NoError(t, err)
+
+	_, err = m.Int64ObservableCounter("observable", metric.WithInt64Callback(
+		func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(3, metric.WithAttributes(attribute.String("a", "x")))
+			return nil
+		},
+	))
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	want := metricdata.ScopeMetrics{
+		Scope: instrumentation.Scope{Name: "TestMeterAttributes"},
+		Metrics: []metricdata.Metrics{
+			{
+				Name: "counter",
+				Data: metricdata.Sum[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints: []metricdata.DataPoint[int64]{
+						{Attributes: attribute.NewSet(component), Value: 9},
+						{Attributes: attribute.NewSet(component, attribute.String("a", "x")), Value: 2},
+						{Attributes: attribute.NewSet(attribute.String("component", "db")), Value: 4},
+						{Attributes: *attribute.EmptySet(), Value: 16},
+					},
+				},
+			},
+			{
+				Name: "gauge",
+				Data: metricdata.Gauge[float64]{
+					DataPoints: []metricdata.DataPoint[float64]{
+						{Attributes: attribute.NewSet(component), Value: 1},
+					},
+				},
+			},
+			{
+				Name: "observable",
+				Data: metricdata.Sum[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints: []metricdata.DataPoint[int64]{
+						{Attributes: attribute.NewSet(component, attribute.String("a", "x")), Value: 3},
+					},
+				},
+			},
+		},
+	}
+	metricdatatest.AssertEqual(t, want, rm.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestContextAttributes(t *testing.T) {
+	user := attribute.String("user", "alice")
+	route := attribute.String("route", "/")
+	ctx := metric.ContextWithAttributes(context.Background(), user, route)
+
+	tests := []struct {
+		name string
+		opts []Option
+		want []metricdata.DataPoint[int64]
+	}{
+		{
+			name: "Disabled",
+			want: []metricdata.DataPoint[int64]{
+				{Attributes: *attribute.EmptySet(), Value: 3},
+				{Attributes: attribute.NewSet(attribute.String("user", "bob")), Value: 4},
+			},
+		},
+		{
+			name: "AllKeys",
+			opts: []Option{WithContextAttributes()},
+			want: []metricdata.DataPoint[int64]{
+				{Attributes: attribute.NewSet(user, route), Value: 3},
+				{Attributes: attribute.NewSet(attribute.String("user", "bob"), route), Value: 4},
+			},
+		},
+		{
+			name: "SelectedKeys",
+			opts: []Option{WithContextAttributes("route")},
+			want: []metricdata.DataPoint[int64]{
+				{Attributes: attribute.NewSet(route), Value: 3},
+				{Attributes: attribute.NewSet(attribute.String("user", "bob"), route), Value: 4},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rdr := NewManualReader()
+			mp := NewMeterProvider(append(tt.opts, WithReader(rdr))...)
+			m := mp.Meter("TestContextAttributes")
+			ctr, err := m.Int64Counter("counter")
+			require.NoError(t, err)
+
+			ctr.Add(ctx, 1)
+			m.RecordBatch(ctx, *attribute.EmptySet(), ctr.Measurement(2))
+			ctr.Add(ctx, 4, metric.WithAttributes(attribute.String("user", "bob")))
+
+			var rm metricdata.ResourceMetrics
+			require.NoError(t, rdr.Collect(context.Background(), &rm))
+			require.Len(t, rm.ScopeMetrics, 1)
+			require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+			want := metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints:  tt.want,
+			}
+			metricdatatest.AssertAggregationsEqual(t, want, rm.ScopeMetrics[0].Metrics[0].Data, metricdatatest.IgnoreTimestamp())
+		})
+	}
+}
+
+func TestHistogramRecordBuckets(t *testing.T) {
+	rdr := NewManualReader()
+	m := NewMeterProvider(WithReader(rdr)).Meter("TestHistogramRecordBuckets")
+
+	hist, err := m.Int64Histogram("histogram", metric.WithExplicitBucketBoundaries(1, 5, 10))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	hist.RecordBuckets(ctx, metric.HistogramBuckets{
+		Bounds: []float64{1, 5},
+		Counts: []uint64{2, 3, 4},
+		Sum:    50,
+		Min:    1,
+		Max:    20,
+	})
+	// Invalid buckets are dropped.
+	hist.RecordBuckets(ctx, metric.HistogramBuckets{Bounds: []float64{1}, Counts: []uint64{1}})
+	hist.RecordBuckets(ctx, metric.HistogramBuckets{Bounds: []float64{5, 1}, Counts: []uint64{1, 1, 1}})
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	want := metricdata.ScopeMetrics{
+		Scope: instrumentation.Scope{Name: "TestHistogramRecordBuckets"},
+		Metrics: []metricdata.Metrics{
+			{
+				Name: "histogram",
+				Data: metricdata.Histogram[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints: []metricdata.HistogramDataPoint[int64]{
+						{
+							Count:        9,
+							Bounds:       []float64{1, 5, 10},
+							BucketCounts: []uint64{2, 3, 0, 4},
+							Min:          metricdata.NewExtrema[int64](1),
+							Max:          metricdata.NewExtrema[int64](20),
+							Sum:          50,
+						},
+					},
+				},
+			},
+		},
+	}
+	metricdatatest.AssertEqual(t, want, rm.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestHistogramRecordBucketsSumAggregation(t *testing.T) {
+	rdr := NewManualReader()
+	view := NewView(Instrument{Name: "histogram"}, Stream{Aggregation: AggregationSum{}})
+	m := NewMeterProvider(WithReader(rdr), WithView(view)).Meter("TestHistogramRecordBucketsSumAggregation")
+
+	hist, err := m.Float64Histogram("histogram")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	hist.RecordBuckets(ctx, metric.HistogramBuckets{
+		Bounds: []float64{1, 5},
+		Counts: []uint64{2, 3, 4},
+		Sum:    50.5,
+		Min:    1,
+		Max:    20,
+	}, metric.WithAttributes(attribute.String("a", "x")))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	want := metricdata.ScopeMetrics{
+		Scope: instrumentation.Scope{Name: "TestHistogramRecordBucketsSumAggregation"},
+		Metrics: []metricdata.Metrics{
+			{
+				Name: "histogram",
+				Data: metricdata.Sum[float64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints: []metricdata.DataPoint[float64]{
+						{Attributes: attribute.NewSet(attribute.String("a", "x")), Value: 50.5},
+					},
+				},
+			},
+		},
+	}
+	metricdatatest.AssertEqual(t, want, rm.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestHistogramRecordBucketsUnitConversion(t *testing.T) {
+	msToS, err := NewUnitConversion(metric.UnitMilliseconds, metric.UnitSeconds)
+	require.NoError(t, err)
+
+	rdr := NewManualReader()
+	view := NewView(Instrument{Name: "duration"}, Stream{
+		UnitConversion: msToS,
+		Aggregation:    AggregationExplicitBucketHistogram{Boundaries: []float64{0.001, 0.005, 0.01}},
+	})
+	m := NewMeterProvider(WithReader(rdr), WithView(view)).Meter("TestHistogramRecordBucketsUnitConversion")
+
+	hist, err := m.Float64Histogram("duration", metric.WithUnit(metric.UnitMilliseconds))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	b := metric.HistogramBuckets{
+		Bounds: []float64{1, 5},
+		Counts: []uint64{2, 3, 4},
+		Sum:    50,
+		Min:    0.5,
+		Max:    20,
+	}
+	hist.RecordBuckets(ctx, b)
+	assert.Equal(t, []float64{1, 5}, b.Bounds, "recorded buckets modified")
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	want := metricdata.ScopeMetrics{
+		Scope: instrumentation.Scope{Name: "TestHistogramRecordBucketsUnitConversion"},
+		Metrics: []metricdata.Metrics{
+			{
+				Name: "duration",
+				Unit: metric.UnitSeconds,
+				Data: metricdata.Histogram[float64]{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints: []metricdata.HistogramDataPoint[float64]{
+						{
+							Count:        9,
+							Bounds:       []float64{0.001, 0.005, 0.01},
+							BucketCounts: []uint64{2, 3, 0, 4},
+							Min:          metricdata.NewExtrema(0.0005),
+							Max:          metricdata.NewExtrema(0.02),
+							Sum:          0.05,
+						},
+					},
+				},
+			},
+		},
+	}
+	metricdatatest.AssertEqual(t, want, rm.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+}
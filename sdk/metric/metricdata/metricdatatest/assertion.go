@@ -7,6 +7,7 @@ package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata
 
 import (
 	"fmt"
+	"strings"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
@@ -62,6 +63,7 @@ type config struct {
 	ignoreTimestamp bool
 	ignoreExemplars bool
 	ignoreValue     bool
+	tolerance       float64
 }
 
 func newConfig(opts []Option) config {
@@ -113,6 +115,20 @@ func IgnoreValue() Option {
 	})
 }
 
+// FloatTolerance considers values equal if they differ by at most
+// tolerance. This can be useful for float64 values resulting from
+// computations subject to rounding errors.
+//
+// This applies to the value of DataPoints and Exemplars; the sum, min, and max
+// of HistogramDataPoints and ExponentialHistogramDataPoints; the sum of
+// SummaryDataPoints and the value of QuantileValues; and Extrema.
+func FloatTolerance(tolerance float64) Option {
+	return fnOption(func(cfg config) config {
+		cfg.tolerance = tolerance
+		return cfg
+	})
+}
+
 // AssertEqual asserts that the two concrete data-types from the metricdata
 // package are equal.
 func AssertEqual[T Datatypes](t TestingT, expected, actual T, opts ...Option) bool {
@@ -120,10 +136,19 @@ func AssertEqual[T Datatypes](t TestingT, expected, actual T, opts ...Option) bo
 
 	cfg := newConfig(opts)
 
+	if r := equalDatatypes(expected, actual, cfg); len(r) > 0 {
+		t.Error(r)
+		return false
+	}
+	return true
+}
+
+// equalDatatypes returns reasons the concrete data-types expected and actual
+// are not equal. If they are equal, the returned reasons will be empty.
+func equalDatatypes[T Datatypes](expected, actual T, cfg config) (r []string) {
 	// Generic types cannot be type asserted. Use an interface instead.
 	aIface := interface{}(actual)
 
-	var r []string
 	switch e := interface{}(expected).(type) {
 	case metricdata.Exemplar[int64]:
 		r = equalExemplars(e, aIface.(metricdata.Exemplar[int64]), cfg)
@@ -181,11 +206,7 @@ func AssertEqual[T Datatypes](t TestingT, expected, actual T, opts ...Option) bo
 		panic(fmt.Sprintf("unknown types: %T", expected))
 	}
 
-	if len(r) > 0 {
-		t.Error(r)
-		return false
-	}
-	return true
+	return r
 }
 
 // AssertAggregationsEqual asserts that two Aggregations are equal.
@@ -200,6 +221,109 @@ func AssertAggregationsEqual(t TestingT, expected, actual metricdata.Aggregation
 	return true
 }
 
+// DataPointTypes are the data point types of the metricdata package.
+type DataPointTypes interface {
+	metricdata.DataPoint[int64] |
+		metricdata.DataPoint[float64] |
+		metricdata.HistogramDataPoint[int64] |
+		metricdata.HistogramDataPoint[float64] |
+		metricdata.ExponentialHistogramDataPoint[int64] |
+		metricdata.ExponentialHistogramDataPoint[float64] |
+		metricdata.SummaryDataPoint
+}
+
+// AssertDataPoint asserts that agg holds a data point with the same
+// attributes as expected, and that this data point is equal to expected.
+// Only this data point is compared, agg can hold other data points.
+//
+// Timestamps and exemplars are not compared. Use AssertEqual to compare
+// them.
+func AssertDataPoint[T DataPointTypes](t TestingT, agg metricdata.Aggregation, expected T, opts ...Option) bool {
+	t.Helper()
+
+	cfg := newConfig(opts)
+	cfg.ignoreTimestamp = true
+	cfg.ignoreExemplars = true
+
+	points, ok := dataPoints(agg).([]T)
+	if !ok {
+		t.Error(fmt.Sprintf("aggregation %T does not hold data points of type %T", agg, expected))
+		return false
+	}
+
+	attrs := dataPointAttributes(expected)
+	for _, p := range points {
+		pAttrs := dataPointAttributes(p)
+		if !pAttrs.Equals(&attrs) {
+			continue
+		}
+		if r := equalDatatypes(expected, p, cfg); len(r) > 0 {
+			t.Error(r)
+			return false
+		}
+		return true
+	}
+
+	found := make([]string, len(points))
+	for i, p := range points {
+		pAttrs := dataPointAttributes(p)
+		found[i] = "{" + pAttrs.Encoded(attribute.DefaultEncoder()) + "}"
+	}
+	t.Error(fmt.Sprintf(
+		"no data point with attributes {%s}, found data points with attributes: %s",
+		attrs.Encoded(attribute.DefaultEncoder()), strings.Join(found, ", "),
+	))
+	return false
+}
+
+// dataPoints returns the data points held by agg.
+func dataPoints(agg metricdata.Aggregation) any {
+	switch a := agg.(type) {
+	case metricdata.Gauge[int64]:
+		return a.DataPoints
+	case metricdata.Gauge[float64]:
+		return a.DataPoints
+	case metricdata.Sum[int64]:
+		return a.DataPoints
+	case metricdata.Sum[float64]:
+		return a.DataPoints
+	case metricdata.Histogram[int64]:
+		return a.DataPoints
+	case metricdata.Histogram[float64]:
+		return a.DataPoints
+	case metricdata.ExponentialHistogram[int64]:
+		return a.DataPoints
+	case metricdata.ExponentialHistogram[float64]:
+		return a.DataPoints
+	case metricdata.Summary:
+		return a.DataPoints
+	}
+	return nil
+}
+
+func dataPointAttributes[T DataPointTypes](p T) attribute.Set {
+	switch v := interface{}(p).(type) {
+	case metricdata.DataPoint[int64]:
+		return v.Attributes
+	case metricdata.DataPoint[float64]:
+		return v.Attributes
+	case metricdata.HistogramDataPoint[int64]:
+		return v.Attributes
+	case metricdata.HistogramDataPoint[float64]:
+		return v.Attributes
+	case metricdata.ExponentialHistogramDataPoint[int64]:
+		return v.Attributes
+	case metricdata.ExponentialHistogramDataPoint[float64]:
+		return v.Attributes
+	case metricdata.SummaryDataPoint:
+		return v.Attributes
+	default:
+		// We control all types passed to this, panic to signal developers
+		// early they changed things in an incompatible way.
+		panic(fmt.Sprintf("unknown types: %T", p))
+	}
+}
+
 // AssertHasAttributes asserts that all Datapoints or HistogramDataPoints have all passed attrs.
 func AssertHasAttributes[T Datatypes](t TestingT, actual T, attrs ...attribute.KeyValue) bool {
 	t.Helper()
@@ -1137,6 +1137,58 @@ func TestAssertAttributesFail(t *testing.T) {
 	assert.False(t, AssertHasAttributes(fakeT, sum, attribute.Bool("A", true)))
 }
 
+func TestAssertEqualFloatTolerance(t *testing.T) {
+	dp := dataPointFloat64A
+	dp.Value += 1e-9
+	dp.Exemplars = []metricdata.Exemplar[float64]{exemplarFloat64A}
+	dp.Exemplars[0].Value += 1e-9
+	assert.False(t, AssertEqual(&testing.T{}, dataPointFloat64A, dp))
+	assert.False(t, AssertEqual(&testing.T{}, dataPointFloat64A, dp, FloatTolerance(1e-10)))
+	assert.True(t, AssertEqual(t, dataPointFloat64A, dp, FloatTolerance(1e-6)))
+
+	hdp := histogramDataPointFloat64A
+	hdp.Sum += 1e-9
+	minV, _ := minFloat64A.Value()
+	hdp.Min = metricdata.NewExtrema(minV + 1e-9)
+	assert.False(t, AssertEqual(&testing.T{}, histogramDataPointFloat64A, hdp))
+	assert.True(t, AssertEqual(t, histogramDataPointFloat64A, hdp, FloatTolerance(1e-6)))
+
+	sdp := summaryDataPointA
+	sdp.QuantileValues = []metricdata.QuantileValue{quantileValueA}
+	sdp.QuantileValues[0].Value += 1e-9
+	assert.False(t, AssertEqual(&testing.T{}, summaryDataPointA, sdp))
+	assert.True(t, AssertEqual(t, summaryDataPointA, sdp, FloatTolerance(1e-6)))
+}
+
+func TestAssertDataPoint(t *testing.T) {
+	sum := metricdata.Sum[float64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints:  []metricdata.DataPoint[float64]{dataPointFloat64A, dataPointFloat64B},
+	}
+
+	// Timestamps and exemplars are ignored.
+	want := metricdata.DataPoint[float64]{Attributes: attrB, Value: 2}
+	assert.True(t, AssertDataPoint(t, sum, want))
+	want.Value = 2.0001
+	assert.True(t, AssertDataPoint(t, sum, want, FloatTolerance(0.001)))
+	assert.False(t, AssertDataPoint(&testing.T{}, sum, want), "value")
+
+	other := metricdata.DataPoint[float64]{Attributes: attribute.NewSet(attribute.Bool("C", true))}
+	assert.False(t, AssertDataPoint(&testing.T{}, sum, other), "attributes")
+	assert.False(t, AssertDataPoint(&testing.T{}, sum, metricdata.DataPoint[int64]{Attributes: attrA}), "type")
+
+	assert.True(t, AssertDataPoint(t, histogramInt64A, metricdata.HistogramDataPoint[int64]{
+		Attributes:   attrA,
+		Count:        2,
+		Bounds:       []float64{0, 10},
+		BucketCounts: []uint64{1, 1},
+		Min:          minInt64A,
+		Sum:          2,
+	}))
+	assert.True(t, AssertDataPoint(t, summaryA, summaryDataPointA))
+}
+
 func AssertMarshal[N int64 | float64](t *testing.T, expected string, i *metricdata.Extrema[N]) {
 	t.Helper()
 
@@ -1157,3 +1209,26 @@ func TestAssertMarshal(t *testing.T) {
 	AssertMarshal(t, "3", &minInt64B)
 	AssertMarshal(t, "99", &maxInt64B)
 }
+
+func TestEqualKeyValue(t *testing.T) {
+	for _, kv := range []attribute.KeyValue{
+		attribute.Bytes("bytes", []byte("a")),
+		attribute.Slice("slice", attribute.StringValue("a"), attribute.SliceValue(attribute.Int64Value(1))),
+		attribute.Map("map", attribute.String("a", "b"), attribute.Map("c", attribute.Bool("d", true))),
+	} {
+		assert.Truef(t, equalKeyValue(kv, kv), "%s not equal to itself", kv.Value.Type())
+	}
+
+	assert.False(t, equalKeyValue(
+		attribute.Bytes("bytes", []byte("a")),
+		attribute.Bytes("bytes", []byte("b")),
+	))
+	assert.False(t, equalKeyValue(
+		attribute.Slice("slice", attribute.StringValue("a")),
+		attribute.Slice("slice", attribute.StringValue("a"), attribute.StringValue("b")),
+	))
+	assert.False(t, equalKeyValue(
+		attribute.Map("map", attribute.Map("c", attribute.Bool("d", true))),
+		attribute.Map("map", attribute.Map("c", attribute.Bool("d", false))),
+	))
+}
@@ -6,6 +6,7 @@ package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"reflect"
 	"slices"
 
@@ -252,7 +253,7 @@ func equalDataPoints[N int64 | float64](
 	}
 
 	if !cfg.ignoreValue {
-		if a.Value != b.Value {
+		if !eqValue(a.Value, b.Value, cfg) {
 			reasons = append(reasons, notEqualStr("Value", a.Value, b.Value))
 		}
 	}
@@ -304,13 +305,13 @@ func equalHistogramDataPoints[N int64 | float64](
 		if !slices.Equal(a.BucketCounts, b.BucketCounts) {
 			reasons = append(reasons, notEqualStr("BucketCounts", a.BucketCounts, b.BucketCounts))
 		}
-		if !eqExtrema(a.Min, b.Min) {
+		if !eqExtrema(a.Min, b.Min, cfg) {
 			reasons = append(reasons, notEqualStr("Min", a.Min, b.Min))
 		}
-		if !eqExtrema(a.Max, b.Max) {
+		if !eqExtrema(a.Max, b.Max, cfg) {
 			reasons = append(reasons, notEqualStr("Max", a.Max, b.Max))
 		}
-		if a.Sum != b.Sum {
+		if !eqValue(a.Sum, b.Sum, cfg) {
 			reasons = append(reasons, notEqualStr("Sum", a.Sum, b.Sum))
 		}
 	}
@@ -382,13 +383,13 @@ func equalExponentialHistogramDataPoints[N int64 | float64](
 		if a.Count != b.Count {
 			reasons = append(reasons, notEqualStr("Count", a.Count, b.Count))
 		}
-		if !eqExtrema(a.Min, b.Min) {
+		if !eqExtrema(a.Min, b.Min, cfg) {
 			reasons = append(reasons, notEqualStr("Min", a.Min, b.Min))
 		}
-		if !eqExtrema(a.Max, b.Max) {
+		if !eqExtrema(a.Max, b.Max, cfg) {
 			reasons = append(reasons, notEqualStr("Max", a.Max, b.Max))
 		}
-		if a.Sum != b.Sum {
+		if !eqValue(a.Sum, b.Sum, cfg) {
 			reasons = append(reasons, notEqualStr("Sum", a.Sum, b.Sum))
 		}
 
@@ -469,7 +470,7 @@ func equalSummaryDataPoint(a, b metricdata.SummaryDataPoint, cfg config) (reason
 		if a.Count != b.Count {
 			reasons = append(reasons, notEqualStr("Count", a.Count, b.Count))
 		}
-		if a.Sum != b.Sum {
+		if !eqValue(a.Sum, b.Sum, cfg) {
 			reasons = append(reasons, notEqualStr("Sum", a.Sum, b.Sum))
 		}
 		r := compareDiff(diffSlices(
@@ -487,11 +488,11 @@ func equalSummaryDataPoint(a, b metricdata.SummaryDataPoint, cfg config) (reason
 	return reasons
 }
 
-func equalQuantileValue(a, b metricdata.QuantileValue, _ config) (reasons []string) {
+func equalQuantileValue(a, b metricdata.QuantileValue, cfg config) (reasons []string) {
 	if a.Quantile != b.Quantile {
 		reasons = append(reasons, notEqualStr("Quantile", a.Quantile, b.Quantile))
 	}
-	if a.Value != b.Value {
+	if !eqValue(a.Value, b.Value, cfg) {
 		reasons = append(reasons, notEqualStr("Value", a.Value, b.Value))
 	}
 	return reasons
@@ -501,21 +502,30 @@ func notEqualStr(prefix string, expected, actual interface{}) string {
 	return fmt.Sprintf("%s not equal:\nexpected: %v\nactual: %v", prefix, expected, actual)
 }
 
-func equalExtrema[N int64 | float64](a, b metricdata.Extrema[N], _ config) (reasons []string) {
-	if !eqExtrema(a, b) {
+func equalExtrema[N int64 | float64](a, b metricdata.Extrema[N], cfg config) (reasons []string) {
+	if !eqExtrema(a, b, cfg) {
 		reasons = append(reasons, notEqualStr("Extrema", a, b))
 	}
 	return reasons
 }
 
-func eqExtrema[N int64 | float64](a, b metricdata.Extrema[N]) bool {
+func eqExtrema[N int64 | float64](a, b metricdata.Extrema[N], cfg config) bool {
 	aV, aOk := a.Value()
 	bV, bOk := b.Value()
 
 	if !aOk || !bOk {
 		return aOk == bOk
 	}
-	return aV == bV
+	return eqValue(aV, bV, cfg)
+}
+
+// eqValue returns true if a and b are equal, or differ by at most the
+// tolerance of cfg.
+func eqValue[N int64 | float64](a, b N, cfg config) bool {
+	if a == b {
+		return true
+	}
+	return cfg.tolerance > 0 && math.Abs(float64(a)-float64(b)) <= cfg.tolerance
 }
 
 func equalKeyValue(a, b attribute.KeyValue) bool {
@@ -558,6 +568,18 @@ func equalKeyValue(a, b attribute.KeyValue) bool {
 		if ok := slices.Equal(a.Value.AsStringSlice(), b.Value.AsStringSlice()); !ok {
 			return false
 		}
+	case attribute.BYTES:
+		if ok := slices.Equal(a.Value.AsBytes(), b.Value.AsBytes()); !ok {
+			return false
+		}
+	case attribute.SLICE:
+		if ok := slices.EqualFunc(a.Value.AsSlice(), b.Value.AsSlice(), equalValue); !ok {
+			return false
+		}
+	case attribute.MAP:
+		if ok := slices.EqualFunc(a.Value.AsMap(), b.Value.AsMap(), equalKeyValue); !ok {
+			return false
+		}
 	default:
 		// We control all types passed to this, panic to signal developers
 		// early they changed things in an incompatible way.
@@ -566,6 +588,10 @@ func equalKeyValue(a, b attribute.KeyValue) bool {
 	return true
 }
 
+func equalValue(a, b attribute.Value) bool {
+	return equalKeyValue(attribute.KeyValue{Value: a}, attribute.KeyValue{Value: b})
+}
+
 func equalExemplars[N int64 | float64](a, b metricdata.Exemplar[N], cfg config) (reasons []string) {
 	if !slices.EqualFunc(a.FilteredAttributes, b.FilteredAttributes, equalKeyValue) {
 		reasons = append(reasons, notEqualStr("FilteredAttributes", a.FilteredAttributes, b.FilteredAttributes))
@@ -576,7 +602,7 @@ func equalExemplars[N int64 | float64](a, b metricdata.Exemplar[N], cfg config)
 		}
 	}
 	if !cfg.ignoreValue {
-		if a.Value != b.Value {
+		if !eqValue(a.Value, b.Value, cfg) {
 			reasons = append(reasons, notEqualStr("Value", a.Value, b.Value))
 		}
 	}
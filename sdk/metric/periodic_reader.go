@@ -12,7 +12,8 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/internal/exportstatus"
+	"go.opentelemetry.io/otel/sdk/internal/logging"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
@@ -27,18 +28,31 @@ type periodicReaderConfig struct {
 	interval  time.Duration
 	timeout   time.Duration
 	producers []Producer
+
+	// ignoreEnv disables the configuration from environment variables.
+	ignoreEnv bool
 }
 
 // newPeriodicReaderConfig returns a periodicReaderConfig configured with
 // options.
 func newPeriodicReaderConfig(options []PeriodicReaderOption) periodicReaderConfig {
-	c := periodicReaderConfig{
-		interval: envDuration(envInterval, defaultInterval),
-		timeout:  envDuration(envTimeout, defaultTimeout),
-	}
+	var c periodicReaderConfig
 	for _, o := range options {
 		c = o.applyPeriodic(c)
 	}
+	// Options have precedence, only non-positive durations are unset.
+	if c.interval <= 0 {
+		c.interval = defaultInterval
+		if !c.ignoreEnv {
+			c.interval = envDuration(envInterval, defaultInterval)
+		}
+	}
+	if c.timeout <= 0 {
+		c.timeout = defaultTimeout
+		if !c.ignoreEnv {
+			c.timeout = envDuration(envTimeout, defaultTimeout)
+		}
+	}
 	return c
 }
 
@@ -94,6 +108,17 @@ func WithInterval(d time.Duration) PeriodicReaderOption {
 	})
 }
 
+// WithoutPeriodicReaderEnvironment configures a PeriodicReader to ignore the
+// OTEL_METRIC_EXPORT_INTERVAL and OTEL_METRIC_EXPORT_TIMEOUT environment
+// variables. The defaults are used for the settings not configured by
+// options.
+func WithoutPeriodicReaderEnvironment() PeriodicReaderOption {
+	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
+		conf.ignoreEnv = true
+		return conf
+	})
+}
+
 // NewPeriodicReader returns a Reader that collects and exports metric data to
 // the exporter at a defined interval. By default, the returned Reader will
 // collect and export data every 60 seconds, and will cancel any attempts that
@@ -107,12 +132,13 @@ func NewPeriodicReader(exporter Exporter, options ...PeriodicReaderOption) *Peri
 	conf := newPeriodicReaderConfig(options)
 	ctx, cancel := context.WithCancel(context.Background())
 	r := &PeriodicReader{
-		interval: conf.interval,
-		timeout:  conf.timeout,
-		exporter: exporter,
-		flushCh:  make(chan chan error),
-		cancel:   cancel,
-		done:     make(chan struct{}),
+		interval:   conf.interval,
+		timeout:    conf.timeout,
+		exporter:   exporter,
+		flushCh:    make(chan chan error),
+		intervalCh: make(chan time.Duration, 1),
+		cancel:     cancel,
+		done:       make(chan struct{}),
 		rmPool: sync.Pool{
 			New: func() interface{} {
 				return &metricdata.ResourceMetrics{}
@@ -138,10 +164,15 @@ type PeriodicReader struct {
 	isShutdown        bool
 	externalProducers atomic.Value
 
-	interval time.Duration
-	timeout  time.Duration
-	exporter Exporter
-	flushCh  chan chan error
+	interval   time.Duration // Protected by mu.
+	timeout    time.Duration
+	exporter   Exporter
+	flushCh    chan chan error
+	intervalCh chan time.Duration
+	exports    exportstatus.Recorder
+
+	// log is the internal logger of the MeterProvider r is registered with.
+	log logging.Pointer
 
 	done         chan struct{}
 	cancel       context.CancelFunc
@@ -166,27 +197,72 @@ func (r *PeriodicReader) run(ctx context.Context, interval time.Duration) {
 		select {
 		case <-ticker.C:
 			err := r.collectAndExport(ctx)
-			if err != nil {
-				otel.Handle(err)
+			// The reader of a disabled MeterProvider is never registered.
+			if err != nil && !errors.Is(err, ErrReaderNotRegistered) {
+				handle(ctx, otel.SeverityError, err)
 			}
 		case errCh := <-r.flushCh:
 			errCh <- r.collectAndExport(ctx)
 			ticker.Reset(interval)
+		case interval = <-r.intervalCh:
+			ticker.Reset(interval)
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
+// SetInterval changes the interval between the collections and exports of r
+// to d. The next collection happens d after this call. The exporter and the
+// registered producers are not affected. This allows changing the collection
+// interval of an application at runtime, e.g. from a remote configuration,
+// without recreating the telemetry pipeline.
+//
+// If d is less than or equal to zero, or r is shut down, the interval is not
+// changed.
+//
+// This method is safe to call concurrently.
+func (r *PeriodicReader) SetInterval(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.isShutdown {
+		return
+	}
+	r.interval = d
+
+	// Replace the interval not yet received by the run loop, if any, so the
+	// hand-off never blocks. The channel is only sent to while r.mu is held,
+	// it has room once drained.
+	select {
+	case <-r.intervalCh:
+	default:
+	}
+	r.intervalCh <- d
+}
+
 // register registers p as the producer of this reader.
 func (r *PeriodicReader) register(p sdkProducer) {
 	// Only register once. If producer is already set, do nothing.
 	if !r.sdkProducer.CompareAndSwap(nil, produceHolder{produce: p.produce}) {
 		msg := "did not register periodic reader"
-		global.Error(errDuplicateRegister, msg)
+		r.log.Load().Error(errDuplicateRegister, msg)
 	}
 }
 
+// setInternalLogger sets the logger of the internal diagnostics of r.
+func (r *PeriodicReader) setInternalLogger(l logging.Logger) {
+	r.log.Store(l)
+}
+
+// addProducer registers p as an external Producer of r.
+func (r *PeriodicReader) addProducer(p Producer) (func(), error) {
+	return addProducer(&r.mu, &r.isShutdown, &r.externalProducers, p)
+}
+
 // temporality reports the Temporality for the instrument kind provided.
 func (r *PeriodicReader) temporality(kind InstrumentKind) metricdata.Temporality {
 	return r.exporter.Temporality(kind)
@@ -261,14 +337,16 @@ func (r *PeriodicReader) collect(ctx context.Context, p interface{}, rm *metricd
 		rm.ScopeMetrics = append(rm.ScopeMetrics, externalMetrics...)
 	}
 
-	global.Debug("PeriodicReader collection", "Data", rm)
+	r.log.Load().Debug("PeriodicReader collection", "Data", rm)
 
 	return err
 }
 
 // export exports metric data m using r's exporter.
 func (r *PeriodicReader) export(ctx context.Context, m *metricdata.ResourceMetrics) error {
-	return r.exporter.Export(ctx, m)
+	err := r.exporter.Export(ctx, m)
+	r.exports.Record(err)
+	return err
 }
 
 // ForceFlush flushes pending telemetry.
@@ -352,6 +430,7 @@ func (r *PeriodicReader) Shutdown(ctx context.Context) error {
 func (r *PeriodicReader) MarshalLog() interface{} {
 	r.mu.Lock()
 	down := r.isShutdown
+	interval := r.interval
 	r.mu.Unlock()
 	return struct {
 		Type       string
@@ -365,7 +444,7 @@ func (r *PeriodicReader) MarshalLog() interface{} {
 		Exporter:   r.exporter,
 		Registered: r.sdkProducer.Load() != nil,
 		Shutdown:   down,
-		Interval:   r.interval,
+		Interval:   interval,
 		Timeout:    r.timeout,
 	}
 }
@@ -5,6 +5,7 @@ package metric // import "go.opentelemetry.io/otel/sdk/metric"
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -139,6 +140,17 @@ func TestIntervalEnvAndOption(t *testing.T) {
 	assert.Equal(t, want, got, "option should have precedence over env var")
 }
 
+func TestWithoutPeriodicReaderEnvironment(t *testing.T) {
+	t.Setenv(envInterval, "999")
+	t.Setenv(envTimeout, "888")
+	c := newPeriodicReaderConfig([]PeriodicReaderOption{
+		WithoutPeriodicReaderEnvironment(),
+		WithTimeout(time.Second),
+	})
+	assert.Equal(t, defaultInterval, c.interval, "env var should be ignored")
+	assert.Equal(t, time.Second, c.timeout)
+}
+
 type fnExporter struct {
 	temporalityFunc TemporalitySelector
 	aggregationFunc AggregationSelector
@@ -410,6 +422,72 @@ func TestPeriodicReaderFlushesPending(t *testing.T) {
 	})
 }
 
+func TestPeriodicReaderSetInterval(t *testing.T) {
+	exported := make(chan struct{}, 1)
+	exp := &fnExporter{
+		exportFunc: func(context.Context, *metricdata.ResourceMetrics) error {
+			select {
+			case exported <- struct{}{}:
+			default:
+			}
+			return nil
+		},
+	}
+
+	r := NewPeriodicReader(exp, WithInterval(time.Hour))
+	r.register(testSDKProducer{})
+
+	r.SetInterval(-time.Second)
+	r.SetInterval(time.Millisecond)
+	select {
+	case <-exported:
+	case <-time.After(10 * time.Second):
+		t.Fatal("no export with the new interval")
+	}
+	assert.Equal(t, time.Millisecond, r.interval)
+
+	require.NoError(t, r.Shutdown(context.Background()))
+	// Must not block nor change the interval once shut down.
+	r.SetInterval(time.Minute)
+	assert.Equal(t, time.Millisecond, r.interval)
+}
+
+func TestPeriodicReaderSetIntervalNonBlocking(t *testing.T) {
+	exporting, release := make(chan struct{}), make(chan struct{})
+	var once sync.Once
+	exp := &fnExporter{
+		exportFunc: func(context.Context, *metricdata.ResourceMetrics) error {
+			once.Do(func() {
+				close(exporting)
+				<-release
+			})
+			return nil
+		},
+	}
+
+	r := NewPeriodicReader(exp, WithInterval(time.Millisecond))
+	r.register(testSDKProducer{})
+	<-exporting
+
+	// The run loop is busy exporting, the intervals are handed off without
+	// blocking and the last one is kept.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.SetInterval(time.Minute)
+		r.SetInterval(time.Hour)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("SetInterval blocked")
+	}
+	assert.Equal(t, time.Hour, <-r.intervalCh)
+
+	close(release)
+	require.NoError(t, r.Shutdown(context.Background()))
+}
+
 func TestPeriodicReaderMultipleForceFlush(t *testing.T) {
 	ctx := context.Background()
 	r := NewPeriodicReader(new(fnExporter), WithProducer(testExternalProducer{}))
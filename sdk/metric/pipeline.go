@@ -10,10 +10,11 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
-	"go.opentelemetry.io/otel/internal/global"
-	"go.opentelemetry.io/otel/metric/embedded"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/internal/logging"
 	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/metric/internal"
 	"go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
@@ -60,6 +61,10 @@ func newPipeline(res *resource.Resource, reader Reader, views []View, exemplarFi
 // to the pipeline.
 type pipeline struct {
 	resource *resource.Resource
+	// asyncResource, if not nil, is used instead of resource.
+	asyncResource *resource.Async
+	// log logs the internal diagnostics of the pipeline.
+	log logging.Logger
 
 	reader Reader
 	views  []View
@@ -153,6 +158,9 @@ func (p *pipeline) produce(ctx context.Context, rm *metricdata.ResourceMetrics)
 	}
 
 	rm.Resource = p.resource
+	if p.asyncResource != nil {
+		rm.Resource = p.asyncResource.Resource()
+	}
 	rm.ScopeMetrics = internal.ReuseSlice(rm.ScopeMetrics, len(p.aggregations))
 
 	i := 0
@@ -234,10 +242,14 @@ func newInserter[N int64 | float64](p *pipeline, vc *cache[string, instID]) *ins
 //
 // If an instrument is determined to use a Drop aggregation, that instrument is
 // not inserted nor returned.
-func (i *inserter[N]) Instrument(inst Instrument, readerAggregation Aggregation) ([]aggregate.Measure[N], error) {
+func (i *inserter[N]) Instrument(
+	inst Instrument,
+	readerAggregation Aggregation,
+) ([]aggregate.Measure[N], []aggregate.MeasureBuckets, error) {
 	var (
-		matched  bool
-		measures []aggregate.Measure[N]
+		matched        bool
+		measures       []aggregate.Measure[N]
+		bucketMeasures []aggregate.MeasureBuckets
 	)
 
 	var err error
@@ -248,19 +260,21 @@ func (i *inserter[N]) Instrument(inst Instrument, readerAggregation Aggregation)
 			continue
 		}
 		matched = true
-		in, id, e := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
-		if e != nil {
-			err = errors.Join(err, e)
+		stream = inst.adviseFilter(stream)
+		cv := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
+		if cv.Err != nil {
+			err = errors.Join(err, cv.Err)
 		}
-		if in == nil { // Drop aggregation.
+		if cv.Measure == nil { // Drop aggregation.
 			continue
 		}
-		if _, ok := seen[id]; ok {
+		if _, ok := seen[cv.ID]; ok {
 			// This aggregate function has already been added.
 			continue
 		}
-		seen[id] = struct{}{}
-		measures = append(measures, in)
+		seen[cv.ID] = struct{}{}
+		measures = append(measures, cv.Measure)
+		bucketMeasures = append(bucketMeasures, cv.MeasureBuckets)
 	}
 
 	if err != nil {
@@ -268,27 +282,40 @@ func (i *inserter[N]) Instrument(inst Instrument, readerAggregation Aggregation)
 	}
 
 	if matched {
-		return measures, err
+		return measures, bucketMeasures, err
 	}
 
 	// Apply implicit default view if no explicit matched.
-	stream := Stream{
+	stream := inst.adviseFilter(Stream{
 		Name:        inst.Name,
 		Description: inst.Description,
 		Unit:        inst.Unit,
-	}
-	in, _, e := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
-	if e != nil {
+	})
+	cv := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
+	if cv.Err != nil {
 		if err == nil {
 			err = errCreatingAggregators
 		}
-		err = errors.Join(err, e)
+		err = errors.Join(err, cv.Err)
 	}
-	if in != nil {
+	if cv.Measure != nil {
 		// Ensured to have not seen given matched was false.
-		measures = append(measures, in)
+		measures = append(measures, cv.Measure)
+		bucketMeasures = append(bucketMeasures, cv.MeasureBuckets)
 	}
-	return measures, err
+	return measures, bucketMeasures, err
+}
+
+// advised returns whether all the streams of inst in the pipeline use the
+// attribute filter of its advisory attribute keys, i.e. none of the views
+// matching inst set an AttributeFilter.
+func (i *inserter[N]) advised(inst Instrument) bool {
+	for _, v := range i.pipeline.views {
+		if stream, match := v(inst); match && stream.AttributeFilter != nil {
+			return false
+		}
+	}
+	return true
 }
 
 // addCallback registers a single instrument callback to be run when
@@ -305,7 +332,9 @@ var aggIDCount uint64
 type aggVal[N int64 | float64] struct {
 	ID      uint64
 	Measure aggregate.Measure[N]
-	Err     error
+	// MeasureBuckets measures the buckets recorded for the aggregation.
+	MeasureBuckets aggregate.MeasureBuckets
+	Err            error
 }
 
 // readerDefaultAggregation returns the default aggregation for the instrument
@@ -323,7 +352,7 @@ func (i *inserter[N]) readerDefaultAggregation(kind InstrumentKind) Aggregation
 		if err := aggregation.err(); err != nil {
 			orig := aggregation
 			aggregation = DefaultAggregationSelector(kind)
-			global.Error(
+			i.pipeline.log.Error(
 				err, "using default aggregation instead",
 				"aggregation", orig,
 				"replacement", aggregation,
@@ -352,7 +381,7 @@ func (i *inserter[N]) cachedAggregator(
 	kind InstrumentKind,
 	stream Stream,
 	readerAggregation Aggregation,
-) (meas aggregate.Measure[N], aggID uint64, err error) {
+) aggVal[N] {
 	switch stream.Aggregation.(type) {
 	case nil:
 		// The aggregation was not overridden with a view. Use the aggregation
@@ -365,12 +394,23 @@ func (i *inserter[N]) cachedAggregator(
 	if stream.ExemplarReservoirProviderSelector == nil {
 		stream.ExemplarReservoirProviderSelector = DefaultExemplarReservoirProviderSelector
 	}
+	if c := stream.UnitConversion; !c.isZero() {
+		if err := lossless[N](c); err != nil {
+			i.pipeline.log.Error(
+				err, "not converting instrument unit",
+				"instrument", stream.Name,
+			)
+			stream.UnitConversion = UnitConversion{}
+		} else {
+			stream.Unit = c.to
+		}
+	}
 
 	if err := isAggregatorCompatible(kind, stream.Aggregation); err != nil {
-		return nil, 0, fmt.Errorf(
+		return aggVal[N]{Err: fmt.Errorf(
 			"creating aggregator with instrumentKind: %d, aggregation %v: %w",
 			kind, stream.Aggregation, err,
-		)
+		)}
 	}
 
 	id := i.instID(kind, stream)
@@ -397,12 +437,12 @@ func (i *inserter[N]) cachedAggregator(
 		// unrecognized input). Use that value directly.
 		b.AggregationLimit, _ = x.CardinalityLimit.Lookup()
 
-		in, out, err := i.aggregateFunc(b, stream.Aggregation, kind)
+		in, inBuckets, out, err := i.aggregateFunc(b, stream.Aggregation, kind)
 		if err != nil {
-			return aggVal[N]{0, nil, err}
+			return aggVal[N]{Err: err}
 		}
 		if in == nil { // Drop aggregator.
-			return aggVal[N]{0, nil, nil}
+			return aggVal[N]{}
 		}
 		i.pipeline.addSync(scope, instrumentSync{
 			// Use the first-seen name casing for this and all subsequent
@@ -412,10 +452,65 @@ func (i *inserter[N]) cachedAggregator(
 			unit:        stream.Unit,
 			compAgg:     out,
 		})
+		if stream.ExtremaStreams && (kind == InstrumentKindObservableUpDownCounter ||
+			kind == InstrumentKindObservableGauge) {
+			in = i.addExtrema(scope, kind, stream, b, in)
+		}
+		in = convert(stream.UnitConversion, in)
+		if inBuckets == nil {
+			inBuckets = measureSum(in)
+		} else {
+			inBuckets = convertBuckets(stream.UnitConversion, inBuckets)
+		}
 		id := atomic.AddUint64(&aggIDCount, 1)
-		return aggVal[N]{id, in, err}
+		return aggVal[N]{ID: id, Measure: in, MeasureBuckets: inBuckets, Err: err}
 	})
-	return cv.Measure, cv.ID, cv.Err
+	return cv
+}
+
+// measureSum returns the input of buckets for the aggregations that do not
+// aggregate buckets. It measures the sum of the buckets with in.
+func measureSum[N int64 | float64](in aggregate.Measure[N]) aggregate.MeasureBuckets {
+	return func(ctx context.Context, b aggregate.Buckets, a attribute.Set) {
+		in(ctx, N(b.Sum), a, time.Time{})
+	}
+}
+
+// addExtrema adds the ".min" and ".max" streams of stream to the pipeline.
+// It returns an aggregate function input that measures both in and the
+// extrema.
+//
+// The extrema streams are registered like the streams of instruments, so
+// conflicts with other streams are logged.
+func (i *inserter[N]) addExtrema(
+	scope instrumentation.Scope,
+	kind InstrumentKind,
+	stream Stream,
+	b aggregate.Builder[N],
+	in aggregate.Measure[N],
+) aggregate.Measure[N] {
+	exIn, minOut, maxOut := b.Extrema()
+	for _, ex := range []struct {
+		suffix string
+		out    aggregate.ComputeAggregation
+	}{
+		{".min", minOut},
+		{".max", maxOut},
+	} {
+		s := stream
+		s.Name += ex.suffix
+		i.logConflict(i.instID(kind, s))
+		i.pipeline.addSync(scope, instrumentSync{
+			name:        s.Name,
+			description: s.Description,
+			unit:        s.Unit,
+			compAgg:     ex.out,
+		})
+	}
+	return func(ctx context.Context, n N, a attribute.Set, t time.Time) {
+		in(ctx, n, a, t)
+		exIn(ctx, n, a, t)
+	}
 }
 
 // logConflict validates if an instrument with the same case-insensitive name
@@ -445,7 +540,7 @@ func (i *inserter[N]) logConflict(id instID) {
 	// https://github.com/open-telemetry/opentelemetry-specification/blob/v1.21.0/specification/metrics/sdk.md#duplicate-instrument-registration
 	if id.Unit != existing.Unit || id.Number != existing.Number {
 		// There is no view resolution for these, don't make a suggestion.
-		global.Warn(msg, args...)
+		i.pipeline.log.Warn(msg, args...)
 		return
 	}
 
@@ -462,7 +557,7 @@ func (i *inserter[N]) logConflict(id instID) {
 	)
 	args = append(args, "suggested.view", fmt.Sprintf("NewView(%s, %s)", inst, stream))
 
-	global.Warn(msg, args...)
+	i.pipeline.log.Warn(msg, args...)
 }
 
 func (i *inserter[N]) instID(kind InstrumentKind, stream Stream) instID {
@@ -483,7 +578,12 @@ func (i *inserter[N]) aggregateFunc(
 	b aggregate.Builder[N],
 	agg Aggregation,
 	kind InstrumentKind,
-) (meas aggregate.Measure[N], comp aggregate.ComputeAggregation, err error) {
+) (
+	meas aggregate.Measure[N],
+	measBuckets aggregate.MeasureBuckets,
+	comp aggregate.ComputeAggregation,
+	err error,
+) {
 	switch a := agg.(type) {
 	case AggregationDefault:
 		return i.aggregateFunc(b, DefaultAggregationSelector(kind), kind)
@@ -518,7 +618,7 @@ func (i *inserter[N]) aggregateFunc(
 			// https://github.com/open-telemetry/opentelemetry-specification/blob/v1.21.0/specification/metrics/sdk.md#histogram-aggregations
 			noSum = true
 		}
-		meas, comp = b.ExplicitBucketHistogram(a.Boundaries, a.NoMinMax, noSum)
+		meas, measBuckets, comp = b.ExplicitBucketHistogram(a.Boundaries, a.NoMinMax, noSum)
 	case AggregationBase2ExponentialHistogram:
 		var noSum bool
 		switch kind {
@@ -528,13 +628,13 @@ func (i *inserter[N]) aggregateFunc(
 			// https://github.com/open-telemetry/opentelemetry-specification/blob/v1.21.0/specification/metrics/sdk.md#histogram-aggregations
 			noSum = true
 		}
-		meas, comp = b.ExponentialBucketHistogram(a.MaxSize, a.MaxScale, a.NoMinMax, noSum)
+		meas, measBuckets, comp = b.ExponentialBucketHistogram(a.MaxSize, a.MaxScale, a.NoMinMax, noSum)
 
 	default:
 		err = errUnknownAggregation
 	}
 
-	return meas, comp, err
+	return meas, measBuckets, comp, err
 }
 
 // isAggregatorCompatible checks if the aggregation can be used by the instrument.
@@ -605,18 +705,6 @@ func newPipelines(res *resource.Resource, readers []Reader, views []View, exempl
 	return pipes
 }
 
-type unregisterFuncs struct {
-	embedded.Registration
-	f []func()
-}
-
-func (u unregisterFuncs) Unregister() error {
-	for _, f := range u.f {
-		f()
-	}
-	return nil
-}
-
 // resolver facilitates resolving aggregate functions an instrument calls to
 // aggregate measurements with while updating all pipelines that need to pull
 // from those aggregations.
@@ -639,7 +727,7 @@ func (r resolver[N]) Aggregators(id Instrument) ([]aggregate.Measure[N], error)
 
 	var err error
 	for _, i := range r.inserters {
-		in, e := i.Instrument(id, i.readerDefaultAggregation(id.Kind))
+		in, _, e := i.Instrument(id, i.readerDefaultAggregation(id.Kind))
 		if e != nil {
 			err = errors.Join(err, e)
 		}
@@ -648,11 +736,29 @@ func (r resolver[N]) Aggregators(id Instrument) ([]aggregate.Measure[N], error)
 	return measures, err
 }
 
+// AttributeKeys returns the only attribute keys measurements of the
+// instrument defined by id are recorded with, or nil if they can be recorded
+// with attributes of any key.
+func (r resolver[N]) AttributeKeys(id Instrument) []attribute.Key {
+	for _, i := range r.inserters {
+		if !i.advised(id) {
+			return nil
+		}
+	}
+	return id.attributeKeys
+}
+
 // HistogramAggregators returns the histogram Aggregators that must be updated by the instrument
-// defined by key. If boundaries were provided on instrument instantiation, those take precedence
-// over boundaries provided by the reader.
-func (r resolver[N]) HistogramAggregators(id Instrument, boundaries []float64) ([]aggregate.Measure[N], error) {
-	var measures []aggregate.Measure[N]
+// defined by key, and their inputs of buckets. If boundaries were provided on instrument
+// instantiation, those take precedence over boundaries provided by the reader.
+func (r resolver[N]) HistogramAggregators(
+	id Instrument,
+	boundaries []float64,
+) ([]aggregate.Measure[N], []aggregate.MeasureBuckets, error) {
+	var (
+		measures       []aggregate.Measure[N]
+		bucketMeasures []aggregate.MeasureBuckets
+	)
 
 	var err error
 	for _, i := range r.inserters {
@@ -661,11 +767,12 @@ func (r resolver[N]) HistogramAggregators(id Instrument, boundaries []float64) (
 			histAgg.Boundaries = boundaries
 			agg = histAgg
 		}
-		in, e := i.Instrument(id, agg)
+		in, inBuckets, e := i.Instrument(id, agg)
 		if e != nil {
 			err = errors.Join(err, e)
 		}
 		measures = append(measures, in...)
+		bucketMeasures = append(bucketMeasures, inBuckets...)
 	}
-	return measures, err
+	return measures, bucketMeasures, err
 }
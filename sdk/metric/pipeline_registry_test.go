@@ -7,6 +7,7 @@ import (
 	"context"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
@@ -60,7 +61,7 @@ func assertSum[N int64 | float64](
 		for m := 0; m < n; m++ {
 			t.Logf("input/output number: %d", m)
 			in, out := meas[m], comps[m]
-			in(context.Background(), 1, *attribute.EmptySet())
+			in(context.Background(), 1, *attribute.EmptySet(), time.Time{})
 
 			var got metricdata.Aggregation
 			assert.Equal(t, 1, out(&got), "1 data-point expected")
@@ -70,7 +71,7 @@ func assertSum[N int64 | float64](
 				DataPoints:  []metricdata.DataPoint[N]{{Value: v[0]}},
 			}, got, metricdatatest.IgnoreTimestamp())
 
-			in(context.Background(), 3, *attribute.EmptySet())
+			in(context.Background(), 3, *attribute.EmptySet(), time.Time{})
 
 			assert.Equal(t, 1, out(&got), "1 data-point expected")
 			metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[N]{
@@ -90,7 +91,7 @@ func assertHist[N int64 | float64](
 		requireN[N](t, 1, meas, comps, err)
 
 		in, out := meas[0], comps[0]
-		in(context.Background(), 1, *attribute.EmptySet())
+		in(context.Background(), 1, *attribute.EmptySet(), time.Time{})
 
 		var got metricdata.Aggregation
 		assert.Equal(t, 1, out(&got), "1 data-point expected")
@@ -108,7 +109,7 @@ func assertHist[N int64 | float64](
 			}},
 		}, got, metricdatatest.IgnoreTimestamp())
 
-		in(context.Background(), 1, *attribute.EmptySet())
+		in(context.Background(), 1, *attribute.EmptySet(), time.Time{})
 
 		if temp == metricdata.CumulativeTemporality {
 			buckets[1] = 2
@@ -139,8 +140,8 @@ func assertLastValue[N int64 | float64](
 	requireN[N](t, 1, meas, comps, err)
 
 	in, out := meas[0], comps[0]
-	in(context.Background(), 10, *attribute.EmptySet())
-	in(context.Background(), 1, *attribute.EmptySet())
+	in(context.Background(), 10, *attribute.EmptySet(), time.Time{})
+	in(context.Background(), 1, *attribute.EmptySet(), time.Time{})
 
 	var got metricdata.Aggregation
 	assert.Equal(t, 1, out(&got), "1 data-point expected")
@@ -288,7 +289,7 @@ func testCreateAggregators[N int64 | float64](t *testing.T) {
 				requireN[N](t, 1, meas, comps, err)
 
 				in, out := meas[0], comps[0]
-				in(context.Background(), 1, *attribute.EmptySet())
+				in(context.Background(), 1, *attribute.EmptySet(), time.Time{})
 
 				var got metricdata.Aggregation
 				assert.Equal(t, 1, out(&got), "1 data-point expected")
@@ -302,7 +303,7 @@ func testCreateAggregators[N int64 | float64](t *testing.T) {
 					}},
 				}, got, metricdatatest.IgnoreTimestamp())
 
-				in(context.Background(), 1, *attribute.EmptySet())
+				in(context.Background(), 1, *attribute.EmptySet(), time.Time{})
 
 				assert.Equal(t, 1, out(&got), "1 data-point expected")
 				metricdatatest.AssertAggregationsEqual(t, metricdata.Histogram[N]{
@@ -395,7 +396,7 @@ func testCreateAggregators[N int64 | float64](t *testing.T) {
 			p := newPipeline(nil, tt.reader, tt.views, exemplar.AlwaysOffFilter)
 			i := newInserter[N](p, &c)
 			readerAggregation := i.readerDefaultAggregation(tt.inst.Kind)
-			input, err := i.Instrument(tt.inst, readerAggregation)
+			input, _, err := i.Instrument(tt.inst, readerAggregation)
 			var comps []aggregate.ComputeAggregation
 			for _, instSyncs := range p.aggregations {
 				for _, i := range instSyncs {
@@ -420,7 +421,7 @@ func testInvalidInstrumentShouldPanic[N int64 | float64]() {
 		Kind: InstrumentKind(255),
 	}
 	readerAggregation := i.readerDefaultAggregation(inst.Kind)
-	_, _ = i.Instrument(inst, readerAggregation)
+	_, _, _ = i.Instrument(inst, readerAggregation)
 }
 
 func TestInvalidInstrumentShouldPanic(t *testing.T) {
@@ -537,7 +538,7 @@ func testPipelineRegistryResolveIntHistogramAggregators(t *testing.T, p pipeline
 	inst := Instrument{Name: "foo", Kind: InstrumentKindCounter}
 	var c cache[string, instID]
 	r := newResolver[int64](p, &c)
-	aggs, err := r.HistogramAggregators(inst, []float64{1, 2, 3})
+	aggs, _, err := r.HistogramAggregators(inst, []float64{1, 2, 3})
 	assert.NoError(t, err)
 
 	require.Len(t, aggs, wantCount)
@@ -547,7 +548,7 @@ func testPipelineRegistryResolveFloatHistogramAggregators(t *testing.T, p pipeli
 	inst := Instrument{Name: "foo", Kind: InstrumentKindCounter}
 	var c cache[string, instID]
 	r := newResolver[float64](p, &c)
-	aggs, err := r.HistogramAggregators(inst, []float64{1, 2, 3})
+	aggs, _, err := r.HistogramAggregators(inst, []float64{1, 2, 3})
 	assert.NoError(t, err)
 
 	require.Len(t, aggs, wantCount)
@@ -585,11 +586,11 @@ func TestPipelineRegistryCreateAggregatorsIncompatibleInstrument(t *testing.T) {
 	assert.Error(t, err)
 	assert.Empty(t, floatAggs)
 
-	intAggs, err = ri.HistogramAggregators(inst, []float64{1, 2, 3})
+	intAggs, _, err = ri.HistogramAggregators(inst, []float64{1, 2, 3})
 	assert.Error(t, err)
 	assert.Empty(t, intAggs)
 
-	floatAggs, err = rf.HistogramAggregators(inst, []float64{1, 2, 3})
+	floatAggs, _, err = rf.HistogramAggregators(inst, []float64{1, 2, 3})
 	assert.Error(t, err)
 	assert.Empty(t, floatAggs)
 }
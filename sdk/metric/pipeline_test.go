@@ -13,6 +13,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/funcr"
@@ -157,11 +158,11 @@ func testDefaultViewImplicit[N int64 | float64]() func(t *testing.T) {
 				var c cache[string, instID]
 				i := newInserter[N](test.pipe, &c)
 				readerAggregation := i.readerDefaultAggregation(inst.Kind)
-				got, err := i.Instrument(inst, readerAggregation)
+				got, _, err := i.Instrument(inst, readerAggregation)
 				require.NoError(t, err)
 				assert.Len(t, got, 1, "default view not applied")
 				for _, in := range got {
-					in(context.Background(), 1, *attribute.EmptySet())
+					in(context.Background(), 1, *attribute.EmptySet(), time.Time{})
 				}
 
 				out := metricdata.ResourceMetrics{}
@@ -384,8 +385,8 @@ func TestInserterCachedAggregatorNameConflict(t *testing.T) {
 	i := newInserter[int64](pipe, &vc)
 
 	readerAggregation := i.readerDefaultAggregation(kind)
-	_, origID, err := i.cachedAggregator(scope, kind, stream, readerAggregation)
-	require.NoError(t, err)
+	orig := i.cachedAggregator(scope, kind, stream, readerAggregation)
+	require.NoError(t, orig.Err)
 
 	require.Len(t, pipe.aggregations, 1)
 	require.Contains(t, pipe.aggregations, scope)
@@ -394,9 +395,9 @@ func TestInserterCachedAggregatorNameConflict(t *testing.T) {
 	require.Equal(t, name, iSync[0].name)
 
 	stream.Name = "RequestCount"
-	_, id, err := i.cachedAggregator(scope, kind, stream, readerAggregation)
-	require.NoError(t, err)
-	assert.Equal(t, origID, id, "multiple aggregators for equivalent name")
+	cv := i.cachedAggregator(scope, kind, stream, readerAggregation)
+	require.NoError(t, cv.Err)
+	assert.Equal(t, orig.ID, cv.ID, "multiple aggregators for equivalent name")
 
 	assert.Len(t, pipe.aggregations, 1, "additional scope added")
 	require.Contains(t, pipe.aggregations, scope, "original scope removed")
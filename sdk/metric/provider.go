@@ -7,11 +7,11 @@ import (
 	"context"
 	"sync/atomic"
 
-	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
 	"go.opentelemetry.io/otel/metric/noop"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/internal/logging"
 )
 
 // MeterProvider handles the creation and coordination of Meters. All Meters
@@ -21,8 +21,12 @@ import (
 type MeterProvider struct {
 	embedded.MeterProvider
 
-	pipes  pipelines
-	meters cache[instrumentation.Scope, *meter]
+	pipes       pipelines
+	ctxAttrs    *contextAttributes
+	strictUnits bool
+	log         logging.Logger
+	meters      cache[instrumentation.Scope, *meter]
+	disabled    bool
 
 	forceFlush, shutdown func(context.Context) error
 	stopped              atomic.Bool
@@ -37,17 +41,41 @@ var _ metric.MeterProvider = (*MeterProvider)(nil)
 // Resource and no Readers. Readers cannot be added after a MeterProvider is
 // created. This means the returned MeterProvider, one created with no
 // Readers, will perform no operations.
+//
+// The exemplar filter is read from the OTEL_METRICS_EXEMPLAR_FILTER
+// environment variable if it is not configured by options. Options always
+// have precedence over environment variables. If the OTEL_SDK_DISABLED
+// environment variable is true, the returned MeterProvider provides no-op
+// Meters and does not register its Readers. Use WithoutEnvironment to ignore
+// these environment variables.
 func NewMeterProvider(options ...Option) *MeterProvider {
 	conf := newConfig(options)
 	flush, sdown := conf.readerSignals()
+	for _, r := range conf.readers {
+		setInternalLogger(r, conf.log)
+	}
+	readers := conf.readers
+	if conf.disabled {
+		// Nothing is collected by a disabled MeterProvider, its readers are
+		// not registered. They are still shut down with it.
+		readers, flush = nil, nil
+	}
 
 	mp := &MeterProvider{
-		pipes:      newPipelines(conf.res, conf.readers, conf.views, conf.exemplarFilter),
-		forceFlush: flush,
-		shutdown:   sdown,
+		pipes:       newPipelines(conf.res, readers, conf.views, conf.exemplarFilter),
+		ctxAttrs:    conf.ctxAttrs,
+		strictUnits: conf.strictUnits,
+		log:         conf.log,
+		disabled:    conf.disabled,
+		forceFlush:  flush,
+		shutdown:    sdown,
+	}
+	for _, p := range mp.pipes {
+		p.asyncResource = conf.asyncRes
+		p.log = conf.log
 	}
 	// Log after creation so all readers show correctly they are registered.
-	global.Info("MeterProvider created",
+	mp.log.Info("MeterProvider created",
 		"Resource", conf.res,
 		"Readers", conf.readers,
 		"Views", len(conf.views),
@@ -67,10 +95,10 @@ func NewMeterProvider(options ...Option) *MeterProvider {
 // This method is safe to call concurrently.
 func (mp *MeterProvider) Meter(name string, options ...metric.MeterOption) metric.Meter {
 	if name == "" {
-		global.Warn("Invalid Meter name.", "name", name)
+		mp.log.Warn("Invalid Meter name.", "name", name)
 	}
 
-	if mp.stopped.Load() {
+	if mp.disabled || mp.stopped.Load() {
 		return noop.Meter{}
 	}
 
@@ -82,16 +110,29 @@ func (mp *MeterProvider) Meter(name string, options ...metric.MeterOption) metri
 		Attributes: c.InstrumentationAttributes(),
 	}
 
-	global.Info("Meter created",
+	mp.log.Info("Meter created",
 		"Name", s.Name,
 		"Version", s.Version,
 		"SchemaURL", s.SchemaURL,
 		"Attributes", s.Attributes,
 	)
 
-	return mp.meters.Lookup(s, func() *meter {
-		return newMeter(s, mp.pipes)
+	m := mp.meters.Lookup(s, func() *meter {
+		m := newMeter(s, mp.pipes)
+		m.provider = mp
+		m.ctxAttrs = mp.ctxAttrs
+		m.strictUnits = mp.strictUnits
+		m.log = mp.log
+		return m
 	})
+	if attrs := c.MeterAttributes(); attrs.Len() > 0 {
+		// Share the instruments and aggregations of the scope, only the
+		// measurements made with the returned meter get the attributes.
+		cp := *m
+		cp.attrs = attrs
+		m = &cp
+	}
+	return m
 }
 
 // ForceFlush flushes all pending telemetry.
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr/funcr"
 	"github.com/go-logr/logr/testr"
@@ -16,9 +17,11 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/internal/global"
 	api "go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/noop"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
 )
 
 func TestMeterConcurrentSafe(t *testing.T) {
@@ -113,6 +116,57 @@ func TestEmptyMeterName(t *testing.T) {
 	assert.Contains(t, buf.String(), `"level"=1 "msg"="Invalid Meter name." "name"=""`)
 }
 
+func TestMeterProviderWithInternalLogger(t *testing.T) {
+	var globalBuf strings.Builder
+	orig := global.GetLogger()
+	t.Cleanup(func() { global.SetLogger(orig) })
+	global.SetLogger(funcr.New(func(prefix, args string) {
+		_, _ = globalBuf.WriteString(fmt.Sprint(prefix, args))
+	}, funcr.Options{Verbosity: 8}))
+
+	var buf strings.Builder
+	l := funcr.New(func(prefix, args string) {
+		_, _ = buf.WriteString(fmt.Sprint(prefix, args))
+	}, funcr.Options{Verbosity: 8})
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithInternalLogger(l), WithReader(rdr))
+
+	m := mp.Meter("")
+	cb := func(context.Context, api.Int64Observer) error { return nil }
+	_, err := m.Int64ObservableCounter("c", api.WithInt64Callback(cb))
+	require.NoError(t, err)
+	_, err = m.Int64ObservableCounter("c", api.WithInt64Callback(cb))
+	require.NoError(t, err)
+	_, err = m.Int64Counter("dup", api.WithUnit("1"))
+	require.NoError(t, err)
+	_, err = m.Int64Counter("dup", api.WithUnit("s"))
+	require.NoError(t, err)
+	require.NoError(t, rdr.Collect(context.Background(), &metricdata.ResourceMetrics{}))
+
+	assert.Empty(t, globalBuf.String(), "messages logged to the global logger")
+	got := buf.String()
+	assert.Contains(t, got, `"msg"="MeterProvider created"`)
+	assert.Contains(t, got, `"level"=1 "msg"="Invalid Meter name." "name"=""`)
+	assert.Contains(t, got, `"msg"="Meter created"`)
+	assert.Contains(t, got, `"msg"="Repeated observable instrument creation with callbacks.`)
+	assert.Contains(t, got, `"msg"="duplicate metric stream definitions"`)
+	assert.Contains(t, got, `"level"=8 "msg"="ManualReader collection"`)
+}
+
+func TestMeterProviderWithInternalLogLevel(t *testing.T) {
+	var buf strings.Builder
+	l := funcr.New(func(prefix, args string) {
+		_, _ = buf.WriteString(fmt.Sprint(prefix, args))
+	}, funcr.Options{Verbosity: 8})
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithInternalLogger(l), WithInternalLogLevel(1), WithReader(rdr))
+
+	mp.Meter("")
+	require.NoError(t, rdr.Collect(context.Background(), &metricdata.ResourceMetrics{}))
+
+	assert.Equal(t, `"level"=1 "msg"="Invalid Meter name." "name"=""`, buf.String())
+}
+
 func TestMeterProviderReturnsNoopMeterAfterShutdown(t *testing.T) {
 	mp := NewMeterProvider()
 
@@ -174,3 +228,29 @@ func TestMeterProviderMixingOnRegisterErrors(t *testing.T) {
 		"Metrics produced for instrument collected by different MeterProvider",
 	)
 }
+
+func TestMeterProviderWithAsyncResource(t *testing.T) {
+	release := make(chan struct{})
+	a := resource.NewAsync(context.Background(), time.Millisecond,
+		resource.WithAttributes(attribute.String("fast", "1")),
+		resource.WithDetectors(resource.StringDetector("", "slow", func() (string, error) {
+			<-release
+			return "1", nil
+		})),
+	)
+
+	rdr := NewManualReader()
+	_ = NewMeterProvider(WithReader(rdr), WithAsyncResource(a))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(context.Background(), &rm))
+	assert.Equal(t, resource.NewSchemaless(attribute.String("fast", "1")), rm.Resource)
+
+	close(release)
+	<-a.Done()
+	require.NoError(t, rdr.Collect(context.Background(), &rm))
+	assert.Equal(t, resource.NewSchemaless(
+		attribute.String("fast", "1"),
+		attribute.String("slow", "1"),
+	), rm.Resource)
+}
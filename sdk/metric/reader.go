@@ -6,7 +6,11 @@ package metric // import "go.opentelemetry.io/otel/sdk/metric"
 import (
 	"context"
 	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
 
+	"go.opentelemetry.io/otel/sdk/internal/logging"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
@@ -22,6 +26,10 @@ var ErrReaderNotRegistered = errors.New("reader is not registered")
 // reader has been Shutdown once.
 var ErrReaderShutdown = errors.New("reader is shutdown")
 
+// errProducerRegistration is returned by RegisterProducer for Readers
+// Producers cannot be registered with after their creation.
+var errProducerRegistration = errors.New("reader does not support producer registration")
+
 // errNonPositiveDuration is logged when an environmental variable
 // has non-positive value.
 var errNonPositiveDuration = errors.New("non-positive duration")
@@ -86,6 +94,20 @@ type Reader interface {
 	// must never be done outside of a new major release.
 }
 
+// internalLoggerSetter is implemented by the Readers logging their internal
+// diagnostics with the logger of the MeterProvider they are registered with.
+type internalLoggerSetter interface {
+	setInternalLogger(logging.Logger)
+}
+
+// setInternalLogger sets the internal logger of r to l if r logs its
+// internal diagnostics.
+func setInternalLogger(r Reader, l logging.Logger) {
+	if s, ok := r.(internalLoggerSetter); ok {
+		s.setInternalLogger(l)
+	}
+}
+
 // sdkProducer produces metrics for a Reader.
 type sdkProducer interface {
 	// produce returns aggregated metrics from a single collection.
@@ -107,6 +129,54 @@ type Producer interface {
 	// must never be done outside of a new major release.
 }
 
+// RegisterProducer registers p with r as an external Producer of metric
+// data, in addition to the Producers r was created with (see WithProducer).
+// The returned function unregisters p.
+//
+// It is meant for components that are set up after the Reader, like the
+// bridges of other metric libraries. An error is returned if r is shut down,
+// or is not a ManualReader or a PeriodicReader.
+func RegisterProducer(r Reader, p Producer) (unregister func(), err error) {
+	reg, ok := r.(producerRegistry)
+	if !ok {
+		return nil, errProducerRegistration
+	}
+	return reg.addProducer(p)
+}
+
+// producerRegistry is implemented by the Readers Producers can be registered
+// with after their creation.
+type producerRegistry interface {
+	addProducer(Producer) (remove func(), err error)
+}
+
+// registeredProducer is a Producer registered with RegisterProducer. It is
+// compared by pointer to be unregistered, as Producers may not be comparable.
+type registeredProducer struct {
+	Producer
+}
+
+// addProducer adds p to the []Producer stored in producers. The reader lock
+// mu guards the updates of producers and isShutdown.
+func addProducer(mu *sync.Mutex, isShutdown *bool, producers *atomic.Value, p Producer) (func(), error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if *isShutdown {
+		return nil, ErrReaderShutdown
+	}
+	rp := &registeredProducer{Producer: p}
+	current := producers.Load().([]Producer)
+	producers.Store(append(slices.Clip(current), rp))
+	return func() {
+		mu.Lock()
+		defer mu.Unlock()
+		current := producers.Load().([]Producer)
+		producers.Store(slices.DeleteFunc(slices.Clone(current), func(p Producer) bool {
+			return p == Producer(rp)
+		}))
+	}, nil
+}
+
 // produceHolder is used as an atomic.Value to wrap the non-concrete producer
 // type.
 type produceHolder struct {
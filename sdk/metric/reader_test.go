@@ -60,6 +60,25 @@ func (ts *readerTestSuite) TestExternalProducer() {
 	ts.Equal(testResourceMetricsAB, m)
 }
 
+func (ts *readerTestSuite) TestRegisterProducer() {
+	ts.Reader = ts.Factory()
+	ts.Reader.register(testSDKProducer{})
+	unregister, err := RegisterProducer(ts.Reader, testExternalProducer{})
+	ts.Require().NoError(err)
+
+	m := metricdata.ResourceMetrics{}
+	ts.Require().NoError(ts.Reader.Collect(context.Background(), &m))
+	ts.Equal(testResourceMetricsAB, m)
+
+	unregister()
+	ts.Require().NoError(ts.Reader.Collect(context.Background(), &m))
+	ts.Equal(testResourceMetricsA, m)
+
+	ts.Require().NoError(ts.Reader.Shutdown(context.Background()))
+	_, err = RegisterProducer(ts.Reader, testExternalProducer{})
+	ts.ErrorIs(err, ErrReaderShutdown)
+}
+
 func (ts *readerTestSuite) TestCollectAfterShutdown() {
 	ts.Reader = ts.Factory(WithProducer(testExternalProducer{}))
 	ctx := context.Background()
@@ -345,3 +364,9 @@ func TestReadersNotRequiredToBeComparable(t *testing.T) {
 	r := noCompareReader{Reader: NewManualReader()}
 	assert.NotPanics(t, func() { _ = NewMeterProvider(WithReader(r)) })
 }
+
+func TestRegisterProducerUnsupportedReader(t *testing.T) {
+	r := struct{ Reader }{NewManualReader()}
+	_, err := RegisterProducer(r, testExternalProducer{})
+	assert.ErrorIs(t, err, errProducerRegistration)
+}
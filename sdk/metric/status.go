@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"fmt"
+	"strconv"
+
+	"go.opentelemetry.io/otel/sdk/sdkstatus"
+)
+
+var (
+	_ sdkstatus.Reporter = (*MeterProvider)(nil)
+	_ sdkstatus.Reporter = (*PeriodicReader)(nil)
+	_ sdkstatus.Reporter = (*ManualReader)(nil)
+)
+
+// Status returns the status of mp and of its Readers.
+//
+// This method is safe to call concurrently.
+func (mp *MeterProvider) Status() sdkstatus.Status {
+	s := sdkstatus.Status{
+		Kind:     "MeterProvider",
+		Shutdown: mp.stopped.Load(),
+		Settings: map[string]string{
+			"disabled": strconv.FormatBool(mp.disabled),
+		},
+	}
+	for _, p := range mp.pipes {
+		if r, ok := p.reader.(sdkstatus.Reporter); ok {
+			s.Components = append(s.Components, r.Status())
+			continue
+		}
+		s.Components = append(s.Components, sdkstatus.Status{Kind: fmt.Sprintf("%T", p.reader)})
+	}
+	return s
+}
+
+// Status returns the status of r.
+//
+// This method is safe to call concurrently.
+func (r *PeriodicReader) Status() sdkstatus.Status {
+	r.mu.Lock()
+	down, interval := r.isShutdown, r.interval
+	r.mu.Unlock()
+	return sdkstatus.Status{
+		Kind:     "PeriodicReader",
+		Shutdown: down,
+		Settings: map[string]string{
+			"interval":   interval.String(),
+			"timeout":    r.timeout.String(),
+			"registered": strconv.FormatBool(r.sdkProducer.Load() != nil),
+		},
+		Export: r.exports.Status(r.exporter),
+	}
+}
+
+// Status returns the status of mr.
+//
+// This method is safe to call concurrently.
+func (mr *ManualReader) Status() sdkstatus.Status {
+	mr.mu.Lock()
+	down := mr.isShutdown
+	mr.mu.Unlock()
+	return sdkstatus.Status{
+		Kind:     "ManualReader",
+		Shutdown: down,
+		Settings: map[string]string{
+			"registered": strconv.FormatBool(mr.sdkProducer.Load() != nil),
+		},
+	}
+}
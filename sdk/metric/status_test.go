@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestMeterProviderStatus(t *testing.T) {
+	exp := &fnExporter{
+		exportFunc: func(context.Context, *metricdata.ResourceMetrics) error {
+			return assert.AnError
+		},
+	}
+	pr := NewPeriodicReader(exp, WithInterval(time.Hour), WithTimeout(time.Minute))
+	mr := NewManualReader()
+	mp := NewMeterProvider(WithReader(pr), WithReader(mr))
+
+	ctx := context.Background()
+	assert.ErrorIs(t, pr.ForceFlush(ctx), assert.AnError)
+
+	s := mp.Status()
+	assert.Equal(t, "MeterProvider", s.Kind)
+	assert.False(t, s.Shutdown)
+	require.Len(t, s.Components, 2)
+
+	p := s.Components[0]
+	assert.Equal(t, "PeriodicReader", p.Kind)
+	assert.Equal(t, map[string]string{
+		"interval":   time.Hour.String(),
+		"timeout":    time.Minute.String(),
+		"registered": "true",
+	}, p.Settings)
+	require.NotNil(t, p.Export)
+	assert.Equal(t, "*metric.fnExporter", p.Export.Exporter)
+	assert.Equal(t, uint64(1), p.Export.Count)
+	assert.Equal(t, uint64(1), p.Export.Failed)
+	assert.Equal(t, assert.AnError.Error(), p.Export.LastError)
+
+	m := s.Components[1]
+	assert.Equal(t, "ManualReader", m.Kind)
+	assert.Nil(t, m.Export)
+
+	_ = mp.Shutdown(ctx)
+	s = mp.Status()
+	assert.True(t, s.Shutdown)
+	assert.True(t, s.Components[0].Shutdown)
+	assert.True(t, s.Components[1].Shutdown)
+}
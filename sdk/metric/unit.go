@@ -0,0 +1,337 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+)
+
+// ErrInstrumentUnit indicates the created instrument has a unit that is not a
+// valid UCUM case-sensitive code. It is only returned by the Meters of a
+// MeterProvider configured with WithStrictUnits.
+var ErrInstrumentUnit = errors.New("invalid instrument unit")
+
+// unitPrefixes are the UCUM prefixes, including the binary ones, that can be
+// applied to metric atoms.
+var unitPrefixes = []string{
+	"Y", "Z", "E", "P", "T", "G", "M", "k", "h", "da", "d", "c", "m", "u", "n", "p", "f", "a", "z", "y",
+	"Ki", "Mi", "Gi", "Ti",
+}
+
+// metricAtoms are the supported UCUM atoms that accept a prefix.
+var metricAtoms = map[string]struct{}{
+	"m": {}, "s": {}, "g": {}, "rad": {}, "K": {}, "C": {}, "cd": {}, "mol": {}, "sr": {},
+	"Hz": {}, "N": {}, "Pa": {}, "J": {}, "W": {}, "A": {}, "V": {}, "F": {}, "Ohm": {},
+	"S": {}, "Wb": {}, "Cel": {}, "T": {}, "H": {}, "lm": {}, "lx": {}, "Bq": {}, "Gy": {},
+	"Sv": {}, "l": {}, "L": {}, "ar": {}, "t": {}, "bar": {}, "eV": {}, "By": {}, "bit": {},
+	"Bd": {}, "cal": {},
+}
+
+// atoms are the supported UCUM atoms that do not accept a prefix.
+var atoms = map[string]struct{}{
+	"%": {}, "min": {}, "h": {}, "d": {}, "wk": {}, "mo": {}, "a": {}, "deg": {},
+	"[ppth]": {}, "[ppm]": {}, "[ppb]": {}, "[degF]": {},
+	"[in_i]": {}, "[ft_i]": {}, "[yd_i]": {}, "[mi_i]": {}, "[lb_av]": {}, "[oz_av]": {},
+}
+
+// validateUnit returns an error wrapping ErrInstrumentUnit if unit is not a
+// valid UCUM case-sensitive code. An empty unit is valid.
+//
+// The UCUM syntax is fully validated, but only commonly used atoms are
+// supported.
+func validateUnit(unit string) error {
+	if unit == "" {
+		return nil
+	}
+	p := unitParser{s: unit}
+	err := p.term()
+	if err == nil && p.i < len(p.s) {
+		err = fmt.Errorf("unexpected %q", p.s[p.i])
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrInstrumentUnit, unit, err)
+	}
+	return nil
+}
+
+// unitParser parses a UCUM case-sensitive code.
+type unitParser struct {
+	s string
+	i int
+}
+
+func (p *unitParser) peek() byte {
+	if p.i < len(p.s) {
+		return p.s[p.i]
+	}
+	return 0
+}
+
+// term parses ['/'] component (('.' | '/') component)*.
+func (p *unitParser) term() error {
+	if p.peek() == '/' {
+		p.i++
+	}
+	for {
+		if err := p.component(); err != nil {
+			return err
+		}
+		if c := p.peek(); c != '.' && c != '/' {
+			return nil
+		}
+		p.i++
+	}
+}
+
+// component parses a factor, an annotation, a parenthesized term, or a
+// simple unit with an optional exponent and annotation.
+func (p *unitParser) component() error {
+	switch c := p.peek(); {
+	case c == '(':
+		p.i++
+		if err := p.term(); err != nil {
+			return err
+		}
+		if p.peek() != ')' {
+			return errors.New("missing closing parenthesis")
+		}
+		p.i++
+		return nil
+	case c == '{':
+		return p.annotation()
+	case isDigit(c):
+		p.digits()
+		return nil
+	}
+
+	if err := p.simpleUnit(); err != nil {
+		return err
+	}
+	if err := p.exponent(); err != nil {
+		return err
+	}
+	return p.annotation()
+}
+
+// simpleUnit parses an atom with an optional prefix.
+func (p *unitParser) simpleUnit() error {
+	start := p.i
+	for p.i < len(p.s) {
+		c := p.s[p.i]
+		if c == '[' {
+			end := strings.IndexByte(p.s[p.i:], ']')
+			if end < 0 {
+				return errors.New("missing closing bracket")
+			}
+			p.i += end + 1
+			continue
+		}
+		if strings.IndexByte("./(){}+-", c) >= 0 || isDigit(c) {
+			break
+		}
+		p.i++
+	}
+	sym := p.s[start:p.i]
+	if sym == "" {
+		if p.i < len(p.s) {
+			return fmt.Errorf("unexpected %q", p.s[p.i])
+		}
+		return errors.New("missing unit")
+	}
+
+	if _, ok := atoms[sym]; ok {
+		return nil
+	}
+	if _, ok := metricAtoms[sym]; ok {
+		return nil
+	}
+	for _, prefix := range unitPrefixes {
+		if atom, ok := strings.CutPrefix(sym, prefix); ok {
+			if _, ok := metricAtoms[atom]; ok {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("unknown unit %q", sym)
+}
+
+// exponent parses an optional signed integer exponent.
+func (p *unitParser) exponent() error {
+	if c := p.peek(); c == '+' || c == '-' {
+		p.i++
+		if !isDigit(p.peek()) {
+			return errors.New("missing exponent")
+		}
+	}
+	p.digits()
+	return nil
+}
+
+// annotation parses an optional annotation in curly braces.
+func (p *unitParser) annotation() error {
+	if p.peek() != '{' {
+		return nil
+	}
+	for p.i++; p.i < len(p.s); p.i++ {
+		switch c := p.s[p.i]; {
+		case c == '}':
+			p.i++
+			return nil
+		case c < '!' || c > '~' || c == '{':
+			return fmt.Errorf("invalid annotation character %q", c)
+		}
+	}
+	return errors.New("missing closing curly brace")
+}
+
+func (p *unitParser) digits() {
+	for isDigit(p.peek()) {
+		p.i++
+	}
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// unitScale is the scale of a unit convertible with a UnitConversion,
+// relative to the smallest unit of its dimension. Scales are integers, but
+// the conversion factor between a decimal and a binary unit (e.g. KiBy and
+// kBy) is not.
+type unitScale struct {
+	dim   string
+	scale float64
+}
+
+// convertibleUnits are the units a UnitConversion supports.
+var convertibleUnits = map[string]unitScale{
+	"ns":  {"time", 1},
+	"us":  {"time", 1e3},
+	"ms":  {"time", 1e6},
+	"s":   {"time", 1e9},
+	"min": {"time", 60e9},
+	"h":   {"time", 3600e9},
+	"d":   {"time", 86400e9},
+
+	"bit":  {"data", 1},
+	"kbit": {"data", 1e3},
+	"Mbit": {"data", 1e6},
+	"Gbit": {"data", 1e9},
+	"By":   {"data", 8},
+	"kBy":  {"data", 8e3},
+	"MBy":  {"data", 8e6},
+	"GBy":  {"data", 8e9},
+	"TBy":  {"data", 8e12},
+	"KiBy": {"data", 8 << 10},
+	"MiBy": {"data", 8 << 20},
+	"GiBy": {"data", 8 << 30},
+	"TiBy": {"data", 8 << 40},
+
+	"%": {"ratio", 1},
+	"1": {"ratio", 100},
+}
+
+// UnitConversion converts the measurements of an instrument from one unit to
+// another. The zero value performs no conversion.
+//
+// Use NewUnitConversion to create a UnitConversion.
+type UnitConversion struct {
+	from, to string
+	// Measurements are multiplied by mul and divided by div. At least one of
+	// them is 1. They are not integers if the scale of one unit is not a
+	// multiple of the other (e.g. from KiBy to kBy, mul is 1.024).
+	mul, div float64
+}
+
+// NewUnitConversion returns a UnitConversion converting measurements in the
+// from unit to the to unit. The units need to be [UCUM] case-sensitive codes
+// of the same dimension, out of the following:
+//
+//   - time: ns, us, ms, s, min, h, and d.
+//   - data: bit, kbit, Mbit, Gbit, By, kBy, MBy, GBy, TBy, KiBy, MiBy, GiBy,
+//     and TiBy.
+//   - ratio: 1 and %.
+//
+// An error is returned if the units are not supported or not of the same
+// dimension.
+//
+// [UCUM]: https://ucum.org
+func NewUnitConversion(from, to string) (UnitConversion, error) {
+	f, ok := convertibleUnits[from]
+	if !ok {
+		return UnitConversion{}, fmt.Errorf("unsupported unit conversion from %q: unknown unit", from)
+	}
+	t, ok := convertibleUnits[to]
+	if !ok {
+		return UnitConversion{}, fmt.Errorf("unsupported unit conversion to %q: unknown unit", to)
+	}
+	if f.dim != t.dim {
+		return UnitConversion{}, fmt.Errorf("unsupported unit conversion from %q (%s) to %q (%s)", from, f.dim, to, t.dim)
+	}
+
+	c := UnitConversion{from: from, to: to, mul: 1, div: 1}
+	if f.scale >= t.scale {
+		c.mul = f.scale / t.scale
+	} else {
+		c.div = t.scale / f.scale
+	}
+	return c, nil
+}
+
+func (c UnitConversion) isZero() bool { return c.from == "" }
+
+// lossless returns an error if c cannot be applied without loss of precision
+// to measurements of type N: integer measurements can only be converted to
+// smaller units whose scale divides the one of the original unit.
+func lossless[N int64 | float64](c UnitConversion) error {
+	var zero N
+	if _, ok := any(zero).(int64); ok && (c.div != 1 || c.mul != math.Trunc(c.mul)) {
+		return fmt.Errorf("integer measurements cannot be converted from %q to %q without loss of precision", c.from, c.to)
+	}
+	return nil
+}
+
+// convert returns in with the measurements converted by c.
+func convert[N int64 | float64](c UnitConversion, in aggregate.Measure[N]) aggregate.Measure[N] {
+	if c.isZero() || (c.mul == 1 && c.div == 1) {
+		return in
+	}
+	if c.div == 1 {
+		mul := N(c.mul)
+		return func(ctx context.Context, n N, a attribute.Set, t time.Time) {
+			in(ctx, n*mul, a, t)
+		}
+	}
+	// Only float64 measurements are divided or multiplied by a non-integer.
+	div := N(c.div)
+	return func(ctx context.Context, n N, a attribute.Set, t time.Time) {
+		in(ctx, n/div, a, t)
+	}
+}
+
+// convertBuckets returns in with the bounds, sum, minimum, and maximum of the
+// buckets converted by c. The counts of the buckets are unchanged.
+func convertBuckets(c UnitConversion, in aggregate.MeasureBuckets) aggregate.MeasureBuckets {
+	if c.isZero() || (c.mul == 1 && c.div == 1) {
+		return in
+	}
+	scale := func(v float64) float64 { return v * c.mul / c.div }
+	return func(ctx context.Context, b aggregate.Buckets, a attribute.Set) {
+		bounds := make([]float64, len(b.Bounds))
+		for i, v := range b.Bounds {
+			bounds[i] = scale(v)
+		}
+		b.Bounds = bounds
+		b.Sum, b.Min, b.Max = scale(b.Sum), scale(b.Min), scale(b.Max)
+		in(ctx, b, a)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+func TestValidateUnit(t *testing.T) {
+	valid := []string{
+		"",
+		metric.UnitDimensionless,
+		metric.UnitPercent,
+		metric.UnitBits,
+		metric.UnitBytes,
+		metric.UnitKibibytes,
+		metric.UnitMebibytes,
+		metric.UnitGibibytes,
+		metric.UnitKilobytes,
+		metric.UnitMegabytes,
+		metric.UnitGigabytes,
+		metric.UnitBytesPerSecond,
+		metric.UnitDays,
+		metric.UnitHours,
+		metric.UnitMinutes,
+		metric.UnitSeconds,
+		metric.UnitMilliseconds,
+		metric.UnitMicroseconds,
+		metric.UnitNanoseconds,
+		metric.UnitHertz,
+		metric.UnitCelsius,
+		metric.UnitWatts,
+		metric.UnitJoules,
+		metric.UnitVolts,
+		metric.UnitAmperes,
+		"{request}",
+		"{packet}/s",
+		"/s",
+		"m2",
+		"m.s-2",
+		"kg.m/s2",
+		"(By/s)/{connection}",
+		"10",
+		"[ppm]",
+		"[in_i]",
+		"By{compressed}",
+		"daL",
+	}
+	for _, unit := range valid {
+		t.Run(unit, func(t *testing.T) {
+			assert.NoError(t, validateUnit(unit))
+		})
+	}
+
+	invalid := []string{
+		"seconds",
+		"bytes",
+		"sec",
+		"ms ",
+		"Sec",
+		"kmin",
+		"{request",
+		"{re quest}",
+		"(By/s",
+		"By)",
+		"m-",
+		"By//s",
+		"[ppm",
+		".s",
+	}
+	for _, unit := range invalid {
+		t.Run(unit, func(t *testing.T) {
+			assert.ErrorIs(t, validateUnit(unit), ErrInstrumentUnit)
+		})
+	}
+}
+
+func TestNewUnitConversion(t *testing.T) {
+	tests := []struct {
+		from, to string
+		in, want float64
+	}{
+		{from: metric.UnitMilliseconds, to: metric.UnitSeconds, in: 1500, want: 1.5},
+		{from: metric.UnitNanoseconds, to: metric.UnitMilliseconds, in: 2e6, want: 2},
+		{from: metric.UnitSeconds, to: metric.UnitMilliseconds, in: 1.5, want: 1500},
+		{from: metric.UnitHours, to: metric.UnitSeconds, in: 2, want: 7200},
+		{from: metric.UnitBytes, to: metric.UnitMebibytes, in: 3 << 20, want: 3},
+		{from: metric.UnitKilobytes, to: metric.UnitBytes, in: 2, want: 2000},
+		{from: metric.UnitBytes, to: metric.UnitBits, in: 2, want: 16},
+		{from: metric.UnitDimensionless, to: metric.UnitPercent, in: 0.25, want: 25},
+		{from: metric.UnitSeconds, to: metric.UnitSeconds, in: 3, want: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.from+"->"+tt.to, func(t *testing.T) {
+			c, err := NewUnitConversion(tt.from, tt.to)
+			require.NoError(t, err)
+
+			var got float64
+			in := convert(c, func(_ context.Context, n float64, _ attribute.Set, _ time.Time) { got = n })
+			in(context.Background(), tt.in, *attribute.EmptySet(), time.Time{})
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for _, units := range [][2]string{
+		{"", metric.UnitSeconds},
+		{metric.UnitSeconds, "{request}"},
+		{metric.UnitBytesPerSecond, metric.UnitBytes},
+		{metric.UnitSeconds, metric.UnitBytes},
+		{metric.UnitPercent, metric.UnitMilliseconds},
+	} {
+		_, err := NewUnitConversion(units[0], units[1])
+		assert.Error(t, err, "%s -> %s", units[0], units[1])
+	}
+}
+
+func TestUnitConversionInt64(t *testing.T) {
+	up, err := NewUnitConversion(metric.UnitSeconds, metric.UnitMilliseconds)
+	require.NoError(t, err)
+	require.NoError(t, lossless[int64](up))
+
+	var got int64
+	in := convert(up, func(_ context.Context, n int64, _ attribute.Set, _ time.Time) { got = n })
+	in(context.Background(), 3, *attribute.EmptySet(), time.Time{})
+	assert.Equal(t, int64(3000), got)
+
+	down, err := NewUnitConversion(metric.UnitMilliseconds, metric.UnitSeconds)
+	require.NoError(t, err)
+	assert.Error(t, lossless[int64](down), "lossy integer conversion")
+	assert.NoError(t, lossless[float64](down))
+
+	// 1 KiBy is 1.024 kBy: the factor is not an integer.
+	binToDec, err := NewUnitConversion(metric.UnitKibibytes, metric.UnitKilobytes)
+	require.NoError(t, err)
+	assert.Error(t, lossless[int64](binToDec), "non-integral integer conversion")
+	require.NoError(t, lossless[float64](binToDec))
+
+	var gotF float64
+	inF := convert(binToDec, func(_ context.Context, n float64, _ attribute.Set, _ time.Time) { gotF = n })
+	inF(context.Background(), 1000, *attribute.EmptySet(), time.Time{})
+	assert.InDelta(t, 1024.0, gotF, 1e-9)
+
+	binToBits, err := NewUnitConversion(metric.UnitKibibytes, "bit")
+	require.NoError(t, err)
+	assert.NoError(t, lossless[int64](binToBits))
+}
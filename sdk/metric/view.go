@@ -42,6 +42,10 @@ type View func(Instrument) (Stream, bool)
 // AttributeFilter are set. All non-zero-value fields of mask are used instead
 // of the default. If you need to zero out an Stream field returned from a
 // View, create a View directly.
+//
+// The UnitConversion of mask is only used for the matched instruments with
+// the unit it converts from, e.g. a view matching all instruments can convert
+// the ones recording in ms to s.
 func NewView(criteria Instrument, mask Stream) View {
 	if criteria.IsEmpty() {
 		global.Error(
@@ -95,6 +99,12 @@ func NewView(criteria Instrument, mask Stream) View {
 
 	return func(i Instrument) (Stream, bool) {
 		if matchFunc(i) {
+			// The unit conversion only applies to instruments with the
+			// unit it converts from.
+			conv := mask.UnitConversion
+			if conv.from != i.Unit {
+				conv = UnitConversion{}
+			}
 			return Stream{
 				Name:                              nonZero(mask.Name, i.Name),
 				Description:                       nonZero(mask.Description, i.Description),
@@ -102,6 +112,8 @@ func NewView(criteria Instrument, mask Stream) View {
 				Aggregation:                       agg,
 				AttributeFilter:                   mask.AttributeFilter,
 				ExemplarReservoirProviderSelector: mask.ExemplarReservoirProviderSelector,
+				ExtremaStreams:                    mask.ExtremaStreams,
+				UnitConversion:                    conv,
 			}, true
 		}
 		return Stream{}, false
@@ -0,0 +1,197 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+// Async is a Resource that is detected asynchronously.
+//
+// Detectors are run concurrently and the Resource returned by
+// [Async.Resource] is upgraded as each of them completes. This allows
+// telemetry to be produced with a partial Resource while slow detectors (e.g.
+// ones querying a cloud instance metadata service) are still running.
+//
+// Use [Async.Refresh] or [Async.RefreshPeriodically] to re-run detection for
+// attributes that can change during the lifetime of the process.
+type Async struct {
+	schemaURL string
+	detectors []Detector
+
+	res  atomic.Pointer[Resource]
+	err  atomic.Pointer[error]
+	done chan struct{}
+
+	// mu ensures only one detection is run at a time.
+	mu sync.Mutex
+}
+
+// NewAsync returns an [Async] built using opts. Detection is started
+// immediately using ctx and NewAsync waits up to timeout for it to complete.
+// If detection is not complete after the timeout, the returned Async
+// provides the partial Resource produced by the detectors that have already
+// completed, and is upgraded when the others complete.
+//
+// Detection is canceled if ctx is canceled, so ctx should outlive the
+// timeout.
+func NewAsync(ctx context.Context, timeout time.Duration, opts ...Option) *Async {
+	cfg := config{}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+
+	a := &Async{
+		schemaURL: cfg.schemaURL,
+		detectors: cfg.orderedDetectors(),
+		done:      make(chan struct{}),
+	}
+	a.res.Store(&Resource{schemaURL: cfg.schemaURL})
+
+	go func() {
+		defer close(a.done)
+		_ = a.detect(ctx, true)
+	}()
+
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+	select {
+	case <-a.done:
+	case <-t.C:
+	case <-ctx.Done():
+	}
+	return a
+}
+
+// Resource returns the most recently detected Resource. This may be a
+// partial Resource if the initial detection is not yet complete.
+func (a *Async) Resource() *Resource {
+	if a == nil {
+		return Empty()
+	}
+	return a.res.Load()
+}
+
+// Done returns a channel that is closed when the initial detection is
+// complete.
+func (a *Async) Done() <-chan struct{} {
+	return a.done
+}
+
+// Err returns the error of the most recently completed detection. See
+// [New] for the errors that can be returned. It returns nil if no detection
+// has completed yet.
+func (a *Async) Err() error {
+	if err := a.err.Load(); err != nil {
+		return *err
+	}
+	return nil
+}
+
+// Refresh re-runs all detectors using ctx and replaces the Resource when
+// they complete. The returned error is the detection error, the same as is
+// subsequently returned by [Async.Err].
+func (a *Async) Refresh(ctx context.Context) error {
+	return a.detect(ctx, false)
+}
+
+// RefreshPeriodically calls [Async.Refresh] every interval until ctx is
+// canceled or the returned stop function is called. Detection errors are
+// sent to the global error handler.
+func (a *Async) RefreshPeriodically(ctx context.Context, interval time.Duration) (stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+				if err := a.Refresh(ctx); err != nil {
+					otel.Handle(err)
+				}
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
+// detect runs all detectors concurrently. If progressive is true, the
+// Resource is updated as each detector completes. Otherwise, it is only
+// updated when all have completed so a previously detected Resource is not
+// replaced with a partial one.
+func (a *Async) detect(ctx context.Context, progressive bool) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	type result struct {
+		idx int
+		res *Resource
+		err error
+	}
+
+	results := make(chan result, len(a.detectors))
+	for i, d := range a.detectors {
+		if d == nil {
+			continue
+		}
+		go func(i int, d Detector) {
+			res, err := d.Detect(ctx)
+			results <- result{idx: i, res: res, err: err}
+		}(i, d)
+	}
+
+	// Completed results are merged in the order of the detectors, the same
+	// as New, by replaying them as detectors.
+	completed := make([]Detector, len(a.detectors))
+	var (
+		res *Resource
+		err error
+	)
+	for _, d := range a.detectors {
+		if d == nil {
+			continue
+		}
+		r := <-results
+		completed[r.idx] = detected{a.detectors[r.idx], r.res, r.err}
+
+		if progressive {
+			res = &Resource{schemaURL: a.schemaURL}
+			err = detect(ctx, res, completed, false)
+			a.res.Store(res)
+		}
+	}
+
+	if !progressive {
+		res = &Resource{schemaURL: a.schemaURL}
+		err = detect(ctx, res, completed, false)
+		a.res.Store(res)
+	}
+	a.err.Store(&err)
+	return err
+}
+
+// detected is a Detector that returns a Resource already detected by
+// detector.
+type detected struct {
+	detector Detector
+	res      *Resource
+	err      error
+}
+
+func (d detected) Detect(context.Context) (*Resource, error) {
+	return d.res, d.err
+}
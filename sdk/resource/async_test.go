@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+type blockingDetector struct {
+	release chan struct{}
+	attr    attribute.KeyValue
+}
+
+func (d blockingDetector) Detect(ctx context.Context) (*resource.Resource, error) {
+	select {
+	case <-d.release:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return resource.NewSchemaless(d.attr), nil
+}
+
+type countingDetector struct {
+	n *atomic.Int64
+}
+
+func (d countingDetector) Detect(context.Context) (*resource.Resource, error) {
+	return resource.NewSchemaless(attribute.Int64("count", d.n.Add(1))), nil
+}
+
+func TestNewAsyncPartial(t *testing.T) {
+	release := make(chan struct{})
+	a := resource.NewAsync(context.Background(), 10*time.Millisecond,
+		resource.WithAttributes(attribute.String("fast", "1")),
+		resource.WithDetectors(blockingDetector{release, attribute.String("slow", "1")}),
+		resource.WithAttributes(attribute.String("fast", "2")),
+	)
+
+	// The slow detector is blocked, but the later fast detector still wins.
+	assert.Equal(t, resource.NewSchemaless(attribute.String("fast", "2")), a.Resource())
+	assert.NoError(t, a.Err())
+
+	close(release)
+	<-a.Done()
+	assert.Equal(t, resource.NewSchemaless(
+		attribute.String("fast", "2"),
+		attribute.String("slow", "1"),
+	), a.Resource())
+	assert.NoError(t, a.Err())
+}
+
+func TestNewAsyncComplete(t *testing.T) {
+	a := resource.NewAsync(context.Background(), time.Minute,
+		resource.WithAttributes(attribute.String("a", "1")),
+		resource.WithSchemaURL("https://example.com/1.0.0"),
+	)
+
+	select {
+	case <-a.Done():
+	default:
+		t.Fatal("detection not complete")
+	}
+	assert.Equal(t, resource.NewWithAttributes(
+		"https://example.com/1.0.0",
+		attribute.String("a", "1"),
+	), a.Resource())
+}
+
+func TestNewAsyncError(t *testing.T) {
+	errDetector := resource.StringDetector("", "k", func() (string, error) {
+		return "", errors.New("failed")
+	})
+	a := resource.NewAsync(context.Background(), time.Minute,
+		resource.WithDetectors(errDetector),
+		resource.WithAttributes(attribute.String("a", "1")),
+	)
+
+	<-a.Done()
+	assert.Error(t, a.Err())
+	assert.Equal(t, resource.NewSchemaless(attribute.String("a", "1")), a.Resource())
+}
+
+func TestAsyncRefresh(t *testing.T) {
+	var n atomic.Int64
+	a := resource.NewAsync(context.Background(), time.Minute,
+		resource.WithDetectors(countingDetector{&n}),
+	)
+	<-a.Done()
+	assert.Equal(t, resource.NewSchemaless(attribute.Int64("count", 1)), a.Resource())
+
+	require.NoError(t, a.Refresh(context.Background()))
+	assert.Equal(t, resource.NewSchemaless(attribute.Int64("count", 2)), a.Resource())
+}
+
+func TestAsyncRefreshPeriodically(t *testing.T) {
+	var n atomic.Int64
+	a := resource.NewAsync(context.Background(), time.Minute,
+		resource.WithDetectors(countingDetector{&n}),
+	)
+	<-a.Done()
+
+	stop := a.RefreshPeriodically(context.Background(), time.Millisecond)
+	assert.Eventually(t, func() bool {
+		return n.Load() >= 3
+	}, time.Second, time.Millisecond)
+	stop()
+
+	got := n.Load()
+	v, ok := a.Resource().Set().Value("count")
+	require.True(t, ok)
+	assert.Equal(t, got, v.AsInt64())
+}
+
+func TestAsyncNil(t *testing.T) {
+	var a *resource.Async
+	assert.Equal(t, resource.Empty(), a.Resource())
+}
@@ -29,6 +29,56 @@ type Detector interface {
 	// must never be done outside of a new major release.
 }
 
+// DetectorError is an error returned by a [Detector].
+type DetectorError struct {
+	// Detector is the Detector that returned Err.
+	Detector Detector
+	// Err is the error returned by Detector.
+	Err error
+}
+
+// Error returns the error message of e prefixed with the type of the
+// Detector.
+func (e *DetectorError) Error() string {
+	return fmt.Sprintf("%T: %s", e.Detector, e.Err)
+}
+
+// Unwrap returns the error returned by the Detector.
+func (e *DetectorError) Unwrap() error {
+	return e.Err
+}
+
+// DetectionError is the error returned when detecting a [Resource] fails. It
+// identifies which detectors failed and which succeeded.
+//
+// It wraps the error of each failed detector as a [*DetectorError] and any
+// error merging the detected resources (i.e. [ErrSchemaURLConflict]), so
+// [errors.Is] and [errors.As] can be used to inspect it.
+type DetectionError struct {
+	// Failed holds the errors of the detectors that failed, in the order
+	// the detectors were evaluated.
+	Failed []*DetectorError
+	// Succeeded holds the detectors that did not return an error, in the
+	// order they were evaluated.
+	Succeeded []Detector
+	// MergeErrors holds the errors merging the detected resources.
+	MergeErrors []error
+}
+
+// Error returns a message describing all errors in e.
+func (e *DetectionError) Error() string {
+	return "error detecting resource: " + errors.Join(e.Unwrap()...).Error()
+}
+
+// Unwrap returns all errors in e.
+func (e *DetectionError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failed)+len(e.MergeErrors))
+	for _, f := range e.Failed {
+		errs = append(errs, f)
+	}
+	return append(errs, e.MergeErrors...)
+}
+
 // Detect returns a new [Resource] merged from all the Resources each of the
 // detectors produces. Each of the detectors are called sequentially, in the
 // order they are passed, merging the produced resource into the previous.
@@ -45,20 +95,23 @@ type Detector interface {
 // error will wrap that detector's error.
 func Detect(ctx context.Context, detectors ...Detector) (*Resource, error) {
 	r := new(Resource)
-	return r, detect(ctx, r, detectors)
+	return r, detect(ctx, r, detectors, false)
 }
 
 // detect runs all detectors using ctx and merges the result into res. This
 // assumes res is allocated and not nil, it will panic otherwise.
 //
 // If the detectors or merging resources produces any errors (i.e.
-// [ErrPartialResource] [ErrSchemaURLConflict]), a single error wrapping all of
-// these errors will be returned. Otherwise, nil is returned.
-func detect(ctx context.Context, res *Resource, detectors []Detector) error {
+// [ErrPartialResource] [ErrSchemaURLConflict]), a [*DetectionError] wrapping
+// all of these errors will be returned. Otherwise, nil is returned.
+//
+// If keepFailed is true, the resources returned by detectors that fail with
+// an error other than [ErrPartialResource] are also merged.
+func detect(ctx context.Context, res *Resource, detectors []Detector, keepFailed bool) error {
 	var (
-		r   *Resource
-		err error
-		e   error
+		r    *Resource
+		e    error
+		dErr DetectionError
 	)
 
 	for _, detector := range detectors {
@@ -66,27 +119,32 @@ func detect(ctx context.Context, res *Resource, detectors []Detector) error {
 			continue
 		}
 		r, e = detector.Detect(ctx)
+		if d, ok := detector.(detected); ok {
+			// Report the Detector that produced a replayed result.
+			detector = d.detector
+		}
 		if e != nil {
-			err = errors.Join(err, e)
-			if !errors.Is(e, ErrPartialResource) {
+			dErr.Failed = append(dErr.Failed, &DetectorError{Detector: detector, Err: e})
+			if !keepFailed && !errors.Is(e, ErrPartialResource) {
 				continue
 			}
+		} else {
+			dErr.Succeeded = append(dErr.Succeeded, detector)
 		}
 		r, e = Merge(res, r)
 		if e != nil {
-			err = errors.Join(err, e)
+			dErr.MergeErrors = append(dErr.MergeErrors, e)
 		}
 		*res = *r
 	}
 
-	if err != nil {
-		if errors.Is(err, ErrSchemaURLConflict) {
-			// If there has been a merge conflict, ensure the resource has no
-			// schema URL.
-			res.schemaURL = ""
-		}
-
-		err = fmt.Errorf("error detecting resource: %w", err)
+	if len(dErr.Failed) == 0 && len(dErr.MergeErrors) == 0 {
+		return nil
+	}
+	if len(dErr.MergeErrors) > 0 {
+		// If there has been a merge conflict, ensure the resource has no
+		// schema URL.
+		res.schemaURL = ""
 	}
-	return err
+	return &dErr
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
@@ -91,3 +92,96 @@ func TestDetect(t *testing.T) {
 		})
 	}
 }
+
+type failingDetector struct {
+	res *resource.Resource
+	err error
+}
+
+func (d failingDetector) Detect(context.Context) (*resource.Resource, error) {
+	return d.res, d.err
+}
+
+func TestNewDetectionError(t *testing.T) {
+	errFailed := errors.New("failed")
+	ok := newDetector("", attribute.String("ok", "1"))
+	partial := failingDetector{
+		res: resource.NewSchemaless(attribute.String("partial", "1")),
+		err: fmt.Errorf("%w: missing value", resource.ErrPartialResource),
+	}
+	failed := failingDetector{
+		res: resource.NewSchemaless(attribute.String("failed", "1")),
+		err: errFailed,
+	}
+
+	res, err := resource.New(context.Background(), resource.WithDetectors(ok, partial, failed))
+
+	var dErr *resource.DetectionError
+	if !assert.ErrorAs(t, err, &dErr) {
+		return
+	}
+	assert.Equal(t, []resource.Detector{ok}, dErr.Succeeded)
+	if assert.Len(t, dErr.Failed, 2) {
+		assert.Equal(t, partial, dErr.Failed[0].Detector)
+		assert.Equal(t, failed, dErr.Failed[1].Detector)
+	}
+	assert.Empty(t, dErr.MergeErrors)
+	assert.ErrorIs(t, err, resource.ErrPartialResource)
+	assert.ErrorIs(t, err, errFailed)
+
+	var detectorErr *resource.DetectorError
+	assert.ErrorAs(t, err, &detectorErr)
+	assert.Contains(t, err.Error(), "resource_test.failingDetector: failed")
+
+	// The resource of a detector failing with a non-partial error is dropped.
+	assert.Equal(t, resource.NewSchemaless(
+		attribute.String("ok", "1"),
+		attribute.String("partial", "1"),
+	), res)
+}
+
+func TestNewDetectionMergeError(t *testing.T) {
+	_, err := resource.New(context.Background(), resource.WithDetectors(
+		newDetector("https://opentelemetry.io/schemas/1.3.0"),
+		newDetector("https://opentelemetry.io/schemas/1.4.0"),
+	))
+
+	var dErr *resource.DetectionError
+	if !assert.ErrorAs(t, err, &dErr) {
+		return
+	}
+	assert.Len(t, dErr.Succeeded, 2)
+	assert.Empty(t, dErr.Failed)
+	assert.Len(t, dErr.MergeErrors, 1)
+	assert.ErrorIs(t, err, resource.ErrSchemaURLConflict)
+}
+
+func TestNewWithDetectionWarnings(t *testing.T) {
+	var handled []error
+	eh := otel.GetErrorHandler()
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		handled = append(handled, err)
+	}))
+	t.Cleanup(func() { otel.SetErrorHandler(eh) })
+
+	errFailed := errors.New("failed")
+	res, err := resource.New(context.Background(),
+		resource.WithDetectionWarnings(),
+		resource.WithDetectors(
+			newDetector("", attribute.String("ok", "1")),
+			failingDetector{
+				res: resource.NewSchemaless(attribute.String("failed", "1")),
+				err: errFailed,
+			},
+		),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, resource.NewSchemaless(
+		attribute.String("failed", "1"),
+		attribute.String("ok", "1"),
+	), res)
+	if assert.Len(t, handled, 1) {
+		assert.ErrorIs(t, handled[0], errFailed)
+	}
+}
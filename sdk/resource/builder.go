@@ -0,0 +1,151 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// Builder builds a Resource from attributes defined by the OpenTelemetry
+// semantic conventions.
+//
+// The typed setters use the semantic conventions version the SDK is built
+// with, which avoids misspelled attribute keys and keys that drift from the
+// schema URL of the Resource. Attributes not covered by a setter can be added
+// with Set.
+//
+// A Builder must not be used concurrently. The zero value is ready to use.
+type Builder struct {
+	attrs []attribute.KeyValue
+}
+
+// NewBuilder returns a new, empty, Builder.
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// Set adds attrs to the Resource being built. If an attribute key is set more
+// than once, the last value is used.
+func (b *Builder) Set(attrs ...attribute.KeyValue) *Builder {
+	b.attrs = append(b.attrs, attrs...)
+	return b
+}
+
+// Build returns a Resource with all the attributes set on b and the schema
+// URL of the semantic conventions used by b.
+func (b *Builder) Build() *Resource {
+	return NewWithAttributes(semconv.SchemaURL, b.attrs...)
+}
+
+// SetServiceName sets the "service.name" attribute.
+func (b *Builder) SetServiceName(name string) *Builder {
+	return b.Set(semconv.ServiceName(name))
+}
+
+// SetServiceVersion sets the "service.version" attribute.
+func (b *Builder) SetServiceVersion(version string) *Builder {
+	return b.Set(semconv.ServiceVersion(version))
+}
+
+// SetServiceNamespace sets the "service.namespace" attribute.
+func (b *Builder) SetServiceNamespace(namespace string) *Builder {
+	return b.Set(semconv.ServiceNamespace(namespace))
+}
+
+// SetServiceInstanceID sets the "service.instance.id" attribute.
+func (b *Builder) SetServiceInstanceID(id string) *Builder {
+	return b.Set(semconv.ServiceInstanceID(id))
+}
+
+// SetDeploymentEnvironment sets the "deployment.environment" attribute.
+func (b *Builder) SetDeploymentEnvironment(env string) *Builder {
+	return b.Set(semconv.DeploymentEnvironment(env))
+}
+
+// SetHostName sets the "host.name" attribute.
+func (b *Builder) SetHostName(name string) *Builder {
+	return b.Set(semconv.HostName(name))
+}
+
+// SetHostID sets the "host.id" attribute.
+func (b *Builder) SetHostID(id string) *Builder {
+	return b.Set(semconv.HostID(id))
+}
+
+// SetHostType sets the "host.type" attribute.
+func (b *Builder) SetHostType(hostType string) *Builder {
+	return b.Set(semconv.HostType(hostType))
+}
+
+// SetContainerID sets the "container.id" attribute.
+func (b *Builder) SetContainerID(id string) *Builder {
+	return b.Set(semconv.ContainerID(id))
+}
+
+// SetContainerName sets the "container.name" attribute.
+func (b *Builder) SetContainerName(name string) *Builder {
+	return b.Set(semconv.ContainerName(name))
+}
+
+// SetContainerImageName sets the "container.image.name" attribute.
+func (b *Builder) SetContainerImageName(name string) *Builder {
+	return b.Set(semconv.ContainerImageName(name))
+}
+
+// SetK8sClusterName sets the "k8s.cluster.name" attribute.
+func (b *Builder) SetK8sClusterName(name string) *Builder {
+	return b.Set(semconv.K8SClusterName(name))
+}
+
+// SetK8sNamespaceName sets the "k8s.namespace.name" attribute.
+func (b *Builder) SetK8sNamespaceName(name string) *Builder {
+	return b.Set(semconv.K8SNamespaceName(name))
+}
+
+// SetK8sNodeName sets the "k8s.node.name" attribute.
+func (b *Builder) SetK8sNodeName(name string) *Builder {
+	return b.Set(semconv.K8SNodeName(name))
+}
+
+// SetK8sPodName sets the "k8s.pod.name" attribute.
+func (b *Builder) SetK8sPodName(name string) *Builder {
+	return b.Set(semconv.K8SPodName(name))
+}
+
+// SetK8sPodUID sets the "k8s.pod.uid" attribute.
+func (b *Builder) SetK8sPodUID(uid string) *Builder {
+	return b.Set(semconv.K8SPodUID(uid))
+}
+
+// SetK8sContainerName sets the "k8s.container.name" attribute.
+func (b *Builder) SetK8sContainerName(name string) *Builder {
+	return b.Set(semconv.K8SContainerName(name))
+}
+
+// SetK8sDeploymentName sets the "k8s.deployment.name" attribute.
+func (b *Builder) SetK8sDeploymentName(name string) *Builder {
+	return b.Set(semconv.K8SDeploymentName(name))
+}
+
+// SetCloudProvider sets the "cloud.provider" attribute. Well-known values,
+// like "aws", "azure", and "gcp", are defined by the semantic conventions.
+func (b *Builder) SetCloudProvider(provider string) *Builder {
+	return b.Set(semconv.CloudProviderKey.String(provider))
+}
+
+// SetCloudRegion sets the "cloud.region" attribute.
+func (b *Builder) SetCloudRegion(region string) *Builder {
+	return b.Set(semconv.CloudRegion(region))
+}
+
+// SetCloudAvailabilityZone sets the "cloud.availability_zone" attribute.
+func (b *Builder) SetCloudAvailabilityZone(zone string) *Builder {
+	return b.Set(semconv.CloudAvailabilityZone(zone))
+}
+
+// SetCloudAccountID sets the "cloud.account.id" attribute.
+func (b *Builder) SetCloudAccountID(id string) *Builder {
+	return b.Set(semconv.CloudAccountID(id))
+}
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+func TestBuilder(t *testing.T) {
+	res := resource.NewBuilder().
+		SetServiceName("svc").
+		SetServiceVersion("1.0").
+		SetServiceNamespace("ns").
+		SetServiceInstanceID("id").
+		SetDeploymentEnvironment("prod").
+		SetHostName("host").
+		SetHostID("host-id").
+		SetHostType("t2.micro").
+		SetContainerID("container-id").
+		SetContainerName("container").
+		SetContainerImageName("image").
+		SetK8sClusterName("cluster").
+		SetK8sNamespaceName("k8s-ns").
+		SetK8sNodeName("node").
+		SetK8sPodName("pod").
+		SetK8sPodUID("pod-uid").
+		SetK8sContainerName("k8s-container").
+		SetK8sDeploymentName("deployment").
+		SetCloudProvider("gcp").
+		SetCloudRegion("us-central1").
+		SetCloudAvailabilityZone("us-central1-a").
+		SetCloudAccountID("account").
+		Set(attribute.String("custom", "value")).
+		Build()
+
+	assert.Equal(t, semconv.SchemaURL, res.SchemaURL())
+	assert.Equal(t, map[string]string{
+		"service.name":            "svc",
+		"service.version":         "1.0",
+		"service.namespace":       "ns",
+		"service.instance.id":     "id",
+		"deployment.environment":  "prod",
+		"host.name":               "host",
+		"host.id":                 "host-id",
+		"host.type":               "t2.micro",
+		"container.id":            "container-id",
+		"container.name":          "container",
+		"container.image.name":    "image",
+		"k8s.cluster.name":        "cluster",
+		"k8s.namespace.name":      "k8s-ns",
+		"k8s.node.name":           "node",
+		"k8s.pod.name":            "pod",
+		"k8s.pod.uid":             "pod-uid",
+		"k8s.container.name":      "k8s-container",
+		"k8s.deployment.name":     "deployment",
+		"cloud.provider":          "gcp",
+		"cloud.region":            "us-central1",
+		"cloud.availability_zone": "us-central1-a",
+		"cloud.account.id":        "account",
+		"custom":                  "value",
+	}, toMap(res))
+}
+
+func TestBuilderLastValueWins(t *testing.T) {
+	var b resource.Builder
+	res := b.SetServiceName("a").SetServiceName("b").Build()
+	assert.Equal(t, map[string]string{"service.name": "b"}, toMap(res))
+}
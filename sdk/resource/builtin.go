@@ -29,6 +29,10 @@ type (
 	// resource.New() to explicitly disable them.
 	host struct{}
 
+	// hostArchDetector is a Detector that provides the CPU architecture of
+	// the host being run on.
+	hostArchDetector struct{}
+
 	stringDetector struct {
 		schemaURL string
 		K         attribute.Key
@@ -43,6 +47,7 @@ type (
 var (
 	_ Detector = telemetrySDK{}
 	_ Detector = host{}
+	_ Detector = hostArchDetector{}
 	_ Detector = stringDetector{}
 	_ Detector = defaultServiceNameDetector{}
 	_ Detector = defaultServiceInstanceIDDetector{}
@@ -63,6 +68,36 @@ func (host) Detect(ctx context.Context) (*Resource, error) {
 	return StringDetector(semconv.SchemaURL, semconv.HostNameKey, os.Hostname).Detect(ctx)
 }
 
+// Detect returns a *Resource that describes the CPU architecture of the host
+// being run on.
+func (hostArchDetector) Detect(context.Context) (*Resource, error) {
+	return NewWithAttributes(semconv.SchemaURL, mapRuntimeArchToSemconvHostArch(runtimeArch())), nil
+}
+
+// mapRuntimeArchToSemconvHostArch translates the architecture name as provided
+// by the Go runtime into a host architecture attribute with the corresponding
+// value defined by the semantic conventions. In case the provided architecture
+// isn't mapped, it is used as the value for the returned attribute.
+func mapRuntimeArchToSemconvHostArch(arch string) attribute.KeyValue {
+	switch arch {
+	case "amd64":
+		return semconv.HostArchAMD64
+	case "arm":
+		return semconv.HostArchARM32
+	case "arm64":
+		return semconv.HostArchARM64
+	case "386":
+		return semconv.HostArchX86
+	case "ppc", "ppcle":
+		return semconv.HostArchPPC32
+	case "ppc64", "ppc64le":
+		return semconv.HostArchPPC64
+	case "s390x":
+		return semconv.HostArchS390x
+	}
+	return semconv.HostArchKey.String(arch)
+}
+
 // StringDetector returns a Detector that will produce a *Resource
 // containing the string as a value corresponding to k. The resulting Resource
 // will have the specified schemaURL.
@@ -0,0 +1,248 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// Instance metadata service (IMDS) endpoints. These are variables so they
+// can be overridden in tests.
+var (
+	ec2Endpoint   = "http://169.254.169.254"
+	gceEndpoint   = "http://metadata.google.internal"
+	azureEndpoint = "http://169.254.169.254"
+)
+
+// imdsTimeout is the maximum duration a single cloud detector waits for the
+// instance metadata service to respond. The services are link-local, so a
+// short timeout avoids delaying startup when not running in the cloud.
+var imdsTimeout = 2 * time.Second
+
+var imdsClient = &http.Client{}
+
+// errIMDSStatus is returned when an instance metadata service responds with a
+// status other than 200 OK.
+var errIMDSStatus = errors.New("unexpected instance metadata service response status")
+
+type (
+	// ec2Detector is a Detector that provides information about the AWS
+	// EC2 instance being run on.
+	ec2Detector struct{}
+
+	// gceDetector is a Detector that provides information about the Google
+	// Compute Engine instance being run on.
+	gceDetector struct{}
+
+	// azureVMDetector is a Detector that provides information about the
+	// Azure virtual machine being run on.
+	azureVMDetector struct{}
+)
+
+var (
+	_ Detector = ec2Detector{}
+	_ Detector = gceDetector{}
+	_ Detector = azureVMDetector{}
+)
+
+// imdsRequest sends a request to an instance metadata service and returns
+// the response body. If the service cannot be reached, ok is false and no
+// error is returned: the process is not running on that cloud.
+func imdsRequest(ctx context.Context, method, url string, header map[string]string) (body []byte, ok bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, http.NoBody)
+	if err != nil {
+		return nil, false, err
+	}
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := imdsClient.Do(req)
+	if err != nil {
+		return nil, false, nil
+	}
+	defer resp.Body.Close()
+
+	body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, true, fmt.Errorf("%w: %s %s: %s", errIMDSStatus, method, url, resp.Status)
+	}
+	return body, true, nil
+}
+
+// imdsProbe is an imdsRequest used to identify the cloud being run on. The
+// instance metadata services of multiple clouds share the same link-local
+// address, so a response with a status other than 200 OK also means the
+// process is not running on that cloud: ok is false and no error is
+// returned.
+func imdsProbe(ctx context.Context, method, url string, header map[string]string) (body []byte, ok bool, err error) {
+	body, ok, err = imdsRequest(ctx, method, url, header)
+	if errors.Is(err, errIMDSStatus) {
+		return nil, false, nil
+	}
+	return body, ok, err
+}
+
+// Detect returns a *Resource that describes the EC2 instance being run on.
+// If not running on EC2, an empty resource will be returned.
+func (ec2Detector) Detect(ctx context.Context) (*Resource, error) {
+	ctx, cancel := context.WithTimeout(ctx, imdsTimeout)
+	defer cancel()
+
+	// IMDSv2 requires a session token.
+	token, ok, err := imdsProbe(ctx, http.MethodPut, ec2Endpoint+"/latest/api/token", map[string]string{
+		"X-aws-ec2-metadata-token-ttl-seconds": "60",
+	})
+	if !ok || err != nil {
+		return Empty(), err
+	}
+
+	body, ok, err := imdsRequest(ctx, http.MethodGet, ec2Endpoint+"/latest/dynamic/instance-identity/document", map[string]string{
+		"X-aws-ec2-metadata-token": string(token),
+	})
+	if !ok || err != nil {
+		return Empty(), err
+	}
+
+	var doc struct {
+		AccountID        string `json:"accountId"`
+		AvailabilityZone string `json:"availabilityZone"`
+		ImageID          string `json:"imageId"`
+		InstanceID       string `json:"instanceId"`
+		InstanceType     string `json:"instanceType"`
+		Region           string `json:"region"`
+	}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return Empty(), fmt.Errorf("ec2: invalid instance identity document: %w", err)
+	}
+
+	return newCloudResource(
+		semconv.CloudProviderAWS,
+		semconv.CloudPlatformAWSEC2,
+		semconv.CloudRegion(doc.Region),
+		semconv.CloudAvailabilityZone(doc.AvailabilityZone),
+		semconv.CloudAccountID(doc.AccountID),
+		semconv.HostID(doc.InstanceID),
+		semconv.HostType(doc.InstanceType),
+		semconv.HostImageID(doc.ImageID),
+	), nil
+}
+
+// Detect returns a *Resource that describes the Compute Engine instance
+// being run on. If not running on Compute Engine, an empty resource will be
+// returned.
+func (gceDetector) Detect(ctx context.Context) (*Resource, error) {
+	ctx, cancel := context.WithTimeout(ctx, imdsTimeout)
+	defer cancel()
+
+	header := map[string]string{"Metadata-Flavor": "Google"}
+	body, ok, err := imdsProbe(ctx, http.MethodGet, gceEndpoint+"/computeMetadata/v1/instance/?recursive=true", header)
+	if !ok || err != nil {
+		return Empty(), err
+	}
+
+	var inst struct {
+		ID          json.Number `json:"id"`
+		Name        string      `json:"name"`
+		MachineType string      `json:"machineType"`
+		Zone        string      `json:"zone"`
+	}
+	if err := json.Unmarshal(body, &inst); err != nil {
+		return Empty(), fmt.Errorf("gce: invalid instance metadata: %w", err)
+	}
+
+	project, _, err := imdsRequest(ctx, http.MethodGet, gceEndpoint+"/computeMetadata/v1/project/project-id", header)
+	if err != nil {
+		return Empty(), err
+	}
+
+	// The zone and machine type are formatted as
+	// "projects/<number>/zones/<zone>" and
+	// "projects/<number>/machineTypes/<type>".
+	zone := lastPathSegment(inst.Zone)
+	var region string
+	if i := strings.LastIndexByte(zone, '-'); i > 0 {
+		region = zone[:i]
+	}
+
+	return newCloudResource(
+		semconv.CloudProviderGCP,
+		semconv.CloudPlatformGCPComputeEngine,
+		semconv.CloudRegion(region),
+		semconv.CloudAvailabilityZone(zone),
+		semconv.CloudAccountID(string(project)),
+		semconv.HostID(inst.ID.String()),
+		semconv.HostName(inst.Name),
+		semconv.HostType(lastPathSegment(inst.MachineType)),
+	), nil
+}
+
+// Detect returns a *Resource that describes the Azure virtual machine being
+// run on. If not running on an Azure virtual machine, an empty resource will
+// be returned.
+func (azureVMDetector) Detect(ctx context.Context) (*Resource, error) {
+	ctx, cancel := context.WithTimeout(ctx, imdsTimeout)
+	defer cancel()
+
+	body, ok, err := imdsProbe(ctx, http.MethodGet, azureEndpoint+"/metadata/instance/compute?api-version=2021-12-13&format=json", map[string]string{
+		"Metadata": "true",
+	})
+	if !ok || err != nil {
+		return Empty(), err
+	}
+
+	var compute struct {
+		Location       string `json:"location"`
+		Name           string `json:"name"`
+		ResourceID     string `json:"resourceId"`
+		SubscriptionID string `json:"subscriptionId"`
+		VMID           string `json:"vmId"`
+		VMSize         string `json:"vmSize"`
+		Zone           string `json:"zone"`
+	}
+	if err := json.Unmarshal(body, &compute); err != nil {
+		return Empty(), fmt.Errorf("azure: invalid instance metadata: %w", err)
+	}
+
+	return newCloudResource(
+		semconv.CloudProviderAzure,
+		semconv.CloudPlatformAzureVM,
+		semconv.CloudRegion(compute.Location),
+		semconv.CloudAvailabilityZone(compute.Zone),
+		semconv.CloudAccountID(compute.SubscriptionID),
+		semconv.CloudResourceID(compute.ResourceID),
+		semconv.HostID(compute.VMID),
+		semconv.HostName(compute.Name),
+		semconv.HostType(compute.VMSize),
+	), nil
+}
+
+// newCloudResource returns a Resource with attrs, omitting any attribute
+// that has an empty value.
+func newCloudResource(attrs ...attribute.KeyValue) *Resource {
+	valid := attrs[:0]
+	for _, a := range attrs {
+		if a.Value.Emit() != "" {
+			valid = append(valid, a)
+		}
+	}
+	return NewWithAttributes(semconv.SchemaURL, valid...)
+}
+
+func lastPathSegment(s string) string {
+	return s[strings.LastIndexByte(s, '/')+1:]
+}
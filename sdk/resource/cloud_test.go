@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+func setEndpoint(t *testing.T, endpoint *string, h http.Handler) {
+	srv := httptest.NewServer(h)
+	orig := *endpoint
+	t.Cleanup(func() {
+		srv.Close()
+		*endpoint = orig
+	})
+	*endpoint = srv.URL
+}
+
+func TestEC2Detector(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /latest/api/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "60", r.Header.Get("X-aws-ec2-metadata-token-ttl-seconds"))
+		_, _ = w.Write([]byte("token"))
+	})
+	mux.HandleFunc("GET /latest/dynamic/instance-identity/document", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-aws-ec2-metadata-token") != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{
+			"accountId": "123456789012",
+			"availabilityZone": "us-west-2b",
+			"imageId": "ami-5fb8c835",
+			"instanceId": "i-1234567890abcdef0",
+			"instanceType": "t2.micro",
+			"region": "us-west-2"
+		}`))
+	})
+	setEndpoint(t, &ec2Endpoint, mux)
+
+	res, err := ec2Detector{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.CloudProviderAWS,
+		semconv.CloudPlatformAWSEC2,
+		semconv.CloudRegion("us-west-2"),
+		semconv.CloudAvailabilityZone("us-west-2b"),
+		semconv.CloudAccountID("123456789012"),
+		semconv.HostID("i-1234567890abcdef0"),
+		semconv.HostType("t2.micro"),
+		semconv.HostImageID("ami-5fb8c835"),
+	), res)
+}
+
+func TestGCEDetector(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /computeMetadata/v1/instance/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Google", r.Header.Get("Metadata-Flavor"))
+		_, _ = w.Write([]byte(`{
+			"id": 4520031799277581759,
+			"name": "instance-1",
+			"machineType": "projects/123/machineTypes/e2-medium",
+			"zone": "projects/123/zones/us-central1-a"
+		}`))
+	})
+	mux.HandleFunc("GET /computeMetadata/v1/project/project-id", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("my-project"))
+	})
+	setEndpoint(t, &gceEndpoint, mux)
+
+	res, err := gceDetector{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.CloudProviderGCP,
+		semconv.CloudPlatformGCPComputeEngine,
+		semconv.CloudRegion("us-central1"),
+		semconv.CloudAvailabilityZone("us-central1-a"),
+		semconv.CloudAccountID("my-project"),
+		semconv.HostID("4520031799277581759"),
+		semconv.HostName("instance-1"),
+		semconv.HostType("e2-medium"),
+	), res)
+}
+
+func TestAzureVMDetector(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /metadata/instance/compute", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.Header.Get("Metadata"))
+		_, _ = w.Write([]byte(`{
+			"location": "westus",
+			"name": "examplevmname",
+			"resourceId": "/subscriptions/xxx/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/examplevmname",
+			"subscriptionId": "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+			"vmId": "02aab8a4-74ef-476e-8182-f6d2ba4166a6",
+			"vmSize": "Standard_A3",
+			"zone": ""
+		}`))
+	})
+	setEndpoint(t, &azureEndpoint, mux)
+
+	res, err := azureVMDetector{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.CloudProviderAzure,
+		semconv.CloudPlatformAzureVM,
+		semconv.CloudRegion("westus"),
+		semconv.CloudAccountID("xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"),
+		semconv.CloudResourceID("/subscriptions/xxx/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/examplevmname"),
+		semconv.HostID("02aab8a4-74ef-476e-8182-f6d2ba4166a6"),
+		semconv.HostName("examplevmname"),
+		semconv.HostType("Standard_A3"),
+	), res)
+}
+
+func TestCloudDetectorsUnreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	unreachable := srv.URL
+	srv.Close()
+
+	for _, endpoint := range []*string{&ec2Endpoint, &gceEndpoint, &azureEndpoint} {
+		orig := *endpoint
+		t.Cleanup(func() { *endpoint = orig })
+		*endpoint = unreachable
+	}
+
+	for _, d := range []Detector{ec2Detector{}, gceDetector{}, azureVMDetector{}} {
+		res, err := d.Detect(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, Empty(), res)
+	}
+}
+
+func TestCloudDetectorsOtherCloud(t *testing.T) {
+	// The instance metadata service of another cloud responds to requests
+	// it does not serve with an error status.
+	for _, status := range []int{http.StatusNotFound, http.StatusBadRequest, http.StatusMethodNotAllowed} {
+		h := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(status)
+		})
+		setEndpoint(t, &ec2Endpoint, h)
+		setEndpoint(t, &gceEndpoint, h)
+		setEndpoint(t, &azureEndpoint, h)
+
+		for _, d := range []Detector{ec2Detector{}, gceDetector{}, azureVMDetector{}} {
+			res, err := d.Detect(context.Background())
+			assert.NoError(t, err, "%T: %d", d, status)
+			assert.Equal(t, Empty(), res, "%T: %d", d, status)
+		}
+	}
+}
+
+func TestEC2DetectorErrorStatus(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /latest/api/token", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("token"))
+	})
+	mux.HandleFunc("GET /latest/dynamic/instance-identity/document", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	setEndpoint(t, &ec2Endpoint, mux)
+
+	_, err := ec2Detector{}.Detect(context.Background())
+	assert.ErrorIs(t, err, errIMDSStatus)
+}
@@ -15,6 +15,11 @@ type config struct {
 	detectors []Detector
 	// SchemaURL to associate with the Resource.
 	schemaURL string
+	// warnOnError reports detection errors to the global error handler
+	// instead of returning them.
+	warnOnError bool
+	// precedence of layers, from highest to lowest.
+	precedence []Layer
 }
 
 // Option is the interface that applies a configuration option.
@@ -25,7 +30,7 @@ type Option interface {
 
 // WithAttributes adds attributes to the configured Resource.
 func WithAttributes(attributes ...attribute.KeyValue) Option {
-	return WithDetectors(detectAttributes{attributes})
+	return WithLayer(LayerProgrammatic, detectAttributes{attributes})
 }
 
 type detectAttributes struct {
@@ -52,7 +57,30 @@ func (o detectorsOption) apply(cfg config) config {
 
 // WithFromEnv adds attributes from environment variables to the configured resource.
 func WithFromEnv() Option {
-	return WithDetectors(fromEnv{})
+	return WithLayer(LayerEnv, fromEnv{})
+}
+
+// WithFromFile adds attributes read from the file at path to the configured
+// resource. If path is empty, the path is read from the
+// OTEL_RESOURCE_ATTRIBUTES_FILE environment variable, and no attributes are
+// added if it is not set. The same detector is registered as "file" (see
+// WithRegisteredDetectors) so reading the file can also be enabled with the
+// OTEL_RESOURCE_DETECTORS environment variable.
+//
+// Files are only read when this option or the "file" detector is used: the
+// Resource returned by Default does not include their attributes.
+//
+// Files with a ".yaml" or ".yml" extension are parsed as a YAML mapping of
+// attribute keys to values. Nested mappings are flattened by joining their
+// keys with ".", and values can be strings, booleans, integers, floating
+// point numbers, or sequences of one of these types. Any other file is
+// parsed as one key=value pair per line, where empty lines and lines
+// starting with "#" are ignored.
+//
+// This is useful on platforms that provide the identity of a service with a
+// mounted file instead of environment variables.
+func WithFromFile(path string) Option {
+	return WithLayer(LayerFile, fromFile{path: path})
 }
 
 // WithHost adds attributes from the host to the configured resource.
@@ -61,10 +89,59 @@ func WithHost() Option {
 }
 
 // WithHostID adds host ID information to the configured resource.
+//
+// The host ID is read from the platform specific source:
+//
+//   - Linux: /etc/machine-id, falling back to /var/lib/dbus/machine-id.
+//   - BSD: /etc/hostid, falling back to `kenv -q smbios.system.uuid`.
+//   - macOS: the IOPlatformUUID reported by `ioreg`.
+//   - Windows: the MachineGuid value of the
+//     HKEY_LOCAL_MACHINE\SOFTWARE\Microsoft\Cryptography registry key.
+//
+// Use WithCloudHostID to prefer the cloud instance ID when running on a
+// cloud virtual machine.
 func WithHostID() Option {
 	return WithDetectors(hostIDDetector{})
 }
 
+// WithCloudHostID adds host ID information to the configured resource,
+// preferring the instance ID of the cloud virtual machine being run on as
+// recommended by the OpenTelemetry semantic conventions.
+//
+// The host ID is determined in the following order of precedence:
+//
+//  1. The AWS EC2 instance ID.
+//  2. The Google Compute Engine instance ID.
+//  3. The Azure VM ID.
+//  4. The platform specific host ID (see WithHostID).
+//
+// The cloud instance IDs are queried from the instance metadata services of
+// each cloud concurrently, which may delay detection by up to two seconds
+// when not running in a cloud.
+func WithCloudHostID() Option {
+	return WithDetectors(hostIDDetector{cloud: true})
+}
+
+// WithServiceInstanceID adds the service.instance.id attribute generated
+// with strategy to the configured resource. The name of the strategy is
+// added as the "io.opentelemetry.go.service.instance.id_strategy"
+// attribute.
+func WithServiceInstanceID(strategy ServiceInstanceIDStrategy) Option {
+	return WithDetectors(serviceInstanceIDDetector{strategy: strategy})
+}
+
+// WithHostNetwork adds the host.ip and host.mac attributes to the configured
+// resource. These contain the IP addresses of the network interfaces of the
+// host that are up and the MAC addresses of those interfaces.
+//
+// Loopback, link-local, multicast, and unspecified IP addresses are never
+// included. The filters can be used to exclude other addresses, e.g.
+// ExcludePrivateIPs. MAC addresses are only included for interfaces with at
+// least one included IP address.
+func WithHostNetwork(filters ...HostIPFilter) Option {
+	return WithDetectors(hostNetworkDetector{filters: filters})
+}
+
 // WithTelemetrySDK adds TelemetrySDK version info to the configured resource.
 func WithTelemetrySDK() Option {
 	return WithDetectors(telemetrySDK{})
@@ -82,6 +159,22 @@ func (o schemaURLOption) apply(cfg config) config {
 	return cfg
 }
 
+// WithDetectionWarnings configures detection errors to be treated as
+// warnings. Instead of being returned, the [*DetectionError] is sent to the
+// global error handler (see go.opentelemetry.io/otel.SetErrorHandler) and
+// the resources returned by failing detectors are still merged, so the
+// Resource contains everything that could be detected.
+func WithDetectionWarnings() Option {
+	return detectionWarningsOption{}
+}
+
+type detectionWarningsOption struct{}
+
+func (detectionWarningsOption) apply(cfg config) config {
+	cfg.warnOnError = true
+	return cfg
+}
+
 // WithOS adds all the OS attributes to the configured Resource.
 // See individual WithOS* functions to configure specific attributes.
 func WithOS() Option {
@@ -103,6 +196,21 @@ func WithOSDescription() Option {
 	return WithDetectors(osDescriptionDetector{})
 }
 
+// WithOSVersion adds an attribute with the operating system version to the
+// configured Resource. On Linux and other Unix systems this is the VERSION_ID
+// of the os-release file, on macOS the product version (e.g. "14.5"), and on
+// Windows the "major.minor.build" version (e.g. "10.0.19045"). No attribute
+// is added if the version is unknown.
+func WithOSVersion() Option {
+	return WithDetectors(osVersionDetector{})
+}
+
+// WithHostArch adds an attribute with the CPU architecture of the host to the
+// configured Resource.
+func WithHostArch() Option {
+	return WithDetectors(hostArchDetector{})
+}
+
 // WithProcess adds all the Process attributes to the configured Resource.
 //
 // Warning! This option will include process command line arguments. If these
@@ -155,6 +263,25 @@ func WithProcessCommandArgs() Option {
 	return WithDetectors(processCommandArgsDetector{})
 }
 
+// WithProcessCommandArgsSanitized is like WithProcessCommandArgs, but the
+// command arguments are passed to sanitize before they are added to the
+// configured Resource. This can be used to remove sensitive information from
+// the arguments (see RedactCommandArgs). The arguments passed to sanitize are
+// a copy and can be modified.
+func WithProcessCommandArgsSanitized(sanitize func(args []string) []string) Option {
+	return WithDetectors(processCommandArgsSanitizedDetector{sanitize: sanitize})
+}
+
+// WithProcessBuildInfo adds attributes describing the build of the Go
+// executable to the configured Resource. These are read from the build
+// information embedded in the executable (see runtime/debug.ReadBuildInfo)
+// and include the main module path and version as well as the version
+// control revision, time, and modified state if it was built from a
+// repository.
+func WithProcessBuildInfo() Option {
+	return WithDetectors(processBuildInfoDetector{})
+}
+
 // WithProcessOwner adds an attribute with the username of the user that owns the process
 // to the configured Resource.
 func WithProcessOwner() Option {
@@ -193,3 +320,43 @@ func WithContainer() Option {
 func WithContainerID() Option {
 	return WithDetectors(cgroupContainerIDDetector{})
 }
+
+// WithContainerRuntime adds an attribute with the name of the container
+// runtime (i.e. docker, containerd, cri-o, podman) managing the container to
+// the configured Resource.
+func WithContainerRuntime() Option {
+	return WithDetectors(containerRuntimeDetector{})
+}
+
+// WithContainerCgroupLimits adds attributes describing the cgroup version
+// and the memory (in bytes) and CPU (in number of CPUs) limits of the cgroup
+// the process runs in to the configured Resource. Both cgroup v1 and v2 are
+// supported. Limits that are not set are not added.
+func WithContainerCgroupLimits() Option {
+	return WithDetectors(cgroupLimitsDetector{})
+}
+
+// WithKubernetes adds attributes describing the Kubernetes pod the process
+// runs in to the configured Resource. The k8s.pod.name, k8s.pod.uid,
+// k8s.namespace.name, k8s.node.name, and k8s.container.name attributes are
+// read from the K8S_POD_NAME, K8S_POD_UID, K8S_NAMESPACE_NAME,
+// K8S_NODE_NAME, and K8S_CONTAINER_NAME environment variables respectively,
+// or, for all but the container name, from POD_NAME, POD_UID, POD_NAMESPACE,
+// and NODE_NAME if those are not set. These are expected to be populated using the Kubernetes
+// downward API. If no namespace is found in the environment, it is read from
+// the service account namespace file mounted into the pod.
+func WithKubernetes() Option {
+	return WithDetectors(k8sDetector{})
+}
+
+// WithKubernetesEnv is like WithKubernetes, but the attributes with keys in
+// env are read from the environment variable they map to instead. This can
+// be used to read the default attributes from non-standard environment
+// variables, or to read additional attributes (e.g. k8s.deployment.name).
+func WithKubernetesEnv(env map[attribute.Key]string) Option {
+	e := make(map[attribute.Key]string, len(env))
+	for k, v := range env {
+		e[k] = v
+	}
+	return WithDetectors(k8sDetector{env: e})
+}
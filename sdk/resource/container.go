@@ -10,20 +10,72 @@ import (
 	"io"
 	"os"
 	"regexp"
+	"strconv"
+	"strings"
 
+	"go.opentelemetry.io/otel/attribute"
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
-type containerIDProvider func() (string, error)
+type (
+	containerIDProvider      func() (string, error)
+	containerRuntimeProvider func() (string, error)
+	cgroupLimitsProvider     func() (cgroupLimits, error)
+)
+
+var (
+	containerID         containerIDProvider      = getContainerID
+	containerRuntime    containerRuntimeProvider = getContainerRuntime
+	limits              cgroupLimitsProvider     = getCgroupLimits
+	cgroupContainerIDRe                          = regexp.MustCompile(`^.*/(?:.*[-:])?([0-9a-f]+)(?:\.|\s*$)`)
+
+	// mountInfoContainerIDRe matches the container scoped files (i.e.
+	// hostname, resolv.conf) container runtimes bind mount into a container.
+	// These are the only place the container ID is visible when running
+	// with a private cgroup namespace on cgroup v2.
+	mountInfoContainerIDRe = regexp.MustCompile(`/(?:containers|overlay-containers|sandboxes)/([0-9a-f]{64})/`)
+)
+
+// Attribute keys used to describe the resource limits of the cgroup the
+// process is running in. These are not defined by the OpenTelemetry semantic
+// conventions, so they use a namespace owned by this project to avoid
+// conflicting with keys the semantic conventions may define in the future.
+const (
+	cgroupVersionKey     = attribute.Key("io.opentelemetry.go.cgroup.version")
+	cgroupMemoryLimitKey = attribute.Key("io.opentelemetry.go.cgroup.memory.limit")
+	cgroupCPULimitKey    = attribute.Key("io.opentelemetry.go.cgroup.cpu.limit")
+)
+
+type (
+	cgroupContainerIDDetector struct{}
+	containerRuntimeDetector  struct{}
+	cgroupLimitsDetector      struct{}
+)
 
 var (
-	containerID         containerIDProvider = getContainerIDFromCGroup
-	cgroupContainerIDRe                     = regexp.MustCompile(`^.*/(?:.*[-:])?([0-9a-f]+)(?:\.|\s*$)`)
+	_ Detector = cgroupContainerIDDetector{}
+	_ Detector = containerRuntimeDetector{}
+	_ Detector = cgroupLimitsDetector{}
 )
 
-type cgroupContainerIDDetector struct{}
+const (
+	cgroupPath    = "/proc/self/cgroup"
+	mountInfoPath = "/proc/self/mountinfo"
+
+	cgroupRoot = "/sys/fs/cgroup"
+	// cgroupV2ControllersPath only exists on a unified (v2) hierarchy.
+	cgroupV2ControllersPath = cgroupRoot + "/cgroup.controllers"
+	cgroupV2MemoryMaxPath   = cgroupRoot + "/memory.max"
+	cgroupV2CPUMaxPath      = cgroupRoot + "/cpu.max"
+	cgroupV1MemoryLimitPath = cgroupRoot + "/memory/memory.limit_in_bytes"
+	cgroupV1CPUQuotaPath    = cgroupRoot + "/cpu/cpu.cfs_quota_us"
+	cgroupV1CPUPeriodPath   = cgroupRoot + "/cpu/cpu.cfs_period_us"
 
-const cgroupPath = "/proc/self/cgroup"
+	// cgroupV1Unlimited is a lower bound for the value the kernel reports
+	// for an unlimited cgroup v1 memory limit (PAGE_COUNTER_MAX multiplied
+	// by the page size, which varies by platform).
+	cgroupV1Unlimited = 1 << 62
+)
 
 // Detect returns a *Resource that describes the id of the container.
 // If no container id found, an empty resource will be returned.
@@ -39,6 +91,45 @@ func (cgroupContainerIDDetector) Detect(ctx context.Context) (*Resource, error)
 	return NewWithAttributes(semconv.SchemaURL, semconv.ContainerID(containerID)), nil
 }
 
+// Detect returns a *Resource that describes the container runtime managing
+// the container. If no container runtime is found, an empty resource will be
+// returned.
+func (containerRuntimeDetector) Detect(ctx context.Context) (*Resource, error) {
+	runtime, err := containerRuntime()
+	if err != nil {
+		return nil, err
+	}
+
+	if runtime == "" {
+		return Empty(), nil
+	}
+	return NewWithAttributes(semconv.SchemaURL, semconv.ContainerRuntime(runtime)), nil
+}
+
+// Detect returns a *Resource that describes the cgroup version and the
+// memory and CPU limits applied to the cgroup of the process. Limits that are
+// not set are omitted. If cgroupfs is not available, an empty resource will
+// be returned.
+func (cgroupLimitsDetector) Detect(ctx context.Context) (*Resource, error) {
+	l, err := limits()
+	if err != nil {
+		return nil, err
+	}
+
+	if l.version == 0 {
+		return Empty(), nil
+	}
+
+	attrs := []attribute.KeyValue{cgroupVersionKey.Int(l.version)}
+	if l.memory > 0 {
+		attrs = append(attrs, cgroupMemoryLimitKey.Int64(l.memory))
+	}
+	if l.cpu > 0 {
+		attrs = append(attrs, cgroupCPULimitKey.Float64(l.cpu))
+	}
+	return NewWithAttributes(semconv.SchemaURL, attrs...), nil
+}
+
 var (
 	defaultOSStat = os.Stat
 	osStat        = defaultOSStat
@@ -49,6 +140,18 @@ var (
 	osOpen = defaultOSOpen
 )
 
+// getContainerID returns the id of the container from the cgroup file,
+// falling back to the mount info file if the cgroup file does not contain
+// it (i.e. cgroup v2 with a private cgroup namespace).
+// If no container id found, an empty string will be returned.
+func getContainerID() (string, error) {
+	id, err := getContainerIDFromCGroup()
+	if err != nil || id != "" {
+		return id, err
+	}
+	return getContainerIDFromMountInfo()
+}
+
 // getContainerIDFromCGroup returns the id of the container from the cgroup file.
 // If no container id found, an empty string will be returned.
 func getContainerIDFromCGroup() (string, error) {
@@ -87,3 +190,205 @@ func getContainerIDFromLine(line string) string {
 	}
 	return matches[1]
 }
+
+// getContainerIDFromMountInfo returns the id of the container from the mount
+// info file. If no container id found, an empty string will be returned.
+func getContainerIDFromMountInfo() (string, error) {
+	if _, err := osStat(mountInfoPath); errors.Is(err, os.ErrNotExist) {
+		// File does not exist, skip
+		return "", nil
+	}
+
+	file, err := osOpen(mountInfoPath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	return getContainerIDFromMountInfoReader(file), nil
+}
+
+// getContainerIDFromMountInfoReader returns the id of the container from
+// reader containing mount info.
+func getContainerIDFromMountInfoReader(reader io.Reader) string {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		matches := mountInfoContainerIDRe.FindStringSubmatch(scanner.Text())
+		if len(matches) > 1 {
+			return matches[1]
+		}
+	}
+	return ""
+}
+
+// getContainerRuntime returns the name of the container runtime managing the
+// container based on the cgroup and mount info files. If no container
+// runtime is found, an empty string will be returned.
+func getContainerRuntime() (string, error) {
+	for _, path := range []string{cgroupPath, mountInfoPath} {
+		if _, err := osStat(path); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+
+		file, err := osOpen(path)
+		if err != nil {
+			return "", err
+		}
+		runtime := getContainerRuntimeFromReader(file)
+		_ = file.Close()
+
+		if runtime != "" {
+			return runtime, nil
+		}
+	}
+	return "", nil
+}
+
+// getContainerRuntimeFromReader returns the container runtime identified in
+// the first line of reader that references a container.
+func getContainerRuntimeFromReader(reader io.Reader) string {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if getContainerIDFromLine(line) == "" && !mountInfoContainerIDRe.MatchString(line) {
+			continue
+		}
+		if runtime := getContainerRuntimeFromLine(line); runtime != "" {
+			return runtime
+		}
+	}
+	return ""
+}
+
+// getContainerRuntimeFromLine returns the container runtime referenced in
+// line. The more specific runtimes are checked first as their paths may also
+// contain the name of the runtime they are built on.
+func getContainerRuntimeFromLine(line string) string {
+	switch {
+	case strings.Contains(line, "libpod") || strings.Contains(line, "overlay-containers"):
+		return "podman"
+	case strings.Contains(line, "crio"):
+		return "cri-o"
+	case strings.Contains(line, "containerd"):
+		return "containerd"
+	case strings.Contains(line, "docker"):
+		return "docker"
+	}
+	return ""
+}
+
+// cgroupLimits are the resource limits applied to a cgroup. A zero version
+// means cgroupfs is not available, and a zero limit means it is not set.
+type cgroupLimits struct {
+	version int
+	// memory is the memory limit in bytes.
+	memory int64
+	// cpu is the CPU limit in number of CPUs.
+	cpu float64
+}
+
+// getCgroupLimits returns the resource limits of the cgroup from cgroupfs.
+func getCgroupLimits() (cgroupLimits, error) {
+	if _, err := osStat(cgroupV2ControllersPath); err == nil {
+		return getCgroupV2Limits()
+	}
+	if _, err := osStat(cgroupV1MemoryLimitPath); err == nil {
+		return getCgroupV1Limits()
+	}
+	return cgroupLimits{}, nil
+}
+
+func getCgroupV2Limits() (cgroupLimits, error) {
+	l := cgroupLimits{version: 2}
+
+	mem, err := readFileContent(cgroupV2MemoryMaxPath)
+	if err != nil {
+		return l, err
+	}
+	if mem != "" && mem != "max" {
+		if l.memory, err = strconv.ParseInt(mem, 10, 64); err != nil {
+			return l, err
+		}
+	}
+
+	cpu, err := readFileContent(cgroupV2CPUMaxPath)
+	if err != nil {
+		return l, err
+	}
+	// Formatted as "$MAX $PERIOD" where $MAX may be "max".
+	if quota, period, ok := strings.Cut(cpu, " "); ok && quota != "max" {
+		if l.cpu, err = parseCPULimit(quota, period); err != nil {
+			return l, err
+		}
+	}
+	return l, nil
+}
+
+func getCgroupV1Limits() (cgroupLimits, error) {
+	l := cgroupLimits{version: 1}
+
+	mem, err := readFileContent(cgroupV1MemoryLimitPath)
+	if err != nil {
+		return l, err
+	}
+	if mem != "" {
+		if l.memory, err = strconv.ParseInt(mem, 10, 64); err != nil {
+			return l, err
+		}
+		if l.memory >= cgroupV1Unlimited {
+			l.memory = 0
+		}
+	}
+
+	quota, err := readFileContent(cgroupV1CPUQuotaPath)
+	if err != nil {
+		return l, err
+	}
+	period, err := readFileContent(cgroupV1CPUPeriodPath)
+	if err != nil {
+		return l, err
+	}
+	// A quota of -1 means the CPU is not limited.
+	if quota != "" && quota != "-1" && period != "" {
+		if l.cpu, err = parseCPULimit(quota, period); err != nil {
+			return l, err
+		}
+	}
+	return l, nil
+}
+
+// parseCPULimit returns the number of CPUs a quota over period allows.
+func parseCPULimit(quota, period string) (float64, error) {
+	q, err := strconv.ParseFloat(quota, 64)
+	if err != nil {
+		return 0, err
+	}
+	p, err := strconv.ParseFloat(period, 64)
+	if err != nil {
+		return 0, err
+	}
+	if p <= 0 {
+		return 0, nil
+	}
+	return q / p, nil
+}
+
+// readFileContent returns the trimmed content of the file at path. If the
+// file does not exist, an empty string is returned.
+func readFileContent(path string) (string, error) {
+	if _, err := osStat(path); errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+
+	file, err := osOpen(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	b, err := io.ReadAll(file)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
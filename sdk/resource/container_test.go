@@ -15,8 +15,10 @@ import (
 
 func setDefaultContainerProviders() {
 	setContainerProviders(
-		getContainerIDFromCGroup,
+		getContainerID,
 	)
+	setContainerRuntimeProvider(getContainerRuntime)
+	limits = getCgroupLimits
 }
 
 func setContainerProviders(
@@ -25,6 +27,16 @@ func setContainerProviders(
 	containerID = idProvider
 }
 
+func setContainerRuntimeProvider(runtimeProvider containerRuntimeProvider) {
+	containerRuntime = runtimeProvider
+}
+
+func setCgroupLimits(version int, memory int64, cpu float64, err error) {
+	limits = func() (cgroupLimits, error) {
+		return cgroupLimits{version: version, memory: memory, cpu: cpu}, err
+	}
+}
+
 func TestGetContainerIDFromLine(t *testing.T) {
 	testCases := []struct {
 		name                string
@@ -161,3 +173,154 @@ func TestGetContainerIDFromCGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestGetContainerIDFromMountInfoReader(t *testing.T) {
+	testCases := []struct {
+		name                string
+		reader              io.Reader
+		expectedContainerID string
+	}{
+		{
+			name: "docker",
+			reader: strings.NewReader(`1143 1124 0:65 / / rw,relatime master:311 - overlay overlay rw
+1177 1143 259:1 /var/lib/docker/containers/1a2b3c4d5e6f1a2b3c4d5e6f1a2b3c4d5e6f1a2b3c4d5e6f1a2b3c4d5e6f1a2b/hostname /etc/hostname rw,relatime - ext4 /dev/nvme0n1p1 rw
+`),
+			expectedContainerID: "1a2b3c4d5e6f1a2b3c4d5e6f1a2b3c4d5e6f1a2b3c4d5e6f1a2b3c4d5e6f1a2b",
+		},
+		{
+			name:                "podman",
+			reader:              strings.NewReader(`1000 900 0:50 /containers/storage/overlay-containers/2b3c4d5e6f1a2b3c4d5e6f1a2b3c4d5e6f1a2b3c4d5e6f1a2b3c4d5e6f1a2b3c/userdata/hostname /etc/hostname rw - tmpfs tmpfs rw`),
+			expectedContainerID: "2b3c4d5e6f1a2b3c4d5e6f1a2b3c4d5e6f1a2b3c4d5e6f1a2b3c4d5e6f1a2b3c",
+		},
+		{
+			name:   "no container id",
+			reader: strings.NewReader(`25 1 259:1 / / rw,relatime shared:1 - ext4 /dev/nvme0n1p1 rw`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedContainerID, getContainerIDFromMountInfoReader(tc.reader))
+		})
+	}
+}
+
+func TestGetContainerRuntimeFromReader(t *testing.T) {
+	testCases := []struct {
+		name            string
+		content         string
+		expectedRuntime string
+	}{
+		{
+			name:            "docker",
+			content:         "0::/system.slice/docker-dc579f8a8319c8cf7d38e1adf263bc08d23.scope",
+			expectedRuntime: "docker",
+		},
+		{
+			name:            "containerd",
+			content:         "13:name=systemd:/kuberuntime/containerd/kubepods-pod872d2066_00ef_48ea_a7d8_51b18b72d739:cri-containerd:e857a4bf05a69080a759574949d7a0e69572e27647800fa7faff6a05a8332aa1",
+			expectedRuntime: "containerd",
+		},
+		{
+			name:            "cri-o",
+			content:         "0::/kubepods.slice/kubepods-burstable.slice/crio-dc679f8a8319c8cf7d38e1adf263bc08d23.scope",
+			expectedRuntime: "cri-o",
+		},
+		{
+			name:            "podman",
+			content:         "0::/machine.slice/libpod-dc679f8a8319c8cf7d38e1adf263bc08d23.scope",
+			expectedRuntime: "podman",
+		},
+		{
+			name:    "not in a container",
+			content: "0::/user.slice/user-1000.slice/session-2.scope",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedRuntime, getContainerRuntimeFromReader(strings.NewReader(tc.content)))
+		})
+	}
+}
+
+func TestGetCgroupLimits(t *testing.T) {
+	t.Cleanup(func() {
+		osStat = defaultOSStat
+		osOpen = defaultOSOpen
+	})
+
+	testCases := []struct {
+		name     string
+		files    map[string]string
+		expected cgroupLimits
+		wantErr  bool
+	}{
+		{
+			name: "no cgroupfs",
+		},
+		{
+			name: "v2 limited",
+			files: map[string]string{
+				cgroupV2ControllersPath: "cpu memory",
+				cgroupV2MemoryMaxPath:   "536870912\n",
+				cgroupV2CPUMaxPath:      "150000 100000\n",
+			},
+			expected: cgroupLimits{version: 2, memory: 536870912, cpu: 1.5},
+		},
+		{
+			name: "v2 unlimited",
+			files: map[string]string{
+				cgroupV2ControllersPath: "cpu memory",
+				cgroupV2MemoryMaxPath:   "max\n",
+				cgroupV2CPUMaxPath:      "max 100000\n",
+			},
+			expected: cgroupLimits{version: 2},
+		},
+		{
+			name: "v2 invalid memory",
+			files: map[string]string{
+				cgroupV2ControllersPath: "cpu memory",
+				cgroupV2MemoryMaxPath:   "invalid",
+			},
+			expected: cgroupLimits{version: 2},
+			wantErr:  true,
+		},
+		{
+			name: "v1 limited",
+			files: map[string]string{
+				cgroupV1MemoryLimitPath: "268435456\n",
+				cgroupV1CPUQuotaPath:    "50000\n",
+				cgroupV1CPUPeriodPath:   "100000\n",
+			},
+			expected: cgroupLimits{version: 1, memory: 268435456, cpu: 0.5},
+		},
+		{
+			name: "v1 unlimited",
+			files: map[string]string{
+				cgroupV1MemoryLimitPath: "9223372036854771712\n",
+				cgroupV1CPUQuotaPath:    "-1\n",
+				cgroupV1CPUPeriodPath:   "100000\n",
+			},
+			expected: cgroupLimits{version: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			osStat = func(name string) (os.FileInfo, error) {
+				if _, ok := tc.files[name]; !ok {
+					return nil, os.ErrNotExist
+				}
+				return nil, nil
+			}
+			osOpen = func(name string) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader(tc.files[name])), nil
+			}
+
+			l, err := getCgroupLimits()
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.expected, l)
+		})
+	}
+}
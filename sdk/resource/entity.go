@@ -0,0 +1,243 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Entity is an entity that produces telemetry (e.g. a service, a host, or a
+// Kubernetes pod) and is described by a Resource.
+//
+// The attributes of an Entity are split into identifying attributes, which
+// together uniquely identify the entity and must not change during its
+// lifetime, and descriptive attributes, which provide additional non-identifying
+// information about the entity.
+//
+// This is an experimental part of the OpenTelemetry specification and may
+// change in future releases.
+type Entity struct {
+	// Type is the type of the entity (e.g. "service" or "host"). It is
+	// required.
+	Type string
+	// SchemaURL is the schema URL the entity attributes are recorded in.
+	SchemaURL string
+	// ID holds the identifying attributes of the entity. At least one is
+	// required.
+	ID []attribute.KeyValue
+	// Description holds the descriptive attributes of the entity.
+	Description []attribute.KeyValue
+}
+
+// valid returns if e has a type and at least one valid identifying attribute.
+func (e Entity) valid() bool {
+	return e.Type != "" && slices.ContainsFunc(e.ID, attribute.KeyValue.Valid)
+}
+
+// entityRef is an Entity as stored in a Resource. Only the attribute keys are
+// stored, the values are the values of the Resource attributes.
+type entityRef struct {
+	typ       string
+	schemaURL string
+	idKeys    []attribute.Key
+	descKeys  []attribute.Key
+}
+
+// entityRefs are the entities of a Resource. They are referenced with a
+// pointer so Resource remains comparable.
+type entityRefs []entityRef
+
+func newEntityRef(e Entity) entityRef {
+	ref := entityRef{typ: e.Type, schemaURL: e.SchemaURL}
+	for _, kv := range e.ID {
+		if kv.Valid() {
+			ref.idKeys = appendKey(ref.idKeys, kv.Key)
+		}
+	}
+	for _, kv := range e.Description {
+		if kv.Valid() && !slices.Contains(ref.idKeys, kv.Key) {
+			ref.descKeys = appendKey(ref.descKeys, kv.Key)
+		}
+	}
+	return ref
+}
+
+func appendKey(keys []attribute.Key, k attribute.Key) []attribute.Key {
+	if slices.Contains(keys, k) {
+		return keys
+	}
+	return append(keys, k)
+}
+
+// NewWithEntities creates a resource from entities and associates the
+// resource with a schema URL. The resource attributes are the identifying and
+// descriptive attributes of all entities. If multiple entities have the same
+// type, the last one will be used. Entities without a type or a valid
+// identifying attribute are dropped.
+//
+// This is an experimental part of the OpenTelemetry specification and may
+// change in future releases.
+func NewWithEntities(schemaURL string, entities ...Entity) *Resource {
+	var (
+		attrs []attribute.KeyValue
+		refs  entityRefs
+	)
+	for _, e := range entities {
+		if !e.valid() {
+			continue
+		}
+		attrs = append(attrs, e.ID...)
+		attrs = append(attrs, e.Description...)
+
+		ref := newEntityRef(e)
+		if i := refs.index(ref.typ); i >= 0 {
+			refs[i] = ref
+		} else {
+			refs = append(refs, ref)
+		}
+	}
+
+	r := NewWithAttributes(schemaURL, attrs...)
+	if len(refs) > 0 {
+		r.entities = &refs
+	}
+	return r
+}
+
+func (refs entityRefs) index(typ string) int {
+	return slices.IndexFunc(refs, func(ref entityRef) bool { return ref.typ == typ })
+}
+
+// Entities returns the entities associated with the resource. The attribute
+// values of each Entity are the values of the resource attributes.
+//
+// This is an experimental part of the OpenTelemetry specification and may
+// change in future releases.
+func (r *Resource) Entities() []Entity {
+	if r == nil || r.entities == nil {
+		return nil
+	}
+
+	out := make([]Entity, 0, len(*r.entities))
+	for _, ref := range *r.entities {
+		out = append(out, Entity{
+			Type:        ref.typ,
+			SchemaURL:   ref.schemaURL,
+			ID:          r.values(ref.idKeys),
+			Description: r.values(ref.descKeys),
+		})
+	}
+	return out
+}
+
+func (r *Resource) values(keys []attribute.Key) []attribute.KeyValue {
+	var out []attribute.KeyValue
+	for _, k := range keys {
+		if v, ok := r.attrs.Value(k); ok {
+			out = append(out, attribute.KeyValue{Key: k, Value: v})
+		}
+	}
+	return out
+}
+
+// IdentifyingAttributes returns the resource attributes that identify one of
+// the resource entities, in sorted order.
+//
+// This is an experimental part of the OpenTelemetry specification and may
+// change in future releases.
+func (r *Resource) IdentifyingAttributes() []attribute.KeyValue {
+	return r.filter(true)
+}
+
+// DescriptiveAttributes returns the resource attributes that do not identify
+// any of the resource entities, in sorted order. This includes attributes
+// that are not associated with any entity.
+//
+// This is an experimental part of the OpenTelemetry specification and may
+// change in future releases.
+func (r *Resource) DescriptiveAttributes() []attribute.KeyValue {
+	return r.filter(false)
+}
+
+func (r *Resource) filter(identifying bool) []attribute.KeyValue {
+	if r == nil {
+		return nil
+	}
+
+	var out []attribute.KeyValue
+	for iter := r.attrs.Iter(); iter.Next(); {
+		kv := iter.Attribute()
+		if r.isIdentifying(kv.Key) == identifying {
+			out = append(out, kv)
+		}
+	}
+	return out
+}
+
+func (r *Resource) isIdentifying(k attribute.Key) bool {
+	if r.entities == nil {
+		return false
+	}
+	for _, ref := range *r.entities {
+		if slices.Contains(ref.idKeys, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeEntities returns the entities of a merged with those of b. An entity
+// of b replaces the entity of a with the same type unless both identify the
+// same entity, in which case their descriptive attributes are combined.
+func mergeEntities(a, b *Resource) *entityRefs {
+	switch {
+	case a.entities == nil:
+		return b.entities
+	case b.entities == nil:
+		return a.entities
+	}
+
+	merged := slices.Clone(*a.entities)
+	for _, ref := range *b.entities {
+		i := merged.index(ref.typ)
+		if i < 0 {
+			merged = append(merged, ref)
+			continue
+		}
+
+		old := merged[i]
+		if slices.Equal(old.idKeys, ref.idKeys) &&
+			slices.Equal(a.values(old.idKeys), b.values(ref.idKeys)) {
+			desc := slices.Clone(old.descKeys)
+			for _, k := range ref.descKeys {
+				desc = appendKey(desc, k)
+			}
+			ref.descKeys = desc
+			if ref.schemaURL == "" {
+				ref.schemaURL = old.schemaURL
+			}
+		}
+		merged[i] = ref
+	}
+	return &merged
+}
+
+// WithEntity adds the entity e to the configured Resource.
+//
+// This is an experimental part of the OpenTelemetry specification and may
+// change in future releases.
+func WithEntity(e Entity) Option {
+	return WithDetectors(entityDetector{e})
+}
+
+type entityDetector struct {
+	entity Entity
+}
+
+func (d entityDetector) Detect(context.Context) (*Resource, error) {
+	return NewWithEntities(d.entity.SchemaURL, d.entity), nil
+}
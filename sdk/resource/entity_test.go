@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+var (
+	serviceEntity = resource.Entity{
+		Type:        "service",
+		SchemaURL:   "https://opentelemetry.io/schemas/1.26.0",
+		ID:          []attribute.KeyValue{attribute.String("service.name", "checkout"), attribute.String("service.instance.id", "1")},
+		Description: []attribute.KeyValue{attribute.String("service.version", "1.0.0")},
+	}
+	hostEntity = resource.Entity{
+		Type: "host",
+		ID:   []attribute.KeyValue{attribute.String("host.id", "h1")},
+	}
+)
+
+func TestNewWithEntities(t *testing.T) {
+	res := resource.NewWithEntities("https://opentelemetry.io/schemas/1.26.0",
+		serviceEntity,
+		hostEntity,
+		resource.Entity{Type: "invalid"},
+		resource.Entity{ID: []attribute.KeyValue{attribute.String("no.type", "1")}},
+	)
+
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("host.id", "h1"),
+		attribute.String("service.instance.id", "1"),
+		attribute.String("service.name", "checkout"),
+		attribute.String("service.version", "1.0.0"),
+	}, res.Attributes())
+	assert.Equal(t, []resource.Entity{serviceEntity, hostEntity}, res.Entities())
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("host.id", "h1"),
+		attribute.String("service.instance.id", "1"),
+		attribute.String("service.name", "checkout"),
+	}, res.IdentifyingAttributes())
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("service.version", "1.0.0"),
+	}, res.DescriptiveAttributes())
+}
+
+func TestResourceWithoutEntities(t *testing.T) {
+	res := resource.NewSchemaless(attribute.String("a", "1"))
+	assert.Nil(t, res.Entities())
+	assert.Nil(t, res.IdentifyingAttributes())
+	assert.Equal(t, res.Attributes(), res.DescriptiveAttributes())
+
+	var nilRes *resource.Resource
+	assert.Nil(t, nilRes.Entities())
+	assert.Nil(t, nilRes.IdentifyingAttributes())
+	assert.Nil(t, nilRes.DescriptiveAttributes())
+}
+
+func TestMergeEntities(t *testing.T) {
+	a := resource.NewWithEntities("", serviceEntity)
+
+	t.Run("SameEntity", func(t *testing.T) {
+		b := resource.NewWithEntities("", resource.Entity{
+			Type:        "service",
+			ID:          serviceEntity.ID,
+			Description: []attribute.KeyValue{attribute.String("service.namespace", "shop")},
+		})
+		res, err := resource.Merge(a, b)
+		require.NoError(t, err)
+
+		want := serviceEntity
+		want.Description = []attribute.KeyValue{
+			attribute.String("service.version", "1.0.0"),
+			attribute.String("service.namespace", "shop"),
+		}
+		assert.Equal(t, []resource.Entity{want}, res.Entities())
+	})
+
+	t.Run("DifferentEntity", func(t *testing.T) {
+		other := resource.Entity{
+			Type: "service",
+			ID:   []attribute.KeyValue{attribute.String("service.name", "cart"), attribute.String("service.instance.id", "2")},
+		}
+		res, err := resource.Merge(a, resource.NewWithEntities("", other))
+		require.NoError(t, err)
+		assert.Equal(t, []resource.Entity{other}, res.Entities())
+		// Attributes of the replaced entity are still merged.
+		assert.Contains(t, res.DescriptiveAttributes(), attribute.String("service.version", "1.0.0"))
+	})
+
+	t.Run("NewEntity", func(t *testing.T) {
+		res, err := resource.Merge(a, resource.NewWithEntities("", hostEntity))
+		require.NoError(t, err)
+		assert.Equal(t, []resource.Entity{serviceEntity, hostEntity}, res.Entities())
+	})
+
+	t.Run("AttributesOnly", func(t *testing.T) {
+		res, err := resource.Merge(a, resource.NewSchemaless(attribute.String("service.version", "2.0.0")))
+		require.NoError(t, err)
+
+		want := serviceEntity
+		want.Description = []attribute.KeyValue{attribute.String("service.version", "2.0.0")}
+		assert.Equal(t, []resource.Entity{want}, res.Entities())
+	})
+}
+
+func TestWithEntity(t *testing.T) {
+	res, err := resource.New(context.Background(),
+		resource.WithEntity(hostEntity),
+		resource.WithEntity(serviceEntity),
+		resource.WithAttributes(attribute.String("extra", "1")),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []resource.Entity{hostEntity, serviceEntity}, res.Entities())
+	assert.Equal(t, serviceEntity.SchemaURL, res.SchemaURL())
+}
@@ -34,3 +34,22 @@ func ExampleNew() {
 	// Now, you can use the resource (e.g. pass it to a tracer or meter provider).
 	fmt.Println(res.SchemaURL())
 }
+
+func ExampleBuilder() {
+	res := resource.NewBuilder().
+		SetServiceName("checkout").
+		SetServiceVersion("1.2.3").
+		SetDeploymentEnvironment("production").
+		SetK8sPodName("checkout-7d4b9c8f6-x2x7z").
+		Build()
+
+	// Merge with the default resource to include the SDK attributes.
+	res, err := resource.Merge(resource.Default(), res)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	v, _ := res.Set().Value("service.name")
+	fmt.Println(v.AsString())
+	// Output: checkout
+}
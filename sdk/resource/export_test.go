@@ -12,8 +12,14 @@ var (
 	SetUserProviders                = setUserProviders
 	SetDefaultOSDescriptionProvider = setDefaultOSDescriptionProvider
 	SetOSDescriptionProvider        = setOSDescriptionProvider
+	SetDefaultOSVersionProvider     = setDefaultOSVersionProvider
+	SetOSVersionProvider            = setOSVersionProvider
+	SetDefaultBuildInfoProvider     = setDefaultBuildInfoProvider
+	SetBuildInfoProvider            = setBuildInfoProvider
 	SetDefaultContainerProviders    = setDefaultContainerProviders
 	SetContainerProviders           = setContainerProviders
+	SetContainerRuntimeProvider     = setContainerRuntimeProvider
+	SetCgroupLimits                 = setCgroupLimits
 )
 
 var (
@@ -23,4 +29,7 @@ var (
 	RuntimeArch = runtimeArch
 )
 
-var MapRuntimeOSToSemconvOSType = mapRuntimeOSToSemconvOSType
+var (
+	MapRuntimeOSToSemconvOSType     = mapRuntimeOSToSemconvOSType
+	MapRuntimeArchToSemconvHostArch = mapRuntimeArchToSemconvHostArch
+)
@@ -0,0 +1,278 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// resourceAttrFileKey is the environment variable name the path of a file
+// containing OpenTelemetry Resource information will be read from.
+const resourceAttrFileKey = "OTEL_RESOURCE_ATTRIBUTES_FILE"
+
+// fromFile is a Detector that collects resources from a file.
+type fromFile struct {
+	// path of the file. If empty, it is read from the
+	// OTEL_RESOURCE_ATTRIBUTES_FILE environment variable.
+	path string
+}
+
+// compile time assertion that fromFile implements Detector interface.
+var _ Detector = fromFile{}
+
+// Detect collects resources from the file. If no path is configured and the
+// OTEL_RESOURCE_ATTRIBUTES_FILE environment variable is not set, an empty
+// resource is returned.
+func (d fromFile) Detect(context.Context) (*Resource, error) {
+	path := d.path
+	if path == "" {
+		path = strings.TrimSpace(os.Getenv(resourceAttrFileKey))
+	}
+	if path == "" {
+		return Empty(), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading resource attributes file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return parseYAMLResource(data)
+	default:
+		return parseKeyValueResource(data)
+	}
+}
+
+// parseKeyValueResource parses data containing one key=value pair per line.
+// Empty lines and lines starting with '#' are ignored.
+func parseKeyValueResource(data []byte) (*Resource, error) {
+	var attrs []attribute.KeyValue
+	var invalid []string
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		k, v, found := strings.Cut(line, "=")
+		if !found {
+			invalid = append(invalid, line)
+			continue
+		}
+		attrs = append(attrs, attribute.String(strings.TrimSpace(k), strings.TrimSpace(v)))
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("reading resource attributes file: %w", err)
+	}
+
+	var err error
+	if len(invalid) > 0 {
+		err = fmt.Errorf("%w: %v", errMissingValue, invalid)
+	}
+	return NewSchemaless(attrs...), err
+}
+
+// parseYAMLResource parses data containing a YAML mapping of attribute keys
+// to values. Nested mappings are flattened by joining the keys with '.', so
+// both "service.name: foo" and a "name: foo" entry nested in a "service"
+// mapping define the "service.name" attribute. Values can be strings, booleans, integers,
+// floating point numbers, or flow sequences of one of these types.
+//
+// Only the subset of YAML needed to describe such a mapping is supported:
+// block mappings, plain and quoted scalars, flow sequences, and comments.
+func parseYAMLResource(data []byte) (*Resource, error) {
+	var attrs []attribute.KeyValue
+	var invalid []string
+
+	// parents holds the indentation and key of the mappings the current line
+	// is nested in.
+	type parent struct {
+		indent int
+		key    string
+	}
+	var parents []parent
+
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimRight(s.Text(), " \t")
+		content := strings.TrimLeft(line, " ")
+		if content == "" || strings.HasPrefix(content, "#") || content == "---" {
+			continue
+		}
+		indent := len(line) - len(content)
+
+		k, v, ok := strings.Cut(content, ":")
+		if !ok || (v != "" && v[0] != ' ') || strings.HasPrefix(content, "- ") {
+			return nil, fmt.Errorf("parsing resource attributes file: line %d: expected a mapping entry: %q", n, content)
+		}
+		for len(parents) > 0 && parents[len(parents)-1].indent >= indent {
+			parents = parents[:len(parents)-1]
+		}
+		key := strings.TrimSpace(k)
+		if len(parents) > 0 {
+			key = parents[len(parents)-1].key + "." + key
+		}
+
+		v = stripYAMLComment(strings.TrimSpace(v))
+		if v == "" {
+			// The value is a nested mapping.
+			parents = append(parents, parent{indent: indent, key: key})
+			continue
+		}
+		if kv, ok := yamlAttribute(key, parseYAMLValue(v)); ok {
+			attrs = append(attrs, kv)
+		} else {
+			invalid = append(invalid, key)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("reading resource attributes file: %w", err)
+	}
+
+	// Sort the keys so the invalid keys are reported deterministically.
+	sort.Strings(invalid)
+	var err error
+	if len(invalid) > 0 {
+		err = fmt.Errorf("%w: unsupported value types: %v", ErrPartialResource, invalid)
+	}
+	return NewSchemaless(attrs...), err
+}
+
+// stripYAMLComment returns v without a trailing comment. Comments are not
+// stripped from quoted values.
+func stripYAMLComment(v string) string {
+	if strings.HasPrefix(v, `"`) || strings.HasPrefix(v, "'") {
+		return v
+	}
+	if i := strings.Index(v, " #"); i >= 0 {
+		return strings.TrimSpace(v[:i])
+	}
+	return v
+}
+
+// parseYAMLValue returns the value of the YAML scalar or flow sequence v: a
+// string, bool, int, float64, []any, or nil if v is null or cannot be
+// parsed.
+func parseYAMLValue(v string) any {
+	if strings.HasPrefix(v, "[") {
+		if !strings.HasSuffix(v, "]") {
+			return nil
+		}
+		inner := strings.TrimSpace(v[1 : len(v)-1])
+		if inner == "" {
+			return []any{}
+		}
+		elems := strings.Split(inner, ",")
+		out := make([]any, len(elems))
+		for i, e := range elems {
+			out[i] = parseYAMLScalar(strings.TrimSpace(e))
+		}
+		return out
+	}
+	return parseYAMLScalar(v)
+}
+
+// parseYAMLScalar returns the value of the YAML scalar v: a string, bool,
+// int, float64, or nil if v is null or an invalid quoted string.
+func parseYAMLScalar(v string) any {
+	switch {
+	case strings.HasPrefix(v, `"`):
+		if s, err := strconv.Unquote(v); err == nil {
+			return s
+		}
+		return nil
+	case strings.HasPrefix(v, "'"):
+		if len(v) < 2 || !strings.HasSuffix(v, "'") {
+			return nil
+		}
+		return strings.ReplaceAll(v[1:len(v)-1], "''", "'")
+	}
+
+	switch v {
+	case "", "~", "null", "Null", "NULL":
+		return nil
+	case "true", "True", "TRUE":
+		return true
+	case "false", "False", "FALSE":
+		return false
+	}
+	if i, err := strconv.Atoi(v); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(v, 64); err == nil {
+		return f
+	}
+	return v
+}
+
+// yamlAttribute returns an attribute for the YAML value v decoded into an
+// any. It returns false if v has an unsupported type.
+func yamlAttribute(key string, v any) (attribute.KeyValue, bool) {
+	k := attribute.Key(key)
+	switch v := v.(type) {
+	case string:
+		return k.String(v), true
+	case bool:
+		return k.Bool(v), true
+	case int:
+		return k.Int(v), true
+	case float64:
+		return k.Float64(v), true
+	case []any:
+		return yamlSliceAttribute(k, v)
+	}
+	return attribute.KeyValue{}, false
+}
+
+// yamlSliceAttribute returns a slice attribute for the YAML sequence s. It
+// returns false if s is empty or does not contain elements of a single
+// supported type.
+func yamlSliceAttribute(k attribute.Key, s []any) (attribute.KeyValue, bool) {
+	if len(s) == 0 {
+		return attribute.KeyValue{}, false
+	}
+	switch s[0].(type) {
+	case string:
+		if v, ok := yamlSlice[string](s); ok {
+			return k.StringSlice(v), true
+		}
+	case bool:
+		if v, ok := yamlSlice[bool](s); ok {
+			return k.BoolSlice(v), true
+		}
+	case int:
+		if v, ok := yamlSlice[int](s); ok {
+			return k.IntSlice(v), true
+		}
+	case float64:
+		if v, ok := yamlSlice[float64](s); ok {
+			return k.Float64Slice(v), true
+		}
+	}
+	return attribute.KeyValue{}, false
+}
+
+func yamlSlice[T any](s []any) ([]T, bool) {
+	out := make([]T, len(s))
+	for i, e := range s {
+		v, ok := e.(T)
+		if !ok {
+			return nil, false
+		}
+		out[i] = v
+	}
+	return out, true
+}
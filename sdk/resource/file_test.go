@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestFromFileKeyValue(t *testing.T) {
+	path := writeFile(t, "resource.properties", `
+# Injected by the platform.
+service.name = checkout
+k8s.cluster.name=prod=eu
+
+invalid
+`)
+
+	res, err := fromFile{path: path}.Detect(context.Background())
+	assert.ErrorIs(t, err, ErrPartialResource)
+	assert.Equal(t, NewSchemaless(
+		attribute.String("service.name", "checkout"),
+		attribute.String("k8s.cluster.name", "prod=eu"),
+	), res)
+}
+
+func TestFromFileYAML(t *testing.T) {
+	path := writeFile(t, "resource.yaml", `
+# Injected by the platform.
+service:
+  name: checkout # The service name.
+  version: "1.2"
+  namespace:
+    name: 'shop''s'
+deployment.environment: prod
+host.cpu.count: 4
+sampled: true
+ratio: 0.5
+note: "# not a comment"
+url: http://example.com:8080
+tags: [a, b]
+ports: [80, 443]
+mixed: [1, a]
+empty: ~
+`)
+
+	res, err := fromFile{path: path}.Detect(context.Background())
+	assert.ErrorIs(t, err, ErrPartialResource)
+	assert.ErrorContains(t, err, "[empty mixed]")
+	assert.Equal(t, NewSchemaless(
+		attribute.String("service.name", "checkout"),
+		attribute.String("service.version", "1.2"),
+		attribute.String("service.namespace.name", "shop's"),
+		attribute.String("deployment.environment", "prod"),
+		attribute.Int("host.cpu.count", 4),
+		attribute.Bool("sampled", true),
+		attribute.Float64("ratio", 0.5),
+		attribute.String("note", "# not a comment"),
+		attribute.String("url", "http://example.com:8080"),
+		attribute.StringSlice("tags", []string{"a", "b"}),
+		attribute.IntSlice("ports", []int{80, 443}),
+	), res)
+}
+
+func TestFromFileInvalidYAML(t *testing.T) {
+	path := writeFile(t, "resource.yml", "- not a mapping")
+
+	_, err := fromFile{path: path}.Detect(context.Background())
+	assert.ErrorContains(t, err, "parsing resource attributes file")
+}
+
+func TestFromFileEnv(t *testing.T) {
+	path := writeFile(t, "resource", "key=value")
+	t.Setenv(resourceAttrFileKey, path)
+
+	res, err := fromFile{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewSchemaless(attribute.String("key", "value")), res)
+}
+
+func TestFromFileUnset(t *testing.T) {
+	t.Setenv(resourceAttrFileKey, "")
+
+	res, err := fromFile{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Empty(), res)
+}
+
+func TestFromFileMissing(t *testing.T) {
+	_, err := fromFile{path: filepath.Join(t.TempDir(), "missing")}.Detect(context.Background())
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestWithFromFile(t *testing.T) {
+	path := writeFile(t, "resource", "team=platform")
+
+	res, err := New(context.Background(),
+		WithFromFile(path),
+		WithAttributes(attribute.String("team", "app")),
+		WithPrecedence(LayerFile),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, NewSchemaless(attribute.String("team", "platform")), res)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"sync"
 
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
@@ -93,10 +94,30 @@ func (r *hostIDReaderLinux) read() (string, error) {
 	return "", errors.New("host id not found in: /etc/machine-id or /var/lib/dbus/machine-id")
 }
 
-type hostIDDetector struct{}
+// cloudHostIDDetectors are the detectors used to look up the instance ID of
+// the cloud virtual machine being run on, in order of precedence.
+var cloudHostIDDetectors = []Detector{ec2Detector{}, gceDetector{}, azureVMDetector{}}
+
+// hostIDDetector is a Detector that provides the host.id attribute.
+//
+// If cloud is true, the instance ID of the cloud virtual machine being run
+// on takes precedence over the platform specific host id.
+type hostIDDetector struct {
+	cloud bool
+}
+
+// Detect returns a *Resource containing the host id. If the detector is
+// configured to use the cloud instance ID, the first of the AWS EC2 instance
+// ID, the Google Compute Engine instance ID, or the Azure VM ID that is found
+// is used. Otherwise, or if not running on one of these clouds, the platform
+// specific host id is used.
+func (d hostIDDetector) Detect(ctx context.Context) (*Resource, error) {
+	if d.cloud {
+		if id := cloudHostID(ctx); id != "" {
+			return NewWithAttributes(semconv.SchemaURL, semconv.HostID(id)), nil
+		}
+	}
 
-// Detect returns a *Resource containing the platform specific host id.
-func (hostIDDetector) Detect(ctx context.Context) (*Resource, error) {
 	hostID, err := hostID()
 	if err != nil {
 		return nil, err
@@ -107,3 +128,33 @@ func (hostIDDetector) Detect(ctx context.Context) (*Resource, error) {
 		semconv.HostID(hostID),
 	), nil
 }
+
+// cloudHostID queries all cloudHostIDDetectors concurrently and returns the
+// host.id of the one with the highest precedence that found one. An empty
+// string is returned if none did. Errors from the detectors are ignored.
+func cloudHostID(ctx context.Context) string {
+	ids := make([]string, len(cloudHostIDDetectors))
+
+	var wg sync.WaitGroup
+	for i, d := range cloudHostIDDetectors {
+		wg.Add(1)
+		go func(i int, d Detector) {
+			defer wg.Done()
+			res, err := d.Detect(ctx)
+			if err != nil || res == nil {
+				return
+			}
+			if v, ok := res.Set().Value(semconv.HostIDKey); ok {
+				ids[i] = v.AsString()
+			}
+		}(i, d)
+	}
+	wg.Wait()
+
+	for _, id := range ids {
+		if id != "" {
+			return id
+		}
+	}
+	return ""
+}
@@ -4,10 +4,15 @@
 package resource
 
 import (
+	"context"
 	"errors"
+	"net/http"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
 var (
@@ -209,3 +214,46 @@ func TestHostIDReaderDarwin(t *testing.T) {
 		})
 	}
 }
+
+func TestHostIDDetectorCloud(t *testing.T) {
+	SetHostIDProvider(func() (string, error) { return expectedHostID, nil })
+	t.Cleanup(SetDefaultHostIDProvider)
+
+	notFound := http.NotFoundHandler()
+	ec2 := http.NewServeMux()
+	ec2.HandleFunc("PUT /latest/api/token", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("token"))
+	})
+	ec2.HandleFunc("GET /latest/dynamic/instance-identity/document", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"instanceId": "i-1234567890abcdef0"}`))
+	})
+	azure := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"vmId": "02aab8a4-74ef-476e-8182-f6d2ba4166a6"}`))
+	})
+
+	tests := []struct {
+		name  string
+		cloud bool
+		ec2   http.Handler
+		azure http.Handler
+		want  string
+	}{
+		{"Platform", false, ec2, azure, expectedHostID},
+		{"EC2", true, ec2, notFound, "i-1234567890abcdef0"},
+		{"Azure", true, notFound, azure, "02aab8a4-74ef-476e-8182-f6d2ba4166a6"},
+		{"Precedence", true, ec2, azure, "i-1234567890abcdef0"},
+		{"Fallback", true, notFound, notFound, expectedHostID},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			setEndpoint(t, &ec2Endpoint, tc.ec2)
+			setEndpoint(t, &gceEndpoint, notFound)
+			setEndpoint(t, &azureEndpoint, tc.azure)
+
+			res, err := hostIDDetector{cloud: tc.cloud}.Detect(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, NewWithAttributes(semconv.SchemaURL, semconv.HostID(tc.want)), res)
+		})
+	}
+}
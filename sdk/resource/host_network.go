@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// HostIPFilter reports whether ip is included in the host.ip attribute.
+type HostIPFilter func(ip netip.Addr) bool
+
+// ExcludePrivateIPs returns a HostIPFilter that excludes private addresses,
+// as defined by RFC 1918 for IPv4 and RFC 4193 for IPv6, from the host.ip
+// attribute.
+func ExcludePrivateIPs() HostIPFilter {
+	return func(ip netip.Addr) bool { return !ip.IsPrivate() }
+}
+
+// netInterface is a network interface of the host.
+type netInterface struct {
+	hardwareAddr net.HardwareAddr
+	addrs        []netip.Addr
+}
+
+type netInterfacesProvider func() ([]netInterface, error)
+
+var (
+	defaultNetInterfacesProvider netInterfacesProvider = getNetInterfaces
+
+	netInterfaces = defaultNetInterfacesProvider
+)
+
+func setDefaultNetInterfacesProvider() {
+	setNetInterfacesProvider(defaultNetInterfacesProvider)
+}
+
+func setNetInterfacesProvider(p netInterfacesProvider) {
+	netInterfaces = p
+}
+
+// getNetInterfaces returns the network interfaces of the host that are up
+// and are not loopback interfaces.
+func getNetInterfaces() ([]netInterface, error) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	var out []netInterface
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagUp == 0 || iface.Flags&net.FlagLoopback != 0 {
+			continue
+		}
+		addrs, err := iface.Addrs()
+		if err != nil {
+			return nil, err
+		}
+
+		ni := netInterface{hardwareAddr: iface.HardwareAddr}
+		for _, a := range addrs {
+			if prefix, err := netip.ParsePrefix(a.String()); err == nil {
+				ni.addrs = append(ni.addrs, prefix.Addr())
+			}
+		}
+		out = append(out, ni)
+	}
+	return out, nil
+}
+
+// hostNetworkDetector is a Detector that provides the IP and MAC addresses
+// of the host.
+type hostNetworkDetector struct {
+	filters []HostIPFilter
+}
+
+var _ Detector = hostNetworkDetector{}
+
+// Detect returns a *Resource that describes the IP addresses of the host and
+// the MAC addresses of the interfaces they belong to. Loopback, link-local,
+// and unspecified addresses, as well as addresses excluded by any of the
+// filters, are not included.
+func (d hostNetworkDetector) Detect(context.Context) (*Resource, error) {
+	ifaces, err := netInterfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	var ips, macs []string
+	seenIP := make(map[netip.Addr]struct{})
+	seenMAC := make(map[string]struct{})
+	for _, iface := range ifaces {
+		var included bool
+		for _, ip := range iface.addrs {
+			ip = ip.Unmap()
+			if !d.include(ip) {
+				continue
+			}
+			included = true
+			if _, ok := seenIP[ip]; !ok {
+				seenIP[ip] = struct{}{}
+				ips = append(ips, ip.String())
+			}
+		}
+
+		if !included || len(iface.hardwareAddr) == 0 {
+			continue
+		}
+		mac := formatMAC(iface.hardwareAddr)
+		if _, ok := seenMAC[mac]; !ok {
+			seenMAC[mac] = struct{}{}
+			macs = append(macs, mac)
+		}
+	}
+
+	if len(ips) == 0 {
+		return Empty(), nil
+	}
+	attrs := []attribute.KeyValue{semconv.HostIP(ips...)}
+	if len(macs) > 0 {
+		attrs = append(attrs, semconv.HostMac(macs...))
+	}
+	return NewWithAttributes(semconv.SchemaURL, attrs...), nil
+}
+
+func (d hostNetworkDetector) include(ip netip.Addr) bool {
+	if !ip.IsValid() || ip.IsLoopback() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsMulticast() {
+		return false
+	}
+	for _, f := range d.filters {
+		if f != nil && !f(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// formatMAC returns addr in the format required by the semantic conventions:
+// uppercase hexadecimal bytes separated by hyphens (e.g. AC-DE-48-23-45-67).
+func formatMAC(addr net.HardwareAddr) string {
+	return strings.ToUpper(strings.ReplaceAll(addr.String(), ":", "-"))
+}
@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+func mustMAC(t *testing.T, s string) net.HardwareAddr {
+	t.Helper()
+	mac, err := net.ParseMAC(s)
+	require.NoError(t, err)
+	return mac
+}
+
+func TestHostNetworkDetector(t *testing.T) {
+	ifaces := []netInterface{
+		{
+			hardwareAddr: mustMAC(t, "ac:de:48:23:45:67"),
+			addrs: []netip.Addr{
+				netip.MustParseAddr("192.168.1.10"),
+				netip.MustParseAddr("fe80::1"),
+				netip.MustParseAddr("fd00::10"),
+			},
+		},
+		{
+			hardwareAddr: mustMAC(t, "00:11:22:33:44:55"),
+			addrs: []netip.Addr{
+				netip.MustParseAddr("::ffff:203.0.113.5"),
+				netip.MustParseAddr("2001:db8::5"),
+			},
+		},
+		{
+			// Interface with only excluded addresses.
+			hardwareAddr: mustMAC(t, "66:77:88:99:aa:bb"),
+			addrs:        []netip.Addr{netip.MustParseAddr("169.254.0.1")},
+		},
+		{
+			// Interface without a hardware address and a duplicate IP.
+			addrs: []netip.Addr{netip.MustParseAddr("203.0.113.5"), netip.MustParseAddr("127.0.0.1")},
+		},
+	}
+	setNetInterfacesProvider(func() ([]netInterface, error) { return ifaces, nil })
+	t.Cleanup(setDefaultNetInterfacesProvider)
+
+	t.Run("All", func(t *testing.T) {
+		res, err := hostNetworkDetector{}.Detect(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.HostIP("192.168.1.10", "fd00::10", "203.0.113.5", "2001:db8::5"),
+			semconv.HostMac("AC-DE-48-23-45-67", "00-11-22-33-44-55"),
+		), res)
+	})
+
+	t.Run("ExcludePrivateIPs", func(t *testing.T) {
+		res, err := hostNetworkDetector{filters: []HostIPFilter{ExcludePrivateIPs()}}.Detect(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.HostIP("203.0.113.5", "2001:db8::5"),
+			semconv.HostMac("00-11-22-33-44-55"),
+		), res)
+	})
+
+	t.Run("ExcludeAll", func(t *testing.T) {
+		none := func(netip.Addr) bool { return false }
+		res, err := hostNetworkDetector{filters: []HostIPFilter{none}}.Detect(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, Empty(), res)
+	})
+}
+
+func TestHostNetworkDetectorError(t *testing.T) {
+	setNetInterfacesProvider(func() ([]netInterface, error) { return nil, assert.AnError })
+	t.Cleanup(setDefaultNetInterfacesProvider)
+
+	_, err := hostNetworkDetector{}.Detect(context.Background())
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestGetNetInterfaces(t *testing.T) {
+	ifaces, err := getNetInterfaces()
+	require.NoError(t, err)
+	for _, iface := range ifaces {
+		for _, ip := range iface.addrs {
+			assert.True(t, ip.IsValid())
+		}
+	}
+}
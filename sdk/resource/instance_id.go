@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// serviceInstanceIDStrategyKey is the attribute key used to expose the
+// ServiceInstanceIDStrategy used to generate the service.instance.id.
+const serviceInstanceIDStrategyKey = attribute.Key("io.opentelemetry.go.service.instance.id_strategy")
+
+// serviceInstanceIDNamespace is the UUID namespace recommended by the
+// semantic conventions to generate a version 5 UUID for service.instance.id.
+var serviceInstanceIDNamespace = uuid.MustParse("4d63009a-8d0f-11ee-aad7-4c796ed8e320")
+
+// processInstanceID is the random service.instance.id of the process.
+var processInstanceID = sync.OnceValues(func() (string, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+})
+
+var errEmptyServiceInstanceID = errors.New("empty service.instance.id")
+
+// ServiceInstanceIDStrategy determines how the service.instance.id resource
+// attribute is generated.
+//
+// The name of the strategy is added to the resource as the
+// "io.opentelemetry.go.service.instance.id_strategy" attribute. This allows
+// telemetry backends to distinguish a restart of a service instance, which
+// keeps its ID with a stable strategy, from a new instance.
+type ServiceInstanceIDStrategy struct {
+	name string
+	id   func() (string, error)
+}
+
+// Name returns the name of the strategy.
+func (s ServiceInstanceIDStrategy) Name() string {
+	return s.name
+}
+
+// RandomServiceInstanceID returns a ServiceInstanceIDStrategy that uses a
+// random version 4 UUID. The UUID is generated once per process. Its name is
+// "random".
+func RandomServiceInstanceID() ServiceInstanceIDStrategy {
+	return ServiceInstanceIDStrategy{name: "random", id: processInstanceID}
+}
+
+// HostPIDServiceInstanceID returns a ServiceInstanceIDStrategy that uses a
+// version 5 UUID derived from the host ID (see WithHostID) and the process
+// identifier (PID). The ID is stable for the same process on the same host.
+// If the host ID cannot be determined, the host name is used instead. Its
+// name is "host_pid".
+func HostPIDServiceInstanceID() ServiceInstanceIDStrategy {
+	return ServiceInstanceIDStrategy{name: "host_pid", id: hostPIDInstanceID}
+}
+
+// UserServiceInstanceID returns a ServiceInstanceIDStrategy that uses id.
+// Its name is "user".
+func UserServiceInstanceID(id string) ServiceInstanceIDStrategy {
+	return ServiceInstanceIDStrategy{
+		name: "user",
+		id: func() (string, error) {
+			if id == "" {
+				return "", errEmptyServiceInstanceID
+			}
+			return id, nil
+		},
+	}
+}
+
+func hostPIDInstanceID() (string, error) {
+	host, err := hostID()
+	if err != nil || host == "" {
+		if host, err = os.Hostname(); err != nil {
+			return "", err
+		}
+	}
+	name := host + "/" + strconv.Itoa(pid())
+	return uuid.NewSHA1(serviceInstanceIDNamespace, []byte(name)).String(), nil
+}
+
+// serviceInstanceIDDetector is a Detector that provides the
+// service.instance.id generated with strategy.
+type serviceInstanceIDDetector struct {
+	strategy ServiceInstanceIDStrategy
+}
+
+var _ Detector = serviceInstanceIDDetector{}
+
+// Detect returns a *Resource containing the service.instance.id and the name
+// of the strategy used to generate it.
+func (d serviceInstanceIDDetector) Detect(context.Context) (*Resource, error) {
+	if d.strategy.id == nil {
+		return Empty(), nil
+	}
+
+	id, err := d.strategy.id()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceInstanceID(id),
+		serviceInstanceIDStrategyKey.String(d.strategy.name),
+	), nil
+}
@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+func detectInstanceID(t *testing.T, s ServiceInstanceIDStrategy) (id, strategy string) {
+	t.Helper()
+
+	res, err := New(context.Background(), WithServiceInstanceID(s))
+	require.NoError(t, err)
+
+	v, ok := res.Set().Value(semconv.ServiceInstanceIDKey)
+	require.True(t, ok, "missing service.instance.id")
+	st, ok := res.Set().Value(serviceInstanceIDStrategyKey)
+	require.True(t, ok, "missing io.opentelemetry.go.service.instance.id_strategy")
+	return v.AsString(), st.AsString()
+}
+
+func TestRandomServiceInstanceID(t *testing.T) {
+	s := RandomServiceInstanceID()
+	assert.Equal(t, "random", s.Name())
+
+	id, strategy := detectInstanceID(t, s)
+	assert.Equal(t, "random", strategy)
+	u, err := uuid.Parse(id)
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Version(4), u.Version())
+
+	again, _ := detectInstanceID(t, RandomServiceInstanceID())
+	assert.Equal(t, id, again, "ID not stable within the process")
+}
+
+func TestHostPIDServiceInstanceID(t *testing.T) {
+	SetHostIDProvider(func() (string, error) { return expectedHostID, nil })
+	setOSProviders(func() int { return 42 }, defaultExecutablePathProvider, defaultCommandArgsProvider)
+	t.Cleanup(func() {
+		SetDefaultHostIDProvider()
+		setDefaultOSProviders()
+	})
+
+	s := HostPIDServiceInstanceID()
+	assert.Equal(t, "host_pid", s.Name())
+
+	id, strategy := detectInstanceID(t, s)
+	assert.Equal(t, "host_pid", strategy)
+	want := uuid.NewSHA1(serviceInstanceIDNamespace, []byte(expectedHostID+"/42"))
+	assert.Equal(t, want.String(), id)
+
+	setOSProviders(func() int { return 43 }, defaultExecutablePathProvider, defaultCommandArgsProvider)
+	other, _ := detectInstanceID(t, s)
+	assert.NotEqual(t, id, other)
+}
+
+func TestUserServiceInstanceID(t *testing.T) {
+	s := UserServiceInstanceID("instance-1")
+	assert.Equal(t, "user", s.Name())
+
+	id, strategy := detectInstanceID(t, s)
+	assert.Equal(t, "instance-1", id)
+	assert.Equal(t, "user", strategy)
+
+	_, err := New(context.Background(), WithServiceInstanceID(UserServiceInstanceID("")))
+	assert.ErrorIs(t, err, errEmptyServiceInstanceID)
+}
+
+func TestServiceInstanceIDZeroStrategy(t *testing.T) {
+	res, err := New(context.Background(), WithServiceInstanceID(ServiceInstanceIDStrategy{}))
+	require.NoError(t, err)
+	assert.Equal(t, 0, res.Len())
+}
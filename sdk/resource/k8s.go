@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// k8sNamespacePath is the path of the namespace file Kubernetes mounts into
+// every pod with a service account token.
+const k8sNamespacePath = "/var/run/secrets/kubernetes.io/serviceaccount/namespace" //nolint:gosec // False positive G101: Potential hardcoded credentials
+
+// k8sDefaultEnv is the environment variables, in order of precedence, each
+// Kubernetes attribute is read from. These are the names conventionally used
+// when exposing pod fields with the downward API.
+var k8sDefaultEnv = map[attribute.Key][]string{
+	semconv.K8SPodNameKey:       {"K8S_POD_NAME", "POD_NAME"},
+	semconv.K8SPodUIDKey:        {"K8S_POD_UID", "POD_UID"},
+	semconv.K8SNamespaceNameKey: {"K8S_NAMESPACE_NAME", "POD_NAMESPACE"},
+	semconv.K8SNodeNameKey:      {"K8S_NODE_NAME", "NODE_NAME"},
+	semconv.K8SContainerNameKey: {"K8S_CONTAINER_NAME"},
+}
+
+// k8sDetector is a Detector that provides information about the Kubernetes
+// pod the process is running in from downward API environment variables and
+// service account files.
+type k8sDetector struct {
+	// env overrides the environment variables an attribute is read from.
+	env map[attribute.Key]string
+}
+
+var _ Detector = k8sDetector{}
+
+// Detect returns a *Resource that describes the Kubernetes pod the process
+// is running in. If no Kubernetes information is found, an empty resource
+// will be returned.
+func (d k8sDetector) Detect(context.Context) (*Resource, error) {
+	var attrs []attribute.KeyValue
+	for k, names := range k8sDefaultEnv {
+		if name, ok := d.env[k]; ok {
+			names = []string{name}
+		}
+		if v := lookupEnv(names); v != "" {
+			attrs = append(attrs, k.String(v))
+		}
+	}
+	for k, name := range d.env {
+		if _, ok := k8sDefaultEnv[k]; ok {
+			continue
+		}
+		if v := lookupEnv([]string{name}); v != "" {
+			attrs = append(attrs, k.String(v))
+		}
+	}
+
+	if !hasKey(attrs, semconv.K8SNamespaceNameKey) {
+		ns, err := readFileContent(k8sNamespacePath)
+		if err != nil {
+			return nil, err
+		}
+		if ns != "" {
+			attrs = append(attrs, semconv.K8SNamespaceName(ns))
+		}
+	}
+
+	if len(attrs) == 0 {
+		return Empty(), nil
+	}
+	return NewWithAttributes(semconv.SchemaURL, attrs...), nil
+}
+
+// lookupEnv returns the trimmed value of the first environment variable in
+// names that is set and not empty.
+func lookupEnv(names []string) string {
+	for _, name := range names {
+		if v := strings.TrimSpace(os.Getenv(name)); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func hasKey(attrs []attribute.KeyValue, k attribute.Key) bool {
+	for _, a := range attrs {
+		if a.Key == k {
+			return true
+		}
+	}
+	return false
+}
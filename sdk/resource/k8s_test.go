@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+func setK8sNamespaceFile(t *testing.T, namespace string, err error) {
+	t.Cleanup(func() {
+		osStat = defaultOSStat
+		osOpen = defaultOSOpen
+	})
+
+	osStat = func(name string) (os.FileInfo, error) {
+		if name != k8sNamespacePath || (namespace == "" && err == nil) {
+			return nil, os.ErrNotExist
+		}
+		return nil, nil
+	}
+	osOpen = func(string) (io.ReadCloser, error) {
+		if err != nil {
+			return nil, err
+		}
+		return io.NopCloser(strings.NewReader(namespace + "\n")), nil
+	}
+}
+
+func clearK8sEnv(t *testing.T) {
+	for _, names := range k8sDefaultEnv {
+		for _, name := range names {
+			t.Setenv(name, "")
+		}
+	}
+}
+
+func TestK8sDetector(t *testing.T) {
+	clearK8sEnv(t)
+	t.Setenv("K8S_POD_NAME", "pod-1")
+	t.Setenv("POD_NAME", "ignored")
+	t.Setenv("POD_UID", "2d6e1d84-7c4a-4d5c-9a62-4f8d2b7a1c3e")
+	t.Setenv("NODE_NAME", "node-1")
+	setK8sNamespaceFile(t, "default", nil)
+
+	res, err := k8sDetector{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.K8SPodName("pod-1"),
+		semconv.K8SPodUID("2d6e1d84-7c4a-4d5c-9a62-4f8d2b7a1c3e"),
+		semconv.K8SNodeName("node-1"),
+		semconv.K8SNamespaceName("default"),
+	), res)
+}
+
+func TestK8sDetectorNamespaceEnvPrecedence(t *testing.T) {
+	clearK8sEnv(t)
+	t.Setenv("POD_NAMESPACE", "from-env")
+	setK8sNamespaceFile(t, "from-file", nil)
+
+	res, err := k8sDetector{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.K8SNamespaceName("from-env"),
+	), res)
+}
+
+func TestK8sDetectorEnvMapping(t *testing.T) {
+	clearK8sEnv(t)
+	t.Setenv("K8S_POD_NAME", "ignored")
+	t.Setenv("MY_POD", "pod-1")
+	t.Setenv("MY_DEPLOYMENT", "checkout")
+	setK8sNamespaceFile(t, "", nil)
+
+	d := k8sDetector{env: map[attribute.Key]string{
+		semconv.K8SPodNameKey:        "MY_POD",
+		semconv.K8SDeploymentNameKey: "MY_DEPLOYMENT",
+		semconv.K8SNodeNameKey:       "UNSET_NODE",
+	}}
+	res, err := d.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.K8SPodName("pod-1"),
+		semconv.K8SDeploymentName("checkout"),
+	), res)
+}
+
+func TestK8sDetectorNotInKubernetes(t *testing.T) {
+	clearK8sEnv(t)
+	setK8sNamespaceFile(t, "", nil)
+
+	res, err := k8sDetector{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Empty(), res)
+}
+
+func TestK8sDetectorNamespaceFileError(t *testing.T) {
+	setK8sNamespaceFile(t, "", errors.New("permission denied"))
+
+	_, err := k8sDetector{}.Detect(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import "slices"
+
+// Layer is a source of resource attributes.
+//
+// By default, when the same attribute is provided by more than one source,
+// the value from the source configured last takes precedence. WithPrecedence
+// can be used to instead give the layers an explicit order of precedence.
+// For example, this allows a platform team to guarantee attributes set with
+// environment variables cannot be overridden by application code.
+type Layer int
+
+const (
+	// LayerDetectors is the layer of attributes provided by detectors. This
+	// includes the built-in detectors added with options like WithHost or
+	// WithProcess and any Detector added with WithDetectors.
+	LayerDetectors Layer = iota
+	// LayerProgrammatic is the layer of attributes added with
+	// WithAttributes.
+	LayerProgrammatic
+	// LayerEnv is the layer of attributes read from the
+	// OTEL_RESOURCE_ATTRIBUTES and OTEL_SERVICE_NAME environment variables
+	// with WithFromEnv.
+	LayerEnv
+	// LayerFile is the layer of attributes read from a file with
+	// WithFromFile.
+	LayerFile
+)
+
+// WithLayer adds detectors to be evaluated for the configured resource as
+// part of layer. This can be used to add custom attribute providers to a
+// layer other than LayerDetectors.
+func WithLayer(layer Layer, detectors ...Detector) Option {
+	layered := make([]Detector, len(detectors))
+	for i, d := range detectors {
+		layered[i] = layeredDetector{Detector: d, layer: layer}
+	}
+	return WithDetectors(layered...)
+}
+
+// WithPrecedence sets the order of precedence of layers for the configured
+// resource. The layers are listed from highest to lowest precedence: an
+// attribute provided by a layer cannot be overridden by any layer that
+// follows it. Layers that are not listed have a lower precedence than all
+// listed layers.
+//
+// Within a layer, and if WithPrecedence is not used, the value of an
+// attribute is taken from the source configured last.
+func WithPrecedence(layers ...Layer) Option {
+	return precedenceOption(slices.Clone(layers))
+}
+
+type precedenceOption []Layer
+
+func (o precedenceOption) apply(cfg config) config {
+	cfg.precedence = o
+	return cfg
+}
+
+// layeredDetector is a Detector that is part of a Layer other than
+// LayerDetectors.
+type layeredDetector struct {
+	Detector
+
+	layer Layer
+}
+
+// layerOf returns the Layer of d.
+func layerOf(d Detector) Layer {
+	if l, ok := d.(layeredDetector); ok {
+		return l.layer
+	}
+	return LayerDetectors
+}
+
+// orderedDetectors returns the detectors of cfg ordered so that merging their
+// results in order honors the configured precedence. Detectors from layers
+// with a higher precedence are placed after those with a lower one.
+func (cfg config) orderedDetectors() []Detector {
+	detectors := slices.Clone(cfg.detectors)
+	if cfg.precedence != nil {
+		// rank is the precedence of a layer, lower values having a higher
+		// precedence.
+		rank := func(d Detector) int {
+			if i := slices.Index(cfg.precedence, layerOf(d)); i >= 0 {
+				return i
+			}
+			return len(cfg.precedence)
+		}
+		slices.SortStableFunc(detectors, func(a, b Detector) int {
+			return rank(b) - rank(a)
+		})
+	}
+
+	// Unwrap the detectors so errors identify the original detector.
+	for i, d := range detectors {
+		if l, ok := d.(layeredDetector); ok {
+			detectors[i] = l.Detector
+		}
+	}
+	return detectors
+}
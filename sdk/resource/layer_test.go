@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+type attrDetector []attribute.KeyValue
+
+func (d attrDetector) Detect(context.Context) (*resource.Resource, error) {
+	return resource.NewSchemaless(d...), nil
+}
+
+func TestWithPrecedence(t *testing.T) {
+	t.Setenv("OTEL_RESOURCE_ATTRIBUTES", "team=platform,env=env")
+	t.Setenv("OTEL_SERVICE_NAME", "")
+
+	detector := attrDetector{attribute.String("env", "detector")}
+	opts := []resource.Option{
+		resource.WithFromEnv(),
+		resource.WithAttributes(attribute.String("team", "app"), attribute.String("env", "app")),
+		resource.WithDetectors(detector),
+	}
+
+	tests := []struct {
+		name       string
+		precedence []resource.Layer
+		want       map[string]string
+	}{
+		{
+			name: "Default",
+			want: map[string]string{"team": "app", "env": "detector"},
+		},
+		{
+			name:       "EnvFirst",
+			precedence: []resource.Layer{resource.LayerEnv},
+			want:       map[string]string{"team": "platform", "env": "env"},
+		},
+		{
+			name:       "ProgrammaticFirst",
+			precedence: []resource.Layer{resource.LayerProgrammatic, resource.LayerEnv},
+			want:       map[string]string{"team": "app", "env": "app"},
+		},
+		{
+			name: "DetectorsFirst",
+			precedence: []resource.Layer{
+				resource.LayerDetectors,
+				resource.LayerEnv,
+				resource.LayerProgrammatic,
+			},
+			want: map[string]string{"team": "platform", "env": "detector"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			o := opts
+			if tc.precedence != nil {
+				o = append(o[:len(o):len(o)], resource.WithPrecedence(tc.precedence...))
+			}
+			res, err := resource.New(context.Background(), o...)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, toMap(res))
+		})
+	}
+}
+
+func TestWithLayer(t *testing.T) {
+	ctx := context.Background()
+	custom := attrDetector{attribute.String("k", "custom")}
+
+	res, err := resource.New(ctx,
+		resource.WithLayer(resource.LayerEnv, custom),
+		resource.WithAttributes(attribute.String("k", "app")),
+		resource.WithPrecedence(resource.LayerEnv),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"k": "custom"}, toMap(res))
+
+	a := resource.NewAsync(ctx, time.Second,
+		resource.WithLayer(resource.LayerEnv, custom),
+		resource.WithAttributes(attribute.String("k", "app")),
+		resource.WithPrecedence(resource.LayerEnv),
+	)
+	<-a.Done()
+	require.NoError(t, a.Err())
+	assert.Equal(t, map[string]string{"k": "custom"}, toMap(a.Resource()))
+}
+
+func TestWithLayerDetectorError(t *testing.T) {
+	_, err := resource.New(context.Background(),
+		resource.WithLayer(resource.LayerEnv, resource.StringDetector("", "k", func() (string, error) {
+			return "", assert.AnError
+		})),
+	)
+
+	var dErr *resource.DetectionError
+	require.ErrorAs(t, err, &dErr)
+	require.Len(t, dErr.Failed, 1)
+	assert.IsType(t, resource.StringDetector("", "k", nil), dErr.Failed[0].Detector)
+}
@@ -11,11 +11,20 @@ import (
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
-type osDescriptionProvider func() (string, error)
+type (
+	osDescriptionProvider func() (string, error)
+	osVersionProvider     func() (string, error)
+)
 
-var defaultOSDescriptionProvider osDescriptionProvider = platformOSDescription
+var (
+	defaultOSDescriptionProvider osDescriptionProvider = platformOSDescription
+	defaultOSVersionProvider     osVersionProvider     = platformOSVersion
+)
 
-var osDescription = defaultOSDescriptionProvider
+var (
+	osDescription = defaultOSDescriptionProvider
+	osVersion     = defaultOSVersionProvider
+)
 
 func setDefaultOSDescriptionProvider() {
 	setOSDescriptionProvider(defaultOSDescriptionProvider)
@@ -25,9 +34,18 @@ func setOSDescriptionProvider(osDescriptionProvider osDescriptionProvider) {
 	osDescription = osDescriptionProvider
 }
 
+func setDefaultOSVersionProvider() {
+	setOSVersionProvider(defaultOSVersionProvider)
+}
+
+func setOSVersionProvider(osVersionProvider osVersionProvider) {
+	osVersion = osVersionProvider
+}
+
 type (
 	osTypeDetector        struct{}
 	osDescriptionDetector struct{}
+	osVersionDetector     struct{}
 )
 
 // Detect returns a *Resource that describes the operating system type the
@@ -57,6 +75,24 @@ func (osDescriptionDetector) Detect(ctx context.Context) (*Resource, error) {
 	), nil
 }
 
+// Detect returns a *Resource that describes the version of the operating
+// system the service is running on. If the version is unknown, an empty
+// resource will be returned.
+func (osVersionDetector) Detect(ctx context.Context) (*Resource, error) {
+	version, err := osVersion()
+	if err != nil {
+		return nil, err
+	}
+	if version == "" {
+		return Empty(), nil
+	}
+
+	return NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.OSVersion(version),
+	), nil
+}
+
 // mapRuntimeOSToSemconvOSType translates the OS name as provided by the Go runtime
 // into an OS type attribute with the corresponding value defined by the semantic
 // conventions. In case the provided OS name isn't mapped, it's transformed to lowercase
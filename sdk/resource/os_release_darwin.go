@@ -43,6 +43,25 @@ func osRelease() string {
 	return buildOSRelease(values)
 }
 
+// platformOSVersion returns the ProductVersion property of the system version
+// property list file (e.g. "14.5"). If no .plist file is found, an empty
+// string is returned.
+func platformOSVersion() (string, error) {
+	file, err := getPlistFile()
+	if err != nil {
+		return "", nil
+	}
+
+	defer file.Close()
+
+	values, err := parsePlistFile(file)
+	if err != nil {
+		return "", err
+	}
+
+	return values["ProductVersion"], nil
+}
+
 // getPlistFile returns a *os.File pointing to one of the well-known .plist files
 // available on macOS. If no file can be opened, it returns an error.
 func getPlistFile() (*os.File, error) {
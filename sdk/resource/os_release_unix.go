@@ -32,6 +32,19 @@ func osRelease() string {
 	return buildOSRelease(values)
 }
 
+// platformOSVersion returns the VERSION_ID property of the os-release file.
+// If no os-release file is found, an empty string is returned.
+func platformOSVersion() (string, error) {
+	file, err := getOSReleaseFile()
+	if err != nil {
+		return "", nil
+	}
+
+	defer file.Close()
+
+	return parseOSReleaseFile(file)["VERSION_ID"], nil
+}
+
 // getOSReleaseFile returns a *os.File pointing to one of the well-known os-release
 // files, according to their order of preference. If no file can be opened, it
 // returns an error.
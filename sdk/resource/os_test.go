@@ -24,6 +24,9 @@ func mockRuntimeProviders() {
 	resource.SetOSDescriptionProvider(
 		func() (string, error) { return "Test", nil },
 	)
+	resource.SetOSVersionProvider(
+		func() (string, error) { return "1.2.3", nil },
+	)
 }
 
 func TestMapRuntimeOSToSemconvOSType(t *testing.T) {
@@ -53,3 +56,24 @@ func TestMapRuntimeOSToSemconvOSType(t *testing.T) {
 		})
 	}
 }
+
+func TestMapRuntimeArchToSemconvHostArch(t *testing.T) {
+	tt := []struct {
+		Goarch   string
+		HostArch attribute.KeyValue
+	}{
+		{"amd64", semconv.HostArchAMD64},
+		{"arm", semconv.HostArchARM32},
+		{"arm64", semconv.HostArchARM64},
+		{"386", semconv.HostArchX86},
+		{"ppc64le", semconv.HostArchPPC64},
+		{"s390x", semconv.HostArchS390x},
+		{"riscv64", semconv.HostArchKey.String("riscv64")},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.Goarch, func(t *testing.T) {
+			require.Equal(t, tc.HostArch, resource.MapRuntimeArchToSemconvHostArch(tc.Goarch))
+		})
+	}
+}
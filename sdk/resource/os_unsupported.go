@@ -13,3 +13,9 @@ package resource // import "go.opentelemetry.io/otel/sdk/resource"
 func platformOSDescription() (string, error) {
 	return "<unknown>", nil
 }
+
+// platformOSVersion is a placeholder implementation for OSes for which this
+// project currently doesn't support os.version attribute detection.
+func platformOSVersion() (string, error) {
+	return "", nil
+}
@@ -48,6 +48,25 @@ func platformOSDescription() (string, error) {
 	), nil
 }
 
+// platformOSVersion returns the version of Windows formatted as
+// "major.minor.build" (e.g. "10.0.19045"), the same as reported by the ver
+// command.
+func platformOSVersion() (string, error) {
+	k, err := registry.OpenKey(
+		registry.LOCAL_MACHINE, `SOFTWARE\Microsoft\Windows NT\CurrentVersion`, registry.QUERY_VALUE)
+	if err != nil {
+		return "", err
+	}
+
+	defer k.Close()
+
+	return fmt.Sprintf("%s.%s.%s",
+		readCurrentMajorVersionNumber(k),
+		readCurrentMinorVersionNumber(k),
+		readCurrentBuildNumber(k),
+	), nil
+}
+
 func getStringValue(name string, k registry.Key) string {
 	value, _, _ := k.GetStringValue(name)
 
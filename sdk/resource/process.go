@@ -10,6 +10,11 @@ import (
 	"os/user"
 	"path/filepath"
 	"runtime"
+	"runtime/debug"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
 
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
@@ -23,6 +28,7 @@ type (
 	runtimeVersionProvider func() string
 	runtimeOSProvider      func() string
 	runtimeArchProvider    func() string
+	buildInfoProvider      func() (*debug.BuildInfo, bool)
 )
 
 var (
@@ -39,6 +45,7 @@ var (
 	defaultRuntimeVersionProvider runtimeVersionProvider = runtime.Version
 	defaultRuntimeOSProvider      runtimeOSProvider      = func() string { return runtime.GOOS }
 	defaultRuntimeArchProvider    runtimeArchProvider    = func() string { return runtime.GOARCH }
+	defaultBuildInfoProvider      buildInfoProvider      = debug.ReadBuildInfo
 )
 
 var (
@@ -50,6 +57,7 @@ var (
 	runtimeVersion = defaultRuntimeVersionProvider
 	runtimeOS      = defaultRuntimeOSProvider
 	runtimeArch    = defaultRuntimeArchProvider
+	buildInfo      = defaultBuildInfoProvider
 )
 
 func setDefaultOSProviders() {
@@ -91,6 +99,14 @@ func setRuntimeProviders(
 	runtimeArch = runtimeArchProvider
 }
 
+func setDefaultBuildInfoProvider() {
+	setBuildInfoProvider(defaultBuildInfoProvider)
+}
+
+func setBuildInfoProvider(buildInfoProvider buildInfoProvider) {
+	buildInfo = buildInfoProvider
+}
+
 func setDefaultUserProviders() {
 	setUserProviders(defaultOwnerProvider)
 }
@@ -108,6 +124,29 @@ type (
 	processRuntimeNameDetector        struct{}
 	processRuntimeVersionDetector     struct{}
 	processRuntimeDescriptionDetector struct{}
+	processBuildInfoDetector          struct{}
+
+	// processCommandArgsSanitizedDetector is a processCommandArgsDetector
+	// that sanitizes the command arguments before they are added.
+	processCommandArgsSanitizedDetector struct {
+		sanitize func([]string) []string
+	}
+)
+
+// Attribute keys used to describe the build of the Go executable. These are
+// not defined by the OpenTelemetry semantic conventions, so they use the same
+// project owned namespace as the cgroup limit attributes.
+const (
+	buildMainPathKey    = attribute.Key("io.opentelemetry.go.build.main.path")
+	buildMainVersionKey = attribute.Key("io.opentelemetry.go.build.main.version")
+	buildVCSRevisionKey = attribute.Key("io.opentelemetry.go.build.vcs.revision")
+	buildVCSTimeKey     = attribute.Key("io.opentelemetry.go.build.vcs.time")
+	buildVCSModifiedKey = attribute.Key("io.opentelemetry.go.build.vcs.modified")
+)
+
+const (
+	redactedCommandArg   = "REDACTED"
+	commandArgFlagPrefix = "-"
 )
 
 // Detect returns a *Resource that describes the process identifier (PID) of the
@@ -139,6 +178,75 @@ func (processCommandArgsDetector) Detect(ctx context.Context) (*Resource, error)
 	return NewWithAttributes(semconv.SchemaURL, semconv.ProcessCommandArgs(commandArgs()...)), nil
 }
 
+// Detect returns a *Resource that describes all the command arguments as
+// received by the process after they have been sanitized.
+func (d processCommandArgsSanitizedDetector) Detect(ctx context.Context) (*Resource, error) {
+	args := slices.Clone(commandArgs())
+	if d.sanitize != nil {
+		args = d.sanitize(args)
+	}
+	return NewWithAttributes(semconv.SchemaURL, semconv.ProcessCommandArgs(args...)), nil
+}
+
+// RedactCommandArgs returns a function that replaces the value of each of
+// the command line flags with the name in flags with "REDACTED". Both the
+// "-flag=value" and "-flag value" forms, with one or two leading dashes, are
+// redacted. It is intended to be used with WithProcessCommandArgsSanitized.
+func RedactCommandArgs(flags ...string) func([]string) []string {
+	redact := make(map[string]struct{}, len(flags))
+	for _, f := range flags {
+		redact[strings.TrimLeft(f, commandArgFlagPrefix)] = struct{}{}
+	}
+
+	return func(args []string) []string {
+		for i := 0; i < len(args); i++ {
+			if !strings.HasPrefix(args[i], commandArgFlagPrefix) {
+				continue
+			}
+			name, _, hasValue := strings.Cut(strings.TrimLeft(args[i], commandArgFlagPrefix), "=")
+			if _, ok := redact[name]; !ok {
+				continue
+			}
+			if hasValue {
+				args[i] = args[i][:strings.IndexByte(args[i], '=')+1] + redactedCommandArg
+			} else if i+1 < len(args) {
+				i++
+				args[i] = redactedCommandArg
+			}
+		}
+		return args
+	}
+}
+
+// Detect returns a *Resource that describes the build of the Go executable
+// from the build information embedded in it. If no build information is
+// available, an empty resource will be returned.
+func (processBuildInfoDetector) Detect(ctx context.Context) (*Resource, error) {
+	info, ok := buildInfo()
+	if !ok || info == nil {
+		return Empty(), nil
+	}
+
+	var attrs []attribute.KeyValue
+	if info.Main.Path != "" {
+		attrs = append(attrs, buildMainPathKey.String(info.Main.Path))
+	}
+	if info.Main.Version != "" {
+		attrs = append(attrs, buildMainVersionKey.String(info.Main.Version))
+	}
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			attrs = append(attrs, buildVCSRevisionKey.String(setting.Value))
+		case "vcs.time":
+			attrs = append(attrs, buildVCSTimeKey.String(setting.Value))
+		case "vcs.modified":
+			attrs = append(attrs, buildVCSModifiedKey.Bool(setting.Value == "true"))
+		}
+	}
+	return NewWithAttributes(semconv.SchemaURL, attrs...), nil
+}
+
 // Detect returns a *Resource that describes the username of the user that owns the
 // process.
 func (processOwnerDetector) Detect(ctx context.Context) (*Resource, error) {
@@ -91,6 +91,8 @@ func restoreAttributesProviders() {
 	resource.SetDefaultRuntimeProviders()
 	resource.SetDefaultUserProviders()
 	resource.SetDefaultOSDescriptionProvider()
+	resource.SetDefaultOSVersionProvider()
+	resource.SetDefaultBuildInfoProvider()
 	resource.SetDefaultContainerProviders()
 }
 
@@ -107,6 +109,27 @@ func TestCommandArgs(t *testing.T) {
 	require.Equal(t, os.Args, resource.CommandArgs())
 }
 
+func TestRedactCommandArgs(t *testing.T) {
+	redact := resource.RedactCommandArgs("password", "--token")
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{"NoFlags", []string{"app", "serve"}, []string{"app", "serve"}},
+		{"Equals", []string{"app", "-password=s3cr3t"}, []string{"app", "-password=REDACTED"}},
+		{"DoubleDashEquals", []string{"app", "--token=abc", "-v"}, []string{"app", "--token=REDACTED", "-v"}},
+		{"Separate", []string{"app", "--password", "s3cr3t", "-v"}, []string{"app", "--password", "REDACTED", "-v"}},
+		{"Trailing", []string{"app", "-token"}, []string{"app", "-token"}},
+		{"Other", []string{"app", "-user", "gopher"}, []string{"app", "-user", "gopher"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, redact(tc.args))
+		})
+	}
+}
+
 func TestRuntimeName(t *testing.T) {
 	if runtime.Compiler == "gc" {
 		require.Equal(t, "go", resource.RuntimeName())
@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// detectorsEnvKey is the environment variable name the names of the
+// registered detectors to use are read from.
+const detectorsEnvKey = "OTEL_RESOURCE_DETECTORS"
+
+const (
+	// allDetectors selects all registered detectors.
+	allDetectors = "all"
+	// noDetectors selects no detectors.
+	noDetectors = "none"
+)
+
+var (
+	// ErrDetectorRegistered is returned when a detector is registered with a
+	// name that is already in use.
+	ErrDetectorRegistered = errors.New("resource detector already registered")
+
+	// ErrUnknownDetector is returned when a detector is selected by a name
+	// that is not registered.
+	ErrUnknownDetector = fmt.Errorf("%w: unknown resource detector", ErrPartialResource)
+)
+
+// namedDetectors holds the detectors registered by name.
+var namedDetectors = newDetectorRegistry()
+
+type detectorRegistry struct {
+	mu sync.Mutex
+	// names holds the registered names in registration order.
+	names     []string
+	detectors map[string][]Detector
+}
+
+func newDetectorRegistry() *detectorRegistry {
+	r := &detectorRegistry{detectors: make(map[string][]Detector)}
+	for _, b := range []struct {
+		name      string
+		detectors []Detector
+	}{
+		{"env", []Detector{fromEnv{}}},
+		{"file", []Detector{fromFile{}}},
+		{"host", []Detector{host{}, hostIDDetector{}}},
+		{"os", []Detector{osTypeDetector{}, osDescriptionDetector{}}},
+		{"process", []Detector{
+			processPIDDetector{},
+			processExecutableNameDetector{},
+			processExecutablePathDetector{},
+			processCommandArgsDetector{},
+			processOwnerDetector{},
+			processRuntimeNameDetector{},
+			processRuntimeVersionDetector{},
+			processRuntimeDescriptionDetector{},
+		}},
+		{"container", []Detector{cgroupContainerIDDetector{}}},
+		{"k8s", []Detector{k8sDetector{}}},
+		{"ec2", []Detector{ec2Detector{}}},
+		{"gce", []Detector{gceDetector{}}},
+		{"azure", []Detector{azureVMDetector{}}},
+	} {
+		_ = r.register(b.name, b.detectors)
+	}
+	return r
+}
+
+func (r *detectorRegistry) register(name string, detectors []Detector) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.detectors[name]; ok || name == allDetectors || name == noDetectors {
+		return fmt.Errorf("%w: %q", ErrDetectorRegistered, name)
+	}
+	r.names = append(r.names, name)
+	r.detectors[name] = detectors
+	return nil
+}
+
+// lookup returns the detectors registered for names. Detectors that are not
+// registered are represented by a Detector returning an ErrUnknownDetector
+// error so detection of the remaining detectors is not interrupted.
+func (r *detectorRegistry) lookup(names []string) []Detector {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var out []Detector
+	for _, name := range names {
+		switch name {
+		case noDetectors:
+			return nil
+		case allDetectors:
+			for _, n := range r.names {
+				out = append(out, r.detectors[n]...)
+			}
+			return out
+		}
+	}
+
+	for _, name := range names {
+		if d, ok := r.detectors[name]; ok {
+			out = append(out, d...)
+			continue
+		}
+		out = append(out, errDetector{fmt.Errorf("%w: %q", ErrUnknownDetector, name)})
+	}
+	return out
+}
+
+// errDetector is a Detector that always returns err.
+type errDetector struct {
+	err error
+}
+
+func (d errDetector) Detect(context.Context) (*Resource, error) {
+	return nil, d.err
+}
+
+// RegisterDetector registers detectors with name so they can be selected with
+// WithRegisteredDetectors or the OTEL_RESOURCE_DETECTORS environment
+// variable. The detectors are evaluated in the order they are passed.
+//
+// An error wrapping ErrDetectorRegistered is returned if name is already
+// registered. The built-in detectors are registered as "env", "file", "host",
+// "os", "process", "container", "k8s", "ec2", "gce", and "azure". The names
+// "all" and "none" are reserved.
+func RegisterDetector(name string, detectors ...Detector) error {
+	return namedDetectors.register(name, detectors)
+}
+
+// WithRegisteredDetectors adds the detectors registered with names (see
+// RegisterDetector) to the configured Resource. If "all" is one of the names,
+// all registered detectors are added in registration order. If "none" is one
+// of the names, no detectors are added.
+//
+// A name that is not registered results in an error wrapping
+// ErrUnknownDetector being returned when the Resource is created. The
+// detectors of all other names are still evaluated.
+func WithRegisteredDetectors(names ...string) Option {
+	return registeredDetectorsOption(func() []string { return names })
+}
+
+// WithDetectorsFromEnv adds the registered detectors named in the
+// comma-separated OTEL_RESOURCE_DETECTORS environment variable to the
+// configured Resource. See WithRegisteredDetectors for how names are
+// resolved. No detectors are added if the environment variable is not set.
+func WithDetectorsFromEnv() Option {
+	return registeredDetectorsOption(func() []string {
+		var names []string
+		for _, name := range strings.Split(os.Getenv(detectorsEnvKey), ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+		return names
+	})
+}
+
+type registeredDetectorsOption func() []string
+
+func (o registeredDetectorsOption) apply(cfg config) config {
+	cfg.detectors = append(cfg.detectors, namedDetectors.lookup(o())...)
+	return cfg
+}
@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func setRegistry(t *testing.T) {
+	orig := namedDetectors
+	t.Cleanup(func() { namedDetectors = orig })
+	namedDetectors = &detectorRegistry{detectors: make(map[string][]Detector)}
+}
+
+func TestRegisterDetector(t *testing.T) {
+	setRegistry(t)
+
+	require.NoError(t, RegisterDetector("a", detectAttributes{[]attribute.KeyValue{attribute.String("a", "1")}}))
+	assert.ErrorIs(t, RegisterDetector("a"), ErrDetectorRegistered)
+	assert.ErrorIs(t, RegisterDetector("all"), ErrDetectorRegistered)
+	assert.ErrorIs(t, RegisterDetector("none"), ErrDetectorRegistered)
+}
+
+func TestWithRegisteredDetectors(t *testing.T) {
+	setRegistry(t)
+	require.NoError(t, RegisterDetector("a", detectAttributes{[]attribute.KeyValue{attribute.String("a", "1")}}))
+	require.NoError(t, RegisterDetector("b",
+		detectAttributes{[]attribute.KeyValue{attribute.String("b", "1")}},
+		detectAttributes{[]attribute.KeyValue{attribute.String("b", "2")}},
+	))
+
+	testCases := []struct {
+		name    string
+		names   []string
+		want    *Resource
+		wantErr error
+	}{
+		{
+			name:  "single",
+			names: []string{"a"},
+			want:  NewSchemaless(attribute.String("a", "1")),
+		},
+		{
+			name:  "ordered",
+			names: []string{"b", "a"},
+			want:  NewSchemaless(attribute.String("a", "1"), attribute.String("b", "2")),
+		},
+		{
+			name:  "all",
+			names: []string{"a", "all"},
+			want:  NewSchemaless(attribute.String("a", "1"), attribute.String("b", "2")),
+		},
+		{
+			name:  "none",
+			names: []string{"a", "none"},
+			want:  Empty(),
+		},
+		{
+			name:    "unknown",
+			names:   []string{"unknown", "a"},
+			want:    NewSchemaless(attribute.String("a", "1")),
+			wantErr: ErrUnknownDetector,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := New(context.Background(), WithRegisteredDetectors(tc.names...))
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.ErrorIs(t, err, ErrPartialResource)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestWithDetectorsFromEnv(t *testing.T) {
+	setRegistry(t)
+	require.NoError(t, RegisterDetector("a", detectAttributes{[]attribute.KeyValue{attribute.String("a", "1")}}))
+	require.NoError(t, RegisterDetector("b", detectAttributes{[]attribute.KeyValue{attribute.String("b", "1")}}))
+
+	t.Setenv(detectorsEnvKey, " b, ,a ")
+	res, err := New(context.Background(), WithDetectorsFromEnv())
+	require.NoError(t, err)
+	assert.Equal(t, NewSchemaless(attribute.String("a", "1"), attribute.String("b", "1")), res)
+
+	t.Setenv(detectorsEnvKey, "")
+	res, err = New(context.Background(), WithDetectorsFromEnv())
+	require.NoError(t, err)
+	assert.Equal(t, Empty(), res)
+}
+
+func TestBuiltinDetectorsRegistered(t *testing.T) {
+	r := newDetectorRegistry()
+	for _, name := range []string{"env", "file", "host", "os", "process", "container", "k8s", "ec2", "gce", "azure"} {
+		assert.Contains(t, r.detectors, name)
+	}
+}
@@ -32,6 +32,7 @@ import (
 type Resource struct {
 	attrs     attribute.Set
 	schemaURL string
+	entities  *entityRefs
 }
 
 // Compile-time check that the Resource remains comparable.
@@ -55,7 +56,11 @@ var ErrSchemaURLConflict = errors.New("conflicting Schema URL")
 // [ErrSchemaURLConflict] if merging Resources from the opts results in a
 // schema URL conflict (see [Resource.Merge] for more information). It is up to
 // the caller to determine if this returned Resource should be used or not
-// based on these errors.
+// based on these errors. The returned error is a [*DetectionError]
+// identifying which detectors failed and which succeeded.
+//
+// If the [WithDetectionWarnings] option is used, no error is returned and
+// any error is sent to the global error handler instead.
 func New(ctx context.Context, opts ...Option) (*Resource, error) {
 	cfg := config{}
 	for _, opt := range opts {
@@ -63,7 +68,12 @@ func New(ctx context.Context, opts ...Option) (*Resource, error) {
 	}
 
 	r := &Resource{schemaURL: cfg.schemaURL}
-	return r, detect(ctx, r, cfg.detectors)
+	err := detect(ctx, r, cfg.orderedDetectors(), cfg.warnOnError)
+	if err != nil && cfg.warnOnError {
+		otel.Handle(err)
+		return r, nil
+	}
+	return r, err
 }
 
 // NewWithAttributes creates a resource from attrs and associates the resource with a
@@ -205,17 +215,24 @@ func Merge(a, b *Resource) (*Resource, error) {
 		combine = append(combine, mi.Attribute())
 	}
 
+	var merged *Resource
 	switch {
 	case a.schemaURL == "":
-		return NewWithAttributes(b.schemaURL, combine...), nil
+		merged = NewWithAttributes(b.schemaURL, combine...)
 	case b.schemaURL == "":
-		return NewWithAttributes(a.schemaURL, combine...), nil
+		merged = NewWithAttributes(a.schemaURL, combine...)
 	case a.schemaURL == b.schemaURL:
-		return NewWithAttributes(a.schemaURL, combine...), nil
+		merged = NewWithAttributes(a.schemaURL, combine...)
+	}
+	if merged != nil {
+		merged.entities = mergeEntities(a, b)
+		return merged, nil
 	}
 	// Return the merged resource with an appropriate error. It is up to
 	// the user to decide if the returned resource can be used or not.
-	return NewSchemaless(combine...), fmt.Errorf(
+	merged = NewSchemaless(combine...)
+	merged.entities = mergeEntities(a, b)
+	return merged, fmt.Errorf(
 		"%w: %s and %s",
 		ErrSchemaURLConflict,
 		a.schemaURL,
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"runtime/debug"
 	"strings"
 	"sync"
 	"testing"
@@ -548,6 +549,44 @@ func TestWithOS(t *testing.T) {
 	}, toMap(res))
 }
 
+func TestWithOSVersion(t *testing.T) {
+	mockRuntimeProviders()
+	t.Cleanup(restoreAttributesProviders)
+
+	ctx := context.Background()
+
+	res, err := resource.New(ctx,
+		resource.WithOSVersion(),
+	)
+
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"os.version": "1.2.3",
+	}, toMap(res))
+
+	resource.SetOSVersionProvider(func() (string, error) { return "", nil })
+	res, err = resource.New(ctx,
+		resource.WithOSVersion(),
+	)
+
+	require.NoError(t, err)
+	require.Empty(t, toMap(res))
+}
+
+func TestWithHostArch(t *testing.T) {
+	mockProcessAttributesProviders()
+	t.Cleanup(restoreAttributesProviders)
+
+	res, err := resource.New(context.Background(),
+		resource.WithHostArch(),
+	)
+
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"host.arch": "amd64",
+	}, toMap(res))
+}
+
 func TestWithProcessPID(t *testing.T) {
 	mockProcessAttributesProvidersWithErrors()
 	ctx := context.Background()
@@ -590,6 +629,55 @@ func TestWithProcessExecutablePath(t *testing.T) {
 	}, toMap(res))
 }
 
+func TestWithProcessCommandArgsSanitized(t *testing.T) {
+	mockProcessAttributesProviders()
+	t.Cleanup(restoreAttributesProviders)
+	ctx := context.Background()
+
+	res, err := resource.New(ctx,
+		resource.WithProcessCommandArgsSanitized(resource.RedactCommandArgs("t")),
+	)
+
+	require.NoError(t, err)
+	jsonCommandArgs, _ := json.Marshal([]string{"mock", "-t", "REDACTED"})
+	require.Equal(t, map[string]string{
+		"process.command_args": string(jsonCommandArgs),
+	}, toMap(res))
+	assert.Equal(t, []string{"mock", "-t", "30"}, fakeCommandArgs, "original arguments modified")
+}
+
+func TestWithProcessBuildInfo(t *testing.T) {
+	mockProcessAttributesProviders()
+	t.Cleanup(restoreAttributesProviders)
+	ctx := context.Background()
+
+	resource.SetBuildInfoProvider(func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{
+			Main: debug.Module{Path: "example.com/app", Version: "v1.0.0"},
+			Settings: []debug.BuildSetting{
+				{Key: "-compiler", Value: "gc"},
+				{Key: "vcs.revision", Value: "abc123"},
+				{Key: "vcs.time", Value: "2024-01-02T03:04:05Z"},
+				{Key: "vcs.modified", Value: "true"},
+			},
+		}, true
+	})
+	res, err := resource.New(ctx, resource.WithProcessBuildInfo())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"io.opentelemetry.go.build.main.path":    "example.com/app",
+		"io.opentelemetry.go.build.main.version": "v1.0.0",
+		"io.opentelemetry.go.build.vcs.revision": "abc123",
+		"io.opentelemetry.go.build.vcs.time":     "2024-01-02T03:04:05Z",
+		"io.opentelemetry.go.build.vcs.modified": "true",
+	}, toMap(res))
+
+	resource.SetBuildInfoProvider(func() (*debug.BuildInfo, bool) { return nil, false })
+	res, err = resource.New(ctx, resource.WithProcessBuildInfo())
+	require.NoError(t, err)
+	assert.Empty(t, toMap(res))
+}
+
 func TestWithProcessCommandArgs(t *testing.T) {
 	mockProcessAttributesProvidersWithErrors()
 	ctx := context.Background()
@@ -770,6 +858,60 @@ func TestWithContainer(t *testing.T) {
 	}, toMap(res))
 }
 
+func TestWithContainerCgroupLimits(t *testing.T) {
+	t.Cleanup(restoreAttributesProviders)
+
+	testCases := []struct {
+		name             string
+		version          int
+		memory           int64
+		cpu              float64
+		err              error
+		expectedResource map[string]string
+	}{
+		{
+			name:    "limited",
+			version: 1,
+			memory:  536870912,
+			cpu:     0.5,
+			expectedResource: map[string]string{
+				"io.opentelemetry.go.cgroup.version":      "1",
+				"io.opentelemetry.go.cgroup.memory.limit": "536870912",
+				"io.opentelemetry.go.cgroup.cpu.limit":    "0.5",
+			},
+		},
+		{
+			name:    "unlimited",
+			version: 2,
+			expectedResource: map[string]string{
+				"io.opentelemetry.go.cgroup.version": "2",
+			},
+		},
+		{
+			name:             "no cgroupfs",
+			expectedResource: map[string]string{},
+		},
+		{
+			name:             "error",
+			err:              errors.New("unable to read cgroupfs"),
+			expectedResource: map[string]string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resource.SetCgroupLimits(tc.version, tc.memory, tc.cpu, tc.err)
+
+			res, err := resource.New(context.Background(),
+				resource.WithContainerCgroupLimits(),
+			)
+
+			assert.Equal(t, tc.err != nil, err != nil)
+			assert.Equal(t, tc.expectedResource, toMap(res))
+		})
+	}
+}
+
 func TestResourceConcurrentSafe(t *testing.T) {
 	// Creating Resources should also be free of any data races,
 	// because Resources are immutable.
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// errSchemaUpgrade is returned when a Resource cannot be upgraded to the
+// schema of the Resource it is merged with.
+var errSchemaUpgrade = errors.New("cannot upgrade resource schema")
+
+// MergeWithSchemaUpgrade creates a new [Resource] by merging a and b, the
+// same as [Merge], except that when a and b have different, non-empty, schema
+// URLs the Resource with the from schema URL is first upgraded to the schema
+// URL of the other: its attribute keys are renamed using renames and it is
+// associated with the schema URL of the other Resource. The precedence of b
+// is maintained regardless of which Resource is upgraded.
+//
+// The renames map the attribute keys of the from schema URL to the ones of
+// the schema URL being upgraded to, with chained renames already resolved.
+// They can be obtained from a schema file with the
+// go.opentelemetry.io/otel/schema module.
+//
+// If neither a nor b has the from schema URL, the result of [Merge] is
+// returned along with an error containing both [ErrSchemaURLConflict] and the
+// reason the upgrade failed.
+func MergeWithSchemaUpgrade(a, b *Resource, from string, renames map[attribute.Key]attribute.Key) (*Resource, error) {
+	if a == nil || b == nil || a.schemaURL == "" || b.schemaURL == "" || a.schemaURL == b.schemaURL {
+		return Merge(a, b)
+	}
+
+	switch from {
+	case a.schemaURL:
+		a = upgrade(a, b.schemaURL, renames)
+	case b.schemaURL:
+		b = upgrade(b, a.schemaURL, renames)
+	default:
+		res, err := Merge(a, b)
+		return res, errors.Join(err, fmt.Errorf("%w: no resource with schema URL %s", errSchemaUpgrade, from))
+	}
+	return Merge(a, b)
+}
+
+// upgrade returns a copy of r with the attribute keys of r, and of its
+// entities, renamed using renames, associated with schemaURL. If r already
+// has an attribute with the key an attribute is renamed to, the value of the
+// existing attribute is kept.
+func upgrade(r *Resource, schemaURL string, renames map[attribute.Key]attribute.Key) *Resource {
+	rename := func(k attribute.Key) attribute.Key {
+		if n, ok := renames[k]; ok {
+			return n
+		}
+		return k
+	}
+
+	attrs := r.Attributes()
+	upgradedAttrs := attrs[:0]
+	for _, a := range attrs {
+		n := rename(a.Key)
+		if n != a.Key && r.attrs.HasValue(n) && rename(n) == n {
+			continue
+		}
+		a.Key = n
+		upgradedAttrs = append(upgradedAttrs, a)
+	}
+	upgraded := NewWithAttributes(schemaURL, upgradedAttrs...)
+
+	if r.entities != nil {
+		refs := make(entityRefs, 0, len(*r.entities))
+		for _, ref := range *r.entities {
+			if ref.schemaURL != "" {
+				ref.schemaURL = schemaURL
+			}
+			ref.idKeys = renameKeys(ref.idKeys, rename)
+			ref.descKeys = renameKeys(ref.descKeys, rename)
+			refs = append(refs, ref)
+		}
+		upgraded.entities = &refs
+	}
+	return upgraded
+}
+
+func renameKeys(keys []attribute.Key, rename func(attribute.Key) attribute.Key) []attribute.Key {
+	out := make([]attribute.Key, len(keys))
+	for i, k := range keys {
+		out[i] = rename(k)
+	}
+	return out
+}
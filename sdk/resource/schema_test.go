@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+const (
+	olderSchemaURL = "https://example.com/schemas/1.1.0"
+	newerSchemaURL = "https://example.com/schemas/1.3.0"
+)
+
+var testRenames = map[attribute.Key]attribute.Key{
+	"old.name":               "mid.name",
+	"deployment.environment": "deployment.environment.name",
+}
+
+func TestMergeWithSchemaUpgrade(t *testing.T) {
+	older := resource.NewWithAttributes(olderSchemaURL,
+		attribute.String("old.name", "a"),
+		attribute.String("deployment.environment", "prod"),
+		attribute.String("shared", "older"),
+	)
+	newer := resource.NewWithAttributes(newerSchemaURL,
+		attribute.String("shared", "newer"),
+	)
+	want := resource.NewWithAttributes(newerSchemaURL,
+		attribute.String("mid.name", "a"),
+		attribute.String("deployment.environment.name", "prod"),
+		attribute.String("shared", "newer"),
+	)
+
+	res, err := resource.MergeWithSchemaUpgrade(older, newer, olderSchemaURL, testRenames)
+	require.NoError(t, err)
+	assert.Equal(t, want, res)
+
+	// The older Resource is upgraded regardless of merge order, and the
+	// precedence of b is maintained.
+	res, err = resource.MergeWithSchemaUpgrade(newer, older, olderSchemaURL, testRenames)
+	require.NoError(t, err)
+	assert.Equal(t, resource.NewWithAttributes(newerSchemaURL,
+		attribute.String("mid.name", "a"),
+		attribute.String("deployment.environment.name", "prod"),
+		attribute.String("shared", "older"),
+	), res)
+}
+
+func TestMergeWithSchemaUpgradeExistingKey(t *testing.T) {
+	// Both the old and new key are set: the value of the new key is kept.
+	older := resource.NewWithAttributes(olderSchemaURL,
+		attribute.String("deployment.environment", "old"),
+		attribute.String("deployment.environment.name", "new"),
+	)
+	newer := resource.NewWithAttributes(newerSchemaURL)
+
+	res, err := resource.MergeWithSchemaUpgrade(older, newer, olderSchemaURL, testRenames)
+	require.NoError(t, err)
+	assert.Equal(t, resource.NewWithAttributes(newerSchemaURL,
+		attribute.String("deployment.environment.name", "new"),
+	), res)
+}
+
+func TestMergeWithSchemaUpgradeEntities(t *testing.T) {
+	older := resource.NewWithEntities(olderSchemaURL, resource.Entity{
+		Type:        "deployment",
+		SchemaURL:   olderSchemaURL,
+		ID:          []attribute.KeyValue{attribute.String("deployment.environment", "prod")},
+		Description: []attribute.KeyValue{attribute.String("old.name", "a")},
+	})
+	newer := resource.NewWithAttributes(newerSchemaURL)
+
+	res, err := resource.MergeWithSchemaUpgrade(older, newer, olderSchemaURL, testRenames)
+	require.NoError(t, err)
+	assert.Equal(t, []resource.Entity{{
+		Type:        "deployment",
+		SchemaURL:   newerSchemaURL,
+		ID:          []attribute.KeyValue{attribute.String("deployment.environment.name", "prod")},
+		Description: []attribute.KeyValue{attribute.String("mid.name", "a")},
+	}}, res.Entities())
+}
+
+func TestMergeWithSchemaUpgradeSameOrEmptySchema(t *testing.T) {
+	a := resource.NewWithAttributes(olderSchemaURL, attribute.String("old.name", "a"))
+	b := resource.NewSchemaless(attribute.String("b", "1"))
+
+	res, err := resource.MergeWithSchemaUpgrade(a, b, olderSchemaURL, testRenames)
+	require.NoError(t, err)
+	want, _ := resource.Merge(a, b)
+	assert.Equal(t, want, res)
+
+	res, err = resource.MergeWithSchemaUpgrade(a, a, olderSchemaURL, testRenames)
+	require.NoError(t, err)
+	assert.Equal(t, a, res)
+}
+
+func TestMergeWithSchemaUpgradeUnknownSchemaURL(t *testing.T) {
+	a := resource.NewWithAttributes(olderSchemaURL, attribute.String("old.name", "a"))
+	b := resource.NewWithAttributes(newerSchemaURL)
+
+	res, err := resource.MergeWithSchemaUpgrade(a, b, "https://example.com/schemas/1.0.0", testRenames)
+	assert.ErrorIs(t, err, resource.ErrSchemaURLConflict)
+
+	want, _ := resource.Merge(a, b)
+	assert.Equal(t, want, res)
+}
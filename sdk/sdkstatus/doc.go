@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package sdkstatus provides snapshots of the status of the telemetry pipelines
+of an application: the providers, their processors or readers, the
+exporters, the queue depths, and the last export errors.
+
+It is intended to help diagnose why telemetry does not arrive to a backend,
+e.g. by serving the [Current] snapshot on a debug endpoint:
+
+	http.HandleFunc("/debug/otel", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(sdkstatus.Current())
+	})
+
+The global TracerProvider and MeterProvider are always included in the
+snapshots. Other providers, such as a LoggerProvider, need to be registered
+with [Register].
+*/
+package sdkstatus // import "go.opentelemetry.io/otel/sdk/sdkstatus"
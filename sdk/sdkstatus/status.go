@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sdkstatus // import "go.opentelemetry.io/otel/sdk/sdkstatus"
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+// Status is the status of a component of a telemetry pipeline, and of the
+// components it holds.
+type Status struct {
+	// Kind is the kind of the component, e.g. "TracerProvider" or
+	// "BatchSpanProcessor".
+	Kind string
+	// Shutdown is true if the component is shut down. A shut down component
+	// does not process any telemetry.
+	Shutdown bool
+	// Settings are the human-readable settings of the component, e.g. its
+	// sampler or its export interval.
+	Settings map[string]string
+	// Queue is the status of the queue of the component. It is nil if the
+	// component does not queue telemetry.
+	Queue *Queue
+	// Export is the status of the exports of the component. It is nil if the
+	// component does not export telemetry.
+	Export *Export
+	// Components are the statuses of the components held by the component,
+	// e.g. the processors of a provider.
+	Components []Status
+}
+
+// Queue is the status of the queue of a component.
+type Queue struct {
+	// Len is the number of items in the queue.
+	Len int
+	// Capacity is the maximum number of items the queue can hold.
+	Capacity int
+	// Dropped is the total number of items dropped because the queue was
+	// full.
+	Dropped uint64
+}
+
+// Export is the status of the exports of a component.
+type Export struct {
+	// Exporter is the type of the exporter.
+	Exporter string
+	// Count is the number of exports attempted.
+	Count uint64
+	// Failed is the number of exports that returned an error.
+	Failed uint64
+	// LastTime is the time of the last export. It is zero if nothing was
+	// exported.
+	LastTime time.Time
+	// LastError is the message of the error returned by the last failed
+	// export. It is empty if no export failed.
+	LastError string
+	// LastErrorTime is the time of the last failed export. It is zero if no
+	// export failed.
+	LastErrorTime time.Time
+}
+
+// Reporter is implemented by the components reporting their status. The
+// TracerProvider of go.opentelemetry.io/otel/sdk/trace, the MeterProvider of
+// go.opentelemetry.io/otel/sdk/metric, and the LoggerProvider of
+// go.opentelemetry.io/otel/sdk/log, as well as their processors and readers,
+// implement it.
+type Reporter interface {
+	// Status returns the current status of the component.
+	//
+	// This method needs to be safe to call concurrently.
+	Status() Status
+}
+
+// Pipeline is the status of a named telemetry pipeline.
+type Pipeline struct {
+	// Name is the name of the pipeline.
+	Name string
+	// Status is the status of the component at the root of the pipeline.
+	Status Status
+}
+
+// Snapshot is the status of all the known telemetry pipelines at a point in
+// time.
+type Snapshot struct {
+	// Time is the time the snapshot was taken.
+	Time time.Time
+	// Pipelines are the statuses of the global providers followed by the
+	// ones of the registered Reporters, in registration order.
+	Pipelines []Pipeline
+}
+
+type registration struct {
+	name string
+	r    Reporter
+}
+
+var (
+	registryMu sync.Mutex
+	registry   []*registration
+)
+
+// Register registers r to be included with name in the snapshots returned by
+// Current. The returned function unregisters r.
+//
+// The global TracerProvider and MeterProvider are always included. Use
+// Register to include a LoggerProvider, or providers that are not registered
+// globally.
+func Register(name string, r Reporter) (unregister func()) {
+	reg := &registration{name: name, r: r}
+
+	registryMu.Lock()
+	registry = append(registry, reg)
+	registryMu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			registryMu.Lock()
+			defer registryMu.Unlock()
+			for i, other := range registry {
+				if other == reg {
+					registry = append(registry[:i], registry[i+1:]...)
+					return
+				}
+			}
+		})
+	}
+}
+
+// Current returns a snapshot of the status of the global TracerProvider, the
+// global MeterProvider, and all the registered Reporters.
+//
+// A global provider that does not report its status, e.g. because no SDK
+// provider was registered globally, is included with its type as Kind.
+func Current() Snapshot {
+	s := Snapshot{
+		Time: time.Now(),
+		Pipelines: []Pipeline{
+			{Name: "global TracerProvider", Status: statusOf(otel.GetTracerProvider())},
+			{Name: "global MeterProvider", Status: statusOf(otel.GetMeterProvider())},
+		},
+	}
+
+	registryMu.Lock()
+	regs := make([]*registration, len(registry))
+	copy(regs, registry)
+	registryMu.Unlock()
+
+	for _, reg := range regs {
+		s.Pipelines = append(s.Pipelines, Pipeline{Name: reg.name, Status: statusOf(reg.r)})
+	}
+	return s
+}
+
+// statusOf returns the status of v if it is a Reporter. Otherwise, a Status
+// with the type of v as Kind is returned.
+func statusOf(v any) Status {
+	if r, ok := v.(Reporter); ok {
+		return r.Status()
+	}
+	return Status{Kind: fmt.Sprintf("%T", v)}
+}
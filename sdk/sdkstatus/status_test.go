@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sdkstatus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type reporter Status
+
+func (r reporter) Status() Status { return Status(r) }
+
+func TestCurrent(t *testing.T) {
+	s := Current()
+	assert.False(t, s.Time.IsZero(), "snapshot time")
+	require.Len(t, s.Pipelines, 2, "global providers")
+	assert.Equal(t, "global TracerProvider", s.Pipelines[0].Name)
+	assert.NotEmpty(t, s.Pipelines[0].Status.Kind)
+	assert.Equal(t, "global MeterProvider", s.Pipelines[1].Name)
+	assert.NotEmpty(t, s.Pipelines[1].Status.Kind)
+}
+
+func TestRegister(t *testing.T) {
+	a := reporter{Kind: "A", Queue: &Queue{Len: 1, Capacity: 2}}
+	b := reporter{Kind: "B", Export: &Export{Count: 3, Failed: 1, LastError: "failed"}}
+
+	unregA := Register("a", a)
+	unregB := Register("b", b)
+	t.Cleanup(unregB)
+
+	got := Current().Pipelines[2:]
+	assert.Equal(t, []Pipeline{
+		{Name: "a", Status: Status(a)},
+		{Name: "b", Status: Status(b)},
+	}, got)
+
+	unregA()
+	unregA() // Idempotent.
+	got = Current().Pipelines[2:]
+	assert.Equal(t, []Pipeline{{Name: "b", Status: Status(b)}}, got)
+}
@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+)
+
+// BaggageFilter determines if a baggage member is added to a span as an
+// attribute by a SpanProcessor created with NewBaggageSpanProcessor.
+type BaggageFilter func(baggage.Member) bool
+
+// AllowAllBaggage is a BaggageFilter that allows all baggage members.
+func AllowAllBaggage(baggage.Member) bool { return true }
+
+// AllowBaggageKeys returns a BaggageFilter that only allows baggage members
+// with one of the passed keys.
+func AllowBaggageKeys(keys ...string) BaggageFilter {
+	allowed := slices.Clone(keys)
+	return func(m baggage.Member) bool {
+		return slices.Contains(allowed, m.Key())
+	}
+}
+
+// baggageSpanProcessor is a SpanProcessor that adds baggage members from the
+// parent context to spans as attributes when they are started.
+type baggageSpanProcessor struct {
+	filter BaggageFilter
+}
+
+var _ SpanProcessor = baggageSpanProcessor{}
+
+// NewBaggageSpanProcessor returns a new SpanProcessor that adds the baggage
+// members contained in the parent context of a span, and allowed by filter,
+// to the span as attributes when it is started. The baggage member key is
+// used as the attribute key and the member value as a string attribute
+// value.
+//
+// If filter is nil, AllowAllBaggage is used.
+//
+// Baggage is commonly used to propagate values (e.g. a tenant ID or feature
+// flag) that should be recorded by all downstream spans. Care should be
+// taken to not record sensitive baggage set by untrusted upstream services.
+func NewBaggageSpanProcessor(filter BaggageFilter) SpanProcessor {
+	if filter == nil {
+		filter = AllowAllBaggage
+	}
+	return baggageSpanProcessor{filter: filter}
+}
+
+// OnStart adds the allowed baggage members from parent to s as attributes.
+func (p baggageSpanProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	members := baggage.FromContext(parent).Members()
+	if len(members) == 0 {
+		return
+	}
+
+	attrs := make([]attribute.KeyValue, 0, len(members))
+	for _, m := range members {
+		if p.filter(m) {
+			attrs = append(attrs, attribute.String(m.Key(), m.Value()))
+		}
+	}
+	if len(attrs) > 0 {
+		s.SetAttributes(attrs...)
+	}
+}
+
+// OnEnd does nothing.
+func (baggageSpanProcessor) OnEnd(ReadOnlySpan) {}
+
+// Shutdown does nothing.
+func (baggageSpanProcessor) Shutdown(context.Context) error { return nil }
+
+// ForceFlush does nothing.
+func (baggageSpanProcessor) ForceFlush(context.Context) error { return nil }
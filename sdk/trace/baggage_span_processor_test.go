@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+)
+
+func TestBaggageSpanProcessor(t *testing.T) {
+	tenant, err := baggage.NewMemberRaw("tenant", "acme")
+	require.NoError(t, err)
+	flag, err := baggage.NewMemberRaw("feature.flag", "on")
+	require.NoError(t, err)
+	bag, err := baggage.New(tenant, flag)
+	require.NoError(t, err)
+	ctx := baggage.ContextWithBaggage(context.Background(), bag)
+
+	tests := []struct {
+		name   string
+		filter BaggageFilter
+		want   []attribute.KeyValue
+	}{
+		{
+			name: "nil filter",
+			want: []attribute.KeyValue{
+				attribute.String("feature.flag", "on"),
+				attribute.String("tenant", "acme"),
+			},
+		},
+		{
+			name:   "allow all",
+			filter: AllowAllBaggage,
+			want: []attribute.KeyValue{
+				attribute.String("feature.flag", "on"),
+				attribute.String("tenant", "acme"),
+			},
+		},
+		{
+			name:   "allow keys",
+			filter: AllowBaggageKeys("tenant", "missing"),
+			want:   []attribute.KeyValue{attribute.String("tenant", "acme")},
+		},
+		{
+			name:   "predicate",
+			filter: func(m baggage.Member) bool { return m.Value() == "on" },
+			want:   []attribute.KeyValue{attribute.String("feature.flag", "on")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := new(recorder)
+			tp := NewTracerProvider(
+				WithSpanProcessor(NewBaggageSpanProcessor(tt.filter)),
+				WithSpanProcessor(rec),
+			)
+			_, span := tp.Tracer(t.Name()).Start(ctx, "span")
+			span.End()
+
+			require.Len(t, *rec, 1)
+			assert.ElementsMatch(t, tt.want, (*rec)[0].Attributes())
+		})
+	}
+}
+
+func TestBaggageSpanProcessorNoBaggage(t *testing.T) {
+	rec := new(recorder)
+	tp := NewTracerProvider(
+		WithSpanProcessor(NewBaggageSpanProcessor(nil)),
+		WithSpanProcessor(rec),
+	)
+	_, span := tp.Tracer(t.Name()).Start(context.Background(), "span")
+	span.End()
+
+	require.Len(t, *rec, 1)
+	assert.Empty(t, (*rec)[0].Attributes())
+}
+
+func TestBaggageSpanProcessorNoop(t *testing.T) {
+	p := NewBaggageSpanProcessor(nil)
+	assert.NoError(t, p.ForceFlush(context.Background()))
+	assert.NoError(t, p.Shutdown(context.Background()))
+}
@@ -10,8 +10,9 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/sdk/internal/env"
+	"go.opentelemetry.io/otel/sdk/internal/exportstatus"
+	"go.opentelemetry.io/otel/sdk/internal/logging"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -55,6 +56,9 @@ type BatchSpanProcessorOptions struct {
 	// Blocking option should be used carefully as it can severely affect the performance of an
 	// application.
 	BlockOnQueueFull bool
+
+	// ignoreEnv disables the configuration from environment variables.
+	ignoreEnv bool
 }
 
 // batchSpanProcessor is a SpanProcessor that batches asynchronously-received
@@ -65,6 +69,7 @@ type batchSpanProcessor struct {
 
 	queue   chan ReadOnlySpan
 	dropped uint32
+	exports exportstatus.Recorder
 
 	batch      []ReadOnlySpan
 	batchMutex sync.Mutex
@@ -73,6 +78,10 @@ type batchSpanProcessor struct {
 	stopOnce   sync.Once
 	stopCh     chan struct{}
 	stopped    atomic.Bool
+
+	// log is the internal logger of the TracerProvider bsp is registered
+	// with.
+	log logging.Pointer
 }
 
 var _ SpanProcessor = (*batchSpanProcessor)(nil)
@@ -81,9 +90,27 @@ var _ SpanProcessor = (*batchSpanProcessor)(nil)
 // span batches to the exporter with the supplied options.
 //
 // If the exporter is nil, the span processor will perform no action.
+//
+// The settings not configured by options are read from the OTEL_BSP_*
+// environment variables. Options always have precedence over environment
+// variables. Use WithoutBatchEnvironment to ignore these environment
+// variables.
 func NewBatchSpanProcessor(exporter SpanExporter, options ...BatchSpanProcessorOption) SpanProcessor {
-	maxQueueSize := env.BatchSpanProcessorMaxQueueSize(DefaultMaxQueueSize)
-	maxExportBatchSize := env.BatchSpanProcessorMaxExportBatchSize(DefaultMaxExportBatchSize)
+	// The options need to be known before reading the environment. They only
+	// set fields, applying them twice is safe.
+	var probe BatchSpanProcessorOptions
+	for _, opt := range options {
+		opt(&probe)
+	}
+
+	maxQueueSize, maxExportBatchSize := DefaultMaxQueueSize, DefaultMaxExportBatchSize
+	scheduleDelay, exportTimeout := DefaultScheduleDelay, DefaultExportTimeout
+	if !probe.ignoreEnv {
+		maxQueueSize = env.BatchSpanProcessorMaxQueueSize(maxQueueSize)
+		maxExportBatchSize = env.BatchSpanProcessorMaxExportBatchSize(maxExportBatchSize)
+		scheduleDelay = env.BatchSpanProcessorScheduleDelay(scheduleDelay)
+		exportTimeout = env.BatchSpanProcessorExportTimeout(exportTimeout)
+	}
 
 	if maxExportBatchSize > maxQueueSize {
 		if DefaultMaxExportBatchSize > maxQueueSize {
@@ -94,8 +121,8 @@ func NewBatchSpanProcessor(exporter SpanExporter, options ...BatchSpanProcessorO
 	}
 
 	o := BatchSpanProcessorOptions{
-		BatchTimeout:       time.Duration(env.BatchSpanProcessorScheduleDelay(DefaultScheduleDelay)) * time.Millisecond,
-		ExportTimeout:      time.Duration(env.BatchSpanProcessorExportTimeout(DefaultExportTimeout)) * time.Millisecond,
+		BatchTimeout:       time.Duration(scheduleDelay) * time.Millisecond,
+		ExportTimeout:      time.Duration(exportTimeout) * time.Millisecond,
 		MaxQueueSize:       maxQueueSize,
 		MaxExportBatchSize: maxExportBatchSize,
 	}
@@ -150,7 +177,7 @@ func (bsp *batchSpanProcessor) Shutdown(ctx context.Context) error {
 			bsp.stopWait.Wait()
 			if bsp.e != nil {
 				if err := bsp.e.Shutdown(ctx); err != nil {
-					otel.Handle(err)
+					handle(ctx, otel.SeverityError, err)
 				}
 			}
 			close(wait)
@@ -258,6 +285,20 @@ func WithBlocking() BatchSpanProcessorOption {
 	}
 }
 
+// WithoutBatchEnvironment returns a BatchSpanProcessorOption that configures
+// a BatchSpanProcessor to ignore the OTEL_BSP_* environment variables. The
+// defaults are used for the settings not configured by options.
+func WithoutBatchEnvironment() BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.ignoreEnv = true
+	}
+}
+
+// setInternalLogger sets the logger of the internal diagnostics of bsp.
+func (bsp *batchSpanProcessor) setInternalLogger(l logging.Logger) {
+	bsp.log.Store(l)
+}
+
 // exportSpans is a subroutine of processing and draining the queue.
 func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 	bsp.timer.Reset(bsp.o.BatchTimeout)
@@ -272,8 +313,9 @@ func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 	}
 
 	if l := len(bsp.batch); l > 0 {
-		global.Debug("exporting spans", "count", len(bsp.batch), "total_dropped", atomic.LoadUint32(&bsp.dropped))
+		bsp.log.Load().Debug("exporting spans", "count", len(bsp.batch), "total_dropped", atomic.LoadUint32(&bsp.dropped))
 		err := bsp.e.ExportSpans(ctx, bsp.batch)
+		bsp.exports.Record(err)
 
 		// A new batch is always created after exporting, even if the batch failed to be exported.
 		//
@@ -303,7 +345,7 @@ func (bsp *batchSpanProcessor) processQueue() {
 			return
 		case <-bsp.timer.C:
 			if err := bsp.exportSpans(ctx); err != nil {
-				otel.Handle(err)
+				handle(ctx, otel.SeverityError, err)
 			}
 		case sd := <-bsp.queue:
 			if ffs, ok := sd.(forceFlushSpan); ok {
@@ -323,7 +365,7 @@ func (bsp *batchSpanProcessor) processQueue() {
 					}
 				}
 				if err := bsp.exportSpans(ctx); err != nil {
-					otel.Handle(err)
+					handle(ctx, otel.SeverityError, err)
 				}
 			}
 		}
@@ -350,13 +392,13 @@ func (bsp *batchSpanProcessor) drainQueue() {
 
 			if shouldExport {
 				if err := bsp.exportSpans(ctx); err != nil {
-					otel.Handle(err)
+					handle(ctx, otel.SeverityError, err)
 				}
 			}
 		default:
 			// There are no more enqueued spans. Make final export.
 			if err := bsp.exportSpans(ctx); err != nil {
-				otel.Handle(err)
+				handle(ctx, otel.SeverityError, err)
 			}
 			return
 		}
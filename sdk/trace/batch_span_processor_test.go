@@ -244,6 +244,17 @@ func TestNewBatchSpanProcessorWithEnvOptions(t *testing.T) {
 				env.BatchSpanProcessorMaxExportBatchSizeKey: "10000",
 			},
 		},
+		{
+			name:           "BatchSpanProcessorEnvOptions - Ignored without batch environment",
+			o:              []BatchSpanProcessorOption{WithoutBatchEnvironment()},
+			wantNumSpans:   2053,
+			wantBatchCount: 4,
+			genNumSpans:    2053,
+			envs: map[string]string{
+				env.BatchSpanProcessorMaxQueueSizeKey:       "50",
+				env.BatchSpanProcessorMaxExportBatchSizeKey: "10000",
+			},
+		},
 	}
 
 	for _, option := range options {
@@ -148,6 +148,21 @@ func BenchmarkStartEndSpan(b *testing.B) {
 	})
 }
 
+func BenchmarkStartEndSpanWithOptions(b *testing.B) {
+	traceBenchmark(b, "Benchmark StartEndSpan With Options", func(b *testing.B, t trace.Tracer) {
+		ctx := context.Background()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, span := t.Start(
+				ctx, "/foo",
+				trace.WithAttributes(attribute.String("key", "value")),
+				trace.WithSpanKind(trace.SpanKindClient),
+			)
+			span.End()
+		}
+	})
+}
+
 func BenchmarkSpanWithAttributes_4(b *testing.B) {
 	traceBenchmark(b, "Benchmark Start With 4 Attributes", func(b *testing.B, t trace.Tracer) {
 		ctx := context.Background()
@@ -7,7 +7,7 @@ import (
 	"slices"
 	"sync"
 
-	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/internal/logging"
 )
 
 // evictedQueue is a FIFO queue with a configurable capacity.
@@ -15,22 +15,25 @@ type evictedQueue[T any] struct {
 	queue          []T
 	capacity       int
 	droppedCount   int
+	log            logging.Logger
 	logDroppedMsg  string
 	logDroppedOnce sync.Once
 }
 
-func newEvictedQueueEvent(capacity int) evictedQueue[Event] {
+func newEvictedQueueEvent(capacity int, log logging.Logger) evictedQueue[Event] {
 	// Do not pre-allocate queue, do this lazily.
 	return evictedQueue[Event]{
 		capacity:      capacity,
+		log:           log,
 		logDroppedMsg: "limit reached: dropping trace trace.Event",
 	}
 }
 
-func newEvictedQueueLink(capacity int) evictedQueue[Link] {
+func newEvictedQueueLink(capacity int, log logging.Logger) evictedQueue[Link] {
 	// Do not pre-allocate queue, do this lazily.
 	return evictedQueue[Link]{
 		capacity:      capacity,
+		log:           log,
 		logDroppedMsg: "limit reached: dropping trace trace.Link",
 	}
 }
@@ -55,7 +58,7 @@ func (eq *evictedQueue[T]) add(value T) {
 }
 
 func (eq *evictedQueue[T]) logDropped() {
-	eq.logDroppedOnce.Do(func() { global.Warn(eq.logDroppedMsg) })
+	eq.logDroppedOnce.Do(func() { eq.log.Warn(eq.logDroppedMsg) })
 }
 
 // copy returns a copy of the evictedQueue.
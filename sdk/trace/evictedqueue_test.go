@@ -12,13 +12,14 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/internal/logging"
 )
 
 func init() {
 }
 
 func TestAdd(t *testing.T) {
-	q := newEvictedQueueLink(3)
+	q := newEvictedQueueLink(3, logging.Logger{})
 	q.add(Link{})
 	q.add(Link{})
 	if wantLen, gotLen := 2, len(q.queue); wantLen != gotLen {
@@ -27,7 +28,7 @@ func TestAdd(t *testing.T) {
 }
 
 func TestCopy(t *testing.T) {
-	q := newEvictedQueueEvent(3)
+	q := newEvictedQueueEvent(3, logging.Logger{})
 	q.add(Event{Name: "value1"})
 	cp := q.copy()
 
@@ -39,7 +40,7 @@ func TestCopy(t *testing.T) {
 }
 
 func TestDropCount(t *testing.T) {
-	q := newEvictedQueueEvent(3)
+	q := newEvictedQueueEvent(3, logging.Logger{})
 
 	var called int
 	t.Cleanup(func(l logr.Logger) func() {
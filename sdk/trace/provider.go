@@ -6,12 +6,16 @@ package trace // import "go.opentelemetry.io/otel/sdk/trace"
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"sync/atomic"
 
+	"github.com/go-logr/logr"
+
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/internal/env"
+	"go.opentelemetry.io/otel/sdk/internal/logging"
 	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/embedded"
@@ -39,9 +43,26 @@ type tracerProviderConfig struct {
 
 	// spanLimits defines the attribute, event, and link limits for spans.
 	spanLimits SpanLimits
+	// spanLimitsSet is true if spanLimits are set by an option.
+	spanLimitsSet bool
+
+	// ignoreEnv disables the configuration from environment variables.
+	ignoreEnv bool
+	// disabled makes the TracerProvider provide no-op Tracers.
+	disabled bool
 
 	// resource contains attributes representing an entity that produces telemetry.
 	resource *resource.Resource
+
+	// asyncResource, if not nil, is used instead of resource.
+	asyncResource *resource.Async
+
+	// semconvValidation enables the validation of span attribute keys
+	// against the semantic conventions.
+	semconvValidation bool
+
+	// log logs the internal diagnostics of the TracerProvider.
+	log logging.Logger
 }
 
 // MarshalLog is the marshaling function used by the logging system to represent this Provider.
@@ -72,12 +93,28 @@ type TracerProvider struct {
 
 	isShutdown atomic.Bool
 
+	hooksMu       sync.Mutex
+	shutdownHooks []*shutdownHook
+
+	// sampler can be replaced at runtime with SetSampler.
+	sampler atomic.Pointer[Sampler]
+
 	// These fields are not protected by the lock mu. They are assumed to be
 	// immutable after creation of the TracerProvider.
-	sampler     Sampler
 	idGenerator IDGenerator
 	spanLimits  SpanLimits
 	resource    *resource.Resource
+
+	asyncResource *resource.Async
+
+	// semconvValidator is nil if attribute keys are not validated.
+	semconvValidator *semconvValidator
+
+	// disabled is true if the SDK is disabled by the OTEL_SDK_DISABLED
+	// environment variable.
+	disabled bool
+
+	log logging.Logger
 }
 
 var _ trace.TracerProvider = &TracerProvider{}
@@ -92,29 +129,54 @@ var _ trace.TracerProvider = &TracerProvider{}
 //
 // The passed opts are used to override these default values and configure the
 // returned TracerProvider appropriately.
+//
+// The sampler and the span limits not configured by opts are read from the
+// OTEL_TRACES_SAMPLER, OTEL_TRACES_SAMPLER_ARG, and span limits environment
+// variables. Options always have precedence over environment variables. If
+// the OTEL_SDK_DISABLED environment variable is true, the returned
+// TracerProvider provides no-op Tracers and does not flush its
+// SpanProcessors, they are only shut down with it. Use WithoutEnvironment to
+// ignore all these environment variables.
 func NewTracerProvider(opts ...TracerProviderOption) *TracerProvider {
-	o := tracerProviderConfig{
-		spanLimits: NewSpanLimits(),
-	}
-	o = applyTracerProviderEnvConfigs(o)
-
+	var o tracerProviderConfig
 	for _, opt := range opts {
 		o = opt.apply(o)
 	}
 
+	if !o.spanLimitsSet {
+		if o.ignoreEnv {
+			o.spanLimits = defaultSpanLimits()
+		} else {
+			o.spanLimits = NewSpanLimits()
+		}
+	}
+	if !o.ignoreEnv {
+		o = applyTracerProviderEnvConfigs(o)
+	}
+
 	o = ensureValidTracerProviderConfig(o)
 
 	tp := &TracerProvider{
 		namedTracer: make(map[instrumentation.Scope]*tracer),
-		sampler:     o.sampler,
 		idGenerator: o.idGenerator,
 		spanLimits:  o.spanLimits,
 		resource:    o.resource,
+
+		asyncResource: o.asyncResource,
+
+		disabled: o.disabled,
+
+		log: o.log,
+	}
+	tp.sampler.Store(&o.sampler)
+	if o.semconvValidation {
+		tp.semconvValidator = &semconvValidator{}
 	}
-	global.Info("TracerProvider created", "config", o)
+	tp.log.Info("TracerProvider created", "config", o)
 
 	spss := make(spanProcessorStates, 0, len(o.processors))
 	for _, sp := range o.processors {
+		setInternalLogger(sp, tp.log)
 		spss = append(spss, newSpanProcessorState(sp))
 	}
 	tp.spanProcessors.Store(&spss)
@@ -122,6 +184,36 @@ func NewTracerProvider(opts ...TracerProviderOption) *TracerProvider {
 	return tp
 }
 
+// getSampler returns the Sampler used to sample new spans.
+func (p *TracerProvider) getSampler() Sampler {
+	return *p.sampler.Load()
+}
+
+// SetSampler replaces the Sampler of p. All spans started after this call are
+// sampled by sampler, including the ones of the Tracers already returned by p.
+// The spans already started and the SpanProcessors are not affected. This
+// allows changing the sampling of an application at runtime, e.g. from a
+// remote configuration, without recreating the telemetry pipeline.
+//
+// If sampler is nil, the default ParentBased(AlwaysSample) Sampler is used.
+//
+// This method is safe to be called concurrently.
+func (p *TracerProvider) SetSampler(sampler Sampler) {
+	if sampler == nil {
+		sampler = ParentBased(AlwaysSample())
+	}
+	p.sampler.Store(&sampler)
+	p.log.Info("TracerProvider sampler changed", "sampler", sampler.Description())
+}
+
+// getResource returns the Resource telemetry is currently associated with.
+func (p *TracerProvider) getResource() *resource.Resource {
+	if p.asyncResource != nil {
+		return p.asyncResource.Resource()
+	}
+	return p.resource
+}
+
 // Tracer returns a Tracer with the given name and options. If a Tracer for
 // the given name and options does not exist it is created, otherwise the
 // existing Tracer is returned.
@@ -131,7 +223,7 @@ func NewTracerProvider(opts ...TracerProviderOption) *TracerProvider {
 // This method is safe to be called concurrently.
 func (p *TracerProvider) Tracer(name string, opts ...trace.TracerOption) trace.Tracer {
 	// This check happens before the mutex is acquired to avoid deadlocking if Tracer() is called from within Shutdown().
-	if p.isShutdown.Load() {
+	if p.disabled || p.isShutdown.Load() {
 		return noop.NewTracerProvider().Tracer(name, opts...)
 	}
 	c := trace.NewTracerConfig(opts...)
@@ -169,7 +261,7 @@ func (p *TracerProvider) Tracer(name string, opts ...trace.TracerOption) trace.T
 		//   slowing down all tracing consumers.
 		// - Logging code may be instrumented with tracing and deadlock because it could try
 		//   acquiring the same non-reentrant mutex.
-		global.Info(
+		p.log.Info(
 			"Tracer created",
 			"name",
 			name,
@@ -197,6 +289,7 @@ func (p *TracerProvider) RegisterSpanProcessor(sp SpanProcessor) {
 		return
 	}
 
+	setInternalLogger(sp, p.log)
 	current := p.getSpanProcessors()
 	newSPS := make(spanProcessorStates, 0, len(current)+1)
 	newSPS = append(newSPS, current...)
@@ -204,6 +297,21 @@ func (p *TracerProvider) RegisterSpanProcessor(sp SpanProcessor) {
 	p.spanProcessors.Store(&newSPS)
 }
 
+// internalLoggerSetter is implemented by the SpanProcessors logging their
+// internal diagnostics with the logger of the TracerProvider they are
+// registered with.
+type internalLoggerSetter interface {
+	setInternalLogger(logging.Logger)
+}
+
+// setInternalLogger sets the internal logger of sp to l if sp logs its
+// internal diagnostics.
+func setInternalLogger(sp SpanProcessor, l logging.Logger) {
+	if s, ok := sp.(internalLoggerSetter); ok {
+		s.setInternalLogger(l)
+	}
+}
+
 // UnregisterSpanProcessor removes the given SpanProcessor from the list of SpanProcessors.
 func (p *TracerProvider) UnregisterSpanProcessor(sp SpanProcessor) {
 	// This check prevents calls during a shutdown.
@@ -234,8 +342,9 @@ func (p *TracerProvider) UnregisterSpanProcessor(sp SpanProcessor) {
 	}
 	if stopOnce != nil {
 		stopOnce.state.Do(func() {
-			if err := sp.Shutdown(context.Background()); err != nil {
-				otel.Handle(err)
+			ctx := context.Background()
+			if err := sp.Shutdown(ctx); err != nil {
+				handle(ctx, otel.SeverityError, err)
 			}
 		})
 	}
@@ -252,7 +361,7 @@ func (p *TracerProvider) UnregisterSpanProcessor(sp SpanProcessor) {
 // all the registered span processors.
 func (p *TracerProvider) ForceFlush(ctx context.Context) error {
 	spss := p.getSpanProcessors()
-	if len(spss) == 0 {
+	if p.disabled || len(spss) == 0 {
 		return nil
 	}
 
@@ -286,6 +395,26 @@ func (p *TracerProvider) Shutdown(ctx context.Context) error {
 	}
 
 	var retErr error
+	hooks := p.takeShutdownHooks()
+	// Call hooks in the reverse order they were registered, like deferred
+	// functions.
+	for i := len(hooks) - 1; i >= 0; i-- {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if err := hooks[i].fn(ctx); err != nil {
+			if retErr == nil {
+				retErr = err
+			} else {
+				// Poor man's list of errors
+				retErr = fmt.Errorf("%w; %w", retErr, err)
+			}
+		}
+	}
+
 	for _, sps := range p.getSpanProcessors() {
 		select {
 		case <-ctx.Done():
@@ -310,6 +439,56 @@ func (p *TracerProvider) Shutdown(ctx context.Context) error {
 	return retErr
 }
 
+// shutdownHook is a function registered to be called on Shutdown.
+type shutdownHook struct {
+	fn func(context.Context) error
+}
+
+// RegisterShutdownHook registers hook to be called when p is shut down. Hooks
+// are called before the registered SpanProcessors are shut down, in the
+// reverse order they were registered, and errors they return are returned
+// from Shutdown. The hook must not call Shutdown.
+//
+// The returned function unregisters hook. If p is already shut down, hook is
+// not registered.
+//
+// Instrumentation libraries should use [trace.RegisterShutdownHook] instead,
+// which supports any TracerProvider.
+func (p *TracerProvider) RegisterShutdownHook(hook func(context.Context) error) (unregister func()) {
+	if hook == nil {
+		return func() {}
+	}
+
+	p.hooksMu.Lock()
+	// Shutdown sets isShutdown before it takes the hooks while holding
+	// hooksMu. Checking it here guarantees a registered hook is called.
+	if p.isShutdown.Load() {
+		p.hooksMu.Unlock()
+		return func() {}
+	}
+	h := &shutdownHook{fn: hook}
+	p.shutdownHooks = append(p.shutdownHooks, h)
+	p.hooksMu.Unlock()
+
+	return func() {
+		p.hooksMu.Lock()
+		defer p.hooksMu.Unlock()
+		p.shutdownHooks = slices.DeleteFunc(p.shutdownHooks, func(e *shutdownHook) bool {
+			return e == h
+		})
+	}
+}
+
+// takeShutdownHooks returns the registered shutdown hooks and unregisters
+// them.
+func (p *TracerProvider) takeShutdownHooks() []*shutdownHook {
+	p.hooksMu.Lock()
+	defer p.hooksMu.Unlock()
+	hooks := p.shutdownHooks
+	p.shutdownHooks = nil
+	return hooks
+}
+
 func (p *TracerProvider) getSpanProcessors() spanProcessorStates {
 	return *(p.spanProcessors.Load())
 }
@@ -363,8 +542,26 @@ func WithResource(r *resource.Resource) TracerProviderOption {
 		var err error
 		cfg.resource, err = resource.Merge(resource.Environment(), r)
 		if err != nil {
-			otel.Handle(err)
+			handle(context.Background(), otel.SeverityWarning, err)
 		}
+		cfg.asyncResource = nil
+		return cfg
+	})
+}
+
+// WithAsyncResource returns a TracerProviderOption that will configure the
+// asynchronously detected Resource a as a TracerProvider's Resource. Spans
+// are associated with the Resource a has detected at the time they end, so
+// they are produced with a partial Resource until detection is complete and
+// reflect any subsequent refresh of a.
+//
+// Unlike WithResource, the Resource is not merged with the
+// resource.Environment() Resource. Use resource.WithFromEnv when creating a
+// to include it.
+func WithAsyncResource(a *resource.Async) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.resource = nil
+		cfg.asyncResource = a
 		return cfg
 	})
 }
@@ -440,6 +637,7 @@ func WithSpanLimits(sl SpanLimits) TracerProviderOption {
 	}
 	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
 		cfg.spanLimits = sl
+		cfg.spanLimitsSet = true
 		return cfg
 	})
 }
@@ -462,11 +660,89 @@ func WithSpanLimits(sl SpanLimits) TracerProviderOption {
 func WithRawSpanLimits(limits SpanLimits) TracerProviderOption {
 	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
 		cfg.spanLimits = limits
+		cfg.spanLimitsSet = true
 		return cfg
 	})
 }
 
+// WithSemconvValidation returns a TracerProviderOption that enables the
+// validation of span attribute keys against the semantic conventions the SDK
+// is built with.
+//
+// An attribute key in a namespace defined by the semantic conventions (e.g.
+// "http.status") that is not a key defined by them (e.g.
+// "http.response.status_code") is reported to the global ErrorHandler once.
+// Keys in other namespaces (e.g. "myapp.tenant") are not reported.
+//
+// This is intended to catch misspelled attribute keys during development.
+// It adds an overhead to setting span attributes and should not be used in
+// production.
+func WithSemconvValidation() TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.semconvValidation = true
+		return cfg
+	})
+}
+
+// WithInternalLogger returns a TracerProviderOption that configures the
+// logger used by the TracerProvider, and the Tracers and Spans it creates, to
+// log internal diagnostics. This allows those diagnostics to be routed to the
+// logger of the application instead of the global logger set with
+// go.opentelemetry.io/otel.SetLogger.
+//
+// Messages are logged with the same verbosity levels as the global logger:
+// warnings with V(1), informational messages with V(4), and debug messages
+// with V(8).
+//
+// The BatchSpanProcessor also logs with l once registered with the
+// TracerProvider. Other span processors are configured independently.
+//
+// If this option is not used, the global logger is used.
+func WithInternalLogger(l logr.Logger) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.log = cfg.log.WithLogger(l)
+		return cfg
+	})
+}
+
+// WithInternalLogLevel returns a TracerProviderOption that configures the
+// most verbose level of the internal diagnostics logged by the
+// TracerProvider, and the Tracers, Spans, and BatchSpanProcessors registered
+// with it. Messages logged with a greater verbosity are dropped: use 0 to
+// only log errors, 1 to also log warnings, 4 informational messages, and 8
+// debug messages.
+//
+// If this option is not used, all messages are passed to the logger, which
+// decides the verbosity levels it logs.
+func WithInternalLogLevel(level int) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.log = cfg.log.WithLevel(logging.Level(level))
+		return cfg
+	})
+}
+
+// WithoutEnvironment returns a TracerProviderOption that configures a
+// TracerProvider to ignore the OTEL_SDK_DISABLED, OTEL_TRACES_SAMPLER,
+// OTEL_TRACES_SAMPLER_ARG, and span limits environment variables. The
+// defaults are used for the settings not configured by options.
+//
+// This does not apply to the span processors registered with the
+// TracerProvider. Use WithoutBatchEnvironment for a BatchSpanProcessor.
+func WithoutEnvironment() TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.ignoreEnv = true
+		return cfg
+	})
+}
+
+// applyTracerProviderEnvConfigs applies the environment variable
+// configuration to the settings of cfg not configured by options.
 func applyTracerProviderEnvConfigs(cfg tracerProviderConfig) tracerProviderConfig {
+	cfg.disabled = env.SDKDisabled()
+	if cfg.sampler != nil {
+		// Options have precedence.
+		return cfg
+	}
 	for _, opt := range tracerProviderOptionsFromEnv() {
 		cfg = opt.apply(cfg)
 	}
@@ -479,7 +755,7 @@ func tracerProviderOptionsFromEnv() []TracerProviderOption {
 
 	sampler, err := samplerFromEnv()
 	if err != nil {
-		otel.Handle(err)
+		handle(context.Background(), otel.SeverityWarning, err)
 	}
 
 	if sampler != nil {
@@ -10,11 +10,16 @@ import (
 	"math/rand/v2"
 	"testing"
 
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/internal/env"
 	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 const (
@@ -189,6 +194,54 @@ func TestFailedProcessorShutdownInUnregister(t *testing.T) {
 	assert.True(t, stp.isShutdown.Load())
 }
 
+func TestShutdownHooks(t *testing.T) {
+	var calls []string
+	hook := func(name string, err error) func(context.Context) error {
+		return func(context.Context) error {
+			calls = append(calls, name)
+			return err
+		}
+	}
+
+	stp := NewTracerProvider()
+	stp.RegisterSpanProcessor(&shutdownSpanProcessor{
+		shutdown: hook("processor", nil),
+	})
+	errHook := errors.New("hook error")
+	stp.RegisterShutdownHook(hook("first", errHook))
+	unregister, ok := trace.RegisterShutdownHook(stp, hook("unregistered", nil))
+	require.True(t, ok)
+	stp.RegisterShutdownHook(hook("second", nil))
+	unregister()
+	unregister()
+
+	assert.ErrorIs(t, stp.Shutdown(context.Background()), errHook)
+	assert.Equal(t, []string{"second", "first", "processor"}, calls)
+
+	calls = nil
+	stp.RegisterShutdownHook(hook("after shutdown", nil))
+	assert.NoError(t, stp.Shutdown(context.Background()))
+	assert.Empty(t, calls)
+}
+
+func TestTracerEnabled(t *testing.T) {
+	ctx := context.Background()
+	param := trace.EnabledParameters{SpanKind: trace.SpanKindServer}
+
+	tp := NewTracerProvider()
+	assert.False(t, tp.Tracer("").Enabled(ctx, param), "no processors")
+
+	tp = NewTracerProvider(WithSpanProcessor(&basicSpanProcessor{}))
+	assert.True(t, tp.Tracer("").Enabled(ctx, param), "registered processor")
+
+	tp = NewTracerProvider(WithSpanProcessor(&basicSpanProcessor{}), WithSampler(NeverSample()))
+	assert.False(t, tp.Tracer("").Enabled(ctx, param), "never sample")
+
+	tp = NewTracerProvider(WithSpanProcessor(&basicSpanProcessor{}))
+	require.NoError(t, tp.Shutdown(ctx))
+	assert.False(t, tp.Tracer("").Enabled(ctx, param), "shut down")
+}
+
 func TestSchemaURL(t *testing.T) {
 	stp := NewTracerProvider()
 	schemaURL := "https://opentelemetry.io/schemas/1.2.0"
@@ -324,7 +377,7 @@ func TestTracerProviderSamplerConfigFromEnv(t *testing.T) {
 			}
 
 			stp := NewTracerProvider(WithSyncer(NewTestExporter()))
-			assert.Equal(t, test.description, stp.sampler.Description())
+			assert.Equal(t, test.description, stp.getSampler().Description())
 			if test.errorType != nil {
 				testStoredError(t, test.errorType)
 			} else {
@@ -340,7 +393,7 @@ func TestTracerProviderSamplerConfigFromEnv(t *testing.T) {
 					t.Cleanup(func() {
 						require.NoError(t, stp.Shutdown(context.Background()))
 					})
-					assert.Equal(t, test.description, stp.sampler.Description())
+					assert.Equal(t, test.description, stp.getSampler().Description())
 
 					if test.invalidArgErrorType != nil {
 						testStoredError(t, test.invalidArgErrorType)
@@ -371,6 +424,54 @@ func testStoredError(t *testing.T, target interface{}) {
 	}
 }
 
+func TestTracerProviderDisabledFromEnv(t *testing.T) {
+	t.Setenv(env.SDKDisabledKey, "true")
+
+	sp := &basicSpanProcessor{}
+	tp := NewTracerProvider(WithSpanProcessor(sp))
+	tr := tp.Tracer("testing")
+	assert.IsType(t, noop.Tracer{}, tr)
+
+	// The span processors are not flushed, but they are shut down.
+	assert.NoError(t, tp.ForceFlush(context.Background()))
+	assert.False(t, sp.flushed)
+	assert.NoError(t, tp.Shutdown(context.Background()))
+	assert.True(t, sp.closed)
+
+	tr = NewTracerProvider(WithoutEnvironment()).Tracer("testing")
+	assert.IsType(t, &tracer{}, tr)
+}
+
+func TestTracerProviderSetSampler(t *testing.T) {
+	tp := NewTracerProvider(WithSpanProcessor(new(recorder)))
+	tr := tp.Tracer("TestTracerProviderSetSampler")
+	ctx := context.Background()
+
+	_, span := tr.Start(ctx, "span")
+	assert.True(t, span.IsRecording(), "default sampler")
+
+	tp.SetSampler(NeverSample())
+	_, span = tr.Start(ctx, "span")
+	assert.False(t, span.IsRecording(), "NeverSample sampler")
+	assert.False(t, tr.(*tracer).Enabled(ctx, trace.EnabledParameters{}))
+
+	tp.SetSampler(nil)
+	_, span = tr.Start(ctx, "span")
+	assert.True(t, span.IsRecording(), "nil sampler")
+	assert.Equal(t, ParentBased(AlwaysSample()).Description(), tp.getSampler().Description())
+}
+
+func TestTracerProviderWithoutEnvironment(t *testing.T) {
+	t.Setenv(envTracesSampler, "always_off")
+
+	stp := NewTracerProvider(WithoutEnvironment())
+	got := stp.getSampler().Description()
+	assert.Equal(t, ParentBased(AlwaysSample()).Description(), got)
+
+	stp = NewTracerProvider(WithoutEnvironment(), WithSampler(NeverSample()))
+	assert.Equal(t, NeverSample().Description(), stp.getSampler().Description())
+}
+
 func TestTracerProviderReturnsSameTracer(t *testing.T) {
 	p := NewTracerProvider()
 
@@ -398,3 +499,73 @@ func TestTracerProviderReturnsSameTracer(t *testing.T) {
 	assert.Same(t, t1, t4)
 	assert.Same(t, t2, t5)
 }
+
+func TestWithInternalLogger(t *testing.T) {
+	var globalMsgs []string
+	t.Cleanup(func(l logr.Logger) func() {
+		return func() { global.SetLogger(l) }
+	}(global.GetLogger()))
+	global.SetLogger(funcr.New(func(_, args string) {
+		globalMsgs = append(globalMsgs, args)
+	}, funcr.Options{Verbosity: 8}))
+
+	var msgs []string
+	l := funcr.New(func(_, args string) {
+		msgs = append(msgs, args)
+	}, funcr.Options{Verbosity: 8})
+
+	tp := NewTracerProvider(
+		WithInternalLogger(l),
+		WithSpanLimits(SpanLimits{
+			AttributeCountLimit: 1,
+			EventCountLimit:     1,
+			LinkCountLimit:      1,
+		}),
+	)
+	_, span := tp.Tracer("test").Start(context.Background(), "span")
+	span.SetAttributes(attribute.Int("a", 1), attribute.Int("b", 2))
+	span.AddEvent("e0")
+	span.AddEvent("e1")
+	span.End()
+
+	assert.Empty(t, globalMsgs, "messages logged to the global logger")
+	require.Len(t, msgs, 4)
+	assert.Contains(t, msgs[0], "TracerProvider created")
+	assert.Contains(t, msgs[1], "Tracer created")
+	assert.Contains(t, msgs[2], "dropping trace Span attributes")
+	assert.Contains(t, msgs[3], "dropping trace trace.Event")
+}
+
+func TestWithInternalLogLevel(t *testing.T) {
+	var msgs []string
+	l := funcr.New(func(_, args string) {
+		msgs = append(msgs, args)
+	}, funcr.Options{Verbosity: 8})
+
+	tp := NewTracerProvider(
+		WithInternalLogger(l),
+		WithInternalLogLevel(1),
+		WithSpanLimits(SpanLimits{AttributeCountLimit: 1}),
+	)
+	_, span := tp.Tracer("test").Start(context.Background(), "span")
+	span.SetAttributes(attribute.Int("a", 1), attribute.Int("b", 2))
+	span.End()
+
+	require.Len(t, msgs, 1, "only warnings logged")
+	assert.Contains(t, msgs[0], "dropping trace Span attributes")
+}
+
+func TestBatchSpanProcessorInternalLogger(t *testing.T) {
+	var msgs []string
+	l := funcr.New(func(_, args string) {
+		msgs = append(msgs, args)
+	}, funcr.Options{Verbosity: 8})
+
+	tp := NewTracerProvider(WithInternalLogger(l), WithInternalLogLevel(8))
+	tp.RegisterSpanProcessor(NewBatchSpanProcessor(NewTestExporter()))
+	_, span := tp.Tracer("test").Start(context.Background(), "span")
+	span.End()
+	require.NoError(t, tp.Shutdown(context.Background()))
+
+	assert.Contains(t, msgs, `"level"=8 "msg"="exporting spans" "count"=1 "total_dropped"=0`)
+}
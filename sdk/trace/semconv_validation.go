@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate semconvkeysgen -semconv ../../semconv/v1.26.0 -package trace -output semconv_keys.go
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// semconvTemplateKeys are the prefixes of the template attribute keys of the
+// semantic conventions of semconvKeys. Keys of template attributes are the
+// prefix followed by a user defined suffix. They are not declared by the
+// semconv packages, so they are maintained here.
+var semconvTemplateKeys = []string{
+	"db.elasticsearch.path_parts.",
+	"http.request.header.",
+	"http.response.header.",
+	"rpc.connect_rpc.request.metadata.",
+	"rpc.connect_rpc.response.metadata.",
+	"rpc.grpc.request.metadata.",
+	"rpc.grpc.response.metadata.",
+}
+
+// semconvNamespaces are the root namespaces of semconvKeys (e.g. "http").
+var semconvNamespaces = sync.OnceValue(func() map[string]struct{} {
+	ns := make(map[string]struct{})
+	for k := range semconvKeys {
+		root, _, _ := strings.Cut(string(k), ".")
+		ns[root] = struct{}{}
+	}
+	return ns
+})
+
+// isUnknownSemconvKey returns true if key is in a namespace defined by the
+// semantic conventions, but is not a key defined by them. Keys in other
+// namespaces (e.g. "myapp.tenant") are never unknown.
+func isUnknownSemconvKey(key attribute.Key) bool {
+	root, _, found := strings.Cut(string(key), ".")
+	if !found {
+		return false
+	}
+	if _, ok := semconvNamespaces()[root]; !ok {
+		return false
+	}
+	if _, ok := semconvKeys[key]; ok {
+		return false
+	}
+	for _, prefix := range semconvTemplateKeys {
+		if strings.HasPrefix(string(key), prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// semconvValidator reports span attribute keys that are unknown to the
+// semantic conventions to the global ErrorHandler.
+type semconvValidator struct {
+	// reported holds the keys already reported, so each is reported once.
+	reported sync.Map
+}
+
+// validate reports the keys of attrs unknown to the semantic conventions not
+// already reported.
+func (v *semconvValidator) validate(attrs []attribute.KeyValue) {
+	for _, a := range attrs {
+		if !isUnknownSemconvKey(a.Key) {
+			continue
+		}
+		if _, loaded := v.reported.LoadOrStore(a.Key, struct{}{}); !loaded {
+			err := fmt.Errorf("unknown semantic convention attribute key: %q", a.Key)
+			handle(context.Background(), otel.SeverityWarning, err, attribute.String("attribute.key", string(a.Key)))
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"go/constant"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestIsUnknownSemconvKey(t *testing.T) {
+	tests := []struct {
+		key  attribute.Key
+		want bool
+	}{
+		{semconv.HTTPResponseStatusCodeKey, false},
+		{semconv.ServiceNameKey, false},
+		{"http.request.header.content-type", false},
+		{"myapp.tenant", false},
+		{"http", false},
+		{"http.status", true},
+		{"db.statment", true},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, isUnknownSemconvKey(test.key), test.key)
+	}
+}
+
+func TestSemconvKeysConsistency(t *testing.T) {
+	// Type-check the semconv package the keys are generated from, and
+	// compare its attribute.Key declarations with semconvKeys. Run "make
+	// generate" if they differ.
+	imp := importer.ForCompiler(token.NewFileSet(), "source", nil)
+	pkg, err := imp.Import("go.opentelemetry.io/otel/semconv/v1.26.0")
+	require.NoError(t, err)
+
+	want := make(map[attribute.Key]struct{})
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok {
+			continue
+		}
+		named, ok := c.Type().(*types.Named)
+		if !ok || named.Obj().Pkg().Path() != "go.opentelemetry.io/otel/attribute" || named.Obj().Name() != "Key" {
+			continue
+		}
+		want[attribute.Key(constant.StringVal(c.Val()))] = struct{}{}
+	}
+	assert.Equal(t, want, semconvKeys)
+}
+
+func TestWithSemconvValidation(t *testing.T) {
+	handler.Reset()
+	t.Cleanup(handler.Reset)
+
+	tp := NewTracerProvider(WithSemconvValidation())
+	_, span := tp.Tracer("SemconvValidation").Start(
+		context.Background(),
+		"span",
+		trace.WithAttributes(attribute.Int("http.status", 200)),
+	)
+	span.SetAttributes(
+		attribute.Int("http.status", 500),
+		semconv.HTTPResponseStatusCode(500),
+		attribute.String("myapp.tenant", "a"),
+	)
+	span.End()
+
+	require.Len(t, handler.errs, 1, "unknown key not reported once")
+	assert.ErrorContains(t, handler.errs[0], `"http.status"`)
+	require.Len(t, handler.diagnostics, 1)
+	d := handler.diagnostics[0]
+	assert.Equal(t, "go.opentelemetry.io/otel/sdk/trace", d.Component)
+	assert.Equal(t, otel.SeverityWarning, d.Severity)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("attribute.key", "http.status")}, d.Attributes)
+
+	handler.Reset()
+	_, span = NewTracerProvider().Tracer("SemconvValidation").Start(
+		context.Background(),
+		"span",
+		trace.WithAttributes(attribute.Int("http.status", 200)),
+	)
+	span.End()
+	assert.Empty(t, handler.errs, "validation not opt-in")
+}
@@ -9,6 +9,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/internal/exportstatus"
 )
 
 // simpleSpanProcessor is a SpanProcessor that synchronously sends all
@@ -17,6 +18,7 @@ type simpleSpanProcessor struct {
 	exporterMu sync.Mutex
 	exporter   SpanExporter
 	stopOnce   sync.Once
+	exports    exportstatus.Recorder
 }
 
 var _ SpanProcessor = (*simpleSpanProcessor)(nil)
@@ -47,8 +49,11 @@ func (ssp *simpleSpanProcessor) OnEnd(s ReadOnlySpan) {
 	defer ssp.exporterMu.Unlock()
 
 	if ssp.exporter != nil && s.SpanContext().TraceFlags().IsSampled() {
-		if err := ssp.exporter.ExportSpans(context.Background(), []ReadOnlySpan{s}); err != nil {
-			otel.Handle(err)
+		ctx := context.Background()
+		err := ssp.exporter.ExportSpans(ctx, []ReadOnlySpan{s})
+		ssp.exports.Record(err)
+		if err != nil {
+			handle(ctx, otel.SeverityError, err)
 		}
 	}
 }
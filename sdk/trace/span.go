@@ -17,8 +17,8 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
-	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/internal/logging"
 	"go.opentelemetry.io/otel/sdk/resource"
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 	"go.opentelemetry.io/otel/trace"
@@ -116,6 +116,11 @@ type recordingSpan struct {
 	// startTime is the time at which this span was started.
 	startTime time.Time
 
+	// startMonotonic is the monotonic clock reading provided at the start of
+	// this span, if hasStartMonotonic is true.
+	startMonotonic    time.Duration
+	hasStartMonotonic bool
+
 	// endTime is the time at which this span was ended. It contains the zero
 	// value of time.Time until the span is ended.
 	endTime time.Time
@@ -148,6 +153,10 @@ type recordingSpan struct {
 	// executionTracerTaskEnd ends the execution tracer span.
 	executionTracerTaskEnd func()
 
+	// stopAutoEnd stops this span from being ended when its context is
+	// canceled. It is nil if the span is not automatically ended.
+	stopAutoEnd func() bool
+
 	// tracer is the SDK tracer that created this span.
 	tracer *tracer
 }
@@ -229,6 +238,12 @@ func (s *recordingSpan) SetAttributes(attributes ...attribute.KeyValue) {
 		return
 	}
 
+	// Validate before acquiring the lock so the ErrorHandler is not called
+	// while holding it.
+	if v := s.tracer.provider.semconvValidator; v != nil {
+		v.validate(attributes)
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if !s.isRecording() {
@@ -264,8 +279,8 @@ func (s *recordingSpan) SetAttributes(attributes ...attribute.KeyValue) {
 }
 
 // Declared as a var so tests can override.
-var logDropAttrs = func() {
-	global.Warn("limit reached: dropping trace Span attributes")
+var logDropAttrs = func(log logging.Logger) {
+	log.Warn("limit reached: dropping trace Span attributes")
 }
 
 // addDroppedAttr adds incr to the count of dropped attributes.
@@ -276,7 +291,7 @@ var logDropAttrs = func() {
 // This method assumes s.mu.Lock is held by the caller.
 func (s *recordingSpan) addDroppedAttr(incr int) {
 	s.droppedAttributes += incr
-	s.logDropAttrsOnce.Do(logDropAttrs)
+	s.logDropAttrsOnce.Do(func() { logDropAttrs(s.tracer.provider.log) })
 }
 
 // addOverCapAttrs adds the attributes attrs to the span s while
@@ -338,25 +353,42 @@ func (s *recordingSpan) addOverCapAttrs(limit int, attrs []attribute.KeyValue) {
 // truncateAttr returns a truncated version of attr. Only string and string
 // slice attribute values are truncated. String values are truncated to at
 // most a length of limit. Each string slice value is truncated in this fashion
-// (the slice length itself is unaffected).
+// (the slice length itself is unaffected). The strings held by slice and map
+// values are truncated recursively.
 //
 // No truncation is performed for a negative limit.
 func truncateAttr(limit int, attr attribute.KeyValue) attribute.KeyValue {
 	if limit < 0 {
 		return attr
 	}
-	switch attr.Value.Type() {
+	return attribute.KeyValue{Key: attr.Key, Value: truncateValue(limit, attr.Value)}
+}
+
+func truncateValue(limit int, val attribute.Value) attribute.Value {
+	switch val.Type() {
 	case attribute.STRING:
-		v := attr.Value.AsString()
-		return attr.Key.String(truncate(limit, v))
+		v := val.AsString()
+		return attribute.StringValue(truncate(limit, v))
 	case attribute.STRINGSLICE:
-		v := attr.Value.AsStringSlice()
+		v := val.AsStringSlice()
 		for i := range v {
 			v[i] = truncate(limit, v[i])
 		}
-		return attr.Key.StringSlice(v)
+		return attribute.StringSliceValue(v)
+	case attribute.SLICE:
+		v := val.AsSlice()
+		for i := range v {
+			v[i] = truncateValue(limit, v[i])
+		}
+		return attribute.SliceValue(v...)
+	case attribute.MAP:
+		v := val.AsMap()
+		for i := range v {
+			v[i] = truncateAttr(limit, v[i])
+		}
+		return attribute.MapValue(v...)
 	}
-	return attr
+	return val
 }
 
 // truncate returns a truncated version of s such that it contains less than
@@ -487,11 +519,16 @@ func (s *recordingSpan) End(options ...trace.SpanEndOption) {
 		s.mu.Lock()
 	}
 
+	if s.stopAutoEnd != nil {
+		s.stopAutoEnd()
+	}
+
 	// Setting endTime to non-zero marks the span as ended and not recording.
 	if config.Timestamp().IsZero() {
 		s.endTime = et
 	} else {
-		s.endTime = config.Timestamp()
+		mono, ok := config.MonotonicTimestamp()
+		s.endTime = s.timestamp(config.Timestamp(), mono, ok)
 	}
 	s.mu.Unlock()
 
@@ -515,6 +552,35 @@ func monotonicEndTime(start time.Time) time.Time {
 	return start.Add(time.Since(start))
 }
 
+// autoEnd ends s with an Error status if ctx is canceled and s is not ended
+// within grace after that.
+func (s *recordingSpan) autoEnd(ctx context.Context, grace time.Duration) {
+	stop := context.AfterFunc(ctx, func() {
+		time.AfterFunc(grace, func() {
+			if !s.IsRecording() {
+				return
+			}
+			s.SetStatus(codes.Error, context.Cause(ctx).Error())
+			s.End()
+		})
+	})
+
+	s.mu.Lock()
+	s.stopAutoEnd = stop
+	s.mu.Unlock()
+}
+
+// timestamp returns the time of a life-cycle moment of s provided as wall,
+// with the optional monotonic clock reading mono. If both mono and the start
+// of s have a reading, the time is offset from the start time of s by the
+// difference between the readings. Otherwise, wall is returned.
+func (s *recordingSpan) timestamp(wall time.Time, mono time.Duration, ok bool) time.Time {
+	if !ok || !s.hasStartMonotonic {
+		return wall
+	}
+	return s.startTime.Add(mono - s.startMonotonic)
+}
+
 // RecordError will record err as a span event for this span. An additional call to
 // SetStatus is required if the Status of the Span should be set to Error, this method
 // does not change the Span status. If this span is not being recorded or err is nil
@@ -530,12 +596,17 @@ func (s *recordingSpan) RecordError(err error, opts ...trace.EventOption) {
 		return
 	}
 
+	c := trace.NewEventConfig(opts...)
+	errType := err
+	if c.UnwrapErrorType() {
+		errType = innermostError(err)
+	}
+
 	opts = append(opts, trace.WithAttributes(
-		semconv.ExceptionType(typeStr(err)),
+		semconv.ExceptionType(typeStr(errType)),
 		semconv.ExceptionMessage(err.Error()),
 	))
 
-	c := trace.NewEventConfig(opts...)
 	if c.StackTrace() {
 		opts = append(opts, trace.WithAttributes(
 			semconv.ExceptionStacktrace(recordStackTrace()),
@@ -554,11 +625,40 @@ func typeStr(i interface{}) string {
 	return fmt.Sprintf("%s.%s", t.PkgPath(), t.Name())
 }
 
+// innermostError returns the innermost error of the unwrap chain of err. If
+// an error wraps multiple errors, the first one is followed.
+func innermostError(err error) error {
+	for {
+		var next error
+		switch e := err.(type) {
+		case interface{ Unwrap() error }:
+			next = e.Unwrap()
+		case interface{ Unwrap() []error }:
+			if errs := e.Unwrap(); len(errs) > 0 {
+				next = errs[0]
+			}
+		}
+		if next == nil {
+			return err
+		}
+		err = next
+	}
+}
+
+// maxStackTraceSize is the maximum size of a recorded stack trace.
+const maxStackTraceSize = 64 << 10
+
+// recordStackTrace returns the formatted stack trace of the calling
+// goroutine. Stack traces larger than maxStackTraceSize are truncated.
 func recordStackTrace() string {
 	stackTrace := make([]byte, 2048)
-	n := runtime.Stack(stackTrace, false)
-
-	return string(stackTrace[0:n])
+	for {
+		n := runtime.Stack(stackTrace, false)
+		if n < len(stackTrace) || len(stackTrace) >= maxStackTraceSize {
+			return string(stackTrace[0:n])
+		}
+		stackTrace = make([]byte, 2*len(stackTrace))
+	}
 }
 
 // AddEvent adds an event with the provided name and options. If this span is
@@ -581,7 +681,8 @@ func (s *recordingSpan) AddEvent(name string, o ...trace.EventOption) {
 // This method assumes s.mu.Lock is held by the caller.
 func (s *recordingSpan) addEvent(name string, o ...trace.EventOption) {
 	c := trace.NewEventConfig(o...)
-	e := Event{Name: name, Attributes: c.Attributes(), Time: c.Timestamp()}
+	mono, ok := c.MonotonicTimestamp()
+	e := Event{Name: name, Attributes: c.Attributes(), Time: s.timestamp(c.Timestamp(), mono, ok)}
 
 	// Discard attributes over limit.
 	limit := s.tracer.provider.spanLimits.AttributePerEventCountLimit
@@ -736,7 +837,7 @@ func (s *recordingSpan) InstrumentationLibrary() instrumentation.Library { //nol
 func (s *recordingSpan) Resource() *resource.Resource {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	return s.tracer.provider.resource
+	return s.tracer.provider.getResource()
 }
 
 func (s *recordingSpan) AddLink(link trace.Link) {
@@ -818,7 +919,7 @@ func (s *recordingSpan) snapshot() ReadOnlySpan {
 	sd.instrumentationScope = s.tracer.instrumentationScope
 	sd.name = s.name
 	sd.parent = s.parent
-	sd.resource = s.tracer.provider.resource
+	sd.resource = s.tracer.provider.getResource()
 	sd.spanContext = s.spanContext
 	sd.spanKind = s.spanKind
 	sd.startTime = s.startTime
@@ -112,3 +112,16 @@ func NewSpanLimits() SpanLimits {
 		AttributePerLinkCountLimit:  env.SpanLinkAttributeCount(DefaultAttributePerLinkCountLimit),
 	}
 }
+
+// defaultSpanLimits returns the default SpanLimits, ignoring the environment
+// variables.
+func defaultSpanLimits() SpanLimits {
+	return SpanLimits{
+		AttributeValueLengthLimit:   DefaultAttributeValueLengthLimit,
+		AttributeCountLimit:         DefaultAttributeCountLimit,
+		EventCountLimit:             DefaultEventCountLimit,
+		LinkCountLimit:              DefaultLinkCountLimit,
+		AttributePerEventCountLimit: DefaultAttributePerEventCountLimit,
+		AttributePerLinkCountLimit:  DefaultAttributePerLinkCountLimit,
+	}
+}
@@ -43,6 +43,7 @@ func TestSettingSpanLimits(t *testing.T) {
 		env    map[string]string
 		opt    *SpanLimits
 		rawOpt *SpanLimits
+		noEnv  bool
 		want   SpanLimits
 	}{
 		{
@@ -104,6 +105,19 @@ func TestSettingSpanLimits(t *testing.T) {
 			rawOpt: limits(-1),
 			want:   *(limits(-1)),
 		},
+		{
+			name:  "without-env",
+			env:   envLimits("42"),
+			noEnv: true,
+			want:  defaultSpanLimits(),
+		},
+		{
+			name:  "without-env-opt",
+			env:   envLimits("42"),
+			noEnv: true,
+			opt:   limits(43),
+			want:  *(limits(43)),
+		},
 	}
 
 	for _, test := range tests {
@@ -115,6 +129,9 @@ func TestSettingSpanLimits(t *testing.T) {
 			}
 
 			var opts []TracerProviderOption
+			if test.noEnv {
+				opts = append(opts, WithoutEnvironment())
+			}
 			if test.opt != nil {
 				opts = append(opts, WithSpanLimits(*test.opt))
 			}
@@ -13,6 +13,7 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/internal/logging"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -201,6 +202,35 @@ func TestTruncateAttr(t *testing.T) {
 			attr:  strSliceAttr,
 			want:  strSliceAttr,
 		},
+		{
+			limit: 1,
+			attr:  attribute.Bytes(key, []byte("value")),
+			want:  attribute.Bytes(key, []byte("value")),
+		},
+		{
+			limit: 1,
+			attr: attribute.Slice(key,
+				attribute.StringValue("value"),
+				attribute.SliceValue(attribute.StringValue("value")),
+				attribute.Int64Value(42),
+			),
+			want: attribute.Slice(key,
+				attribute.StringValue("v"),
+				attribute.SliceValue(attribute.StringValue("v")),
+				attribute.Int64Value(42),
+			),
+		},
+		{
+			limit: 1,
+			attr: attribute.Map(key,
+				attribute.String("a", "value"),
+				attribute.Map("b", attribute.StringSlice("c", []string{"value"})),
+			),
+			want: attribute.Map(key,
+				attribute.String("a", "v"),
+				attribute.Map("b", attribute.StringSlice("c", []string{"v"})),
+			),
+		},
 	}
 
 	for _, test := range tests {
@@ -360,9 +390,9 @@ func TestLogDropAttrs(t *testing.T) {
 	t.Cleanup(func() { logDropAttrs = orig })
 
 	var called bool
-	logDropAttrs = func() { called = true }
+	logDropAttrs = func(logging.Logger) { called = true }
 
-	s := &recordingSpan{}
+	s := &recordingSpan{tracer: &tracer{provider: &TracerProvider{}}}
 	s.addDroppedAttr(1)
 	assert.True(t, called, "logDropAttrs not called")
 
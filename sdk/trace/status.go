@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"fmt"
+	"strconv"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/sdk/sdkstatus"
+)
+
+var (
+	_ sdkstatus.Reporter = (*TracerProvider)(nil)
+	_ sdkstatus.Reporter = (*batchSpanProcessor)(nil)
+	_ sdkstatus.Reporter = (*simpleSpanProcessor)(nil)
+)
+
+// Status returns the status of p and of its registered SpanProcessors.
+//
+// This method is safe to be called concurrently.
+func (p *TracerProvider) Status() sdkstatus.Status {
+	s := sdkstatus.Status{
+		Kind:     "TracerProvider",
+		Shutdown: p.isShutdown.Load(),
+		Settings: map[string]string{
+			"sampler":  p.getSampler().Description(),
+			"disabled": strconv.FormatBool(p.disabled),
+		},
+	}
+	for _, sps := range p.getSpanProcessors() {
+		if r, ok := sps.sp.(sdkstatus.Reporter); ok {
+			s.Components = append(s.Components, r.Status())
+			continue
+		}
+		s.Components = append(s.Components, sdkstatus.Status{Kind: fmt.Sprintf("%T", sps.sp)})
+	}
+	return s
+}
+
+// Status returns the status of bsp.
+func (bsp *batchSpanProcessor) Status() sdkstatus.Status {
+	return sdkstatus.Status{
+		Kind:     "BatchSpanProcessor",
+		Shutdown: bsp.stopped.Load(),
+		Settings: map[string]string{
+			"batchTimeout":       bsp.o.BatchTimeout.String(),
+			"exportTimeout":      bsp.o.ExportTimeout.String(),
+			"maxExportBatchSize": strconv.Itoa(bsp.o.MaxExportBatchSize),
+			"blockOnQueueFull":   strconv.FormatBool(bsp.o.BlockOnQueueFull),
+		},
+		Queue: &sdkstatus.Queue{
+			Len:      len(bsp.queue),
+			Capacity: cap(bsp.queue),
+			Dropped:  uint64(atomic.LoadUint32(&bsp.dropped)),
+		},
+		Export: bsp.exports.Status(bsp.e),
+	}
+}
+
+// Status returns the status of ssp.
+func (ssp *simpleSpanProcessor) Status() sdkstatus.Status {
+	ssp.exporterMu.Lock()
+	exp := ssp.exporter
+	ssp.exporterMu.Unlock()
+	return sdkstatus.Status{
+		Kind:     "SimpleSpanProcessor",
+		Shutdown: exp == nil,
+		Export:   ssp.exports.Status(exp),
+	}
+}
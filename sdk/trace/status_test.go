@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/sdkstatus"
+)
+
+type errExporter struct{ err error }
+
+func (e errExporter) ExportSpans(context.Context, []ReadOnlySpan) error { return e.err }
+func (errExporter) Shutdown(context.Context) error                      { return nil }
+
+func TestTracerProviderStatus(t *testing.T) {
+	bsp := NewBatchSpanProcessor(errExporter{err: assert.AnError}, WithMaxQueueSize(10), WithBlocking())
+	ssp := NewSimpleSpanProcessor(errExporter{})
+	tp := NewTracerProvider(
+		WithSampler(AlwaysSample()),
+		WithSpanProcessor(bsp),
+		WithSpanProcessor(ssp),
+		WithSpanProcessor(new(recorder)),
+	)
+
+	ctx := context.Background()
+	_, span := tp.Tracer("TestTracerProviderStatus").Start(ctx, "span")
+	span.End()
+	assert.ErrorIs(t, bsp.ForceFlush(ctx), assert.AnError)
+
+	s := tp.Status()
+	assert.Equal(t, "TracerProvider", s.Kind)
+	assert.False(t, s.Shutdown)
+	assert.Equal(t, AlwaysSample().Description(), s.Settings["sampler"])
+	require.Len(t, s.Components, 3)
+
+	b := s.Components[0]
+	assert.Equal(t, "BatchSpanProcessor", b.Kind)
+	assert.Equal(t, &sdkstatus.Queue{Len: 0, Capacity: 10}, b.Queue)
+	require.NotNil(t, b.Export)
+	assert.Equal(t, "trace.errExporter", b.Export.Exporter)
+	assert.Equal(t, uint64(1), b.Export.Count)
+	assert.Equal(t, uint64(1), b.Export.Failed)
+	assert.Equal(t, assert.AnError.Error(), b.Export.LastError)
+	assert.False(t, b.Export.LastErrorTime.IsZero())
+
+	simple := s.Components[1]
+	assert.Equal(t, "SimpleSpanProcessor", simple.Kind)
+	require.NotNil(t, simple.Export)
+	assert.Equal(t, uint64(1), simple.Export.Count)
+	assert.Equal(t, uint64(0), simple.Export.Failed)
+	assert.Empty(t, simple.Export.LastError)
+
+	assert.Equal(t, sdkstatus.Status{Kind: "*trace.recorder"}, s.Components[2])
+
+	require.NoError(t, tp.Shutdown(ctx))
+	s = tp.Status()
+	assert.True(t, s.Shutdown)
+	assert.Empty(t, s.Components, "processors are unregistered on shutdown")
+}
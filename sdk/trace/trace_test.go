@@ -31,15 +31,22 @@ import (
 const envVarResourceAttributes = "OTEL_RESOURCE_ATTRIBUTES"
 
 type storingHandler struct {
-	errs []error
+	errs        []error
+	diagnostics []otel.Diagnostic
 }
 
 func (s *storingHandler) Handle(err error) {
 	s.errs = append(s.errs, err)
 }
 
+func (s *storingHandler) HandleDiagnostic(_ context.Context, d otel.Diagnostic) {
+	s.errs = append(s.errs, d.Err)
+	s.diagnostics = append(s.diagnostics, d)
+}
+
 func (s *storingHandler) Reset() {
 	s.errs = nil
+	s.diagnostics = nil
 }
 
 var (
@@ -394,6 +401,56 @@ func TestStartSpanNewRootNotSampled(t *testing.T) {
 	}
 }
 
+func TestSetSpanLazyAttributesOnStart(t *testing.T) {
+	var calls int
+	lazy := trace.WithLazyAttributes(func() []attribute.KeyValue {
+		calls++
+		return []attribute.KeyValue{attribute.String("key1", "lazy"), attribute.String("key2", "value2")}
+	})
+
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithResource(resource.Empty()))
+	span := startSpan(tp,
+		"StartSpanLazyAttribute",
+		lazy,
+		trace.WithAttributes(attribute.String("key1", "value1")),
+	)
+	got, err := endSpan(te, span)
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("key1", "lazy"),
+		attribute.String("key2", "value2"),
+	}, got.Attributes())
+
+	tp = NewTracerProvider(WithSyncer(te), WithSampler(NeverSample()))
+	_, span = tp.Tracer("StartSpanLazyAttribute").Start(context.Background(), "span", lazy)
+	span.End()
+	assert.Equal(t, 1, calls, "lazy attributes evaluated for unsampled span")
+}
+
+func TestSetSpanInheritedAttributesOnStart(t *testing.T) {
+	ctx := trace.ContextWithInheritedAttributes(
+		context.Background(),
+		attribute.String("tenant", "inherited"),
+		attribute.String("region", "eu"),
+	)
+
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithResource(resource.Empty()))
+	_, span := tp.Tracer("StartSpanInheritedAttribute").Start(
+		ctx,
+		"span",
+		trace.WithAttributes(attribute.String("tenant", "explicit")),
+	)
+	got, err := endSpan(te, span)
+	require.NoError(t, err)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("tenant", "explicit"),
+		attribute.String("region", "eu"),
+	}, got.Attributes())
+}
+
 func TestSetSpanAttributesOnStart(t *testing.T) {
 	te := NewTestExporter()
 	tp := NewTracerProvider(WithSyncer(te), WithResource(resource.Empty()))
@@ -1218,6 +1275,72 @@ func TestCustomStartEndTime(t *testing.T) {
 	}
 }
 
+func TestCustomMonotonicTime(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithSampler(AlwaysSample()))
+
+	startTime := time.Date(2019, time.August, 27, 14, 42, 0, 0, time.UTC)
+	_, span := tp.Tracer("Custom monotonic time").Start(
+		context.Background(),
+		"testspan",
+		trace.WithMonotonicTimestamp(startTime, 10*time.Second),
+	)
+	// The wall clock of the recording process was set back.
+	span.AddEvent("event", trace.WithMonotonicTimestamp(startTime.Add(-time.Hour), 15*time.Second))
+	span.End(trace.WithMonotonicTimestamp(startTime.Add(-time.Hour), 30*time.Second))
+
+	require.Equal(t, 1, te.Len())
+	got := te.Spans()[0]
+	assert.Equal(t, startTime, got.StartTime())
+	require.Len(t, got.Events(), 1)
+	assert.Equal(t, startTime.Add(5*time.Second), got.Events()[0].Time)
+	assert.Equal(t, startTime.Add(20*time.Second), got.EndTime())
+
+	// Readings are ignored if the span was not started with one.
+	te.Reset()
+	endTime := startTime.Add(time.Minute)
+	_, span = tp.Tracer("Custom monotonic time").Start(
+		context.Background(),
+		"testspan",
+		trace.WithTimestamp(startTime),
+	)
+	span.End(trace.WithMonotonicTimestamp(endTime, 30*time.Second))
+
+	require.Equal(t, 1, te.Len())
+	assert.Equal(t, endTime, te.Spans()[0].EndTime())
+}
+
+func TestSpanAutoEnd(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te))
+	tr := tp.Tracer("AutoEnd")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	_, span := tr.Start(ctx, "leaked", trace.WithAutoEnd(0))
+	assert.True(t, span.IsRecording())
+	cancel()
+
+	require.Eventually(t, func() bool { return te.Len() == 1 }, time.Second, time.Millisecond)
+	got := te.Spans()[0]
+	assert.Equal(t, "leaked", got.Name())
+	assert.Equal(t, Status{Code: codes.Error, Description: context.Canceled.Error()}, got.Status())
+	assert.False(t, span.IsRecording())
+}
+
+func TestSpanAutoEndEnded(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te))
+	tr := tp.Tracer("AutoEnd")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	_, span := tr.Start(ctx, "ended", trace.WithAutoEnd(time.Hour))
+	cancel()
+	span.End()
+
+	require.Equal(t, 1, te.Len())
+	assert.Equal(t, Status{Code: codes.Unset}, te.Spans()[0].Status())
+}
+
 func TestRecordError(t *testing.T) {
 	scenarios := []struct {
 		err error
@@ -1341,6 +1464,71 @@ func TestRecordErrorWithStackTrace(t *testing.T) {
 	)
 }
 
+func TestRecordErrorUnwrapErrorType(t *testing.T) {
+	testErr := newTestError("test error")
+	scenarios := []struct {
+		name   string
+		err    error
+		unwrap bool
+		typ    string
+	}{
+		{
+			name: "Wrapped",
+			err:  fmt.Errorf("context: %w", testErr),
+			typ:  "*fmt.wrapError",
+		},
+		{
+			name:   "UnwrapWrapped",
+			err:    fmt.Errorf("context: %w", fmt.Errorf("more context: %w", testErr)),
+			unwrap: true,
+			typ:    "go.opentelemetry.io/otel/sdk/trace.testError",
+		},
+		{
+			name:   "UnwrapJoined",
+			err:    errors.Join(testErr, errors.New("other")),
+			unwrap: true,
+			typ:    "go.opentelemetry.io/otel/sdk/trace.testError",
+		},
+		{
+			name:   "UnwrapNotWrapped",
+			err:    errors.New("test error"),
+			unwrap: true,
+			typ:    "*errors.errorString",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			te := NewTestExporter()
+			tp := NewTracerProvider(WithSyncer(te), WithResource(resource.Empty()))
+			span := startSpan(tp, "RecordError")
+			span.RecordError(s.err, trace.WithUnwrapErrorType(s.unwrap))
+
+			got, err := endSpan(te, span)
+			require.NoError(t, err)
+			require.Len(t, got.Events(), 1)
+			assert.Equal(t, []attribute.KeyValue{
+				semconv.ExceptionType(s.typ),
+				semconv.ExceptionMessage(s.err.Error()),
+			}, got.Events()[0].Attributes)
+		})
+	}
+}
+
+func TestRecordStackTraceNotTruncated(t *testing.T) {
+	var deep func(int) string
+	deep = func(n int) string {
+		if n == 0 {
+			return recordStackTrace()
+		}
+		return deep(n - 1)
+	}
+
+	st := deep(100)
+	assert.Greater(t, len(st), 2048)
+	assert.Contains(t, st, "TestRecordStackTraceNotTruncated")
+}
+
 func TestRecordErrorNil(t *testing.T) {
 	te := NewTestExporter()
 	tp := NewTracerProvider(WithSyncer(te), WithResource(resource.Empty()))
@@ -1472,6 +1660,16 @@ func TestWithResource(t *testing.T) {
 				resource.NewSchemaless(attribute.String("rk1", "rv1"), attribute.Int64("rk5", 10)),
 			),
 		},
+		{
+			name: "async resource",
+			options: []TracerProviderOption{
+				WithResource(resource.NewSchemaless(attribute.String("rk1", "rv1"))),
+				WithAsyncResource(resource.NewAsync(context.Background(), time.Minute,
+					resource.WithAttributes(attribute.String("rk3", "rv3")),
+				)),
+			},
+			want: resource.NewSchemaless(attribute.String("rk3", "rv3")),
+		},
 	}
 	for _, tc := range cases {
 		tc := tc
@@ -1950,6 +2148,30 @@ func (gen *testIDGenerator) NewSpanID(ctx context.Context, traceID trace.TraceID
 
 var _ IDGenerator = (*testIDGenerator)(nil)
 
+func TestRandomTraceFlag(t *testing.T) {
+	tp := NewTracerProvider()
+	ctx, root := tp.Tracer("RandomTraceFlag").Start(context.Background(), "root")
+	assert.True(t, root.SpanContext().IsRandom(), "root span of random trace ID")
+
+	_, child := tp.Tracer("RandomTraceFlag").Start(ctx, "child")
+	assert.True(t, child.SpanContext().IsRandom(), "random flag not inherited")
+
+	// The random flag of a parent is only reused with its trace ID.
+	_, newRoot := NewTracerProvider(WithIDGenerator(&testIDGenerator{})).
+		Tracer("RandomTraceFlag").
+		Start(ctx, "new root", trace.WithNewRoot())
+	assert.False(t, newRoot.SpanContext().IsRandom(), "custom IDGenerator")
+
+	remote := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: root.SpanContext().TraceID(),
+		SpanID:  root.SpanContext().SpanID(),
+		Remote:  true,
+	})
+	ctx = trace.ContextWithRemoteSpanContext(context.Background(), remote)
+	_, child = tp.Tracer("RandomTraceFlag").Start(ctx, "child")
+	assert.False(t, child.SpanContext().IsRandom(), "random flag not set by parent")
+}
+
 func TestWithIDGenerator(t *testing.T) {
 	const (
 		startTraceID = 1
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/embedded"
@@ -52,6 +53,11 @@ func (tr *tracer) Start(
 			sp.sp.OnStart(ctx, rw)
 		}
 	}
+	if grace, ok := config.AutoEnd(); ok {
+		if rs, ok := s.(*recordingSpan); ok {
+			rs.autoEnd(ctx, grace)
+		}
+	}
 	if rtt, ok := s.(runtimeTracer); ok {
 		ctx = rtt.runtimeTrace(ctx)
 	}
@@ -59,6 +65,19 @@ func (tr *tracer) Start(
 	return trace.ContextWithSpan(ctx, s), s
 }
 
+// Enabled returns false if tr will not create recording spans. This is the
+// case when the TracerProvider has been shut down, has no registered
+// SpanProcessors, or is configured with the NeverSample Sampler. Otherwise,
+// true is returned as the sampling decision is only made when a span is
+// started.
+func (tr *tracer) Enabled(context.Context, trace.EnabledParameters) bool {
+	if tr.provider.isShutdown.Load() || len(tr.provider.getSpanProcessors()) == 0 {
+		return false
+	}
+	_, off := tr.provider.getSampler().(alwaysOffSampler)
+	return !off
+}
+
 type runtimeTracer interface {
 	// runtimeTrace starts a "runtime/trace".Task for the span and
 	// returns a context containing the task.
@@ -81,14 +100,19 @@ func (tr *tracer) newSpan(ctx context.Context, name string, config *trace.SpanCo
 	// on a unique span ID, even if the Span is non-recording.
 	var tid trace.TraceID
 	var sid trace.SpanID
+	flags := psc.TraceFlags()
 	if !psc.TraceID().IsValid() {
 		tid, sid = tr.provider.idGenerator.NewIDs(ctx)
+		// A new trace ID is only known to be random if generated by the
+		// default IDGenerator.
+		_, random := tr.provider.idGenerator.(*randomIDGenerator)
+		flags = flags.WithRandom(random)
 	} else {
 		tid = psc.TraceID()
 		sid = tr.provider.idGenerator.NewSpanID(ctx, tid)
 	}
 
-	samplingResult := tr.provider.sampler.ShouldSample(SamplingParameters{
+	samplingResult := tr.provider.getSampler().ShouldSample(SamplingParameters{
 		ParentContext: ctx,
 		TraceID:       tid,
 		Name:          name,
@@ -102,17 +126,13 @@ func (tr *tracer) newSpan(ctx context.Context, name string, config *trace.SpanCo
 		SpanID:     sid,
 		TraceState: samplingResult.Tracestate,
 	}
-	if isSampled(samplingResult) {
-		scc.TraceFlags = psc.TraceFlags() | trace.FlagsSampled
-	} else {
-		scc.TraceFlags = psc.TraceFlags() &^ trace.FlagsSampled
-	}
+	scc.TraceFlags = flags.WithSampled(isSampled(samplingResult))
 	sc := trace.NewSpanContext(scc)
 
 	if !isRecording(samplingResult) {
 		return tr.newNonRecordingSpan(sc)
 	}
-	return tr.newRecordingSpan(psc, sc, name, samplingResult, config)
+	return tr.newRecordingSpan(psc, sc, name, samplingResult, config, trace.InheritedAttributesFromContext(ctx))
 }
 
 // newRecordingSpan returns a new configured recordingSpan.
@@ -121,6 +141,7 @@ func (tr *tracer) newRecordingSpan(
 	name string,
 	sr SamplingResult,
 	config *trace.SpanConfig,
+	inherited []attribute.KeyValue,
 ) *recordingSpan {
 	startTime := config.Timestamp()
 	if startTime.IsZero() {
@@ -141,22 +162,30 @@ func (tr *tracer) newRecordingSpan(
 		spanKind:    trace.ValidateSpanKind(config.SpanKind()),
 		name:        name,
 		startTime:   startTime,
-		events:      newEvictedQueueEvent(tr.provider.spanLimits.EventCountLimit),
-		links:       newEvictedQueueLink(tr.provider.spanLimits.LinkCountLimit),
+		events:      newEvictedQueueEvent(tr.provider.spanLimits.EventCountLimit, tr.provider.log),
+		links:       newEvictedQueueLink(tr.provider.spanLimits.LinkCountLimit, tr.provider.log),
 		tracer:      tr,
 	}
+	s.startMonotonic, s.hasStartMonotonic = config.MonotonicTimestamp()
 
 	for _, l := range config.Links() {
 		s.AddLink(l)
 	}
 
 	s.SetAttributes(sr.Attributes...)
+	s.SetAttributes(inherited...)
 	s.SetAttributes(config.Attributes()...)
+	for _, fn := range config.LazyAttributes() {
+		s.SetAttributes(fn()...)
+	}
 
 	return s
 }
 
 // newNonRecordingSpan returns a new configured nonRecordingSpan.
+//
+// Unlike the no-op Tracer, starting a non-recording span allocates: the span
+// has its own span ID and needs to be stored in a new context.
 func (tr *tracer) newNonRecordingSpan(sc trace.SpanContext) nonRecordingSpan {
 	return nonRecordingSpan{tracer: tr, sc: sc}
 }
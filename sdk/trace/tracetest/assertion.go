@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest // import "go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+import (
+	"fmt"
+	"time"
+)
+
+// TestingT is an interface that implements [testing.T], but without the
+// private method of [testing.TB], so other testing packages can rely on it as
+// well.
+// The methods in this interface must match the [testing.TB] interface.
+type TestingT interface {
+	Helper()
+	// DO NOT CHANGE: any modification will not be backwards compatible and
+	// must never be done outside of a new major release.
+
+	Error(...any)
+	// DO NOT CHANGE: any modification will not be backwards compatible and
+	// must never be done outside of a new major release.
+}
+
+type config struct {
+	ignoreTimestamp    bool
+	timestampTolerance time.Duration
+	ignoreSpanContext  bool
+}
+
+func newConfig(opts []Option) config {
+	var cfg config
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	return cfg
+}
+
+// Option allows for fine grain control over how AssertEqual operates.
+type Option interface {
+	apply(cfg config) config
+}
+
+type fnOption func(cfg config) config
+
+func (fn fnOption) apply(cfg config) config {
+	return fn(cfg)
+}
+
+// IgnoreTimestamp disables checking if the start, end, and event timestamps
+// are different.
+func IgnoreTimestamp() Option {
+	return fnOption(func(cfg config) config {
+		cfg.ignoreTimestamp = true
+		return cfg
+	})
+}
+
+// TimestampTolerance considers the start, end, and event timestamps equal if
+// they differ by at most d. This can be useful to compare spans with
+// timestamps measured by the SDK.
+func TimestampTolerance(d time.Duration) Option {
+	return fnOption(func(cfg config) config {
+		cfg.timestampTolerance = d
+		return cfg
+	})
+}
+
+// IgnoreSpanContext disables checking if the SpanContext, the Parent, and
+// the SpanContext of the Links are different. This can be useful to compare
+// spans with randomly generated trace and span IDs.
+func IgnoreSpanContext() Option {
+	return fnOption(func(cfg config) config {
+		cfg.ignoreSpanContext = true
+		return cfg
+	})
+}
+
+// AssertEqual asserts that the two SpanStub or SpanStubs are equal.
+//
+// Attributes are compared regardless of their order. SpanStubs are compared
+// based on containing the same SpanStubs, not the order they are stored in.
+// The deprecated InstrumentationLibrary field of SpanStub is not compared.
+func AssertEqual[T SpanStub | SpanStubs](t TestingT, expected, actual T, opts ...Option) bool {
+	t.Helper()
+
+	cfg := newConfig(opts)
+
+	// Generic types cannot be type asserted. Use an interface instead.
+	aIface := interface{}(actual)
+
+	var r []string
+	switch e := interface{}(expected).(type) {
+	case SpanStub:
+		r = equalSpanStubs(e, aIface.(SpanStub), cfg)
+	case SpanStubs:
+		r = equalSpanStubSlices(e, aIface.(SpanStubs), cfg)
+	default:
+		// We control all types passed to this, panic to signal developers
+		// early they changed things in an incompatible way.
+		panic(fmt.Sprintf("unknown types: %T", expected))
+	}
+
+	if len(r) > 0 {
+		t.Error(r)
+		return false
+	}
+	return true
+}
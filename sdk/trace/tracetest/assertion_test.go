@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type mockTestingT struct {
+	errors []string
+}
+
+func (m *mockTestingT) Helper() {}
+
+func (m *mockTestingT) Error(args ...any) {
+	m.errors = append(m.errors, fmt.Sprint(args...))
+}
+
+var (
+	now = time.Now()
+
+	sc = trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x01},
+		SpanID:  trace.SpanID{0x01},
+	})
+	otherSC = trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x02},
+		SpanID:  trace.SpanID{0x02},
+	})
+
+	stubA = SpanStub{
+		Name:        "a",
+		SpanContext: sc,
+		SpanKind:    trace.SpanKindServer,
+		StartTime:   now,
+		EndTime:     now.Add(time.Second),
+		Attributes:  []attribute.KeyValue{attribute.String("k0", "v0"), attribute.Int("k1", 1)},
+		Events: []sdktrace.Event{
+			{Name: "e0", Time: now},
+			{Name: "e1", Time: now.Add(time.Millisecond)},
+		},
+		Links:  []sdktrace.Link{{SpanContext: otherSC}},
+		Status: sdktrace.Status{Code: codes.Ok},
+	}
+	stubB = SpanStub{Name: "b", SpanContext: otherSC}
+)
+
+func TestAssertEqual(t *testing.T) {
+	assert.True(t, AssertEqual(t, stubA, stubA))
+	assert.True(t, AssertEqual(t, SpanStubs{stubA, stubB}, SpanStubs{stubB, stubA}), "order")
+
+	reordered := stubA
+	reordered.Attributes = []attribute.KeyValue{attribute.Int("k1", 1), attribute.String("k0", "v0")}
+	reordered.Events = []sdktrace.Event{stubA.Events[1], stubA.Events[0]}
+	assert.True(t, AssertEqual(t, stubA, reordered), "attributes and events order")
+
+	shifted := stubA
+	shifted.StartTime = stubA.StartTime.Add(time.Millisecond)
+	shifted.Events = []sdktrace.Event{
+		{Name: "e0", Time: now.Add(-time.Millisecond)},
+		stubA.Events[1],
+	}
+	assert.True(t, AssertEqual(t, stubA, shifted, IgnoreTimestamp()))
+	assert.True(t, AssertEqual(t, stubA, shifted, TimestampTolerance(time.Millisecond)))
+	assert.True(t, assertFails(AssertEqual, stubA, shifted, TimestampTolerance(time.Microsecond)))
+	assert.True(t, assertFails(AssertEqual, stubA, shifted))
+
+	otherIDs := stubA
+	otherIDs.SpanContext = otherSC
+	otherIDs.Parent = sc
+	otherIDs.Links = []sdktrace.Link{{SpanContext: sc}}
+	assert.True(t, AssertEqual(t, stubA, otherIDs, IgnoreSpanContext()))
+	assert.True(t, assertFails(AssertEqual, stubA, otherIDs))
+}
+
+func TestAssertEqualFailure(t *testing.T) {
+	actual := stubA
+	actual.Name = "other"
+	actual.Attributes = []attribute.KeyValue{attribute.String("k0", "v1")}
+	actual.Events = stubA.Events[:1]
+
+	mt := &mockTestingT{}
+	assert.False(t, AssertEqual(mt, stubA, actual))
+	if assert.Len(t, mt.errors, 1) {
+		msg := mt.errors[0]
+		assert.Contains(t, msg, "Name not equal:\nexpected: a\nactual: other")
+		assert.Contains(t, msg, "Attributes not equal:\nexpected: k0=v0,k1=1\nactual: k0=v1")
+		assert.Contains(t, msg, "Events not equal:\nmissing expected values:\nEvent{Name: \"e1\"")
+	}
+
+	mt = &mockTestingT{}
+	assert.False(t, AssertEqual(mt, SpanStubs{stubA}, SpanStubs{stubB}))
+	if assert.Len(t, mt.errors, 1) {
+		msg := mt.errors[0]
+		assert.Contains(t, msg, "missing expected values:\nSpanStub{Name: \"a\"")
+		assert.Contains(t, msg, "unexpected additional values:\nSpanStub{Name: \"b\"")
+	}
+}
+
+// assertFails returns true if assertion fails for the passed arguments.
+func assertFails[T SpanStub | SpanStubs](assertion func(TestingT, T, T, ...Option) bool, expected, actual T, opts ...Option) bool {
+	return !assertion(&mockTestingT{}, expected, actual, opts...)
+}
@@ -0,0 +1,236 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest // import "go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// equalSpanStubSlices returns reasons SpanStubs are not equal. If they are
+// equal, the returned reasons will be empty.
+//
+// The SpanStubs are compared based on containing the same SpanStubs, not the
+// order they are stored in.
+func equalSpanStubSlices(a, b SpanStubs, cfg config) (reasons []string) {
+	missing, extra := diffSlices(
+		a,
+		b,
+		func(a, b SpanStub) bool {
+			r := equalSpanStubs(a, b, cfg)
+			return len(r) == 0
+		},
+	)
+	r := compareDiff(missing, extra, fmtSpanStub)
+	if r != "" {
+		reasons = append(reasons, "SpanStubs not equal:\n"+r)
+	}
+	return reasons
+}
+
+// equalSpanStubs returns reasons SpanStubs are not equal. If they are equal,
+// the returned reasons will be empty.
+func equalSpanStubs(a, b SpanStub, cfg config) (reasons []string) {
+	if a.Name != b.Name {
+		reasons = append(reasons, notEqualStr("Name", a.Name, b.Name))
+	}
+	if !cfg.ignoreSpanContext {
+		if !a.SpanContext.Equal(b.SpanContext) {
+			reasons = append(reasons, notEqualStr("SpanContext", a.SpanContext, b.SpanContext))
+		}
+		if !a.Parent.Equal(b.Parent) {
+			reasons = append(reasons, notEqualStr("Parent", a.Parent, b.Parent))
+		}
+	}
+	if a.SpanKind != b.SpanKind {
+		reasons = append(reasons, notEqualStr("SpanKind", a.SpanKind, b.SpanKind))
+	}
+	if !equalTime(a.StartTime, b.StartTime, cfg) {
+		reasons = append(reasons, notEqualStr("StartTime", a.StartTime, b.StartTime))
+	}
+	if !equalTime(a.EndTime, b.EndTime, cfg) {
+		reasons = append(reasons, notEqualStr("EndTime", a.EndTime, b.EndTime))
+	}
+	if !equalAttributes(a.Attributes, b.Attributes) {
+		reasons = append(reasons, notEqualStr("Attributes", fmtAttributes(a.Attributes), fmtAttributes(b.Attributes)))
+	}
+
+	missingEvents, extraEvents := diffSlices(
+		a.Events,
+		b.Events,
+		func(a, b tracesdk.Event) bool { return equalEvents(a, b, cfg) },
+	)
+	r := compareDiff(missingEvents, extraEvents, fmtEvent)
+	if r != "" {
+		reasons = append(reasons, "Events not equal:\n"+r)
+	}
+
+	missingLinks, extraLinks := diffSlices(
+		a.Links,
+		b.Links,
+		func(a, b tracesdk.Link) bool { return equalLinks(a, b, cfg) },
+	)
+	r = compareDiff(missingLinks, extraLinks, fmtLink)
+	if r != "" {
+		reasons = append(reasons, "Links not equal:\n"+r)
+	}
+
+	if a.Status != b.Status {
+		reasons = append(reasons, notEqualStr("Status", a.Status, b.Status))
+	}
+	if a.DroppedAttributes != b.DroppedAttributes {
+		reasons = append(reasons, notEqualStr("DroppedAttributes", a.DroppedAttributes, b.DroppedAttributes))
+	}
+	if a.DroppedEvents != b.DroppedEvents {
+		reasons = append(reasons, notEqualStr("DroppedEvents", a.DroppedEvents, b.DroppedEvents))
+	}
+	if a.DroppedLinks != b.DroppedLinks {
+		reasons = append(reasons, notEqualStr("DroppedLinks", a.DroppedLinks, b.DroppedLinks))
+	}
+	if a.ChildSpanCount != b.ChildSpanCount {
+		reasons = append(reasons, notEqualStr("ChildSpanCount", a.ChildSpanCount, b.ChildSpanCount))
+	}
+	if !equalResources(a.Resource, b.Resource) {
+		reasons = append(reasons, notEqualStr("Resource", a.Resource, b.Resource))
+	}
+	if a.InstrumentationScope != b.InstrumentationScope {
+		reasons = append(reasons, notEqualStr("InstrumentationScope", a.InstrumentationScope, b.InstrumentationScope))
+	}
+
+	if len(reasons) > 0 {
+		reasons = append([]string{fmt.Sprintf("SpanStub %q:", a.Name)}, reasons...)
+	}
+	return reasons
+}
+
+func equalEvents(a, b tracesdk.Event, cfg config) bool {
+	return a.Name == b.Name &&
+		a.DroppedAttributeCount == b.DroppedAttributeCount &&
+		equalTime(a.Time, b.Time, cfg) &&
+		equalAttributes(a.Attributes, b.Attributes)
+}
+
+func equalLinks(a, b tracesdk.Link, cfg config) bool {
+	return (cfg.ignoreSpanContext || a.SpanContext.Equal(b.SpanContext)) &&
+		a.DroppedAttributeCount == b.DroppedAttributeCount &&
+		equalAttributes(a.Attributes, b.Attributes)
+}
+
+func equalTime(a, b time.Time, cfg config) bool {
+	if cfg.ignoreTimestamp {
+		return true
+	}
+	if cfg.timestampTolerance > 0 {
+		return a.Sub(b).Abs() <= cfg.timestampTolerance
+	}
+	return a.Equal(b)
+}
+
+// equalAttributes returns true if a and b hold the same attributes,
+// regardless of their order.
+func equalAttributes(a, b []attribute.KeyValue) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	as, bs := attribute.NewSet(a...), attribute.NewSet(b...)
+	return as.Equals(&bs)
+}
+
+func equalResources(a, b *resource.Resource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(b)
+}
+
+func fmtSpanStub(s SpanStub) string {
+	return fmt.Sprintf(
+		"SpanStub{Name: %q, SpanKind: %s, TraceID: %s, SpanID: %s, ParentSpanID: %s, Attributes: [%s], Status: %s}",
+		s.Name, s.SpanKind, s.SpanContext.TraceID(), s.SpanContext.SpanID(), s.Parent.SpanID(), fmtAttributes(s.Attributes), s.Status.Code,
+	)
+}
+
+func fmtEvent(e tracesdk.Event) string {
+	return fmt.Sprintf(
+		"Event{Name: %q, Attributes: [%s], Time: %s}",
+		e.Name, fmtAttributes(e.Attributes), e.Time.Format(time.RFC3339Nano),
+	)
+}
+
+func fmtLink(l tracesdk.Link) string {
+	return fmt.Sprintf(
+		"Link{TraceID: %s, SpanID: %s, Attributes: [%s]}",
+		l.SpanContext.TraceID(), l.SpanContext.SpanID(), fmtAttributes(l.Attributes),
+	)
+}
+
+func fmtAttributes(kvs []attribute.KeyValue) string {
+	s := attribute.NewSet(kvs...)
+	return s.Encoded(attribute.DefaultEncoder())
+}
+
+func notEqualStr(prefix string, expected, actual interface{}) string {
+	return fmt.Sprintf("%s not equal:\nexpected: %v\nactual: %v", prefix, expected, actual)
+}
+
+// diffSlices returns the values of a not found in b and the values of b not
+// found in a.
+func diffSlices[T any](a, b []T, equal func(T, T) bool) (extraA, extraB []T) {
+	visited := make([]bool, len(b))
+	for i := 0; i < len(a); i++ {
+		found := false
+		for j := 0; j < len(b); j++ {
+			if visited[j] {
+				continue
+			}
+			if equal(a[i], b[j]) {
+				visited[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			extraA = append(extraA, a[i])
+		}
+	}
+
+	for j := 0; j < len(b); j++ {
+		if visited[j] {
+			continue
+		}
+		extraB = append(extraB, b[j])
+	}
+
+	return extraA, extraB
+}
+
+// compareDiff returns a description of the extra expected and actual values
+// formatted with formatter. An empty string is returned if there are none.
+func compareDiff[T any](extraExpected, extraActual []T, formatter func(T) string) string {
+	if len(extraExpected) == 0 && len(extraActual) == 0 {
+		return ""
+	}
+
+	var msg bytes.Buffer
+	if len(extraExpected) > 0 {
+		_, _ = msg.WriteString("missing expected values:\n")
+		for _, v := range extraExpected {
+			_, _ = msg.WriteString(formatter(v) + "\n")
+		}
+	}
+
+	if len(extraActual) > 0 {
+		_, _ = msg.WriteString("unexpected additional values:\n")
+		for _, v := range extraActual {
+			_, _ = msg.WriteString(formatter(v) + "\n")
+		}
+	}
+
+	return msg.String()
+}
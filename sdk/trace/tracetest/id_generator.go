@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest // import "go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+import (
+	"context"
+	"encoding/binary"
+	"math/rand/v2"
+	"sync"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DeterministicIDGenerator is an [sdktrace.IDGenerator] producing the same
+// sequence of trace and span IDs every time it is created with the same
+// arguments. It can be used to produce stable IDs in golden-file tests and
+// test fixtures shared across processes.
+//
+// The produced sequence is only stable if spans are started in a stable
+// order.
+type DeterministicIDGenerator struct {
+	mu      sync.Mutex
+	traceID func() trace.TraceID
+	spanID  func() trace.SpanID
+}
+
+var _ sdktrace.IDGenerator = (*DeterministicIDGenerator)(nil)
+
+// NewSequentialIDGenerator returns a DeterministicIDGenerator producing
+// sequential IDs. The first trace ID is
+// 00000000000000000000000000000001 and the first span ID is
+// 0000000000000001. Trace and span IDs are incremented independently.
+func NewSequentialIDGenerator() *DeterministicIDGenerator {
+	var traceN, spanN uint64
+	return &DeterministicIDGenerator{
+		traceID: func() (tid trace.TraceID) {
+			traceN++
+			binary.BigEndian.PutUint64(tid[8:], traceN)
+			return tid
+		},
+		spanID: func() (sid trace.SpanID) {
+			spanN++
+			binary.BigEndian.PutUint64(sid[:], spanN)
+			return sid
+		},
+	}
+}
+
+// NewSeededIDGenerator returns a DeterministicIDGenerator producing
+// pseudo-random IDs from seed. Generators created with the same seed produce
+// the same IDs.
+func NewSeededIDGenerator(seed uint64) *DeterministicIDGenerator {
+	rng := rand.New(rand.NewPCG(seed, seed))
+	return &DeterministicIDGenerator{
+		traceID: func() (tid trace.TraceID) {
+			for !tid.IsValid() {
+				binary.BigEndian.PutUint64(tid[:8], rng.Uint64())
+				binary.BigEndian.PutUint64(tid[8:], rng.Uint64())
+			}
+			return tid
+		},
+		spanID: func() (sid trace.SpanID) {
+			for !sid.IsValid() {
+				binary.BigEndian.PutUint64(sid[:], rng.Uint64())
+			}
+			return sid
+		},
+	}
+}
+
+// NewIDs returns the next trace ID and span ID.
+//
+// This method is safe to be called concurrently.
+func (g *DeterministicIDGenerator) NewIDs(context.Context) (trace.TraceID, trace.SpanID) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.traceID(), g.spanID()
+}
+
+// NewSpanID returns the next span ID.
+//
+// This method is safe to be called concurrently.
+func (g *DeterministicIDGenerator) NewSpanID(context.Context, trace.TraceID) trace.SpanID {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.spanID()
+}
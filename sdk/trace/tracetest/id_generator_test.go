@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSequentialIDGenerator(t *testing.T) {
+	ctx := context.Background()
+	gen := NewSequentialIDGenerator()
+
+	tid, sid := gen.NewIDs(ctx)
+	assert.Equal(t, "00000000000000000000000000000001", tid.String())
+	assert.Equal(t, "0000000000000001", sid.String())
+
+	sid = gen.NewSpanID(ctx, tid)
+	assert.Equal(t, "0000000000000002", sid.String())
+
+	tid, sid = gen.NewIDs(ctx)
+	assert.Equal(t, "00000000000000000000000000000002", tid.String())
+	assert.Equal(t, "0000000000000003", sid.String())
+}
+
+func TestSeededIDGenerator(t *testing.T) {
+	ctx := context.Background()
+	ids := func(gen *DeterministicIDGenerator) []string {
+		tid, sid := gen.NewIDs(ctx)
+		return []string{tid.String(), sid.String(), gen.NewSpanID(ctx, tid).String()}
+	}
+
+	got := ids(NewSeededIDGenerator(1))
+	assert.Equal(t, got, ids(NewSeededIDGenerator(1)), "same seed")
+	assert.NotEqual(t, got, ids(NewSeededIDGenerator(2)), "different seed")
+}
+
+func TestDeterministicIDGeneratorTracerProvider(t *testing.T) {
+	sr := NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(sr),
+		sdktrace.WithIDGenerator(NewSequentialIDGenerator()),
+	)
+	tracer := tp.Tracer("test")
+
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	_, child := tracer.Start(ctx, "child")
+	child.End()
+	parent.End()
+
+	want := trace.TraceID{15: 1}
+	assert.Equal(t, want, parent.SpanContext().TraceID())
+	assert.Equal(t, trace.SpanID{7: 1}, parent.SpanContext().SpanID())
+	assert.Equal(t, want, child.SpanContext().TraceID())
+	assert.Equal(t, trace.SpanID{7: 2}, child.SpanContext().SpanID())
+}
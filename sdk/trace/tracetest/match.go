@@ -0,0 +1,219 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest // import "go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+import (
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Matcher reports whether a SpanStub matches a condition.
+type Matcher func(SpanStub) bool
+
+// HasName returns a Matcher matching the SpanStubs named name.
+func HasName(name string) Matcher {
+	return func(s SpanStub) bool { return s.Name == name }
+}
+
+// HasAttributes returns a Matcher matching the SpanStubs holding all the
+// attributes kvs. The SpanStubs can hold other attributes.
+func HasAttributes(kvs ...attribute.KeyValue) Matcher {
+	return func(s SpanStub) bool {
+		set := attribute.NewSet(s.Attributes...)
+		for _, kv := range kvs {
+			if v, ok := set.Value(kv.Key); !ok || v != kv.Value {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasSpanKind returns a Matcher matching the SpanStubs of kind.
+func HasSpanKind(kind trace.SpanKind) Matcher {
+	return func(s SpanStub) bool { return s.SpanKind == kind }
+}
+
+func matchAll(s SpanStub, matchers []Matcher) bool {
+	for _, m := range matchers {
+		if !m(s) {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter returns the SpanStubs of s matching all matchers, in the order
+// they are stored in s.
+func (s SpanStubs) Filter(matchers ...Matcher) SpanStubs {
+	var out SpanStubs
+	for _, stub := range s {
+		if matchAll(stub, matchers) {
+			out = append(out, stub)
+		}
+	}
+	return out
+}
+
+// Find returns the first SpanStub of s matching all matchers. False is
+// returned if none matches.
+func (s SpanStubs) Find(matchers ...Matcher) (SpanStub, bool) {
+	for _, stub := range s {
+		if matchAll(stub, matchers) {
+			return stub, true
+		}
+	}
+	return SpanStub{}, false
+}
+
+// Children returns the SpanStubs of s that are children of parent, in the
+// order they are stored in s.
+func (s SpanStubs) Children(parent SpanStub) SpanStubs {
+	var out SpanStubs
+	for _, stub := range s {
+		if isChild(parent, stub) {
+			out = append(out, stub)
+		}
+	}
+	return out
+}
+
+// Roots returns the SpanStubs of s whose parent is not in s, in the order
+// they are stored in s.
+func (s SpanStubs) Roots() SpanStubs {
+	ids := make(map[trace.SpanID]struct{}, len(s))
+	for _, stub := range s {
+		ids[stub.SpanContext.SpanID()] = struct{}{}
+	}
+
+	var out SpanStubs
+	for _, stub := range s {
+		if _, ok := ids[stub.Parent.SpanID()]; !stub.Parent.IsValid() || !ok {
+			out = append(out, stub)
+		}
+	}
+	return out
+}
+
+func isChild(parent, child SpanStub) bool {
+	return child.Parent.IsValid() &&
+		child.Parent.TraceID() == parent.SpanContext.TraceID() &&
+		child.Parent.SpanID() == parent.SpanContext.SpanID()
+}
+
+// AssertChildOf asserts that child is a child of parent.
+func AssertChildOf(t TestingT, parent, child SpanStub) bool {
+	t.Helper()
+
+	if !isChild(parent, child) {
+		t.Error(fmt.Sprintf(
+			"SpanStub %q is not a child of %q:\nparent: %s\nchild: %s",
+			child.Name, parent.Name, fmtSpanStub(parent), fmtSpanStub(child),
+		))
+		return false
+	}
+	return true
+}
+
+// SpanTree describes the expected structure of a span and its descendants.
+type SpanTree struct {
+	// Name is the name of the span.
+	Name string
+	// Matchers are the additional conditions the span needs to match.
+	Matchers []Matcher
+	// Children are the expected children of the span, in any order. The span
+	// needs to have exactly these children.
+	Children []SpanTree
+}
+
+func (tree SpanTree) match(spans SpanStubs, s SpanStub) bool {
+	if s.Name != tree.Name || !matchAll(s, tree.Matchers) {
+		return false
+	}
+
+	children := spans.Children(s)
+	if len(children) != len(tree.Children) {
+		return false
+	}
+
+	// A child can match several expected children. Assign them with a
+	// maximum bipartite matching, not greedily, so the match does not depend
+	// on the order of the children.
+	matches := make([][]bool, len(tree.Children))
+	for i, want := range tree.Children {
+		matches[i] = make([]bool, len(children))
+		for j, c := range children {
+			matches[i][j] = want.match(spans, c)
+		}
+	}
+	assigned := make([]int, len(children))
+	for j := range assigned {
+		assigned[j] = -1
+	}
+	for i := range tree.Children {
+		if !assign(matches, i, assigned, make([]bool, len(children))) {
+			return false
+		}
+	}
+	return true
+}
+
+// assign assigns the expected child i to a child it matches, reassigning the
+// children already assigned along an augmenting path if needed. assigned
+// holds the index of the expected child assigned to each child, or -1.
+func assign(matches [][]bool, i int, assigned []int, visited []bool) bool {
+	for j, ok := range matches[i] {
+		if !ok || visited[j] {
+			continue
+		}
+		visited[j] = true
+		if assigned[j] < 0 || assign(matches, assigned[j], assigned, visited) {
+			assigned[j] = i
+			return true
+		}
+	}
+	return false
+}
+
+// AssertSpanTree asserts that spans contain a root span, a span whose parent
+// is not in spans, with the structure described by want.
+//
+// When the assertion fails, the expected structure and the structure of all
+// spans are reported.
+func AssertSpanTree(t TestingT, spans SpanStubs, want SpanTree) bool {
+	t.Helper()
+
+	for _, root := range spans.Roots() {
+		if want.match(spans, root) {
+			return true
+		}
+	}
+
+	var msg strings.Builder
+	_, _ = msg.WriteString("span tree not found:\nexpected:\n")
+	writeSpanTree(&msg, want, 1)
+	_, _ = msg.WriteString("actual:\n")
+	for _, root := range spans.Roots() {
+		writeSpanStubTree(&msg, spans, root, 1)
+	}
+	t.Error(msg.String())
+	return false
+}
+
+func writeSpanTree(b *strings.Builder, tree SpanTree, depth int) {
+	_, _ = fmt.Fprintf(b, "%s%q\n", strings.Repeat("  ", depth), tree.Name)
+	for _, c := range tree.Children {
+		writeSpanTree(b, c, depth+1)
+	}
+}
+
+func writeSpanStubTree(b *strings.Builder, spans SpanStubs, s SpanStub, depth int) {
+	_, _ = fmt.Fprintf(b, "%s%q %s\n", strings.Repeat("  ", depth), s.Name, fmtAttributes(s.Attributes))
+	for _, c := range spans.Children(s) {
+		writeSpanStubTree(b, spans, c, depth+1)
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// testSpans returns the spans of the trace:
+//
+//	root
+//	  child0 (k=v)
+//	    grandchild
+//	  child1
+func testSpans(t *testing.T) SpanStubs {
+	exp := NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	tracer := tp.Tracer("test")
+
+	ctx, root := tracer.Start(context.Background(), "root", trace.WithSpanKind(trace.SpanKindServer))
+	ctx0, child0 := tracer.Start(ctx, "child0", trace.WithAttributes(attribute.String("k", "v")))
+	_, grandchild := tracer.Start(ctx0, "grandchild")
+	_, child1 := tracer.Start(ctx, "child1")
+	grandchild.End()
+	child0.End()
+	child1.End()
+	root.End()
+
+	spans := exp.GetSpans()
+	require.Len(t, spans, 4)
+	return spans
+}
+
+func TestSpanStubsFind(t *testing.T) {
+	spans := testSpans(t)
+
+	s, ok := spans.Find(HasName("child0"))
+	assert.True(t, ok)
+	assert.Equal(t, "child0", s.Name)
+
+	s, ok = spans.Find(HasAttributes(attribute.String("k", "v")))
+	assert.True(t, ok)
+	assert.Equal(t, "child0", s.Name)
+
+	s, ok = spans.Find(HasSpanKind(trace.SpanKindServer))
+	assert.True(t, ok)
+	assert.Equal(t, "root", s.Name)
+
+	_, ok = spans.Find(HasName("child0"), HasAttributes(attribute.String("k", "other")))
+	assert.False(t, ok)
+
+	assert.Len(t, spans.Filter(HasSpanKind(trace.SpanKindInternal)), 3)
+	assert.Empty(t, spans.Filter(HasName("missing")))
+	assert.Len(t, spans.Filter(), len(spans))
+}
+
+func TestSpanStubsChildren(t *testing.T) {
+	spans := testSpans(t)
+	root, _ := spans.Find(HasName("root"))
+	child0, _ := spans.Find(HasName("child0"))
+	grandchild, _ := spans.Find(HasName("grandchild"))
+
+	var names []string
+	for _, c := range spans.Children(root) {
+		names = append(names, c.Name)
+	}
+	assert.ElementsMatch(t, []string{"child0", "child1"}, names)
+
+	roots := spans.Roots()
+	require.Len(t, roots, 1)
+	assert.Equal(t, "root", roots[0].Name)
+
+	assert.True(t, AssertChildOf(t, child0, grandchild))
+	mt := &mockTestingT{}
+	assert.False(t, AssertChildOf(mt, root, grandchild))
+	if assert.Len(t, mt.errors, 1) {
+		assert.Contains(t, mt.errors[0], `SpanStub "grandchild" is not a child of "root"`)
+	}
+}
+
+func TestAssertSpanTree(t *testing.T) {
+	spans := testSpans(t)
+
+	assert.True(t, AssertSpanTree(t, spans, SpanTree{
+		Name: "root",
+		Children: []SpanTree{
+			{Name: "child1"},
+			{
+				Name:     "child0",
+				Matchers: []Matcher{HasAttributes(attribute.String("k", "v"))},
+				Children: []SpanTree{{Name: "grandchild"}},
+			},
+		},
+	}))
+
+	for name, tree := range map[string]SpanTree{
+		"MissingChild": {Name: "root", Children: []SpanTree{{Name: "child0"}}},
+		"WrongParent": {Name: "root", Children: []SpanTree{
+			{Name: "child0"},
+			{Name: "child1", Children: []SpanTree{{Name: "grandchild"}}},
+		}},
+		"Matcher": {Name: "root", Matchers: []Matcher{HasSpanKind(trace.SpanKindClient)}},
+		"NotRoot": {Name: "child1"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mt := &mockTestingT{}
+			assert.False(t, AssertSpanTree(mt, spans, tree))
+			if assert.Len(t, mt.errors, 1) {
+				assert.Contains(t, mt.errors[0], "span tree not found:")
+				assert.Contains(t, mt.errors[0], "actual:\n  \"root\" \n")
+				assert.Contains(t, mt.errors[0], "      \"grandchild\" \n")
+			}
+		})
+	}
+}
+
+func TestAssertSpanTreeAmbiguousChildren(t *testing.T) {
+	exp := NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	tracer := tp.Tracer("test")
+
+	ctx, root := tracer.Start(context.Background(), "root")
+	_, child := tracer.Start(ctx, "child", trace.WithAttributes(attribute.String("k", "v")))
+	child.End()
+	_, child = tracer.Start(ctx, "child")
+	child.End()
+	root.End()
+
+	// The first expected child matches both children. It needs to be
+	// assigned the one the second expected child does not match.
+	assert.True(t, AssertSpanTree(t, exp.GetSpans(), SpanTree{
+		Name: "root",
+		Children: []SpanTree{
+			{Name: "child"},
+			{Name: "child", Matchers: []Matcher{HasAttributes(attribute.String("k", "v"))}},
+		},
+	}))
+
+	mt := &mockTestingT{}
+	assert.False(t, AssertSpanTree(mt, exp.GetSpans(), SpanTree{
+		Name: "root",
+		Children: []SpanTree{
+			{Name: "child", Matchers: []Matcher{HasAttributes(attribute.String("k", "v"))}},
+			{Name: "child", Matchers: []Matcher{HasAttributes(attribute.String("k", "v"))}},
+		},
+	}))
+}
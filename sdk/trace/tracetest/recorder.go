@@ -17,6 +17,9 @@ type SpanRecorder struct {
 
 	endedMu sync.RWMutex
 	ended   []sdktrace.ReadOnlySpan
+
+	subsMu sync.Mutex
+	subs   map[*subscription]struct{}
 }
 
 var _ sdktrace.SpanProcessor = (*SpanRecorder)(nil)
@@ -40,8 +43,14 @@ func (sr *SpanRecorder) OnStart(_ context.Context, s sdktrace.ReadWriteSpan) {
 // This method is safe to be called concurrently.
 func (sr *SpanRecorder) OnEnd(s sdktrace.ReadOnlySpan) {
 	sr.endedMu.Lock()
-	defer sr.endedMu.Unlock()
 	sr.ended = append(sr.ended, s)
+	sr.endedMu.Unlock()
+
+	sr.subsMu.Lock()
+	defer sr.subsMu.Unlock()
+	for sub := range sr.subs {
+		sub.offer(s)
+	}
 }
 
 // Shutdown does nothing.
@@ -92,3 +101,101 @@ func (sr *SpanRecorder) Ended() []sdktrace.ReadOnlySpan {
 	copy(dst, sr.ended)
 	return dst
 }
+
+// Subscribe returns a channel receiving the spans ended after the call that
+// match all matchers, in the order they are ended. This allows waiting for
+// spans ended by asynchronous code without polling.
+//
+// Spans are queued for the subscriber, ending a span never blocks on the
+// channel being read. The returned cancel function stops the subscription,
+// discards the queued spans, and closes the channel. It must be called once
+// the subscription is no longer needed.
+//
+// This method is safe to be called concurrently.
+func (sr *SpanRecorder) Subscribe(matchers ...Matcher) (spans <-chan sdktrace.ReadOnlySpan, cancel func()) {
+	sub := &subscription{
+		matchers: matchers,
+		ch:       make(chan sdktrace.ReadOnlySpan),
+		notify:   make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+
+	sr.subsMu.Lock()
+	if sr.subs == nil {
+		sr.subs = make(map[*subscription]struct{})
+	}
+	sr.subs[sub] = struct{}{}
+	sr.subsMu.Unlock()
+
+	go sub.run()
+
+	var once sync.Once
+	return sub.ch, func() {
+		once.Do(func() {
+			sr.subsMu.Lock()
+			delete(sr.subs, sub)
+			sr.subsMu.Unlock()
+			close(sub.done)
+		})
+	}
+}
+
+// subscription delivers the ended spans matching matchers to ch.
+type subscription struct {
+	matchers []Matcher
+
+	mu    sync.Mutex
+	queue []sdktrace.ReadOnlySpan
+
+	ch     chan sdktrace.ReadOnlySpan
+	notify chan struct{}
+	done   chan struct{}
+}
+
+// offer queues s if it matches the subscription.
+func (sub *subscription) offer(s sdktrace.ReadOnlySpan) {
+	if !matchAll(SpanStubFromReadOnlySpan(s), sub.matchers) {
+		return
+	}
+
+	sub.mu.Lock()
+	sub.queue = append(sub.queue, s)
+	sub.mu.Unlock()
+
+	select {
+	case sub.notify <- struct{}{}:
+	default:
+	}
+}
+
+// run delivers the queued spans until the subscription is canceled.
+func (sub *subscription) run() {
+	defer close(sub.ch)
+	for {
+		sub.mu.Lock()
+		var (
+			s  sdktrace.ReadOnlySpan
+			ok bool
+		)
+		if len(sub.queue) > 0 {
+			s, ok = sub.queue[0], true
+			sub.queue = sub.queue[1:]
+		}
+		sub.mu.Unlock()
+
+		if !ok {
+			select {
+			case <-sub.notify:
+				continue
+			case <-sub.done:
+				return
+			}
+		}
+
+		select {
+		case sub.ch <- s:
+		case <-sub.done:
+			return
+		}
+	}
+}
@@ -136,3 +136,39 @@ func TestResetConcurrentSafe(t *testing.T) {
 	assert.Empty(t, sr.Started())
 	assert.Empty(t, sr.Ended())
 }
+
+func TestSpanRecorderSubscribe(t *testing.T) {
+	sr := NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)).Tracer("test")
+
+	_, early := tracer.Start(context.Background(), "early")
+	early.End()
+
+	all, cancelAll := sr.Subscribe()
+	defer cancelAll()
+	filtered, cancelFiltered := sr.Subscribe(HasName("target"))
+	defer cancelFiltered()
+
+	go func() {
+		for _, name := range []string{"other", "target", "last"} {
+			_, s := tracer.Start(context.Background(), name)
+			s.End()
+		}
+	}()
+
+	var names []string
+	for range 3 {
+		names = append(names, (<-all).Name())
+	}
+	assert.Equal(t, []string{"other", "target", "last"}, names)
+	assert.Equal(t, "target", (<-filtered).Name())
+
+	cancelFiltered()
+	_, ok := <-filtered
+	assert.False(t, ok, "channel not closed after cancel")
+	cancelFiltered()
+
+	_, s := tracer.Start(context.Background(), "target")
+	s.End()
+	assert.Equal(t, "target", (<-all).Name())
+}
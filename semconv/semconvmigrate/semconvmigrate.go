@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package semconvmigrate upgrades the attributes of telemetry produced by
+// instrumentation using an older version of the OpenTelemetry semantic
+// conventions to a later version.
+//
+// The attribute renames applied are generated from the OpenTelemetry schema
+// files (https://opentelemetry.io/schemas/). Only the renames that apply to
+// all signals (the "all" section of the schema files) are included, so
+// [Upgrade] can be used for the attributes of resources, spans, span events,
+// logs, and metrics alike. Use the go.opentelemetry.io/otel/schema module to
+// apply the signal specific translations of a schema file.
+package semconvmigrate // import "go.opentelemetry.io/otel/semconv/semconvmigrate"
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	// ErrInvalidVersion is returned when a version is not a semantic
+	// conventions version.
+	ErrInvalidVersion = errors.New("invalid semantic conventions version")
+
+	// ErrUnsupportedUpgrade is returned when attributes cannot be upgraded
+	// between two versions.
+	ErrUnsupportedUpgrade = errors.New("unsupported semantic conventions upgrade")
+)
+
+type version struct {
+	major, minor, patch int
+}
+
+// parseVersion parses a semantic conventions version (e.g. 1.26.0), with an
+// optional "v" prefix.
+func parseVersion(s string) (version, error) {
+	parts := strings.Split(strings.TrimPrefix(s, "v"), ".")
+	if len(parts) != 3 {
+		return version{}, fmt.Errorf("%w: %q", ErrInvalidVersion, s)
+	}
+	var n [3]int
+	for i, p := range parts {
+		var err error
+		n[i], err = strconv.Atoi(p)
+		if err != nil || n[i] < 0 {
+			return version{}, fmt.Errorf("%w: %q", ErrInvalidVersion, s)
+		}
+	}
+	return version{major: n[0], minor: n[1], patch: n[2]}, nil
+}
+
+func (v version) compare(o version) int {
+	return cmp.Or(
+		cmp.Compare(v.major, o.major),
+		cmp.Compare(v.minor, o.minor),
+		cmp.Compare(v.patch, o.patch),
+	)
+}
+
+type versionRenames struct {
+	version version
+	keys    map[attribute.Key]attribute.Key
+}
+
+var latestVersion = func() version {
+	v, err := parseVersion(LatestVersion)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}()
+
+// Upgrade returns attrs with the attribute renames of the semantic
+// conventions versions after fromVersion, up to and including toVersion,
+// applied in version order. Versions are in the form 1.26.0, optionally
+// prefixed with a "v" (e.g. the version of a semconv package).
+//
+// The attributes are returned unchanged if fromVersion and toVersion are the
+// same. Otherwise, attrs is not modified: a copy is returned if any attribute
+// is renamed.
+//
+// An attribute is removed instead of renamed if attrs already has an
+// attribute with the new key that is not itself renamed by the same version:
+// the value set with the new key is kept, as it is the one of the later
+// version. Likewise, only the first attribute is kept if several keys are
+// renamed to the same key.
+//
+// An error wrapping [ErrUnsupportedUpgrade] is returned if toVersion is older
+// than fromVersion or newer than [LatestVersion].
+func Upgrade(attrs []attribute.KeyValue, fromVersion, toVersion string) ([]attribute.KeyValue, error) {
+	from, err := parseVersion(fromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseVersion(toVersion)
+	if err != nil {
+		return nil, err
+	}
+	if to.compare(from) < 0 {
+		return nil, fmt.Errorf("%w: %s is older than %s", ErrUnsupportedUpgrade, toVersion, fromVersion)
+	}
+	if to.compare(latestVersion) > 0 {
+		return nil, fmt.Errorf("%w: %s is newer than %s", ErrUnsupportedUpgrade, toVersion, LatestVersion)
+	}
+
+	var copied bool
+	for _, r := range renames {
+		if r.version.compare(from) <= 0 {
+			continue
+		}
+		if r.version.compare(to) > 0 {
+			break
+		}
+		for i := 0; i < len(attrs); i++ {
+			k, ok := r.keys[attrs[i].Key]
+			if !ok {
+				continue
+			}
+			if !copied {
+				attrs, copied = slices.Clone(attrs), true
+			}
+			if r.has(attrs, k) {
+				attrs = slices.Delete(attrs, i, i+1)
+				i--
+				continue
+			}
+			attrs[i].Key = k
+		}
+	}
+	return attrs, nil
+}
+
+// has reports whether attrs has an attribute with key that is not renamed by
+// r.
+func (r versionRenames) has(attrs []attribute.KeyValue, key attribute.Key) bool {
+	if _, renamed := r.keys[key]; renamed {
+		return false
+	}
+	return slices.ContainsFunc(attrs, func(kv attribute.KeyValue) bool {
+		return kv.Key == key
+	})
+}
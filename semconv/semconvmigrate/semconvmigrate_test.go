@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package semconvmigrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestUpgrade(t *testing.T) {
+	attrs := []attribute.KeyValue{
+		attribute.String("http.method", "GET"),
+		attribute.Int("http.status_code", 200),
+		attribute.String("db.name", "orders"),
+		attribute.String("deployment.environment", "prod"),
+		attribute.String("custom", "value"),
+	}
+
+	tests := []struct {
+		name     string
+		from, to string
+		want     []attribute.KeyValue
+	}{
+		{
+			name: "Identity",
+			from: "1.20.0",
+			to:   "1.20.0",
+			want: attrs,
+		},
+		{
+			name: "SingleVersion",
+			from: "1.20.0",
+			to:   "1.21.0",
+			want: []attribute.KeyValue{
+				attribute.String("http.request.method", "GET"),
+				attribute.Int("http.response.status_code", 200),
+				attribute.String("db.name", "orders"),
+				attribute.String("deployment.environment", "prod"),
+				attribute.String("custom", "value"),
+			},
+		},
+		{
+			name: "MultipleVersions",
+			from: "1.20.0",
+			to:   "1.27.0",
+			want: []attribute.KeyValue{
+				attribute.String("http.request.method", "GET"),
+				attribute.Int("http.response.status_code", 200),
+				attribute.String("db.namespace", "orders"),
+				attribute.String("deployment.environment.name", "prod"),
+				attribute.String("custom", "value"),
+			},
+		},
+		{
+			name: "FromVersionExcluded",
+			from: "1.21.0",
+			to:   "1.26.0",
+			want: []attribute.KeyValue{
+				attribute.String("http.method", "GET"),
+				attribute.Int("http.status_code", 200),
+				attribute.String("db.namespace", "orders"),
+				attribute.String("deployment.environment", "prod"),
+				attribute.String("custom", "value"),
+			},
+		},
+		{
+			name: "VersionPrefix",
+			from: "v1.26.0",
+			to:   "v1.27.0",
+			want: []attribute.KeyValue{
+				attribute.String("http.method", "GET"),
+				attribute.Int("http.status_code", 200),
+				attribute.String("db.name", "orders"),
+				attribute.String("deployment.environment.name", "prod"),
+				attribute.String("custom", "value"),
+			},
+		},
+		{
+			name: "Latest",
+			from: "1.4.0",
+			to:   LatestVersion,
+			want: []attribute.KeyValue{
+				attribute.String("http.request.method", "GET"),
+				attribute.Int("http.response.status_code", 200),
+				attribute.String("db.namespace", "orders"),
+				attribute.String("deployment.environment.name", "prod"),
+				attribute.String("custom", "value"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := append([]attribute.KeyValue(nil), attrs...)
+			got, err := Upgrade(attrs, tt.from, tt.to)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, orig, attrs, "input attributes modified")
+		})
+	}
+}
+
+func TestUpgradeExistingKey(t *testing.T) {
+	// Both the old and new key are set: the value of the new key is kept
+	// regardless of the order of the attributes, and no key is duplicated.
+	for _, attrs := range [][]attribute.KeyValue{
+		{
+			attribute.String("http.method", "old"),
+			attribute.String("http.request.method", "GET"),
+		},
+		{
+			attribute.String("http.request.method", "GET"),
+			attribute.String("http.method", "old"),
+		},
+	} {
+		got, err := Upgrade(attrs, "1.20.0", "1.21.0")
+		require.NoError(t, err)
+		assert.Equal(t, []attribute.KeyValue{
+			attribute.String("http.request.method", "GET"),
+		}, got)
+		assert.Len(t, attrs, 2, "input attributes modified")
+	}
+}
+
+func TestUpgradeSameNewKey(t *testing.T) {
+	// Keys renamed to the same key by a version: the first one is kept.
+	attrs := []attribute.KeyValue{
+		attribute.String("db.sql.table", "orders"),
+		attribute.String("db.mongodb.collection", "users"),
+	}
+	got, err := Upgrade(attrs, "1.25.0", "1.26.0")
+	require.NoError(t, err)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("db.collection.name", "orders"),
+	}, got)
+}
+
+func TestUpgradeErrors(t *testing.T) {
+	attrs := []attribute.KeyValue{attribute.String("http.method", "GET")}
+
+	_, err := Upgrade(attrs, "1.21", "1.26.0")
+	assert.ErrorIs(t, err, ErrInvalidVersion)
+
+	_, err = Upgrade(attrs, "1.21.0", "1.x.0")
+	assert.ErrorIs(t, err, ErrInvalidVersion)
+
+	_, err = Upgrade(attrs, "1.26.0", "1.21.0")
+	assert.ErrorIs(t, err, ErrUnsupportedUpgrade)
+
+	_, err = Upgrade(attrs, "1.21.0", "99.0.0")
+	assert.ErrorIs(t, err, ErrUnsupportedUpgrade)
+}
+
+func TestRenamesOrdered(t *testing.T) {
+	for i := 1; i < len(renames); i++ {
+		assert.Negative(t, renames[i-1].version.compare(renames[i].version), "renames not in ascending version order")
+	}
+	require.NotEmpty(t, renames)
+	assert.LessOrEqual(t, renames[len(renames)-1].version.compare(latestVersion), 0)
+}
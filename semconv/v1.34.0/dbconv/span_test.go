@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dbconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestQuery(t *testing.T) {
+	a := Query(SystemNamePostgreSQL)
+
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("db.system.name", "postgresql"),
+	}, a.Attributes())
+	assert.Equal(t, trace.SpanKindClient, a.SpanKind())
+	assert.Equal(t, semconv.SchemaURL, a.SchemaURL())
+
+	b := a.WithNamespace("orders").WithServerPort(5432).WithErrorType(ErrorTypeOther)
+	assert.Equal(t, attribute.NewSet(
+		attribute.String("db.system.name", "postgresql"),
+		attribute.String("db.namespace", "orders"),
+		attribute.Int("server.port", 5432),
+		attribute.String("error.type", "_OTHER"),
+	), b.Set())
+	assert.Len(t, a.Attributes(), 1, "With modified the receiver")
+}
+
+func TestQueryValidation(t *testing.T) {
+	a := Query("unknown")
+	assert.Empty(t, a.Attributes())
+	assert.ErrorIs(t, a.Err(), ErrInvalidValue)
+
+	a = Query(SystemNameRedis).WithServerPort(65536).WithServerPort(6379)
+	assert.ErrorIs(t, a.Err(), ErrInvalidValue)
+	assert.ErrorContains(t, a.Err(), "server.port: 65536")
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("db.system.name", "redis"),
+		attribute.Int("server.port", 6379),
+	}, a.Attributes())
+
+	// error.type accepts values the semantic conventions do not define.
+	assert.NoError(t, Query(SystemNameRedis).WithErrorType("timeout").Err())
+}
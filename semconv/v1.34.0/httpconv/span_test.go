@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestClientRequest(t *testing.T) {
+	a := ClientRequest(RequestMethodGet, "example.com", 443, "https://example.com/")
+
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("http.request.method", "GET"),
+		attribute.String("server.address", "example.com"),
+		attribute.Int("server.port", 443),
+		attribute.String("url.full", "https://example.com/"),
+	}, a.Attributes())
+	assert.Equal(t, trace.SpanKindClient, a.SpanKind())
+	assert.Equal(t, semconv.SchemaURL, a.SchemaURL())
+
+	b := a.WithResponseStatusCode(200).WithNetworkTransport(NetworkTransportTCP)
+	assert.Equal(t, attribute.NewSet(
+		attribute.String("http.request.method", "GET"),
+		attribute.String("server.address", "example.com"),
+		attribute.Int("server.port", 443),
+		attribute.String("url.full", "https://example.com/"),
+		attribute.Int("http.response.status_code", 200),
+		attribute.String("network.transport", "tcp"),
+	), b.Set())
+	assert.Len(t, a.Attributes(), 4, "With modified the receiver")
+}
+
+func TestServerRequest(t *testing.T) {
+	a := ServerRequest(RequestMethodPost, "/users", "https")
+
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("http.request.method", "POST"),
+		attribute.String("url.path", "/users"),
+		attribute.String("url.scheme", "https"),
+	}, a.Attributes())
+	assert.Equal(t, trace.SpanKindServer, a.SpanKind())
+	assert.Equal(t, semconv.SchemaURL, a.SchemaURL())
+
+	// Both copies share the attributes of a and must not overwrite each
+	// other's added attribute.
+	b := a.WithRoute("/users")
+	c := a.WithErrorType(ErrorTypeOther)
+	assert.Equal(t, attribute.String("http.route", "/users"), b.Attributes()[3])
+	assert.Equal(t, attribute.String("error.type", "_OTHER"), c.Attributes()[3])
+	assert.Equal(t, attribute.String("user_agent.synthetic.type", "bot"), a.WithUserAgentSyntheticType(UserAgentSyntheticTypeBot).Attributes()[3])
+}
+
+func TestAttributesCopy(t *testing.T) {
+	a := ServerRequest(RequestMethodGet, "/", "http")
+	attrs := a.Attributes()
+	attrs[0] = attribute.String("http.request.method", "POST")
+	assert.Equal(t, attribute.String("http.request.method", "GET"), a.Attributes()[0])
+}
+
+func TestWithReplaces(t *testing.T) {
+	a := ServerRequest(RequestMethodGet, "/", "http")
+	b := a.WithResponseStatusCode(200)
+	c := b.WithResponseStatusCode(404).WithRoute("/")
+
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("http.request.method", "GET"),
+		attribute.String("url.path", "/"),
+		attribute.String("url.scheme", "http"),
+		attribute.Int("http.response.status_code", 404),
+		attribute.String("http.route", "/"),
+	}, c.Attributes())
+	assert.Equal(t, attribute.Int("http.response.status_code", 200), b.Attributes()[3])
+	assert.NoError(t, c.Err())
+}
+
+func TestValidation(t *testing.T) {
+	a := ClientRequest("get", "example.com", -1, "https://example.com/")
+	assert.ErrorIs(t, a.Err(), ErrInvalidValue)
+	assert.ErrorContains(t, a.Err(), "http.request.method: get")
+	assert.ErrorContains(t, a.Err(), "server.port: -1")
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("server.address", "example.com"),
+		attribute.String("url.full", "https://example.com/"),
+	}, a.Attributes())
+
+	b := ServerRequest(RequestMethodOther, "/", "https").
+		WithResponseStatusCode(99).
+		WithNetworkTransport("sctp").
+		WithUserAgentSyntheticType(UserAgentSyntheticTypeTest)
+	assert.ErrorContains(t, b.Err(), "http.response.status_code: 99")
+	assert.ErrorContains(t, b.Err(), "network.transport: sctp")
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("http.request.method", "_OTHER"),
+		attribute.String("url.path", "/"),
+		attribute.String("url.scheme", "https"),
+		attribute.String("user_agent.synthetic.type", "test"),
+	}, b.Attributes())
+
+	assert.NoError(t, ServerRequest(RequestMethodGet, "/", "http").Err())
+}
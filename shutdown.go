@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otel // import "go.opentelemetry.io/otel"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/internal/global"
+)
+
+// Shutdown shuts down the global TracerProvider, MeterProvider, and, when the
+// go.opentelemetry.io/otel/log/global package is used, LoggerProvider.
+//
+// A provider is shut down if it has a Shutdown(context.Context) error method,
+// as the SDK providers do, which is expected to flush the telemetry it holds.
+// A provider without a Shutdown method, but with a
+// ForceFlush(context.Context) error method, is flushed instead. Other
+// providers are ignored.
+//
+// The providers are shut down in turn, using ctx. The errors returned are
+// joined in the returned error.
+func Shutdown(ctx context.Context) error {
+	providers := []any{GetTracerProvider(), GetMeterProvider()}
+	providers = append(providers, global.SignalProviders()...)
+
+	var errs []error
+	for _, p := range providers {
+		switch p := p.(type) {
+		case interface{ Shutdown(context.Context) error }:
+			errs = append(errs, p.Shutdown(ctx))
+		case interface{ ForceFlush(context.Context) error }:
+			errs = append(errs, p.ForceFlush(ctx))
+		}
+	}
+	return errors.Join(errs...)
+}
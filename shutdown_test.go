@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/internal/global"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+type shutdownTracerProvider struct {
+	tracenoop.TracerProvider
+
+	err       error
+	shutdownN int
+}
+
+func (p *shutdownTracerProvider) Shutdown(context.Context) error {
+	p.shutdownN++
+	return p.err
+}
+
+type flushMeterProvider struct {
+	metricnoop.MeterProvider
+
+	err    error
+	flushN int
+}
+
+func (p *flushMeterProvider) ForceFlush(context.Context) error {
+	p.flushN++
+	return p.err
+}
+
+type shutdownSignalProvider struct {
+	err       error
+	shutdownN int
+}
+
+func (p *shutdownSignalProvider) Shutdown(context.Context) error {
+	p.shutdownN++
+	return p.err
+}
+
+func TestShutdown(t *testing.T) {
+	tp, mp := GetTracerProvider(), GetMeterProvider()
+	t.Cleanup(func() {
+		SetTracerProvider(tp)
+		SetMeterProvider(mp)
+		global.SetSignalProvider("logs", nil)
+	})
+
+	assert.NoError(t, Shutdown(context.Background()), "noop providers")
+
+	errTracer, errMeter, errLogger := errors.New("tracer"), errors.New("meter"), errors.New("logger")
+	tracerProvider := &shutdownTracerProvider{err: errTracer}
+	meterProvider := &flushMeterProvider{err: errMeter}
+	loggerProvider := &shutdownSignalProvider{err: errLogger}
+	SetTracerProvider(tracerProvider)
+	SetMeterProvider(meterProvider)
+	global.SetSignalProvider("logs", loggerProvider)
+
+	err := Shutdown(context.Background())
+	assert.ErrorIs(t, err, errTracer)
+	assert.ErrorIs(t, err, errMeter)
+	assert.ErrorIs(t, err, errLogger)
+	assert.Equal(t, 1, tracerProvider.shutdownN, "TracerProvider not shut down")
+	assert.Equal(t, 1, meterProvider.flushN, "MeterProvider not flushed")
+	assert.Equal(t, 1, loggerProvider.shutdownN, "LoggerProvider not shut down")
+}
@@ -56,6 +56,13 @@ type autoTracer struct {
 
 var _ Tracer = autoTracer{}
 
+// Enabled returns true. The sampling decision is made by the
+// auto-instrumentation when a span is started, so it cannot be known
+// beforehand.
+func (t autoTracer) Enabled(context.Context, EnabledParameters) bool {
+	return true
+}
+
 func (t autoTracer) Start(ctx context.Context, name string, opts ...SpanStartOption) (context.Context, Span) {
 	var psc, sc SpanContext
 	sampled := true
@@ -72,7 +79,7 @@ func (t autoTracer) Start(ctx context.Context, name string, opts ...SpanStartOpt
 	if sampled {
 		// Only build traces if sampled.
 		cfg := NewSpanStartConfig(opts...)
-		span.traces, span.span = t.traces(name, cfg, span.spanContext, psc)
+		span.traces, span.span = t.traces(name, cfg, span.spanContext, psc, InheritedAttributesFromContext(ctx))
 	}
 
 	return ctx, span
@@ -94,7 +101,7 @@ func (t *autoTracer) start(
 // start is used for testing.
 var start = func(context.Context, *autoSpan, *SpanContext, *bool, *SpanContext) {}
 
-func (t autoTracer) traces(name string, cfg SpanConfig, sc, psc SpanContext) (*telemetry.Traces, *telemetry.Span) {
+func (t autoTracer) traces(name string, cfg SpanConfig, sc, psc SpanContext, inherited []attribute.KeyValue) (*telemetry.Traces, *telemetry.Span) {
 	span := &telemetry.Span{
 		TraceID:      telemetry.TraceID(sc.TraceID()),
 		SpanID:       telemetry.SpanID(sc.SpanID()),
@@ -105,7 +112,15 @@ func (t autoTracer) traces(name string, cfg SpanConfig, sc, psc SpanContext) (*t
 		Kind:         spanKind(cfg.SpanKind()),
 	}
 
-	span.Attrs, span.DroppedAttrs = convCappedAttrs(maxSpan.Attrs, cfg.Attributes())
+	attrs := cfg.Attributes()
+	if len(inherited) > 0 {
+		attrs = append(inherited[:len(inherited):len(inherited)], attrs...)
+	}
+	for _, fn := range cfg.LazyAttributes() {
+		// Do not modify the attributes provided by the user.
+		attrs = append(attrs[:len(attrs):len(attrs)], fn()...)
+	}
+	span.Attrs, span.DroppedAttrs = convCappedAttrs(maxSpan.Attrs, attrs)
 
 	links := cfg.Links()
 	if limit := maxSpan.Links; limit == 0 {
@@ -343,6 +358,22 @@ func convAttrValue(value attribute.Value) telemetry.Value {
 			out = append(out, telemetry.StringValue(v))
 		}
 		return telemetry.SliceValue(out...)
+	case attribute.BYTES:
+		return telemetry.BytesValue(value.AsBytes())
+	case attribute.SLICE:
+		slice := value.AsSlice()
+		out := make([]telemetry.Value, 0, len(slice))
+		for _, v := range slice {
+			out = append(out, convAttrValue(v))
+		}
+		return telemetry.SliceValue(out...)
+	case attribute.MAP:
+		kvs := value.AsMap()
+		out := make([]telemetry.Attr, 0, len(kvs))
+		for _, kv := range kvs {
+			out = append(out, telemetry.Attr{Key: string(kv.Key), Value: convAttrValue(kv.Value)})
+		}
+		return telemetry.MapValue(out...)
 	}
 	return telemetry.Value{}
 }
@@ -462,15 +493,18 @@ func (s *autoSpan) RecordError(err error, opts ...EventOption) {
 
 	cfg := NewEventConfig(opts...)
 
+	errType := err
+	if cfg.UnwrapErrorType() {
+		errType = innermostError(err)
+	}
+
 	attrs := cfg.Attributes()
 	attrs = append(attrs,
-		semconv.ExceptionType(typeStr(err)),
+		semconv.ExceptionType(typeStr(errType)),
 		semconv.ExceptionMessage(err.Error()),
 	)
 	if cfg.StackTrace() {
-		buf := make([]byte, 2048)
-		n := runtime.Stack(buf, false)
-		attrs = append(attrs, semconv.ExceptionStacktrace(string(buf[0:n])))
+		attrs = append(attrs, semconv.ExceptionStacktrace(stackTrace()))
 	}
 
 	s.mu.Lock()
@@ -488,6 +522,42 @@ func typeStr(i any) string {
 	return fmt.Sprintf("%s.%s", t.PkgPath(), t.Name())
 }
 
+// innermostError returns the innermost error of the unwrap chain of err. If
+// an error wraps multiple errors, the first one is followed.
+func innermostError(err error) error {
+	for {
+		var next error
+		switch e := err.(type) {
+		case interface{ Unwrap() error }:
+			next = e.Unwrap()
+		case interface{ Unwrap() []error }:
+			if errs := e.Unwrap(); len(errs) > 0 {
+				next = errs[0]
+			}
+		}
+		if next == nil {
+			return err
+		}
+		err = next
+	}
+}
+
+// maxStackTraceSize is the maximum size of a recorded stack trace.
+const maxStackTraceSize = 64 << 10
+
+// stackTrace returns the formatted stack trace of the calling goroutine.
+// Stack traces larger than maxStackTraceSize are truncated.
+func stackTrace() string {
+	buf := make([]byte, 2048)
+	for {
+		n := runtime.Stack(buf, false)
+		if n < len(buf) || len(buf) >= maxStackTraceSize {
+			return string(buf[0:n])
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
+
 func (s *autoSpan) AddEvent(name string, opts ...EventOption) {
 	if s == nil || !s.sampled.Load() {
 		return
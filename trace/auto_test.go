@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math"
 	"strconv"
 	"sync"
@@ -36,6 +37,9 @@ var (
 		attribute.Int64Slice("int64 slice", []int64{1030, 0, 0}),
 		attribute.Float64Slice("float64 slice", []float64{1e9}),
 		attribute.StringSlice("string slice", []string{"one", "two"}),
+		attribute.Bytes("bytes", []byte("value")),
+		attribute.Slice("slice", attribute.StringValue("one"), attribute.IntValue(2)),
+		attribute.Map("map", attribute.String("one", "1"), attribute.Int("two", 2)),
 	}
 
 	tAttrs = []telemetry.Attr{
@@ -65,6 +69,15 @@ var (
 			telemetry.StringValue("one"),
 			telemetry.StringValue("two"),
 		),
+		telemetry.Bytes("bytes", []byte("value")),
+		telemetry.Slice("slice",
+			telemetry.StringValue("one"),
+			telemetry.Int64Value(2),
+		),
+		telemetry.Map("map",
+			telemetry.String("one", "1"),
+			telemetry.Int64("two", 2),
+		),
 	}
 
 	spanContext0 = NewSpanContext(SpanContextConfig{
@@ -364,6 +377,17 @@ func TestSpanCreation(t *testing.T) {
 				assert.Equal(t, tAttrs, s.span.Attrs)
 			},
 		},
+		{
+			TestName: "WithLazyAttributes",
+			Options: []SpanStartOption{
+				WithAttributes(attrs[:1]...),
+				WithLazyAttributes(func() []attribute.KeyValue { return attrs[1:] }),
+			},
+			Eval: func(t *testing.T, _ context.Context, s *autoSpan) {
+				t.Run("Tracer", assertTracer(s.traces))
+				assert.Equal(t, tAttrs, s.span.Attrs)
+			},
+		},
 		{
 			TestName: "WithLinks",
 			Options: []SpanStartOption{
@@ -393,6 +417,17 @@ func TestSpanCreation(t *testing.T) {
 	}
 }
 
+func TestTracerStartInheritedAttributes(t *testing.T) {
+	t.Parallel()
+
+	ctx := ContextWithInheritedAttributes(context.Background(), attrs[:1]...)
+	tr := newAutoTracerProvider().Tracer(tName)
+	_, s := tr.Start(ctx, "span.name", WithAttributes(attrs[1:]...))
+
+	require.IsType(t, &autoSpan{}, s)
+	assert.Equal(t, tAttrs, s.(*autoSpan).span.Attrs)
+}
+
 func TestSpanEnd(t *testing.T) {
 	orig := ended
 	t.Cleanup(func() { ended = orig })
@@ -602,6 +637,13 @@ func TestSpanRecordError(t *testing.T) {
 		}
 	}
 	assert.True(t, hasST, "missing stacktrace attribute")
+
+	s.RecordError(fmt.Errorf("wrapped: %w", err), WithTimestamp(ts), WithUnwrapErrorType(true))
+	require.Len(t, s.span.Events, 3, "missing event")
+	assert.Equal(t, []telemetry.Attr{
+		telemetry.String(string(semconv.ExceptionTypeKey), "*errors.errorString"),
+		telemetry.String(string(semconv.ExceptionMessageKey), "wrapped: test"),
+	}, s.span.Events[2].Attrs)
 }
 
 func TestAddEventLimit(t *testing.T) {
@@ -877,6 +919,7 @@ func (b spanBuilder) Build() *autoSpan {
 		NewSpanStartConfig(b.Options...),
 		s.spanContext,
 		SpanContext{},
+		nil,
 	)
 
 	return s
@@ -55,12 +55,17 @@ func (fn tracerOptionFunc) apply(cfg TracerConfig) TracerConfig {
 
 // SpanConfig is a group of options for a Span.
 type SpanConfig struct {
-	attributes []attribute.KeyValue
-	timestamp  time.Time
-	links      []Link
-	newRoot    bool
-	spanKind   SpanKind
-	stackTrace bool
+	attributes     []attribute.KeyValue
+	lazyAttributes []func() []attribute.KeyValue
+	timestamp      time.Time
+	monotonic      time.Duration
+	hasMonotonic   bool
+	links          []Link
+	newRoot        bool
+	spanKind       SpanKind
+	stackTrace     bool
+	autoEnd        bool
+	autoEndGrace   time.Duration
 }
 
 // Attributes describe the associated qualities of a Span.
@@ -68,16 +73,34 @@ func (cfg *SpanConfig) Attributes() []attribute.KeyValue {
 	return cfg.attributes
 }
 
+// LazyAttributes are functions returning attributes of a Span that are only
+// to be called if the Span is recording.
+func (cfg *SpanConfig) LazyAttributes() []func() []attribute.KeyValue {
+	return cfg.lazyAttributes
+}
+
 // Timestamp is a time in a Span life-cycle.
 func (cfg *SpanConfig) Timestamp() time.Time {
 	return cfg.timestamp
 }
 
+// MonotonicTimestamp returns the monotonic clock reading taken at Timestamp,
+// and if one was provided with WithMonotonicTimestamp.
+func (cfg *SpanConfig) MonotonicTimestamp() (reading time.Duration, ok bool) {
+	return cfg.monotonic, cfg.hasMonotonic
+}
+
 // StackTrace checks whether stack trace capturing is enabled.
 func (cfg *SpanConfig) StackTrace() bool {
 	return cfg.stackTrace
 }
 
+// AutoEnd returns the grace period after the cancellation of its context a
+// Span is ended within, and if the Span is to be ended automatically.
+func (cfg *SpanConfig) AutoEnd() (grace time.Duration, ok bool) {
+	return cfg.autoEndGrace, cfg.autoEnd
+}
+
 // Links are the associations a Span has with other Spans.
 func (cfg *SpanConfig) Links() []Link {
 	return cfg.links
@@ -139,9 +162,14 @@ type SpanEndOption interface {
 
 // EventConfig is a group of options for an Event.
 type EventConfig struct {
-	attributes []attribute.KeyValue
-	timestamp  time.Time
-	stackTrace bool
+	attributes      []attribute.KeyValue
+	timestamp       time.Time
+	monotonic       time.Duration
+	hasMonotonic    bool
+	stackTrace      bool
+	unwrapErrorType bool
+	errorType       string
+	hasErrorType    bool
 }
 
 // Attributes describe the associated qualities of an Event.
@@ -154,11 +182,30 @@ func (cfg *EventConfig) Timestamp() time.Time {
 	return cfg.timestamp
 }
 
+// MonotonicTimestamp returns the monotonic clock reading taken at Timestamp,
+// and if one was provided with WithMonotonicTimestamp.
+func (cfg *EventConfig) MonotonicTimestamp() (reading time.Duration, ok bool) {
+	return cfg.monotonic, cfg.hasMonotonic
+}
+
 // StackTrace checks whether stack trace capturing is enabled.
 func (cfg *EventConfig) StackTrace() bool {
 	return cfg.stackTrace
 }
 
+// UnwrapErrorType checks whether the type of a recorded error is determined
+// from its unwrap chain.
+func (cfg *EventConfig) UnwrapErrorType() bool {
+	return cfg.unwrapErrorType
+}
+
+// ErrorType returns the value of the error.type attribute a Span is failed
+// with by Fail, and if the attribute is to be set. An empty value with ok set
+// to true means the type of the error is used.
+func (cfg *EventConfig) ErrorType() (errorType string, ok bool) {
+	return cfg.errorType, cfg.hasErrorType
+}
+
 // NewEventConfig applies all the EventOptions to a returned EventConfig. If no
 // timestamp option is passed, the returned EventConfig will have a Timestamp
 // set to the call time, otherwise no validation is performed on the returned
@@ -234,12 +281,14 @@ type timestampOption time.Time
 
 func (o timestampOption) applySpan(c SpanConfig) SpanConfig {
 	c.timestamp = time.Time(o)
+	c.monotonic, c.hasMonotonic = 0, false
 	return c
 }
 func (o timestampOption) applySpanStart(c SpanConfig) SpanConfig { return o.applySpan(c) }
 func (o timestampOption) applySpanEnd(c SpanConfig) SpanConfig   { return o.applySpan(c) }
 func (o timestampOption) applyEvent(c EventConfig) EventConfig {
 	c.timestamp = time.Time(o)
+	c.monotonic, c.hasMonotonic = 0, false
 	return c
 }
 
@@ -251,6 +300,40 @@ func WithTimestamp(t time.Time) SpanEventOption {
 	return timestampOption(t)
 }
 
+type monotonicTimestampOption struct {
+	wall      time.Time
+	monotonic time.Duration
+}
+
+func (o monotonicTimestampOption) applySpan(c SpanConfig) SpanConfig {
+	c.timestamp = o.wall
+	c.monotonic, c.hasMonotonic = o.monotonic, true
+	return c
+}
+func (o monotonicTimestampOption) applySpanStart(c SpanConfig) SpanConfig { return o.applySpan(c) }
+func (o monotonicTimestampOption) applySpanEnd(c SpanConfig) SpanConfig   { return o.applySpan(c) }
+func (o monotonicTimestampOption) applyEvent(c EventConfig) EventConfig {
+	c.timestamp = o.wall
+	c.monotonic, c.hasMonotonic = o.monotonic, true
+	return c
+}
+
+var _ SpanEventOption = monotonicTimestampOption{}
+
+// WithMonotonicTimestamp sets the time of a Span or Event life-cycle moment
+// like WithTimestamp, along with the reading of a monotonic clock taken at
+// the same moment. Monotonic readings are only meaningful relative to each
+// other, e.g. the time elapsed since the recording process started.
+//
+// This is intended to replay recorded telemetry. If a Span is started with a
+// monotonic reading, the time of its end and events provided with one is its
+// start time plus the difference between the readings. This keeps durations
+// correct even if the wall clock of the recording process was adjusted while
+// the Span was active. Otherwise, wall is used as is.
+func WithMonotonicTimestamp(wall time.Time, monotonic time.Duration) SpanEventOption {
+	return monotonicTimestampOption{wall: wall, monotonic: monotonic}
+}
+
 type stackTraceOption bool
 
 func (o stackTraceOption) applyEvent(c EventConfig) EventConfig {
@@ -269,6 +352,61 @@ func WithStackTrace(b bool) SpanEndEventOption {
 	return stackTraceOption(b)
 }
 
+type unwrapErrorTypeOption bool
+
+func (o unwrapErrorTypeOption) applyEvent(c EventConfig) EventConfig {
+	c.unwrapErrorType = bool(o)
+	return c
+}
+
+// WithUnwrapErrorType sets whether the type of an error recorded with
+// Span.RecordError is the type of the innermost error of its unwrap chain
+// (e.g. true, false), instead of the type of the error itself.
+//
+// This is useful when errors are wrapped with additional context (e.g. with
+// fmt.Errorf and the %w verb), in which case the type of the error is a
+// generic wrapper type that does not describe the error. The chain is
+// followed with the Unwrap method of each error, see the errors package. If
+// an error wraps multiple errors, the first one is followed.
+func WithUnwrapErrorType(b bool) EventOption {
+	return unwrapErrorTypeOption(b)
+}
+
+type errorTypeOption string
+
+func (o errorTypeOption) applyEvent(c EventConfig) EventConfig {
+	c.errorType, c.hasErrorType = string(o), true
+	return c
+}
+
+// WithErrorType sets the error.type attribute of a Span failed with Fail to
+// errorType. If errorType is empty, the type of the error (e.g.
+// "*net.OpError") is used instead, see also WithUnwrapErrorType.
+//
+// This option only has an effect when used with Fail.
+func WithErrorType(errorType string) EventOption {
+	return errorTypeOption(errorType)
+}
+
+// WithLazyAttributes adds the attributes returned by fn to a Span. Unlike
+// WithAttributes, fn is only called if the Span is recording, so expensive
+// operations to compute attributes (e.g. serializing a request) are skipped
+// for Spans that are not sampled.
+//
+// Because fn is called after the sampling decision is made, the attributes
+// it returns are not available to samplers. Attributes that a sampler needs
+// should be provided with WithAttributes. If multiple WithLazyAttributes
+// options are passed, the functions are called in the order they were
+// provided, after the attributes from WithAttributes are added.
+func WithLazyAttributes(fn func() []attribute.KeyValue) SpanStartOption {
+	return spanOptionFunc(func(cfg SpanConfig) SpanConfig {
+		if fn != nil {
+			cfg.lazyAttributes = append(cfg.lazyAttributes, fn)
+		}
+		return cfg
+	})
+}
+
 // WithLinks adds links to a Span. The links are added to the existing Span
 // links, i.e. this does not overwrite. Links with invalid span context are ignored.
 func WithLinks(links ...Link) SpanStartOption {
@@ -288,14 +426,38 @@ func WithNewRoot() SpanStartOption {
 	})
 }
 
-// WithSpanKind sets the SpanKind of a Span.
-func WithSpanKind(kind SpanKind) SpanStartOption {
+// WithAutoEnd sets a Span to be ended automatically if the context it is
+// started with is canceled and End is not called within grace after that.
+// The Span is then ended with an Error status describing the cause of the
+// cancellation.
+//
+// This prevents Spans that are never ended, e.g. because of a missing call to
+// End in an early return, from being leaked. Ending Spans remains the
+// responsibility of the caller; this is a safeguard. Implementations that do
+// not record Spans ignore this option.
+func WithAutoEnd(grace time.Duration) SpanStartOption {
 	return spanOptionFunc(func(cfg SpanConfig) SpanConfig {
-		cfg.spanKind = kind
+		cfg.autoEnd = true
+		cfg.autoEndGrace = max(grace, 0)
 		return cfg
 	})
 }
 
+// WithSpanKind sets the SpanKind of a Span.
+func WithSpanKind(kind SpanKind) SpanStartOption {
+	return spanKindOption(kind)
+}
+
+// spanKindOption is a SpanStartOption that sets the SpanKind of a Span.
+// Unlike a closure, converting a valid SpanKind to this option does not
+// allocate.
+type spanKindOption SpanKind
+
+func (o spanKindOption) applySpanStart(cfg SpanConfig) SpanConfig {
+	cfg.spanKind = SpanKind(o)
+	return cfg
+}
+
 // WithInstrumentationVersion sets the instrumentation version.
 func WithInstrumentationVersion(version string) TracerOption {
 	return tracerOptionFunc(func(cfg TracerConfig) TracerConfig {
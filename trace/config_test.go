@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
 )
@@ -176,6 +177,38 @@ func TestSpanStartConfigAttributeMutability(t *testing.T) {
 	assert.Equal(t, want, conf)
 }
 
+func TestWithLazyAttributes(t *testing.T) {
+	k1v1 := attribute.String("key1", "value1")
+	var called bool
+	c := NewSpanStartConfig(
+		WithLazyAttributes(nil),
+		WithLazyAttributes(func() []attribute.KeyValue {
+			called = true
+			return []attribute.KeyValue{k1v1}
+		}),
+	)
+	assert.False(t, called, "lazy attributes evaluated by config")
+	assert.Empty(t, c.Attributes())
+	require.Len(t, c.LazyAttributes(), 1)
+	assert.Equal(t, []attribute.KeyValue{k1v1}, c.LazyAttributes()[0]())
+}
+
+func TestWithAutoEnd(t *testing.T) {
+	c := NewSpanStartConfig()
+	_, ok := c.AutoEnd()
+	assert.False(t, ok)
+
+	c = NewSpanStartConfig(WithAutoEnd(time.Second))
+	grace, ok := c.AutoEnd()
+	assert.True(t, ok)
+	assert.Equal(t, time.Second, grace)
+
+	c = NewSpanStartConfig(WithAutoEnd(-time.Second))
+	grace, ok = c.AutoEnd()
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), grace)
+}
+
 func TestEndSpanConfig(t *testing.T) {
 	timestamp := time.Unix(0, 0)
 
@@ -203,6 +236,25 @@ func TestEndSpanConfig(t *testing.T) {
 				timestamp: timestamp,
 			},
 		},
+		{
+			[]SpanEndOption{
+				WithMonotonicTimestamp(timestamp, time.Second),
+			},
+			SpanConfig{
+				timestamp:    timestamp,
+				monotonic:    time.Second,
+				hasMonotonic: true,
+			},
+		},
+		{
+			[]SpanEndOption{
+				WithMonotonicTimestamp(timestamp, time.Second),
+				WithTimestamp(timestamp),
+			},
+			SpanConfig{
+				timestamp: timestamp,
+			},
+		},
 	}
 	for _, test := range tests {
 		assert.Equal(t, test.expected, NewSpanEndConfig(test.options...))
@@ -3,11 +3,21 @@
 
 package trace // import "go.opentelemetry.io/otel/trace"
 
-import "context"
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+)
 
 type traceContextKeyType int
 
-const currentSpanKey traceContextKeyType = iota
+const (
+	currentSpanKey traceContextKeyType = iota
+	inheritedAttributesKey
+)
+
+// namedSpanKey is the context key of a Span stored in a named slot.
+type namedSpanKey string
 
 // ContextWithSpan returns a copy of parent with span set as the current Span.
 func ContextWithSpan(parent context.Context, span Span) context.Context {
@@ -48,3 +58,62 @@ func SpanFromContext(ctx context.Context) Span {
 func SpanContextFromContext(ctx context.Context) SpanContext {
 	return SpanFromContext(ctx).SpanContext()
 }
+
+// ContextWithSpanNamed returns a copy of parent with span stored in the slot
+// identified by name.
+//
+// Named slots are independent of the current Span and of each other. They
+// allow layered instrumentation (e.g. a server, client, and messaging
+// instrumentation handling the same request) to each keep track of its own
+// Span and retrieve it explicitly with SpanFromContextNamed, regardless of
+// which Span is current. Storing a Span in a named slot does not make it the
+// current Span, use ContextWithSpan for that.
+func ContextWithSpanNamed(parent context.Context, name string, span Span) context.Context {
+	return context.WithValue(parent, namedSpanKey(name), span)
+}
+
+// SpanFromContextNamed returns the Span stored in the slot identified by name
+// in ctx.
+//
+// If no Span is stored in the named slot of ctx an implementation of a Span
+// that performs no operations is returned.
+func SpanFromContextNamed(ctx context.Context, name string) Span {
+	if ctx == nil {
+		return noopSpanInstance
+	}
+	if span, ok := ctx.Value(namedSpanKey(name)).(Span); ok {
+		return span
+	}
+	return noopSpanInstance
+}
+
+// ContextWithInheritedAttributes returns a copy of parent with attrs added to
+// the attributes inherited by Spans started with it, or any context derived
+// from it. Attributes already inherited in parent are kept, unless a key in
+// attrs replaces them.
+//
+// This allows attributes to be attached to an operation (e.g. a tenant ID)
+// without recording a Span for it. Recording Spans started as descendants of
+// the returned context receive the attributes, as if they were passed with
+// WithAttributes, and attributes passed at creation of the Span take
+// precedence. Inherited attributes are not propagated to other processes and
+// are not available to samplers.
+func ContextWithInheritedAttributes(parent context.Context, attrs ...attribute.KeyValue) context.Context {
+	inherited := InheritedAttributesFromContext(parent)
+	merged := make([]attribute.KeyValue, 0, len(inherited)+len(attrs))
+	merged = append(merged, inherited...)
+	merged = append(merged, attrs...)
+	return context.WithValue(parent, inheritedAttributesKey, merged)
+}
+
+// InheritedAttributesFromContext returns the attributes Spans started with
+// ctx inherit. See ContextWithInheritedAttributes.
+//
+// The returned slice must not be modified.
+func InheritedAttributesFromContext(ctx context.Context) []attribute.KeyValue {
+	if ctx == nil {
+		return nil
+	}
+	attrs, _ := ctx.Value(inheritedAttributesKey).([]attribute.KeyValue)
+	return attrs
+}
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type testSpan struct {
@@ -90,3 +92,42 @@ func TestSpanFromContext(t *testing.T) {
 		})
 	}
 }
+
+func TestSpanFromContextNamed(t *testing.T) {
+	server := testSpan{ID: 1}
+	client := testSpan{ID: 2}
+
+	ctx := ContextWithSpan(context.Background(), localSpan)
+	ctx = ContextWithSpanNamed(ctx, "server", server)
+	ctx = ContextWithSpanNamed(ctx, "client", client)
+
+	assert.Equal(t, server, SpanFromContextNamed(ctx, "server"))
+	assert.Equal(t, client, SpanFromContextNamed(ctx, "client"))
+	assert.Equal(t, emptySpan, SpanFromContextNamed(ctx, "messaging"))
+	assert.Equal(t, localSpan, SpanFromContext(ctx), "current span changed")
+
+	// Named slots are not the current span.
+	ctx = ContextWithSpanNamed(context.Background(), "server", server)
+	assert.Equal(t, emptySpan, SpanFromContext(ctx))
+
+	//nolint:staticcheck // Test nil context.
+	assert.Equal(t, emptySpan, SpanFromContextNamed(nil, "server"))
+}
+
+func TestContextWithInheritedAttributes(t *testing.T) {
+	tenant := attribute.String("tenant", "a")
+	region := attribute.String("region", "eu")
+
+	ctx := context.Background()
+	assert.Empty(t, InheritedAttributesFromContext(ctx))
+
+	parent := ContextWithInheritedAttributes(ctx, tenant)
+	assert.Equal(t, []attribute.KeyValue{tenant}, InheritedAttributesFromContext(parent))
+
+	child := ContextWithInheritedAttributes(parent, region)
+	assert.Equal(t, []attribute.KeyValue{tenant, region}, InheritedAttributesFromContext(child))
+	assert.Equal(t, []attribute.KeyValue{tenant}, InheritedAttributesFromContext(parent), "parent modified")
+
+	//nolint:staticcheck // Test nil context.
+	assert.Empty(t, InheritedAttributesFromContext(nil))
+}
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/trace"
+
+import (
+	"context"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// StartClient starts a Span of SpanKindClient using t. It is equivalent to
+// calling t.Start with the WithSpanKind(SpanKindClient) option, except that
+// the kind cannot be changed by opts.
+func StartClient(ctx context.Context, t Tracer, name string, opts ...SpanStartOption) (context.Context, Span) {
+	return startKind(ctx, t, name, SpanKindClient, nil, opts, nil)
+}
+
+// StartServer starts a Span of SpanKindServer using t. It is equivalent to
+// calling t.Start with the WithSpanKind(SpanKindServer) option, except that
+// the kind cannot be changed by opts.
+func StartServer(ctx context.Context, t Tracer, name string, opts ...SpanStartOption) (context.Context, Span) {
+	return startKind(ctx, t, name, SpanKindServer, nil, opts, nil)
+}
+
+// StartProducer starts a Span of SpanKindProducer using t for a message sent
+// to the messaging system identified by system (e.g. "kafka"). The kind
+// cannot be changed by opts.
+//
+// The "messaging.system" attribute, required by the semantic conventions, is
+// set to system and cannot be changed by opts. The "messaging.operation.type"
+// attribute is set to "publish" unless opts set it.
+func StartProducer(ctx context.Context, t Tracer, name, system string, opts ...SpanStartOption) (context.Context, Span) {
+	return startKind(
+		ctx, t, name, SpanKindProducer,
+		WithAttributes(semconv.MessagingOperationTypePublish),
+		opts,
+		WithAttributes(semconv.MessagingSystemKey.String(system)),
+	)
+}
+
+// StartConsumer starts a Span of SpanKindConsumer using t for a message
+// received from the messaging system identified by system (e.g. "kafka").
+// The kind cannot be changed by opts.
+//
+// The "messaging.system" attribute, required by the semantic conventions, is
+// set to system and cannot be changed by opts. The "messaging.operation.type"
+// attribute is set to "process" unless opts set it.
+func StartConsumer(ctx context.Context, t Tracer, name, system string, opts ...SpanStartOption) (context.Context, Span) {
+	return startKind(
+		ctx, t, name, SpanKindConsumer,
+		WithAttributes(semconv.MessagingOperationTypeDeliver),
+		opts,
+		WithAttributes(semconv.MessagingSystemKey.String(system)),
+	)
+}
+
+// startKind starts a Span of kind with t. The defaults option is applied
+// before opts so it can be overridden, enforced is applied after them.
+func startKind(
+	ctx context.Context,
+	t Tracer,
+	name string,
+	kind SpanKind,
+	defaults SpanStartOption,
+	opts []SpanStartOption,
+	enforced SpanStartOption,
+) (context.Context, Span) {
+	o := make([]SpanStartOption, 0, len(opts)+3)
+	if defaults != nil {
+		o = append(o, defaults)
+	}
+	o = append(o, opts...)
+	if enforced != nil {
+		o = append(o, enforced)
+	}
+	o = append(o, WithSpanKind(kind))
+	return t.Start(ctx, name, o...)
+}
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// configTracer records the configuration of the last started Span.
+type configTracer struct {
+	noopTracer
+
+	config SpanConfig
+}
+
+func (t *configTracer) Start(ctx context.Context, name string, opts ...SpanStartOption) (context.Context, Span) {
+	t.config = NewSpanStartConfig(opts...)
+	return t.noopTracer.Start(ctx, name, opts...)
+}
+
+func TestStartKind(t *testing.T) {
+	custom := attribute.String("key", "value")
+	override := []SpanStartOption{
+		WithSpanKind(SpanKindInternal),
+		WithAttributes(custom),
+	}
+
+	tests := []struct {
+		name  string
+		start func(Tracer) (context.Context, Span)
+		kind  SpanKind
+		attrs []attribute.KeyValue
+	}{
+		{
+			name: "Client",
+			start: func(tr Tracer) (context.Context, Span) {
+				return StartClient(context.Background(), tr, "span", override...)
+			},
+			kind:  SpanKindClient,
+			attrs: []attribute.KeyValue{custom},
+		},
+		{
+			name: "Server",
+			start: func(tr Tracer) (context.Context, Span) {
+				return StartServer(context.Background(), tr, "span", override...)
+			},
+			kind:  SpanKindServer,
+			attrs: []attribute.KeyValue{custom},
+		},
+		{
+			name: "Producer",
+			start: func(tr Tracer) (context.Context, Span) {
+				return StartProducer(context.Background(), tr, "span", "kafka", override...)
+			},
+			kind: SpanKindProducer,
+			attrs: []attribute.KeyValue{
+				semconv.MessagingOperationTypePublish,
+				custom,
+				semconv.MessagingSystemKey.String("kafka"),
+			},
+		},
+		{
+			name: "Consumer",
+			start: func(tr Tracer) (context.Context, Span) {
+				return StartConsumer(
+					context.Background(), tr, "span", "kafka",
+					WithAttributes(semconv.MessagingOperationTypeReceive, semconv.MessagingSystemKey.String("rabbitmq")),
+				)
+			},
+			kind: SpanKindConsumer,
+			attrs: []attribute.KeyValue{
+				semconv.MessagingOperationTypeDeliver,
+				semconv.MessagingOperationTypeReceive,
+				semconv.MessagingSystemKey.String("rabbitmq"),
+				semconv.MessagingSystemKey.String("kafka"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tr := new(configTracer)
+			_, span := test.start(tr)
+			assert.NotNil(t, span)
+			assert.Equal(t, test.kind, tr.config.SpanKind())
+			assert.Equal(t, test.attrs, tr.config.Attributes())
+		})
+	}
+}
@@ -30,6 +30,9 @@ func (p noopTracerProvider) Tracer(string, ...TracerOption) Tracer {
 	return noopTracer{}
 }
 
+// IsNoop returns true. The TracerProvider performs no operations.
+func (p noopTracerProvider) IsNoop() bool { return true }
+
 // noopTracer is an implementation of Tracer that performs no operations.
 type noopTracer struct{ embedded.Tracer }
 
@@ -39,13 +42,24 @@ var _ Tracer = noopTracer{}
 // creates a no-op Span.
 func (t noopTracer) Start(ctx context.Context, name string, _ ...SpanStartOption) (context.Context, Span) {
 	span := SpanFromContext(ctx)
-	if _, ok := span.(nonRecordingSpan); !ok {
-		// span is likely already a noopSpan, but let's be sure
-		span = noopSpanInstance
+	switch span.(type) {
+	case nonRecordingSpan, noopSpan:
+		// span is already the current span of ctx, or ctx has no span which
+		// is equivalent to a noopSpan. Do not allocate a new context.
+		return ctx, span
 	}
+	span = noopSpanInstance
 	return ContextWithSpan(ctx, span), span
 }
 
+// Enabled returns false. No recording spans are created.
+func (t noopTracer) Enabled(context.Context, EnabledParameters) bool {
+	return false
+}
+
+// IsNoop returns true. The Tracer performs no operations.
+func (t noopTracer) IsNoop() bool { return true }
+
 // noopSpan is an implementation of Span that performs no operations.
 type noopSpan struct{ embedded.Span }
 
@@ -81,6 +95,9 @@ func (noopSpan) AddLink(Link) {}
 // SetName does nothing.
 func (noopSpan) SetName(string) {}
 
+// IsNoop returns true. The Span performs no operations.
+func (noopSpan) IsNoop() bool { return true }
+
 // TracerProvider returns a no-op TracerProvider.
 func (s noopSpan) TracerProvider() TracerProvider {
 	return s.tracerProvider(autoInstEnabled)
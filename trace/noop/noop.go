@@ -10,6 +10,10 @@
 // This implementation can be embedded in other implementations of the
 // OpenTelemetry trace API. Doing so will mean the implementation defaults to
 // no operation for methods it does not implement.
+//
+// IsNoop reports whether an implementation performs no operations. Types
+// embedding the types of this package, like test doubles overriding some of
+// their methods, are not reported as no-op.
 package noop // import "go.opentelemetry.io/otel/trace/noop"
 
 import (
@@ -51,6 +55,11 @@ type Tracer struct{ embedded.Tracer }
 // If ctx contains a span context, the returned span will also contain that
 // span context. If the span context in ctx is for a non-recording span, that
 // span instance will be returned directly.
+//
+// Start does not allocate if ctx does not need to be updated: if the span in
+// ctx is non-recording, or ctx does not contain a span, ctx is returned.
+// Building the options passed to Start, such as with [trace.WithAttributes],
+// may still allocate.
 func (t Tracer) Start(ctx context.Context, _ string, _ ...trace.SpanStartOption) (context.Context, trace.Span) {
 	span := trace.SpanFromContext(ctx)
 
@@ -66,13 +75,28 @@ func (t Tracer) Start(ctx context.Context, _ string, _ ...trace.SpanStartOption)
 		// Otherwise, return the span context needs in a non-recording span.
 		span = Span{sc: sc}
 	} else {
+		if _, ok := span.(Span); ok || span == emptySpan {
+			// The span in ctx, if any, is already a No-Op span with an
+			// empty span context. Do not allocate a new context.
+			return ctx, noopSpanInstance
+		}
 		// No parent, return a No-Op span with an empty span context.
 		span = noopSpanInstance
 	}
 	return trace.ContextWithSpan(ctx, span), span
 }
 
-var noopSpanInstance trace.Span = Span{}
+var (
+	noopSpanInstance trace.Span = Span{}
+
+	// emptySpan is the span returned from a context without a span.
+	emptySpan = trace.SpanFromContext(context.Background())
+)
+
+// Enabled returns false. The Tracer never creates recording spans.
+func (Tracer) Enabled(context.Context, trace.EnabledParameters) bool {
+	return false
+}
 
 // Span is an OpenTelemetry No-Op Span.
 type Span struct {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -73,6 +74,11 @@ func TestNewTracerProvider(t *testing.T) {
 	assert.Equal(t, Tracer{}, tracer)
 }
 
+func TestTracerEnabled(t *testing.T) {
+	tracer := NewTracerProvider().Tracer("")
+	assert.False(t, tracer.Enabled(context.Background(), trace.EnabledParameters{}))
+}
+
 func TestTracerStartPropagatesSpanContext(t *testing.T) {
 	tracer := NewTracerProvider().Tracer("")
 	spanCtx := trace.SpanContext{}
@@ -101,6 +107,46 @@ func TestTracerStartPropagatesSpanContext(t *testing.T) {
 	assert.False(t, span.IsRecording(), "recording span returned")
 }
 
+func TestTracerStartAllocs(t *testing.T) {
+	var tracer trace.Tracer = NewTracerProvider().Tracer("")
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID([16]byte{1}),
+		SpanID:  trace.SpanID([8]byte{1}),
+	})
+	contexts := map[string]context.Context{
+		"Empty":        context.Background(),
+		"NonRecording": trace.ContextWithSpanContext(context.Background(), sc),
+		"Noop":         trace.ContextWithSpan(context.Background(), Span{}),
+	}
+
+	for name, ctx := range contexts {
+		t.Run(name, func(t *testing.T) {
+			allocs := testing.AllocsPerRun(5, func() {
+				_, span := tracer.Start(ctx, "", trace.WithSpanKind(trace.SpanKindClient))
+				span.End()
+			})
+			assert.Zero(t, allocs)
+
+			// Building attribute options allocates. Start must not add to
+			// that.
+			optAllocs := testing.AllocsPerRun(5, func() {
+				sink = []trace.SpanStartOption{
+					trace.WithAttributes(attribute.String("key", "value")),
+				}
+			})
+			allocs = testing.AllocsPerRun(5, func() {
+				_, span := tracer.Start(ctx, "", trace.WithAttributes(attribute.String("key", "value")))
+				span.End()
+			})
+			assert.LessOrEqual(t, allocs, optAllocs)
+		})
+	}
+}
+
+// sink is used to ensure the options built in allocation tests escape the
+// same way they do when passed to Start.
+var sink []trace.SpanStartOption
+
 func BenchmarkNoopInstance(b *testing.B) {
 	tracer := NewTracerProvider().Tracer("")
 	ctx := trace.ContextWithSpanContext(context.Background(), trace.SpanContext{})
@@ -114,6 +160,23 @@ func BenchmarkNoopInstance(b *testing.B) {
 	}
 }
 
+func BenchmarkNoopInstanceWithOptions(b *testing.B) {
+	tracer := NewTracerProvider().Tracer("")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.SpanContext{})
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, span := tracer.Start(
+			ctx, "",
+			trace.WithAttributes(attribute.String("key", "value")),
+			trace.WithSpanKind(trace.SpanKindClient),
+		)
+		span.End()
+	}
+}
+
 type recordingSpan struct{ Span }
 
 func (recordingSpan) IsRecording() bool { return true }
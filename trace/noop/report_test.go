@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package noop_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type reporter bool
+
+func (r reporter) IsNoop() bool { return bool(r) }
+
+// testTracer overrides Start and embeds noop.Tracer for all other methods.
+type testTracer struct {
+	noop.Tracer
+
+	started int
+}
+
+func (t *testTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	t.started++
+	return t.Tracer.Start(ctx, name, opts...)
+}
+
+func TestIsNoop(t *testing.T) {
+	_, span := noop.Tracer{}.Start(context.Background(), "span")
+
+	assert.True(t, noop.IsNoop(noop.NewTracerProvider()))
+	assert.True(t, noop.IsNoop(noop.NewTracerProvider().Tracer("")))
+	assert.True(t, noop.IsNoop(span))
+	assert.True(t, noop.IsNoop(&noop.Tracer{}))
+	assert.True(t, noop.IsNoop(reporter(true)))
+
+	assert.False(t, noop.IsNoop(nil))
+	assert.False(t, noop.IsNoop(reporter(false)))
+	assert.False(t, noop.IsNoop(&testTracer{}), "embedding type")
+}
+
+func TestIsNoopTracePackage(t *testing.T) {
+	ctx := context.Background()
+	assert.True(t, noop.IsNoop(trace.SpanFromContext(ctx)), "span of empty context")
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x01},
+		SpanID:  trace.SpanID{0x01},
+	})
+	span := trace.SpanFromContext(trace.ContextWithRemoteSpanContext(ctx, sc))
+	assert.True(t, noop.IsNoop(span), "non-recording span")
+
+	//nolint:staticcheck // Testing the deprecated no-op implementation.
+	tp := trace.NewNoopTracerProvider()
+	tracer := tp.Tracer("")
+	_, span = tracer.Start(ctx, "span")
+	assert.True(t, noop.IsNoop(tp), "deprecated provider")
+	assert.True(t, noop.IsNoop(tracer), "deprecated tracer")
+	assert.True(t, noop.IsNoop(span), "deprecated span")
+}
+
+func BenchmarkIsNoop(b *testing.B) {
+	var tp trace.TracerProvider = noop.NewTracerProvider()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = noop.IsNoop(tp)
+	}
+}
@@ -6,6 +6,8 @@ package trace
 import (
 	"context"
 	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func TestNewNoopTracerProvider(t *testing.T) {
@@ -23,6 +25,17 @@ func TestNoopTracerProviderTracer(t *testing.T) {
 	}
 }
 
+func TestRegisterShutdownHookUnsupported(t *testing.T) {
+	unregister, ok := RegisterShutdownHook(NewNoopTracerProvider(), func(context.Context) error {
+		return nil
+	})
+	if ok {
+		t.Error("RegisterShutdownHook() with no-op TracerProvider returned true, want false")
+	}
+	// Must be safe to call.
+	unregister()
+}
+
 func TestNoopTracerStart(t *testing.T) {
 	ctx := context.Background()
 	tracer := NewNoopTracerProvider().Tracer("test instrumentation")
@@ -46,6 +59,13 @@ func TestNoopTracerStart(t *testing.T) {
 	}
 }
 
+func TestNoopTracerEnabled(t *testing.T) {
+	tracer := NewNoopTracerProvider().Tracer("test instrumentation")
+	if tracer.Enabled(context.Background(), EnabledParameters{}) {
+		t.Error("noopTracer.Enabled() returned true, want false")
+	}
+}
+
 func TestNoopSpan(t *testing.T) {
 	tracer := NewNoopTracerProvider().Tracer("test instrumentation")
 	_, s := tracer.Start(context.Background(), "test span")
@@ -78,3 +98,79 @@ func TestNonRecordingSpanTracerStart(t *testing.T) {
 		t.Errorf("SpanContext not carried by nonRecordingSpan. got %#v, want %#v", got, want)
 	}
 }
+
+// noopTracerInstance is used to ensure calls to Start are not devirtualized
+// in allocation tests and benchmarks.
+var noopTracerInstance = NewNoopTracerProvider().Tracer("test instrumentation")
+
+func TestNoopTracerStartAllocs(t *testing.T) {
+	sc := NewSpanContext(SpanContextConfig{TraceID: [16]byte{1}, SpanID: [8]byte{1}})
+	contexts := map[string]context.Context{
+		"Empty":        context.Background(),
+		"NonRecording": ContextWithSpanContext(context.Background(), sc),
+		"Noop":         ContextWithSpan(context.Background(), noopSpanInstance),
+	}
+
+	for name, ctx := range contexts {
+		t.Run(name, func(t *testing.T) {
+			allocs := testing.AllocsPerRun(5, func() {
+				_, span := noopTracerInstance.Start(ctx, "span")
+				span.End()
+			})
+			if allocs != 0 {
+				t.Errorf("Start allocated %v times without options, want 0", allocs)
+			}
+
+			// The options are built for every call, as instrumentation does.
+			// Building them may allocate, but Start must not add to that.
+			optAllocs := testing.AllocsPerRun(5, func() {
+				opts := []SpanStartOption{
+					WithAttributes(attribute.String("key", "value")),
+					WithSpanKind(SpanKindClient),
+				}
+				sink = opts
+			})
+			allocs = testing.AllocsPerRun(5, func() {
+				_, span := noopTracerInstance.Start(
+					ctx, "span",
+					WithAttributes(attribute.String("key", "value")),
+					WithSpanKind(SpanKindClient),
+				)
+				span.End()
+			})
+			if allocs > optAllocs {
+				t.Errorf("Start allocated %v times with options, want at most the %v allocations of the options", allocs, optAllocs)
+			}
+		})
+	}
+}
+
+// sink is used to ensure the options built in allocation tests escape the
+// same way they do when passed to Start.
+var sink []SpanStartOption
+
+func TestWithSpanKindAllocs(t *testing.T) {
+	var opt SpanStartOption
+	allocs := testing.AllocsPerRun(5, func() {
+		opt = WithSpanKind(SpanKindServer)
+	})
+	if allocs != 0 {
+		t.Errorf("WithSpanKind allocated %v times, want 0", allocs)
+	}
+	_ = opt
+}
+
+func BenchmarkNoopTracerStart(b *testing.B) {
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, span := noopTracerInstance.Start(
+			ctx, "span",
+			WithAttributes(attribute.String("key", "value")),
+			WithSpanKind(SpanKindClient),
+		)
+		span.End()
+	}
+}
@@ -3,7 +3,11 @@
 
 package trace // import "go.opentelemetry.io/otel/trace"
 
-import "go.opentelemetry.io/otel/trace/embedded"
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace/embedded"
+)
 
 // TracerProvider provides Tracers that are used by instrumentation code to
 // trace computational workflows.
@@ -57,3 +61,24 @@ type TracerProvider interface {
 	// This method is safe to call concurrently.
 	Tracer(name string, options ...TracerOption) Tracer
 }
+
+// shutdownHookRegisterer is implemented by TracerProviders that support
+// shutdown hooks.
+type shutdownHookRegisterer interface {
+	RegisterShutdownHook(hook func(context.Context) error) (unregister func())
+}
+
+// RegisterShutdownHook registers hook to be called when tp is shut down. This
+// allows instrumentation libraries to release resources tied to the telemetry
+// pipeline of tp (e.g. tickers of runtime metrics) deterministically.
+//
+// The returned unregister function removes hook from tp. It is safe to call
+// multiple times. If tp does not support shutdown hooks (e.g. it is a no-op
+// TracerProvider), hook is not registered and ok is false.
+func RegisterShutdownHook(tp TracerProvider, hook func(context.Context) error) (unregister func(), ok bool) {
+	r, ok := tp.(shutdownHookRegisterer)
+	if !ok || hook == nil {
+		return func() {}, false
+	}
+	return r.RegisterShutdownHook(hook), true
+}
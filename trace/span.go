@@ -8,6 +8,7 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 	"go.opentelemetry.io/otel/trace/embedded"
 )
 
@@ -175,3 +176,32 @@ func (sk SpanKind) String() string {
 		return "unspecified"
 	}
 }
+
+// Fail marks span as failed because of err.
+//
+// This records err as an exception event with opts (see Span.RecordError),
+// and sets the status of span to codes.Error with the message of err as
+// description. If the WithErrorType option is provided, the error.type
+// attribute of span is also set.
+//
+// Nothing is done if err is nil.
+func Fail(span Span, err error, opts ...EventOption) {
+	if span == nil || err == nil {
+		return
+	}
+
+	span.RecordError(err, opts...)
+	span.SetStatus(codes.Error, err.Error())
+
+	cfg := NewEventConfig(opts...)
+	if errType, ok := cfg.ErrorType(); ok {
+		if errType == "" {
+			e := err
+			if cfg.UnwrapErrorType() {
+				e = innermostError(err)
+			}
+			errType = typeStr(e)
+		}
+		span.SetAttributes(semconv.ErrorTypeKey.String(errType))
+	}
+}
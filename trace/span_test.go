@@ -5,12 +5,16 @@ package trace
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace/internal/telemetry"
 )
 
 func TestValidateSpanKind(t *testing.T) {
@@ -99,3 +103,54 @@ func TestLinkFromContext(t *testing.T) {
 	}
 	assert.Equal(t, link.Attributes[0], k1v1)
 }
+
+func TestFail(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", errors.New("test"))
+	tests := []struct {
+		name  string
+		opts  []EventOption
+		attrs []telemetry.Attr
+	}{
+		{name: "NoErrorType"},
+		{
+			name:  "ErrorType",
+			opts:  []EventOption{WithErrorType("timeout")},
+			attrs: []telemetry.Attr{telemetry.String("error.type", "timeout")},
+		},
+		{
+			name:  "ErrorTypeFromError",
+			opts:  []EventOption{WithErrorType("")},
+			attrs: []telemetry.Attr{telemetry.String("error.type", "*fmt.wrapError")},
+		},
+		{
+			name:  "ErrorTypeUnwrapped",
+			opts:  []EventOption{WithErrorType(""), WithUnwrapErrorType(true)},
+			attrs: []telemetry.Attr{telemetry.String("error.type", "*errors.errorString")},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := spanBuilder{}.Build()
+			Fail(s, err, tc.opts...)
+
+			assert.Equal(t, &telemetry.Status{
+				Code:    telemetry.StatusCodeError,
+				Message: err.Error(),
+			}, s.span.Status)
+			require.Len(t, s.span.Events, 1)
+			assert.Equal(t, "exception", s.span.Events[0].Name)
+			assert.Equal(t, tc.attrs, s.span.Attrs)
+		})
+	}
+}
+
+func TestFailNil(t *testing.T) {
+	s := spanBuilder{}.Build()
+	Fail(s, nil, WithErrorType("timeout"))
+	assert.Nil(t, s.span.Status)
+	assert.Empty(t, s.span.Events)
+	assert.Empty(t, s.span.Attrs)
+
+	assert.NotPanics(t, func() { Fail(nil, errors.New("test")) })
+}
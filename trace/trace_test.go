@@ -403,6 +403,22 @@ func TestTraceFlagsWithSampled(t *testing.T) {
 	}
 }
 
+func TestTraceFlagsRandom(t *testing.T) {
+	assert.False(t, TraceFlags(0).IsRandom())
+	assert.False(t, FlagsSampled.IsRandom())
+	assert.True(t, FlagsRandom.IsRandom())
+	assert.True(t, (FlagsSampled | FlagsRandom).IsRandom())
+
+	assert.Equal(t, FlagsRandom, TraceFlags(0).WithRandom(true))
+	assert.Equal(t, FlagsSampled|FlagsRandom, FlagsSampled.WithRandom(true))
+	assert.Equal(t, FlagsSampled, (FlagsSampled | FlagsRandom).WithRandom(false))
+	assert.Equal(t, TraceFlags(0), TraceFlags(0).WithRandom(false))
+
+	sc := SpanContext{traceFlags: FlagsRandom}
+	assert.True(t, sc.IsRandom())
+	assert.False(t, sc.IsSampled())
+}
+
 func TestStringTraceID(t *testing.T) {
 	for _, testcase := range []struct {
 		name string
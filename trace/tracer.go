@@ -34,4 +34,37 @@ type Tracer interface {
 	// Any Span that is created MUST also be ended. This is the responsibility of the user.
 	// Implementations of this API may leak memory or other resources if Spans are not ended.
 	Start(ctx context.Context, spanName string, opts ...SpanStartOption) (context.Context, Span)
+
+	// Enabled returns whether the Tracer creates recording spans for the
+	// given context and param.
+	//
+	// This is useful for users that want to know if a Span will be recorded
+	// before they perform expensive operations to construct the attributes,
+	// links, or name of the Span.
+	//
+	// The passed param is likely to be partial information about the Span
+	// being created (e.g. a param with only the SpanKind set). If a Tracer
+	// needs more information than is provided, it is said to be in an
+	// indeterminate state (see below).
+	//
+	// The returned value will be true when the Tracer may create a recording
+	// Span for the provided context and param, and will be false if the
+	// Tracer will not. The returned value may be true or false in an
+	// indeterminate state. An implementation should default to returning true
+	// for an indeterminate state, but may return false if valid reasons in
+	// particular circumstances exist (e.g. performance, correctness).
+	//
+	// The param should not be held by the implementation. A copy should be
+	// made if the param needs to be held after the call returns.
+	//
+	// Implementations of this method need to be safe for a user to call
+	// concurrently.
+	Enabled(ctx context.Context, param EnabledParameters) bool
+}
+
+// EnabledParameters represents payload for [Tracer]'s Enabled method.
+type EnabledParameters struct {
+	// SpanKind is the kind of the Span that would be created. It is
+	// SpanKindUnspecified if unknown.
+	SpanKind SpanKind
 }
@@ -6,20 +6,34 @@ package trace // import "go.opentelemetry.io/otel/trace"
 import (
 	"encoding/json"
 	"fmt"
+	"iter"
 	"strings"
 )
 
 const (
 	maxListMembers = 32
+	maxLength      = 512
 
 	listDelimiters  = ","
 	memberDelimiter = "="
 
-	errInvalidKey    errorConst = "invalid tracestate key"
-	errInvalidValue  errorConst = "invalid tracestate value"
-	errInvalidMember errorConst = "invalid tracestate list-member"
-	errMemberNumber  errorConst = "too many list-members in tracestate"
-	errDuplicate     errorConst = "duplicate list-member in tracestate"
+	fieldDelimiter      = ";"
+	fieldValueDelimiter = ":"
+
+	errInvalidKey        errorConst = "invalid tracestate key"
+	errInvalidValue      errorConst = "invalid tracestate value"
+	errInvalidMember     errorConst = "invalid tracestate list-member"
+	errMemberNumber      errorConst = "too many list-members in tracestate"
+	errDuplicate         errorConst = "duplicate list-member in tracestate"
+	errInvalidFieldKey   errorConst = "invalid tracestate field key"
+	errInvalidFieldValue errorConst = "invalid tracestate field value"
+
+	// ErrTraceStateMemberLimit is returned when an operation would result in
+	// a TraceState with more than 32 list-members.
+	ErrTraceStateMemberLimit = errMemberNumber
+	// ErrTraceStateLengthLimit is returned when an operation would result in
+	// a TraceState that encodes to more than 512 characters.
+	ErrTraceStateLengthLimit errorConst = "tracestate exceeds 512 characters"
 )
 
 type member struct {
@@ -226,16 +240,9 @@ func (ts TraceState) String() string {
 	if len(ts.list) == 0 {
 		return ""
 	}
-	var n int
-	n += len(ts.list)     // member delimiters: '='
-	n += len(ts.list) - 1 // list delimiters: ','
-	for _, mem := range ts.list {
-		n += len(mem.Key)
-		n += len(mem.Value)
-	}
 
 	var sb strings.Builder
-	sb.Grow(n)
+	sb.Grow(ts.encodedLen())
 	_, _ = sb.WriteString(ts.list[0].Key)
 	_ = sb.WriteByte('=')
 	_, _ = sb.WriteString(ts.list[0].Value)
@@ -248,6 +255,21 @@ func (ts TraceState) String() string {
 	return sb.String()
 }
 
+// encodedLen returns the length of the string encoding of ts.
+func (ts TraceState) encodedLen() int {
+	if len(ts.list) == 0 {
+		return 0
+	}
+	var n int
+	n += len(ts.list)     // member delimiters: '='
+	n += len(ts.list) - 1 // list delimiters: ','
+	for _, mem := range ts.list {
+		n += len(mem.Key)
+		n += len(mem.Value)
+	}
+	return n
+}
+
 // Get returns the value paired with key from the corresponding TraceState
 // list-member if it exists, otherwise an empty string is returned.
 func (ts TraceState) Get(key string) string {
@@ -262,6 +284,9 @@ func (ts TraceState) Get(key string) string {
 
 // Walk walks all key value pairs in the TraceState by calling f
 // Iteration stops if f returns false.
+//
+// Key value pairs are visited in the order they appear in the TraceState,
+// from left-most (most recently updated) to right-most.
 func (ts TraceState) Walk(f func(key, value string) bool) {
 	for _, m := range ts.list {
 		if !f(m.Key, m.Value) {
@@ -270,6 +295,13 @@ func (ts TraceState) Walk(f func(key, value string) bool) {
 	}
 }
 
+// All returns an iterator over all key value pairs in the TraceState. The
+// pairs are yielded in the order they appear in the TraceState, from
+// left-most (most recently updated) to right-most.
+func (ts TraceState) All() iter.Seq2[string, string] {
+	return ts.Walk
+}
+
 // Insert adds a new list-member defined by the key/value pair to the
 // TraceState. If a list-member already exists for the given key, that
 // list-member's value is updated. The new or updated list-member is always
@@ -309,6 +341,26 @@ func (ts TraceState) Insert(key, value string) (TraceState, error) {
 	return cTS, nil
 }
 
+// InsertStrict is like Insert, but instead of dropping the right-most
+// list-member when the TraceState is full it returns the original TraceState
+// and ErrTraceStateMemberLimit. It also returns the original TraceState and
+// ErrTraceStateLengthLimit if the resulting TraceState would encode to more
+// than 512 characters.
+func (ts TraceState) InsertStrict(key, value string) (TraceState, error) {
+	cTS, err := ts.Insert(key, value)
+	if err != nil {
+		return ts, err
+	}
+	if cTS.Len() == ts.Len() && ts.Get(key) == "" {
+		// A new list-member was added and the right-most was dropped.
+		return ts, ErrTraceStateMemberLimit
+	}
+	if cTS.encodedLen() > maxLength {
+		return ts, ErrTraceStateLengthLimit
+	}
+	return cTS, nil
+}
+
 // Delete returns a copy of the TraceState with the list-member identified by
 // key removed.
 func (ts TraceState) Delete(key string) TraceState {
@@ -328,3 +380,125 @@ func (ts TraceState) Delete(key string) TraceState {
 func (ts TraceState) Len() int {
 	return len(ts.list)
 }
+
+// GetField returns the value of the field within the value of the
+// list-member identified by key.
+//
+// Fields are a convention used by vendors (including OpenTelemetry itself) to
+// store multiple values within a single list-member value. Fields are
+// separated by ";" and each field is a name and value separated by ":"
+// (e.g. "ot=th:8;rv:9b8a0c1d2e3f40"). See
+// https://opentelemetry.io/docs/specs/otel/trace/tracestate-handling/.
+//
+// The returned bool is false if the list-member or the field does not exist.
+func (ts TraceState) GetField(key, field string) (string, bool) {
+	v := ts.Get(key)
+	for v != "" {
+		var f string
+		f, v, _ = strings.Cut(v, fieldDelimiter)
+		name, val, ok := strings.Cut(f, fieldValueDelimiter)
+		if ok && name == field {
+			return val, true
+		}
+	}
+	return "", false
+}
+
+// InsertField sets the field to value within the value of the list-member
+// identified by key. If the field already exists its value is updated in
+// place, otherwise the field is appended to the existing fields. The updated
+// list-member is moved to the beginning of the TraceState in the same way as
+// Insert.
+//
+// If field or value are invalid, or the resulting list-member value is
+// invalid according to the W3C Trace Context specification, an error is
+// returned with the original TraceState.
+func (ts TraceState) InsertField(key, field, value string) (TraceState, error) {
+	if !checkFieldKey(field) {
+		return ts, errInvalidFieldKey
+	}
+	if !checkFieldValue(value) {
+		return ts, errInvalidFieldValue
+	}
+
+	var sb strings.Builder
+	var found bool
+	current := ts.Get(key)
+	for current != "" {
+		var f string
+		f, current, _ = strings.Cut(current, fieldDelimiter)
+		if name, _, ok := strings.Cut(f, fieldValueDelimiter); ok && name == field {
+			found = true
+			f = field + fieldValueDelimiter + value
+		}
+		if sb.Len() > 0 {
+			_, _ = sb.WriteString(fieldDelimiter)
+		}
+		_, _ = sb.WriteString(f)
+	}
+	if !found {
+		if sb.Len() > 0 {
+			_, _ = sb.WriteString(fieldDelimiter)
+		}
+		_, _ = sb.WriteString(field)
+		_, _ = sb.WriteString(fieldValueDelimiter)
+		_, _ = sb.WriteString(value)
+	}
+	return ts.Insert(key, sb.String())
+}
+
+// DeleteField returns a copy of the TraceState with field removed from the
+// value of the list-member identified by key. If the list-member has no
+// fields left it is removed. If the field does not exist the original
+// TraceState is returned unchanged.
+func (ts TraceState) DeleteField(key, field string) TraceState {
+	if _, ok := ts.GetField(key, field); !ok {
+		return ts
+	}
+
+	var fields []string
+	current := ts.Get(key)
+	for current != "" {
+		var f string
+		f, current, _ = strings.Cut(current, fieldDelimiter)
+		if name, _, ok := strings.Cut(f, fieldValueDelimiter); ok && name == field {
+			continue
+		}
+		fields = append(fields, f)
+	}
+	if len(fields) == 0 {
+		return ts.Delete(key)
+	}
+	// The remaining fields were already valid, this cannot fail.
+	cTS, _ := ts.Insert(key, strings.Join(fields, fieldDelimiter))
+	return cTS
+}
+
+// checkFieldKey returns whether key is a valid field name. Field names are
+// non-empty and consist of lowercase letters and digits.
+func checkFieldKey(key string) bool {
+	if len(key) == 0 {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if !isAlphaNum(key[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkFieldValue returns whether val is a valid field value. Field values
+// are non-empty and may contain any character valid in a list-member value
+// other than a space or the field delimiter.
+func checkFieldValue(val string) bool {
+	if len(val) == 0 {
+		return false
+	}
+	for i := 0; i < len(val); i++ {
+		if !checkValueLast(val[i]) || val[i] == fieldDelimiter[0] {
+			return false
+		}
+	}
+	return true
+}
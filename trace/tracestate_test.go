@@ -6,6 +6,7 @@ package trace
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -546,6 +547,205 @@ func TestTraceStateInsert(t *testing.T) {
 	}
 }
 
+func TestTraceStateInsertStrict(t *testing.T) {
+	for _, tc := range insertTestcase {
+		if tc.name == "drop the right-most member(oldest) in queue" {
+			continue
+		}
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := tc.tracestate.InsertStrict(tc.key, tc.value)
+			assert.ErrorIs(t, err, tc.err, tc.name)
+			if tc.err != nil {
+				assert.Equal(t, tc.tracestate, actual)
+			} else {
+				assert.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+
+	t.Run("MemberLimit", func(t *testing.T) {
+		actual, err := maxMembers.InsertStrict("keyx", "valx")
+		assert.ErrorIs(t, err, ErrTraceStateMemberLimit)
+		assert.Equal(t, maxMembers, actual)
+
+		// Updating an existing member is allowed.
+		actual, err = maxMembers.InsertStrict("key16", "valx")
+		require.NoError(t, err)
+		assert.Equal(t, maxMembers.Len(), actual.Len())
+		assert.Equal(t, "valx", actual.Get("key16"))
+	})
+
+	t.Run("LengthLimit", func(t *testing.T) {
+		long := strings.Repeat("v", 250)
+		ts, err := TraceState{}.InsertStrict("a", long)
+		require.NoError(t, err)
+		ts, err = ts.InsertStrict("b", long)
+		require.NoError(t, err)
+
+		actual, err := ts.InsertStrict("c", long)
+		assert.ErrorIs(t, err, ErrTraceStateLengthLimit)
+		assert.Equal(t, ts, actual)
+	})
+}
+
+func TestTraceStateAll(t *testing.T) {
+	ts := TraceState{list: []member{
+		{Key: "key1", Value: "val1"},
+		{Key: "key2", Value: "val2"},
+		{Key: "key3", Value: "val3"},
+	}}
+
+	var got [][2]string
+	for k, v := range ts.All() {
+		got = append(got, [2]string{k, v})
+		if k == "key2" {
+			break
+		}
+	}
+	assert.Equal(t, [][2]string{{"key1", "val1"}, {"key2", "val2"}}, got)
+}
+
+func TestTraceStateGetField(t *testing.T) {
+	ts := TraceState{list: []member{
+		{Key: "ot", Value: "th:8;rv:9b8a0c1d2e3f40"},
+		{Key: "foo", Value: "bar"},
+	}}
+
+	v, ok := ts.GetField("ot", "th")
+	assert.True(t, ok)
+	assert.Equal(t, "8", v)
+
+	v, ok = ts.GetField("ot", "rv")
+	assert.True(t, ok)
+	assert.Equal(t, "9b8a0c1d2e3f40", v)
+
+	_, ok = ts.GetField("ot", "p")
+	assert.False(t, ok, "missing field")
+
+	_, ok = ts.GetField("foo", "bar")
+	assert.False(t, ok, "member without fields")
+
+	_, ok = ts.GetField("missing", "th")
+	assert.False(t, ok, "missing member")
+}
+
+func TestTraceStateInsertField(t *testing.T) {
+	ts := TraceState{list: []member{
+		{Key: "foo", Value: "bar"},
+		{Key: "ot", Value: "th:8;rv:9b8a0c1d2e3f40"},
+	}}
+
+	testCases := []struct {
+		name     string
+		key      string
+		field    string
+		value    string
+		expected TraceState
+		err      error
+	}{
+		{
+			name:  "update existing field",
+			key:   "ot",
+			field: "th",
+			value: "c",
+			expected: TraceState{list: []member{
+				{Key: "ot", Value: "th:c;rv:9b8a0c1d2e3f40"},
+				{Key: "foo", Value: "bar"},
+			}},
+		},
+		{
+			name:  "append new field",
+			key:   "ot",
+			field: "p",
+			value: "2",
+			expected: TraceState{list: []member{
+				{Key: "ot", Value: "th:8;rv:9b8a0c1d2e3f40;p:2"},
+				{Key: "foo", Value: "bar"},
+			}},
+		},
+		{
+			name:  "new member",
+			key:   "vendor",
+			field: "id",
+			value: "1",
+			expected: TraceState{list: []member{
+				{Key: "vendor", Value: "id:1"},
+				{Key: "foo", Value: "bar"},
+				{Key: "ot", Value: "th:8;rv:9b8a0c1d2e3f40"},
+			}},
+		},
+		{
+			name:  "invalid field key",
+			key:   "ot",
+			field: "T:H",
+			value: "1",
+			err:   errInvalidFieldKey,
+		},
+		{
+			name:  "invalid field value",
+			key:   "ot",
+			field: "th",
+			value: "a;b",
+			err:   errInvalidFieldValue,
+		},
+		{
+			name:  "empty field value",
+			key:   "ot",
+			field: "th",
+			value: "",
+			err:   errInvalidFieldValue,
+		},
+		{
+			name:  "invalid key",
+			key:   "OT",
+			field: "th",
+			value: "1",
+			err:   errInvalidKey,
+		},
+		{
+			name:  "value too long",
+			key:   "ot",
+			field: "x",
+			value: strings.Repeat("a", 256),
+			err:   errInvalidValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := ts.InsertField(tc.key, tc.field, tc.value)
+			assert.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				assert.Equal(t, ts, actual)
+			} else {
+				assert.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTraceStateDeleteField(t *testing.T) {
+	ts := TraceState{list: []member{
+		{Key: "foo", Value: "bar"},
+		{Key: "ot", Value: "th:8;rv:9b8a0c1d2e3f40"},
+		{Key: "single", Value: "a:1"},
+	}}
+
+	assert.Equal(t, TraceState{list: []member{
+		{Key: "ot", Value: "rv:9b8a0c1d2e3f40"},
+		{Key: "foo", Value: "bar"},
+		{Key: "single", Value: "a:1"},
+	}}, ts.DeleteField("ot", "th"))
+
+	assert.Equal(t, TraceState{list: []member{
+		{Key: "foo", Value: "bar"},
+		{Key: "ot", Value: "th:8;rv:9b8a0c1d2e3f40"},
+	}}, ts.DeleteField("single", "a"), "member with no fields left is removed")
+
+	assert.Equal(t, ts, ts.DeleteField("ot", "p"), "missing field")
+	assert.Equal(t, ts, ts.DeleteField("missing", "a"), "missing member")
+}
+
 func TestTraceStateLen(t *testing.T) {
 	ts := TraceState{}
 	assert.Equal(t, 0, ts.Len(), "zero value TraceState is empty")
@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package zpages provides in-process debug pages, similar to the OpenCensus
+zPages, to drill down into the spans and metrics of an application without a
+telemetry backend.
+
+Register a [SpanProcessor] with the TracerProvider and a ManualReader with the
+MeterProvider, and serve them with a [Handler]:
+
+	sp := zpages.NewSpanProcessor()
+	tp := trace.NewTracerProvider(trace.WithSpanProcessor(sp))
+
+	reader := metric.NewManualReader()
+	mp := metric.NewMeterProvider(metric.WithReader(reader))
+
+	http.Handle("/debug/", zpages.NewHandler(
+		zpages.WithSpanProcessor(sp),
+		zpages.WithMetricReader(reader),
+	))
+
+The tracez page is then served at /debug/tracez and the metricz page at
+/debug/metricz.
+*/
+package zpages // import "go.opentelemetry.io/otel/zpages"
@@ -0,0 +1,289 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages // import "go.opentelemetry.io/otel/zpages"
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type config struct {
+	spanProcessor *SpanProcessor
+	reader        *sdkmetric.ManualReader
+}
+
+// Option configures the Handler.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(c config) config {
+	return fn(c)
+}
+
+// WithSpanProcessor sets the SpanProcessor the tracez page displays the spans
+// of. The tracez page is empty if this option is not used.
+func WithSpanProcessor(sp *SpanProcessor) Option {
+	return optionFunc(func(c config) config {
+		c.spanProcessor = sp
+		return c
+	})
+}
+
+// WithMetricReader sets the ManualReader the metricz page collects the
+// current metric values with. The reader needs to be registered with the
+// MeterProvider of the metrics to display, and not be used for anything else:
+// with a delta temporality, each collection resets the values.
+//
+// The metricz page is empty if this option is not used.
+func WithMetricReader(r *sdkmetric.ManualReader) Option {
+	return optionFunc(func(c config) config {
+		c.reader = r
+		return c
+	})
+}
+
+// Handler serves the zPages:
+//
+//   - tracez: the number of active spans, of ended spans per latency bucket,
+//     and of errored spans, for each span name. The samples of each category
+//     are displayed by following the links.
+//   - metricz: the current value of all the metrics.
+//
+// The pages are served at the tracez and metricz paths relative to the path
+// the Handler is registered at, and an index of the pages is served at that
+// path:
+//
+//	http.Handle("/debug/", zpages.NewHandler(opts...))
+//
+// Use [NewHandler] to create a Handler.
+type Handler struct {
+	cfg config
+}
+
+// NewHandler returns a new Handler configured with opts.
+func NewHandler(opts ...Option) *Handler {
+	var c config
+	for _, o := range opts {
+		c = o.apply(c)
+	}
+	return &Handler{cfg: c}
+}
+
+// ServeHTTP serves the page of the request path.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var err error
+	switch {
+	case path.Base(r.URL.Path) == "tracez":
+		err = h.tracez(w, r)
+	case path.Base(r.URL.Path) == "metricz":
+		err = h.metricz(w, r)
+	case len(r.URL.Path) == 0 || r.URL.Path[len(r.URL.Path)-1] == '/':
+		err = indexTmpl.Execute(w, nil)
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// bucketLabels are the labels of the latency buckets.
+var bucketLabels = func() [len(latencyBounds)]string {
+	var labels [len(latencyBounds)]string
+	for i, b := range latencyBounds {
+		labels[i] = ">" + b.String()
+	}
+	return labels
+}()
+
+type tracezPage struct {
+	Buckets [len(latencyBounds)]string
+	Rows    []spanRow
+
+	// Name, Kind, and Bucket identify the displayed samples, if any.
+	Name    string
+	Kind    string
+	Bucket  int
+	Samples []spanSample
+}
+
+type spanSample struct {
+	TraceID    string
+	SpanID     string
+	ParentID   string
+	Start      string
+	Duration   string
+	Status     string
+	Attributes []attribute.KeyValue
+	Events     []sdktrace.Event
+}
+
+func newSpanSample(s sdktrace.ReadOnlySpan) spanSample {
+	sample := spanSample{
+		TraceID:    s.SpanContext().TraceID().String(),
+		SpanID:     s.SpanContext().SpanID().String(),
+		Start:      s.StartTime().Format(time.RFC3339Nano),
+		Status:     s.Status().Code.String(),
+		Attributes: s.Attributes(),
+		Events:     s.Events(),
+	}
+	if s.Parent().IsValid() {
+		sample.ParentID = s.Parent().SpanID().String()
+	}
+	if d := s.Status().Description; d != "" {
+		sample.Status += ": " + d
+	}
+	if end := s.EndTime(); !end.IsZero() {
+		sample.Duration = end.Sub(s.StartTime()).String()
+	} else {
+		sample.Duration = time.Since(s.StartTime()).String() + " (active)"
+	}
+	return sample
+}
+
+func (h *Handler) tracez(w http.ResponseWriter, r *http.Request) error {
+	page := tracezPage{Buckets: bucketLabels}
+	if sp := h.cfg.spanProcessor; sp != nil {
+		page.Rows = sp.rows()
+
+		q := r.URL.Query()
+		page.Name, page.Kind = q.Get("name"), q.Get("type")
+		page.Bucket, _ = strconv.Atoi(q.Get("bucket"))
+		if page.Name != "" {
+			for _, s := range sp.samples(page.Name, page.Kind, page.Bucket) {
+				page.Samples = append(page.Samples, newSpanSample(s))
+			}
+		}
+	}
+	return tracezTmpl.Execute(w, page)
+}
+
+type metriczPage struct {
+	Resource string
+	Metrics  []metricRow
+	Err      error
+}
+
+type metricRow struct {
+	Scope       string
+	Name        string
+	Description string
+	Unit        string
+	Type        string
+	Points      []pointRow
+}
+
+type pointRow struct {
+	Attributes string
+	Value      string
+}
+
+func (h *Handler) metricz(w http.ResponseWriter, r *http.Request) error {
+	var page metriczPage
+	if h.cfg.reader != nil {
+		var rm metricdata.ResourceMetrics
+		page.Err = h.cfg.reader.Collect(r.Context(), &rm)
+		if rm.Resource != nil {
+			page.Resource = rm.Resource.String()
+		}
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				row := metricRow{
+					Scope:       sm.Scope.Name,
+					Name:        m.Name,
+					Description: m.Description,
+					Unit:        m.Unit,
+				}
+				row.Type, row.Points = points(m.Data)
+				page.Metrics = append(page.Metrics, row)
+			}
+		}
+	}
+	return metriczTmpl.Execute(w, page)
+}
+
+// points returns the name of the type of data, and its data points.
+func points(data metricdata.Aggregation) (string, []pointRow) {
+	switch d := data.(type) {
+	case metricdata.Sum[int64]:
+		return "Sum", numberPoints(d.DataPoints)
+	case metricdata.Sum[float64]:
+		return "Sum", numberPoints(d.DataPoints)
+	case metricdata.Gauge[int64]:
+		return "Gauge", numberPoints(d.DataPoints)
+	case metricdata.Gauge[float64]:
+		return "Gauge", numberPoints(d.DataPoints)
+	case metricdata.Histogram[int64]:
+		return "Histogram", histogramPoints(d.DataPoints)
+	case metricdata.Histogram[float64]:
+		return "Histogram", histogramPoints(d.DataPoints)
+	case metricdata.ExponentialHistogram[int64]:
+		return "ExponentialHistogram", expHistogramPoints(d.DataPoints)
+	case metricdata.ExponentialHistogram[float64]:
+		return "ExponentialHistogram", expHistogramPoints(d.DataPoints)
+	case metricdata.Summary:
+		out := make([]pointRow, 0, len(d.DataPoints))
+		for _, dp := range d.DataPoints {
+			out = append(out, pointRow{
+				Attributes: dp.Attributes.Encoded(attribute.DefaultEncoder()),
+				Value:      fmt.Sprintf("count=%d sum=%v", dp.Count, dp.Sum),
+			})
+		}
+		return "Summary", out
+	default:
+		return fmt.Sprintf("%T", data), nil
+	}
+}
+
+func numberPoints[N int64 | float64](dps []metricdata.DataPoint[N]) []pointRow {
+	out := make([]pointRow, 0, len(dps))
+	for _, dp := range dps {
+		out = append(out, pointRow{
+			Attributes: dp.Attributes.Encoded(attribute.DefaultEncoder()),
+			Value:      fmt.Sprint(dp.Value),
+		})
+	}
+	return out
+}
+
+func histogramPoints[N int64 | float64](dps []metricdata.HistogramDataPoint[N]) []pointRow {
+	out := make([]pointRow, 0, len(dps))
+	for _, dp := range dps {
+		v := fmt.Sprintf("count=%d sum=%v", dp.Count, dp.Sum)
+		if m, ok := dp.Min.Value(); ok {
+			v += fmt.Sprintf(" min=%v", m)
+		}
+		if m, ok := dp.Max.Value(); ok {
+			v += fmt.Sprintf(" max=%v", m)
+		}
+		out = append(out, pointRow{
+			Attributes: dp.Attributes.Encoded(attribute.DefaultEncoder()),
+			Value:      v,
+		})
+	}
+	return out
+}
+
+func expHistogramPoints[N int64 | float64](dps []metricdata.ExponentialHistogramDataPoint[N]) []pointRow {
+	out := make([]pointRow, 0, len(dps))
+	for _, dp := range dps {
+		out = append(out, pointRow{
+			Attributes: dp.Attributes.Encoded(attribute.DefaultEncoder()),
+			Value:      fmt.Sprintf("count=%d sum=%v scale=%d", dp.Count, dp.Sum, dp.Scale),
+		})
+	}
+	return out
+}
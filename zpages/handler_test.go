@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func get(t *testing.T, h http.Handler, target string) (int, string) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, http.NoBody))
+	body, err := io.ReadAll(rec.Result().Body)
+	require.NoError(t, err)
+	return rec.Code, string(body)
+}
+
+func TestHandlerIndex(t *testing.T) {
+	h := NewHandler()
+
+	code, body := get(t, h, "/debug/")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, `href="tracez"`)
+	assert.Contains(t, body, `href="metricz"`)
+
+	code, _ = get(t, h, "/debug/unknown")
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestHandlerEmpty(t *testing.T) {
+	h := NewHandler()
+
+	code, body := get(t, h, "/debug/tracez")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "<h1>tracez</h1>")
+
+	code, body = get(t, h, "/debug/metricz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "<h1>metricz</h1>")
+}
+
+func TestHandlerTracez(t *testing.T) {
+	sp := NewSpanProcessor()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sp))
+	tr := tp.Tracer("TestHandlerTracez")
+
+	_, span := tr.Start(context.Background(), "<operation>")
+	span.SetAttributes(attribute.String("key", "value"))
+	span.SetStatus(codes.Error, "failure")
+	span.End()
+
+	h := NewHandler(WithSpanProcessor(sp))
+	code, body := get(t, h, "/debug/tracez")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "&lt;operation&gt;", "span name escaped")
+	assert.Contains(t, body, "&gt;0s</th>", "latency buckets")
+	assert.NotContains(t, body, "samples of")
+
+	code, body = get(t, h, "/debug/tracez?name=%3Coperation%3E&type=error")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "error samples of &lt;operation&gt;")
+	assert.Contains(t, body, span.SpanContext().TraceID().String())
+	assert.Contains(t, body, "Error: failure")
+	assert.Contains(t, body, "key=value")
+}
+
+func TestHandlerMetricz(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	m := mp.Meter("TestHandlerMetricz")
+
+	ctx := context.Background()
+	c, err := m.Int64Counter("requests", metric.WithDescription("Number of requests"), metric.WithUnit("{request}"))
+	require.NoError(t, err)
+	c.Add(ctx, 3, metric.WithAttributes(attribute.String("method", "GET")))
+	hist, err := m.Float64Histogram("latency")
+	require.NoError(t, err)
+	hist.Record(ctx, 1.5)
+
+	h := NewHandler(WithMetricReader(reader))
+	code, body := get(t, h, "/debug/metricz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "<td>requests</td>")
+	assert.Contains(t, body, "<td>Number of requests</td>")
+	assert.Contains(t, body, "<td>Sum</td>")
+	assert.Contains(t, body, "<td>method=GET</td>")
+	assert.Contains(t, body, "<td>3</td>")
+	assert.Contains(t, body, "<td>Histogram</td>")
+	assert.Contains(t, body, "count=1 sum=1.5 min=1.5 max=1.5")
+
+	require.NoError(t, mp.Shutdown(ctx))
+	_, body = get(t, h, "/debug/metricz")
+	assert.Contains(t, body, "Collection error")
+}
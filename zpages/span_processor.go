@@ -0,0 +1,231 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages // import "go.opentelemetry.io/otel/zpages"
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// samplesPerBucket is the number of span samples kept for each latency
+// bucket and for the errored spans of a span name.
+const samplesPerBucket = 10
+
+const (
+	// maxSpanNames is the maximum number of span names spans are kept for.
+	// The spans with other names are dropped once it is reached.
+	maxSpanNames = 1000
+	// maxActivePerName is the maximum number of active spans kept for a span
+	// name.
+	maxActivePerName = 1000
+)
+
+// latencyBounds are the lower bounds of the latency buckets, the same as the
+// ones of the OpenCensus zPages.
+var latencyBounds = [...]time.Duration{
+	0,
+	10 * time.Microsecond,
+	100 * time.Microsecond,
+	time.Millisecond,
+	10 * time.Millisecond,
+	100 * time.Millisecond,
+	time.Second,
+	10 * time.Second,
+	100 * time.Second,
+}
+
+// latencyBucket returns the index of the latency bucket of d.
+func latencyBucket(d time.Duration) int {
+	for i := len(latencyBounds) - 1; i > 0; i-- {
+		if d >= latencyBounds[i] {
+			return i
+		}
+	}
+	return 0
+}
+
+// Compile-time check SpanProcessor implements sdktrace.SpanProcessor.
+var _ sdktrace.SpanProcessor = (*SpanProcessor)(nil)
+
+// SpanProcessor is an [sdktrace.SpanProcessor] keeping the active spans and
+// samples of the ended spans, grouped by span name, for the tracez page of
+// the Handler.
+//
+// For each span name, the last 10 ended spans of each latency bucket and the
+// last 10 ended spans with an error status are kept. Spans are kept for at
+// most 1000 span names, and at most 1000 active spans are kept for each span
+// name. The spans exceeding these limits are dropped.
+//
+// Use [NewSpanProcessor] to create a SpanProcessor.
+type SpanProcessor struct {
+	mu    sync.Mutex
+	spans map[string]*spanSummary
+	// startNames are the names the kept active spans were started with. The
+	// name of a span can be changed before it ends.
+	startNames map[trace.SpanID]string
+}
+
+// NewSpanProcessor returns a new SpanProcessor. Register it with the
+// TracerProvider of the spans to display.
+func NewSpanProcessor() *SpanProcessor {
+	return &SpanProcessor{
+		spans:      make(map[string]*spanSummary),
+		startNames: make(map[trace.SpanID]string),
+	}
+}
+
+// spanSummary holds the spans of a span name.
+type spanSummary struct {
+	active  map[trace.SpanID]sdktrace.ReadOnlySpan
+	latency [len(latencyBounds)]samples
+	errors  samples
+}
+
+// summary returns the spanSummary of name, creating it if needed. It returns
+// nil if there is none and the maximum number of span names is reached.
+func (sp *SpanProcessor) summary(name string) *spanSummary {
+	s, ok := sp.spans[name]
+	if !ok {
+		if len(sp.spans) >= maxSpanNames {
+			return nil
+		}
+		s = &spanSummary{active: make(map[trace.SpanID]sdktrace.ReadOnlySpan)}
+		sp.spans[name] = s
+	}
+	return s
+}
+
+// OnStart records s as active.
+func (sp *SpanProcessor) OnStart(_ context.Context, s sdktrace.ReadWriteSpan) {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	summary := sp.summary(s.Name())
+	if summary == nil || len(summary.active) >= maxActivePerName {
+		return
+	}
+	id := s.SpanContext().SpanID()
+	summary.active[id] = s
+	sp.startNames[id] = s.Name()
+}
+
+// OnEnd records s as a sample of its latency bucket, or of the errored spans
+// if its status is an error. The sample is kept for the name s ended with.
+func (sp *SpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	id := s.SpanContext().SpanID()
+	if name, ok := sp.startNames[id]; ok {
+		delete(sp.spans[name].active, id)
+		delete(sp.startNames, id)
+	}
+	summary := sp.summary(s.Name())
+	if summary == nil {
+		return
+	}
+	if s.Status().Code == codes.Error {
+		summary.errors.add(s)
+		return
+	}
+	summary.latency[latencyBucket(s.EndTime().Sub(s.StartTime()))].add(s)
+}
+
+// Shutdown does nothing.
+func (*SpanProcessor) Shutdown(context.Context) error { return nil }
+
+// ForceFlush does nothing.
+func (*SpanProcessor) ForceFlush(context.Context) error { return nil }
+
+// samples is a ring buffer of the last span samples.
+type samples struct {
+	spans []sdktrace.ReadOnlySpan
+	next  int
+	// count is the total number of spans added.
+	count uint64
+}
+
+func (s *samples) add(span sdktrace.ReadOnlySpan) {
+	s.count++
+	if len(s.spans) < samplesPerBucket {
+		s.spans = append(s.spans, span)
+		return
+	}
+	s.spans[s.next] = span
+	s.next = (s.next + 1) % samplesPerBucket
+}
+
+// get returns the samples from the most recent to the oldest.
+func (s *samples) get() []sdktrace.ReadOnlySpan {
+	out := make([]sdktrace.ReadOnlySpan, 0, len(s.spans))
+	for i := len(s.spans) - 1; i >= 0; i-- {
+		out = append(out, s.spans[(s.next+i)%len(s.spans)])
+	}
+	return out
+}
+
+// spanRow is the summary of the spans of a span name.
+type spanRow struct {
+	Name    string
+	Active  int
+	Latency [len(latencyBounds)]uint64
+	Errors  uint64
+}
+
+// rows returns the summaries of all the span names, sorted by name.
+func (sp *SpanProcessor) rows() []spanRow {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	rows := make([]spanRow, 0, len(sp.spans))
+	for name, s := range sp.spans {
+		r := spanRow{Name: name, Active: len(s.active), Errors: s.errors.count}
+		for i := range s.latency {
+			r.Latency[i] = s.latency[i].count
+		}
+		rows = append(rows, r)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Name < rows[j].Name })
+	return rows
+}
+
+// Kinds of span samples.
+const (
+	sampleActive  = "active"
+	sampleLatency = "latency"
+	sampleError   = "error"
+)
+
+// samples returns the spans of kind for name. The bucket is only used for the
+// latency samples. The active spans are sorted by start time.
+func (sp *SpanProcessor) samples(name, kind string, bucket int) []sdktrace.ReadOnlySpan {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	s, ok := sp.spans[name]
+	if !ok {
+		return nil
+	}
+	switch kind {
+	case sampleActive:
+		out := make([]sdktrace.ReadOnlySpan, 0, len(s.active))
+		for _, span := range s.active {
+			out = append(out, span)
+		}
+		sort.Slice(out, func(i, j int) bool { return out[i].StartTime().Before(out[j].StartTime()) })
+		return out
+	case sampleLatency:
+		if bucket < 0 || bucket >= len(s.latency) {
+			return nil
+		}
+		return s.latency[bucket].get()
+	case sampleError:
+		return s.errors.get()
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestLatencyBucket(t *testing.T) {
+	for _, tc := range []struct {
+		d    time.Duration
+		want int
+	}{
+		{d: 0, want: 0},
+		{d: 9 * time.Microsecond, want: 0},
+		{d: 10 * time.Microsecond, want: 1},
+		{d: 5 * time.Millisecond, want: 3},
+		{d: time.Second, want: 6},
+		{d: time.Hour, want: 8},
+	} {
+		assert.Equal(t, tc.want, latencyBucket(tc.d), tc.d.String())
+	}
+}
+
+func TestSamples(t *testing.T) {
+	tp := sdktrace.NewTracerProvider()
+	tr := tp.Tracer("TestSamples")
+
+	var s samples
+	var names []string
+	for i := 0; i < samplesPerBucket+3; i++ {
+		name := strconv.Itoa(i)
+		names = append(names, name)
+		_, span := tr.Start(context.Background(), name)
+		span.End()
+		s.add(span.(sdktrace.ReadOnlySpan))
+	}
+	assert.Equal(t, uint64(samplesPerBucket+3), s.count)
+
+	got := s.get()
+	require.Len(t, got, samplesPerBucket)
+	for i, span := range got {
+		assert.Equal(t, names[len(names)-1-i], span.Name(), "most recent first")
+	}
+}
+
+func TestSpanProcessor(t *testing.T) {
+	sp := NewSpanProcessor()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sp))
+	tr := tp.Tracer("TestSpanProcessor")
+	ctx := context.Background()
+
+	start := time.Now()
+	_, active := tr.Start(ctx, "a", trace.WithTimestamp(start))
+	_, fast := tr.Start(ctx, "a", trace.WithTimestamp(start))
+	fast.End(trace.WithTimestamp(start.Add(time.Microsecond)))
+	_, slow := tr.Start(ctx, "a", trace.WithTimestamp(start))
+	slow.End(trace.WithTimestamp(start.Add(2 * time.Second)))
+	_, failed := tr.Start(ctx, "b")
+	failed.SetStatus(codes.Error, "failure")
+	failed.End()
+
+	assert.Equal(t, []spanRow{
+		{Name: "a", Active: 1, Latency: [len(latencyBounds)]uint64{0: 1, 6: 1}},
+		{Name: "b", Errors: 1},
+	}, sp.rows())
+
+	got := sp.samples("a", sampleActive, 0)
+	require.Len(t, got, 1)
+	assert.Equal(t, active.SpanContext(), got[0].SpanContext())
+
+	got = sp.samples("a", sampleLatency, 6)
+	require.Len(t, got, 1)
+	assert.Equal(t, slow.SpanContext(), got[0].SpanContext())
+
+	got = sp.samples("b", sampleError, 0)
+	require.Len(t, got, 1)
+	assert.Equal(t, failed.SpanContext(), got[0].SpanContext())
+
+	assert.Empty(t, sp.samples("a", sampleLatency, len(latencyBounds)), "invalid bucket")
+	assert.Empty(t, sp.samples("a", "invalid", 0), "invalid kind")
+	assert.Empty(t, sp.samples("unknown", sampleActive, 0), "unknown name")
+
+	active.End()
+	assert.Empty(t, sp.samples("a", sampleActive, 0))
+	assert.NoError(t, tp.Shutdown(ctx))
+}
+
+func TestSpanProcessorRenamedSpan(t *testing.T) {
+	sp := NewSpanProcessor()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sp))
+	tr := tp.Tracer("TestSpanProcessorRenamedSpan")
+
+	start := time.Now()
+	_, span := tr.Start(context.Background(), "start", trace.WithTimestamp(start))
+	span.SetName("end")
+	assert.Len(t, sp.samples("start", sampleActive, 0), 1)
+
+	span.End(trace.WithTimestamp(start))
+	assert.Empty(t, sp.samples("start", sampleActive, 0), "renamed span leaked")
+	assert.Empty(t, sp.startNames)
+	assert.Equal(t, []spanRow{
+		{Name: "end", Latency: [len(latencyBounds)]uint64{0: 1}},
+		{Name: "start"},
+	}, sp.rows())
+}
+
+func TestSpanProcessorLimits(t *testing.T) {
+	sp := NewSpanProcessor()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sp))
+	tr := tp.Tracer("TestSpanProcessorLimits")
+	ctx := context.Background()
+
+	spans := make([]trace.Span, 0, maxActivePerName+1)
+	for i := 0; i <= maxActivePerName; i++ {
+		_, span := tr.Start(ctx, "active")
+		spans = append(spans, span)
+	}
+	assert.Len(t, sp.samples("active", sampleActive, 0), maxActivePerName)
+	assert.Len(t, sp.startNames, maxActivePerName)
+	for _, span := range spans {
+		span.End()
+	}
+	assert.Empty(t, sp.samples("active", sampleActive, 0))
+	assert.Empty(t, sp.startNames)
+
+	for i := 1; i <= maxSpanNames; i++ {
+		_, span := tr.Start(ctx, strconv.Itoa(i))
+		span.End()
+	}
+	assert.Len(t, sp.spans, maxSpanNames)
+	_, ok := sp.spans[strconv.Itoa(maxSpanNames)]
+	assert.False(t, ok, "span name beyond the limit kept")
+}
@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages // import "go.opentelemetry.io/otel/zpages"
+
+import "html/template"
+
+const style = `<style>
+body { font-family: sans-serif; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 2px 6px; text-align: left; vertical-align: top; }
+</style>`
+
+var indexTmpl = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html><head><title>zPages</title>` + style + `</head><body>
+<h1>zPages</h1>
+<ul>
+<li><a href="tracez">tracez</a>: active, latency, and errored span samples</li>
+<li><a href="metricz">metricz</a>: current metric values</li>
+</ul>
+</body></html>
+`))
+
+var tracezTmpl = template.Must(template.New("tracez").Parse(`<!DOCTYPE html>
+<html><head><title>tracez</title>` + style + `</head><body>
+<h1>tracez</h1>
+<table>
+<tr><th>Span name</th><th>Active</th>{{range .Buckets}}<th>{{.}}</th>{{end}}<th>Errors</th></tr>
+{{- $buckets := .Buckets}}
+{{- range .Rows}}
+{{- $name := .Name}}
+<tr>
+<td>{{.Name}}</td>
+<td><a href="?name={{.Name}}&amp;type=active">{{.Active}}</a></td>
+{{- range $i, $n := .Latency}}
+<td><a href="?name={{$name}}&amp;type=latency&amp;bucket={{$i}}">{{$n}}</a></td>
+{{- end}}
+<td><a href="?name={{.Name}}&amp;type=error">{{.Errors}}</a></td>
+</tr>
+{{- end}}
+</table>
+{{- if .Name}}
+<h2>{{.Kind}} samples of {{.Name}}{{if eq .Kind "latency"}} ({{index .Buckets .Bucket}}){{end}}</h2>
+<table>
+<tr><th>Trace ID</th><th>Span ID</th><th>Parent ID</th><th>Start</th><th>Duration</th><th>Status</th><th>Attributes</th><th>Events</th></tr>
+{{- range .Samples}}
+<tr>
+<td>{{.TraceID}}</td>
+<td>{{.SpanID}}</td>
+<td>{{.ParentID}}</td>
+<td>{{.Start}}</td>
+<td>{{.Duration}}</td>
+<td>{{.Status}}</td>
+<td>{{range .Attributes}}{{.Key}}={{.Value.Emit}}<br>{{end}}</td>
+<td>{{range .Events}}{{.Name}}<br>{{end}}</td>
+</tr>
+{{- end}}
+</table>
+{{- end}}
+</body></html>
+`))
+
+var metriczTmpl = template.Must(template.New("metricz").Parse(`<!DOCTYPE html>
+<html><head><title>metricz</title>` + style + `</head><body>
+<h1>metricz</h1>
+{{- if .Err}}
+<p>Collection error: {{.Err}}</p>
+{{- end}}
+{{- if .Resource}}
+<p>Resource: {{.Resource}}</p>
+{{- end}}
+<table>
+<tr><th>Scope</th><th>Name</th><th>Description</th><th>Unit</th><th>Type</th><th>Attributes</th><th>Value</th></tr>
+{{- range .Metrics}}
+{{- $m := .}}
+{{- range .Points}}
+<tr>
+<td>{{$m.Scope}}</td>
+<td>{{$m.Name}}</td>
+<td>{{$m.Description}}</td>
+<td>{{$m.Unit}}</td>
+<td>{{$m.Type}}</td>
+<td>{{.Attributes}}</td>
+<td>{{.Value}}</td>
+</tr>
+{{- end}}
+{{- end}}
+</table>
+</body></html>
+`))